@@ -0,0 +1,82 @@
+package main
+
+// LangTag is a language tag from an Accept-Language header with its
+// quality weight.  Tag points into the parsed header.
+type LangTag struct {
+	Tag []byte
+	Q   float32
+}
+
+// ParseAcceptLanguage parses the value of an Accept-Language header into
+// its language tags, in the order given.  Each tag is either "*" or a BCP 47
+// (RFC 5646) tag, including the grandfathered ones.  A tag without a ";q="
+// weight has a weight of 1.  Tags are not canonicalized, sorted, or
+// dropped for having a weight of 0.
+func ParseAcceptLanguage(data []byte) ([]LangTag, bool) {
+	tags, ok, _ := ParseAcceptLanguageAt(data)
+	return tags, ok
+}
+
+// ParseAcceptLanguageAt is like ParseAcceptLanguage but also returns the
+// offset of the first byte that is out of place, len(data) if the header
+// ends in the middle of a range, or -1 if it parsed.
+func ParseAcceptLanguageAt(data []byte) ([]LangTag, bool, int) {
+
+%% machine acceptlang;
+%% write data;
+
+	var tags []LangTag
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var q, scale int
+
+	%%{
+	    action mark   { mark = p }
+	    action tag    { tags = append(tags, LangTag{Tag: data[mark:p], Q: 1}) }
+	    action q_int  { q, scale = int(fc-'0')*1000, 100 }
+	    action q_frac { q += int(fc-'0') * scale; scale /= 10 }
+	    action weight { tags[len(tags)-1].Q = float32(q) / 1000 }
+
+	    # RFC 5646 section 2.1
+	    extlang = alpha{3} ( '-' alpha{3} ){0,2} ;
+	    language = alpha{2,3} ( '-' extlang )? | alpha{4} | alpha{5,8} ;
+	    script = alpha{4} ;
+	    region = alpha{2} | digit{3} ;
+	    variant = alnum{5,8} | digit alnum{3} ;
+	    singleton = digit | [a-wyzA-WYZ] ;
+	    extension = singleton ( '-' alnum{2,8} )+ ;
+	    privateuse = [xX] ( '-' alnum{1,8} )+ ;
+
+	    langtag = language ( '-' script )? ( '-' region )? ( '-' variant )* ( '-' extension )* ( '-' privateuse )? ;
+
+	    irregular = 'en-GB-oed'i | 'i-ami'i | 'i-bnn'i | 'i-default'i | 'i-enochian'i
+	              | 'i-hak'i | 'i-klingon'i | 'i-lux'i | 'i-mingo'i | 'i-navajo'i
+	              | 'i-pwn'i | 'i-tao'i | 'i-tay'i | 'i-tsu'i | 'sgn-BE-FR'i
+	              | 'sgn-BE-NL'i | 'sgn-CH-DE'i ;
+
+	    # the regular grandfathered tags, such as zh-min-nan, already fit langtag
+	    tag = langtag | privateuse | irregular | '*' ;
+
+	    ows = [ \t]* ;
+
+	    qvalue = '0' @q_int ( '.' digit{0,3} $q_frac )?
+	           | '1' @q_int ( '.' '0'{0,3} )? ;
+
+	    weight = ows ';' ows [qQ] '=' qvalue %weight ;
+
+	    range = tag >mark %tag weight? ;
+
+	    main := ows range ( ows ',' ows range )* ows ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < acceptlang_first_final {
+		return nil, false, p
+	}
+
+	return tags, true, -1
+}
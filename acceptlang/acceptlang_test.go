@@ -0,0 +1,136 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+var data = []byte("fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5")
+
+var hits int
+
+type weighted struct {
+	tag string
+	q   float32
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []weighted
+		ok     bool
+	}{
+		{
+			header: string(data),
+			want:   []weighted{{"fr-CH", 1}, {"fr", 0.9}, {"en", 0.8}, {"de", 0.7}, {"*", 0.5}},
+			ok:     true,
+		},
+		{header: "en-US", want: []weighted{{"en-US", 1}}, ok: true},
+		{header: "*", want: []weighted{{"*", 1}}, ok: true},
+		{
+			header: "zh-Hant-TW;q=1.000,zh-yue-HK ; Q=0.123,sr-Latn-RS,es-419;q=0.",
+			want:   []weighted{{"zh-Hant-TW", 1}, {"zh-yue-HK", 0.123}, {"sr-Latn-RS", 1}, {"es-419", 0}},
+			ok:     true,
+		},
+		{
+			header: "de-CH-1901, sl-rozaj-biske, en-a-myext-b-another, x-whatever, i-klingon, zh-min-nan, en-US-x-twain;q=0",
+			want: []weighted{
+				{"de-CH-1901", 1}, {"sl-rozaj-biske", 1}, {"en-a-myext-b-another", 1},
+				{"x-whatever", 1}, {"i-klingon", 1}, {"zh-min-nan", 1}, {"en-US-x-twain", 0},
+			},
+			ok: true,
+		},
+
+		{header: "en;q=1.5"},
+		{header: "en;q=1.001"},
+		{header: "en;q=0.1234"},
+		{header: "en;q=.5"},
+		{header: "en;q="},
+		{header: "en;level=1"},
+		{header: "englishlanguage"},
+		{header: "e"},
+		{header: "en-"},
+		{header: "en--US"},
+		{header: "en_US"},
+		{header: "en,,fr"},
+		{header: "en,"},
+		{header: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseAcceptLanguage([]byte(tt.header))
+		if ok != tt.ok {
+			t.Errorf("ParseAcceptLanguage(%q) ok=%v, want %v", tt.header, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var tags []weighted
+		for _, l := range got {
+			tags = append(tags, weighted{string(l.Tag), l.Q})
+		}
+		if !reflect.DeepEqual(tags, tt.want) {
+			t.Errorf("ParseAcceptLanguage(%q)=%v, want %v", tt.header, tags, tt.want)
+		}
+	}
+}
+
+func TestParseAcceptLanguageAt(t *testing.T) {
+	tests := []struct {
+		header string
+		errPos int
+	}{
+		{string(data), -1},
+		{"en;q=2", 5},
+		{"fr;q=0.1234", 10},
+		{"en-US,,fr", 6},
+		{"englishes", 8},
+		{"en-", 3},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseAcceptLanguageAt([]byte(tt.header))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseAcceptLanguageAt(%q)=%v, %d, want errPos %d", tt.header, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestAcceptLanguageAlternatives(t *testing.T) {
+	want, _ := ParseAcceptLanguage(data)
+
+	tags, qs, err := language.ParseAcceptLanguage(string(data))
+	if err != nil || len(tags) != len(want) {
+		t.Fatalf("language.ParseAcceptLanguage=%v %v (%v), want %d tags", tags, qs, err, len(want))
+	}
+	for i := range tags {
+		// x/text reports "*" as mul, for multiple languages
+		name := tags[i].String()
+		if name == "mul" {
+			name = "*"
+		}
+		if name != string(want[i].Tag) || qs[i] != want[i].Q {
+			t.Errorf("language.ParseAcceptLanguage[%d]=%s;q=%v, want %s;q=%v", i, name, qs[i], want[i].Tag, want[i].Q)
+		}
+	}
+}
+
+func BenchmarkXText(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, _, err := language.ParseAcceptLanguage(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseAcceptLanguage(data); ok {
+			hits++
+		}
+	}
+}
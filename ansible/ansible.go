@@ -0,0 +1,688 @@
+
+//line ansible.rl:1
+package main
+
+// PatternPart is one entry in an Ansible host pattern.  Host is the entry
+// as written, less any leading operator, such as "web[01:50].example.com"
+// or "webservers".  It is a literal host or group name when Ranges is
+// empty, and otherwise a set of hosts with one name for each combination
+// of values of its ranges.  The byte slices point into the parsed pattern.
+type PatternPart struct {
+	// Op is '!' for hosts to leave out, '&' for hosts that must also match
+	// the rest of the pattern, and 0 for hosts to add.
+	Op     byte
+	Host   []byte
+	Ranges []HostRange
+}
+
+// HostRange is a "[start:end:step]" range in the Host of a PatternPart.
+// Start and End are either both numbers or both single letters; numbers
+// with a leading zero are padded to the same width when expanded, so
+// "[01:10]" starts at "01".  Step is 1 if it is left out.  The bracketed
+// range is Host[Pos:Pos+Len].
+type HostRange struct {
+	Start, End []byte
+	Step       int
+	Pos, Len   int
+}
+
+// ParseAnsiblePattern parses a host pattern as given to ansible's --limit
+// or a play's hosts, such as
+//
+//	webservers:!phoenix,web[01:50:2].example.com,&db[a:f].internal
+//
+// The entries are separated by commas or by the older colons, with spaces
+// allowed around either, and may contain the * and ? wildcards.  Ranges
+// follow the rules of Ansible's inventory: letters run through a-z and
+// then A-Z, so "[x:B]" is fine but "[B:x]" is not, and a zero padded
+// start must have the same width as the end.  A numeric range that runs
+// backwards is allowed but expands to no hosts.  Regular expressions and
+// IPv6 addresses, which Ansible also accepts, are not.
+func ParseAnsiblePattern(data []byte) ([]PatternPart, bool) {
+	parts, ok, _ := ParseAnsiblePatternAt(data)
+	return parts, ok
+}
+
+// ParseAnsiblePatternAt is like ParseAnsiblePattern but also returns the
+// offset of the first byte that doesn't fit, or -1 if data parsed.  A
+// range with an end it can't reach fails at the end, and one with a step
+// of zero at the step.
+func ParseAnsiblePatternAt(data []byte) ([]PatternPart, bool, int) {
+
+
+//line ansible.rl:51
+
+//line ansible.go:56
+const ansible_start int = 1
+const ansible_first_final int = 20
+const ansible_error int = 0
+
+const ansible_en_main int = 1
+
+
+//line ansible.rl:52
+
+	var parts []PatternPart
+	var part PatternPart
+	var r HostRange
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark, num := 0, 0
+	endAt, stepAt, bad := 0, 0, -1
+
+	
+//line ansible.go:76
+	{
+	cs = ansible_start
+	}
+
+//line ansible.go:81
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	}
+	goto st_out
+tr27:
+//line ansible.rl:88
+
+	        part.Host = data[mark:p]
+	        parts = append(parts, part)
+	        part = PatternPart{}
+	    
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line ansible.go:146
+		switch data[p] {
+		case 32:
+			goto st1
+		case 33:
+			goto tr2
+		case 38:
+			goto tr2
+		case 42:
+			goto tr3
+		case 63:
+			goto tr3
+		case 91:
+			goto tr4
+		case 95:
+			goto tr3
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr3
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr3
+				}
+			case data[p] >= 65:
+				goto tr3
+			}
+		default:
+			goto tr3
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr2:
+//line ansible.rl:64
+ part.Op = (data[p]) 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line ansible.go:194
+		switch data[p] {
+		case 42:
+			goto tr3
+		case 63:
+			goto tr3
+		case 91:
+			goto tr4
+		case 95:
+			goto tr3
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr3
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr3
+				}
+			case data[p] >= 65:
+				goto tr3
+			}
+		default:
+			goto tr3
+		}
+		goto st0
+tr3:
+//line ansible.rl:63
+ mark = p 
+	goto st20
+tr12:
+//line ansible.rl:67
+ r.End = data[num:p]; endAt = num 
+//line ansible.rl:74
+
+	        r.Len = p + 1 - mark - r.Pos
+	        switch {
+	        case bad >= 0:
+	        case r.Step == 0:
+	            bad = stepAt
+	        case r.Start[0] == '0' && len(r.Start) > 1 && len(r.Start) != len(r.End):
+	            bad = endAt
+	        case !isDigit(r.Start[0]) && letterIndex(r.Start[0]) > letterIndex(r.End[0]):
+	            bad = endAt
+	        }
+	        part.Ranges = append(part.Ranges, r)
+	    
+	goto st20
+tr15:
+//line ansible.rl:74
+
+	        r.Len = p + 1 - mark - r.Pos
+	        switch {
+	        case bad >= 0:
+	        case r.Step == 0:
+	            bad = stepAt
+	        case r.Start[0] == '0' && len(r.Start) > 1 && len(r.Start) != len(r.End):
+	            bad = endAt
+	        case !isDigit(r.Start[0]) && letterIndex(r.Start[0]) > letterIndex(r.End[0]):
+	            bad = endAt
+	        }
+	        part.Ranges = append(part.Ranges, r)
+	    
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line ansible.go:266
+		switch data[p] {
+		case 32:
+			goto tr25
+		case 42:
+			goto st20
+		case 44:
+			goto tr27
+		case 58:
+			goto tr27
+		case 63:
+			goto st20
+		case 91:
+			goto tr28
+		case 95:
+			goto st20
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st20
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st20
+				}
+			case data[p] >= 65:
+				goto st20
+			}
+		default:
+			goto st20
+		}
+		goto st0
+tr25:
+//line ansible.rl:88
+
+	        part.Host = data[mark:p]
+	        parts = append(parts, part)
+	        part = PatternPart{}
+	    
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line ansible.go:314
+		switch data[p] {
+		case 32:
+			goto st21
+		case 44:
+			goto st1
+		case 58:
+			goto st1
+		}
+		goto st0
+tr4:
+//line ansible.rl:63
+ mark = p 
+//line ansible.rl:70
+
+	        r = HostRange{Step: 1, Pos: p - mark}
+	    
+	goto st3
+tr28:
+//line ansible.rl:70
+
+	        r = HostRange{Step: 1, Pos: p - mark}
+	    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line ansible.go:343
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr5
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr6
+			}
+		default:
+			goto tr6
+		}
+		goto st0
+tr5:
+//line ansible.rl:65
+ num = p 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line ansible.go:366
+		if data[p] == 58 {
+			goto tr8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st4
+		}
+		goto st0
+tr8:
+//line ansible.rl:66
+ r.Start = data[num:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line ansible.go:383
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr9
+		}
+		goto st0
+tr9:
+//line ansible.rl:65
+ num = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line ansible.go:397
+		switch data[p] {
+		case 58:
+			goto tr11
+		case 93:
+			goto tr12
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st6
+		}
+		goto st0
+tr11:
+//line ansible.rl:67
+ r.End = data[num:p]; endAt = num 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line ansible.go:417
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr13
+		}
+		goto st0
+tr13:
+//line ansible.rl:97
+ r.Step = 0; stepAt = p 
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line ansible.go:433
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+tr14:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line ansible.go:450
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr16
+		}
+		goto st0
+tr16:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line ansible.go:467
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr17
+		}
+		goto st0
+tr17:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line ansible.go:484
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr18
+		}
+		goto st0
+tr18:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line ansible.go:501
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr19
+		}
+		goto st0
+tr19:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line ansible.go:518
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line ansible.go:535
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr21
+		}
+		goto st0
+tr21:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line ansible.go:552
+		if data[p] == 93 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr22
+		}
+		goto st0
+tr22:
+//line ansible.rl:68
+ r.Step = r.Step*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line ansible.go:569
+		if data[p] == 93 {
+			goto tr15
+		}
+		goto st0
+tr6:
+//line ansible.rl:65
+ num = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line ansible.go:583
+		if data[p] == 58 {
+			goto tr23
+		}
+		goto st0
+tr23:
+//line ansible.rl:66
+ r.Start = data[num:p] 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line ansible.go:597
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr24
+			}
+		case data[p] >= 65:
+			goto tr24
+		}
+		goto st0
+tr24:
+//line ansible.rl:65
+ num = p 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line ansible.go:616
+		switch data[p] {
+		case 58:
+			goto tr11
+		case 93:
+			goto tr12
+		}
+		goto st0
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 20:
+//line ansible.rl:88
+
+	        part.Host = data[mark:p]
+	        parts = append(parts, part)
+	        part = PatternPart{}
+	    
+//line ansible.go:657
+		}
+	}
+
+	_out: {}
+	}
+
+//line ansible.rl:111
+
+
+	if cs < ansible_first_final {
+		return nil, false, p
+	}
+
+	if bad >= 0 {
+		return nil, false, bad
+	}
+
+	return parts, true, -1
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// letterIndex gives c's position in Python's string.ascii_letters, which is
+// the order Ansible expands letter ranges in.
+func letterIndex(c byte) int {
+	if c >= 'a' {
+		return int(c - 'a')
+	}
+	return int(c-'A') + 26
+}
@@ -0,0 +1,135 @@
+package main
+
+// PatternPart is one entry in an Ansible host pattern.  Host is the entry
+// as written, less any leading operator, such as "web[01:50].example.com"
+// or "webservers".  It is a literal host or group name when Ranges is
+// empty, and otherwise a set of hosts with one name for each combination
+// of values of its ranges.  The byte slices point into the parsed pattern.
+type PatternPart struct {
+	// Op is '!' for hosts to leave out, '&' for hosts that must also match
+	// the rest of the pattern, and 0 for hosts to add.
+	Op     byte
+	Host   []byte
+	Ranges []HostRange
+}
+
+// HostRange is a "[start:end:step]" range in the Host of a PatternPart.
+// Start and End are either both numbers or both single letters; numbers
+// with a leading zero are padded to the same width when expanded, so
+// "[01:10]" starts at "01".  Step is 1 if it is left out.  The bracketed
+// range is Host[Pos:Pos+Len].
+type HostRange struct {
+	Start, End []byte
+	Step       int
+	Pos, Len   int
+}
+
+// ParseAnsiblePattern parses a host pattern as given to ansible's --limit
+// or a play's hosts, such as
+//
+//	webservers:!phoenix,web[01:50:2].example.com,&db[a:f].internal
+//
+// The entries are separated by commas or by the older colons, with spaces
+// allowed around either, and may contain the * and ? wildcards.  Ranges
+// follow the rules of Ansible's inventory: letters run through a-z and
+// then A-Z, so "[x:B]" is fine but "[B:x]" is not, and a zero padded
+// start must have the same width as the end.  A numeric range that runs
+// backwards is allowed but expands to no hosts.  Regular expressions and
+// IPv6 addresses, which Ansible also accepts, are not.
+func ParseAnsiblePattern(data []byte) ([]PatternPart, bool) {
+	parts, ok, _ := ParseAnsiblePatternAt(data)
+	return parts, ok
+}
+
+// ParseAnsiblePatternAt is like ParseAnsiblePattern but also returns the
+// offset of the first byte that doesn't fit, or -1 if data parsed.  A
+// range with an end it can't reach fails at the end, and one with a step
+// of zero at the step.
+func ParseAnsiblePatternAt(data []byte) ([]PatternPart, bool, int) {
+
+%% machine ansible;
+%% write data;
+
+	var parts []PatternPart
+	var part PatternPart
+	var r HostRange
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark, num := 0, 0
+	endAt, stepAt, bad := 0, 0, -1
+
+	%%{
+	    action mark  { mark = p }
+	    action op    { part.Op = fc }
+	    action num   { num = p }
+	    action start { r.Start = data[num:p] }
+	    action end   { r.End = data[num:p]; endAt = num }
+	    action step  { r.Step = r.Step*10 + int(fc-'0') }
+
+	    action open {
+	        r = HostRange{Step: 1, Pos: p - mark}
+	    }
+
+	    action close {
+	        r.Len = p + 1 - mark - r.Pos
+	        switch {
+	        case bad >= 0:
+	        case r.Step == 0:
+	            bad = stepAt
+	        case r.Start[0] == '0' && len(r.Start) > 1 && len(r.Start) != len(r.End):
+	            bad = endAt
+	        case !isDigit(r.Start[0]) && letterIndex(r.Start[0]) > letterIndex(r.End[0]):
+	            bad = endAt
+	        }
+	        part.Ranges = append(part.Ranges, r)
+	    }
+
+	    action part {
+	        part.Host = data[mark:p]
+	        parts = append(parts, part)
+	        part = PatternPart{}
+	    }
+
+	    bounds = digit+ >num %start ':' digit+ >num %end
+	           | alpha >num %start ':' alpha >num %end ;
+
+	    step = ':' digit{1,9} >{ r.Step = 0; stepAt = p } $step ;
+
+	    range = '[' @open bounds step? ']' @close ;
+
+	    host = ( alnum | [\-._*?] | range )+ ;
+
+	    entry = ( [!&] @op )? host >mark %part ;
+
+	    sep = ' '* [,:] ' '* ;
+
+	    main := ' '* entry ( sep entry )* ' '* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < ansible_first_final {
+		return nil, false, p
+	}
+
+	if bad >= 0 {
+		return nil, false, bad
+	}
+
+	return parts, true, -1
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// letterIndex gives c's position in Python's string.ascii_letters, which is
+// the order Ansible expands letter ranges in.
+func letterIndex(c byte) int {
+	if c >= 'a' {
+		return int(c - 'a')
+	}
+	return int(c-'A') + 26
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var data = []byte("webservers:!phoenix,web[01:50:2].example.com,&db[a:f].internal")
+
+var hits int
+
+func TestParseAnsiblePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []PatternPart
+		ok      bool
+	}{
+		{
+			pattern: string(data),
+			want: []PatternPart{
+				{Host: []byte("webservers")},
+				{Op: '!', Host: []byte("phoenix")},
+				{Host: []byte("web[01:50:2].example.com"), Ranges: []HostRange{{Start: []byte("01"), End: []byte("50"), Step: 2, Pos: 3, Len: 9}}},
+				{Op: '&', Host: []byte("db[a:f].internal"), Ranges: []HostRange{{Start: []byte("a"), End: []byte("f"), Step: 1, Pos: 2, Len: 5}}},
+			},
+			ok: true,
+		},
+		{
+			pattern: "web1:web2, db1",
+			want:    []PatternPart{{Host: []byte("web1")}, {Host: []byte("web2")}, {Host: []byte("db1")}},
+			ok:      true,
+		},
+		{
+			pattern: "rack[1:2]-node[x:B]",
+			want: []PatternPart{
+				{Host: []byte("rack[1:2]-node[x:B]"), Ranges: []HostRange{
+					{Start: []byte("1"), End: []byte("2"), Step: 1, Pos: 4, Len: 5},
+					{Start: []byte("x"), End: []byte("B"), Step: 1, Pos: 14, Len: 5},
+				}},
+			},
+			ok: true,
+		},
+		{
+			pattern: "[0:9]",
+			want:    []PatternPart{{Host: []byte("[0:9]"), Ranges: []HostRange{{Start: []byte("0"), End: []byte("9"), Step: 1, Pos: 0, Len: 5}}}},
+			ok:      true,
+		},
+		{
+			pattern: "*.example.com,web??",
+			want:    []PatternPart{{Host: []byte("*.example.com")}, {Host: []byte("web??")}},
+			ok:      true,
+		},
+		// backwards, but only numbers are checked for that by Ansible
+		{
+			pattern: "web[5:1]",
+			want:    []PatternPart{{Host: []byte("web[5:1]"), Ranges: []HostRange{{Start: []byte("5"), End: []byte("1"), Step: 1, Pos: 3, Len: 5}}}},
+			ok:      true,
+		},
+
+		{pattern: "web[1:]"},
+		{pattern: "web[:5]"},
+		{pattern: "web[a:5]"},
+		{pattern: "web[aa:b]"},
+		{pattern: "web[B:x]"},
+		{pattern: "web[01:100]"},
+		{pattern: "web[1:10:0]"},
+		{pattern: "web[1:2:3:4]"},
+		{pattern: "web[1:10"},
+		{pattern: "web]"},
+		{pattern: "web1,,web2"},
+		{pattern: "web 1"},
+		{pattern: "!"},
+		{pattern: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseAnsiblePattern([]byte(tt.pattern))
+		if ok != tt.ok {
+			t.Errorf("ParseAnsiblePattern(%q) ok=%v, want %v", tt.pattern, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseAnsiblePattern(%q)=%+v, want %+v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestParseAnsiblePatternAt(t *testing.T) {
+	tests := []struct {
+		pattern string
+		errPos  int
+	}{
+		{string(data), -1},
+		{"web[a:5]", 6},
+		{"web[B:x]", 6},
+		{"web[01:100]", 7},
+		{"web[1:10:0]", 9},
+		{"web[1:10", 8},
+		{"web1,,web2", 5},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseAnsiblePatternAt([]byte(tt.pattern))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseAnsiblePatternAt(%q)=%v, %d, want errPos %d", tt.pattern, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+const asciiLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// expand lists the host names p stands for, the way Ansible's
+// expand_hostname_range does.
+func expand(p PatternPart) []string {
+	hosts := []string{""}
+	last := 0
+	for _, r := range p.Ranges {
+		var values []string
+		if isDigit(r.Start[0]) {
+			start, _ := strconv.Atoi(string(r.Start))
+			end, _ := strconv.Atoi(string(r.End))
+			width := 0
+			if r.Start[0] == '0' {
+				width = len(r.Start)
+			}
+			for i := start; i <= end; i += r.Step {
+				values = append(values, fmt.Sprintf("%0*d", width, i))
+			}
+		} else {
+			for i := letterIndex(r.Start[0]); i <= letterIndex(r.End[0]); i += r.Step {
+				values = append(values, asciiLetters[i:i+1])
+			}
+		}
+
+		var next []string
+		for _, h := range hosts {
+			for _, v := range values {
+				next = append(next, h+string(p.Host[last:r.Pos])+v)
+			}
+		}
+		hosts = next
+		last = r.Pos + r.Len
+	}
+
+	for i := range hosts {
+		hosts[i] += string(p.Host[last:])
+	}
+	return hosts
+}
+
+func TestHostRangeExpansion(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"web", []string{"web"}},
+		{"web[01:03].example.com", []string{"web01.example.com", "web02.example.com", "web03.example.com"}},
+		{"web[0:10:5]", []string{"web0", "web5", "web10"}},
+		{"db-[y:B]", []string{"db-y", "db-z", "db-A", "db-B"}},
+		{"r[1:2]n[a:b]", []string{"r1na", "r1nb", "r2na", "r2nb"}},
+		{"web[5:1]", nil},
+	}
+
+	for _, tt := range tests {
+		parts, ok := ParseAnsiblePattern([]byte(tt.pattern))
+		if !ok || len(parts) != 1 {
+			t.Errorf("ParseAnsiblePattern(%q)=%+v, %v", tt.pattern, parts, ok)
+			continue
+		}
+		if got := expand(parts[0]); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("expand(%q)=%q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+// reAnsibleEntry is the findall that Ansible's split_host_pattern uses to
+// split on colons, extended to commas, which it splits on first.
+var reAnsibleEntry = regexp.MustCompile(`(?:[^\s:\[\],]|\[[^\]]*\])+`)
+
+var reAnsibleRange = regexp.MustCompile(`\[([^\]]*)\]`)
+
+// regexAnsiblePattern follows what Ansible does with a pattern in Python:
+// regular expressions to find the entries and their ranges, and then
+// splitting each range on its colons.
+func regexAnsiblePattern(pattern []byte) ([]PatternPart, bool) {
+	var parts []PatternPart
+	for _, e := range reAnsibleEntry.FindAll(pattern, -1) {
+		var part PatternPart
+		if e[0] == '!' || e[0] == '&' {
+			part.Op, e = e[0], e[1:]
+		}
+		part.Host = e
+
+		for _, m := range reAnsibleRange.FindAllSubmatchIndex(e, -1) {
+			bounds := strings.Split(string(e[m[2]:m[3]]), ":")
+			if len(bounds) != 2 && len(bounds) != 3 {
+				return nil, false
+			}
+			r := HostRange{Step: 1, Pos: m[0], Len: m[1] - m[0]}
+			r.Start = e[m[2] : m[2]+len(bounds[0])]
+			r.End = e[m[2]+len(bounds[0])+1 : m[2]+len(bounds[0])+1+len(bounds[1])]
+			if len(bounds) == 3 {
+				step, err := strconv.Atoi(bounds[2])
+				if err != nil || step <= 0 {
+					return nil, false
+				}
+				r.Step = step
+			}
+
+			beg, end := bounds[0], bounds[1]
+			if beg == "" || end == "" {
+				return nil, false
+			}
+			if beg[0] == '0' && len(beg) > 1 && len(beg) != len(end) {
+				return nil, false
+			}
+			ib, ie := strings.Index(asciiLetters, beg), strings.Index(asciiLetters, end)
+			if len(beg) == 1 && len(end) == 1 && ib >= 0 && ie >= 0 {
+				if ib > ie {
+					return nil, false
+				}
+			} else if _, err := strconv.Atoi(beg); err != nil {
+				return nil, false
+			} else if _, err := strconv.Atoi(end); err != nil {
+				return nil, false
+			}
+
+			part.Ranges = append(part.Ranges, r)
+		}
+		parts = append(parts, part)
+	}
+	return parts, len(parts) > 0
+}
+
+func TestAnsibleAlternatives(t *testing.T) {
+	for _, pattern := range []string{string(data), "web1:web2, db1", "rack[1:2]-node[x:B]", "web[01:100]", "web[B:x]"} {
+		want, wantOK := ParseAnsiblePattern([]byte(pattern))
+		if got, ok := regexAnsiblePattern([]byte(pattern)); ok != wantOK || !reflect.DeepEqual(got, want) {
+			t.Errorf("regexAnsiblePattern(%q)=%+v, %v, want %+v, %v", pattern, got, ok, want, wantOK)
+		}
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexAnsiblePattern(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseAnsiblePattern(data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,1360 @@
+
+//line apache.rl:1
+package main
+
+// ApacheLogEntry holds the fields of an Apache Combined Log Format line.
+// The byte slices point into the parsed line.
+type ApacheLogEntry struct {
+	RemoteHost []byte
+	Ident      []byte
+	AuthUser   []byte
+	Time       []byte
+	Method     []byte
+	URI        []byte
+	Proto      []byte
+	Status     int
+	Bytes      int
+	Referer    []byte
+	UserAgent  []byte
+}
+
+// ParseApacheCombined parses a line written with the format
+//
+//	%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-agent}i"
+//
+// A "-" in the %l, %u, %b, or referer fields is accepted as a placeholder;
+// %b of "-" is reported as zero bytes.  A request of "-" (logged by Apache
+// when the request line was never received) leaves Method, URI, and Proto
+// empty.
+func ParseApacheCombined(line []byte) (ApacheLogEntry, bool) {
+	e, ok, _ := ParseApacheCombinedAt(line)
+	return e, ok
+}
+
+// ParseApacheCombinedAt is like ParseApacheCombined but also reports where
+// the line stopped matching: the offset of the first unexpected byte, or
+// len(line) if the line is cut short.  It is -1 on success.
+func ParseApacheCombinedAt(line []byte) (ApacheLogEntry, bool, int) {
+
+
+//line apache.rl:38
+
+//line apache.go:43
+const apache_start int = 1
+const apache_first_final int = 87
+const apache_error int = 0
+
+const apache_en_main int = 1
+
+
+//line apache.rl:39
+
+	var e ApacheLogEntry
+
+	data := line
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+
+	
+//line apache.go:63
+	{
+	cs = apache_start
+	}
+
+//line apache.go:68
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 0:
+		goto st_case_0
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 87:
+		goto st_case_87
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 32 {
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr0
+tr0:
+//line apache.rl:52
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line apache.go:269
+		if data[p] == 32 {
+			goto tr3
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st2
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr3:
+//line apache.rl:53
+ e.RemoteHost = data[mark:p] 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line apache.go:290
+		if data[p] == 32 {
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr4
+tr4:
+//line apache.rl:52
+ mark = p 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line apache.go:307
+		if data[p] == 32 {
+			goto tr6
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st4
+tr6:
+//line apache.rl:54
+ e.Ident = data[mark:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line apache.go:324
+		if data[p] == 32 {
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr7
+tr7:
+//line apache.rl:52
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line apache.go:341
+		if data[p] == 32 {
+			goto tr9
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st6
+tr9:
+//line apache.rl:55
+ e.AuthUser = data[mark:p] 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line apache.go:358
+		if data[p] == 91 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 48:
+			goto tr11
+		case 51:
+			goto tr13
+		}
+		if 49 <= data[p] && data[p] <= 50 {
+			goto tr12
+		}
+		goto st0
+tr11:
+//line apache.rl:52
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line apache.go:387
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 47 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 65:
+			goto st12
+		case 68:
+			goto st70
+		case 70:
+			goto st72
+		case 74:
+			goto st74
+		case 77:
+			goto st77
+		case 78:
+			goto st79
+		case 79:
+			goto st81
+		case 83:
+			goto st83
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 112:
+			goto st13
+		case 117:
+			goto st69
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 114 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 47 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 58 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 50 {
+			goto st68
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 58 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 58 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 32 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 43:
+			goto st30
+		case 45:
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 93 {
+			goto tr48
+		}
+		goto st0
+tr48:
+//line apache.rl:56
+ e.Time = data[mark:p] 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line apache.go:650
+		if data[p] == 32 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 34 {
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 45 {
+			goto st38
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto tr52
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 34 {
+			goto st39
+		}
+		goto st0
+tr90:
+//line apache.rl:59
+ e.Proto = data[mark:p] 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line apache.go:694
+		if data[p] == 32 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr55
+		}
+		goto st0
+tr55:
+//line apache.rl:60
+ e.Status = e.Status*10 + int((data[p])-'0') 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line apache.go:717
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr56
+		}
+		goto st0
+tr56:
+//line apache.rl:60
+ e.Status = e.Status*10 + int((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line apache.go:731
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr57
+		}
+		goto st0
+tr57:
+//line apache.rl:60
+ e.Status = e.Status*10 + int((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line apache.go:745
+		if data[p] == 32 {
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 45 {
+			goto st45
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr60
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 32 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 34 {
+			goto st47
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 34:
+			goto tr64
+		case 92:
+			goto tr65
+		}
+		goto tr63
+tr63:
+//line apache.rl:52
+ mark = p 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line apache.go:801
+		switch data[p] {
+		case 34:
+			goto tr67
+		case 92:
+			goto st54
+		}
+		goto st48
+tr64:
+//line apache.rl:52
+ mark = p 
+//line apache.rl:62
+ e.Referer = data[mark:p] 
+	goto st49
+tr67:
+//line apache.rl:62
+ e.Referer = data[mark:p] 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line apache.go:824
+		if data[p] == 32 {
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 34 {
+			goto st51
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 34:
+			goto tr72
+		case 92:
+			goto tr73
+		}
+		goto tr71
+tr71:
+//line apache.rl:52
+ mark = p 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line apache.go:859
+		switch data[p] {
+		case 34:
+			goto tr75
+		case 92:
+			goto st53
+		}
+		goto st52
+tr72:
+//line apache.rl:52
+ mark = p 
+//line apache.rl:63
+ e.UserAgent = data[mark:p] 
+	goto st87
+tr75:
+//line apache.rl:63
+ e.UserAgent = data[mark:p] 
+	goto st87
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+//line apache.go:882
+		goto st0
+tr73:
+//line apache.rl:52
+ mark = p 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line apache.go:893
+		goto st52
+tr65:
+//line apache.rl:52
+ mark = p 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line apache.go:904
+		goto st48
+tr60:
+//line apache.rl:61
+ e.Bytes = e.Bytes*10 + int((data[p])-'0') 
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line apache.go:915
+		if data[p] == 32 {
+			goto st46
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr60
+		}
+		goto st0
+tr52:
+//line apache.rl:52
+ mark = p 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line apache.go:932
+		if data[p] == 32 {
+			goto tr77
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st56
+		}
+		goto st0
+tr77:
+//line apache.rl:57
+ e.Method = data[mark:p] 
+	goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+//line apache.go:949
+		switch data[p] {
+		case 32:
+			goto st0
+		case 34:
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr79
+tr79:
+//line apache.rl:52
+ mark = p 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line apache.go:969
+		switch data[p] {
+		case 32:
+			goto tr81
+		case 34:
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st58
+tr81:
+//line apache.rl:58
+ e.URI = data[mark:p] 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line apache.go:989
+		if data[p] == 72 {
+			goto tr82
+		}
+		goto st0
+tr82:
+//line apache.rl:52
+ mark = p 
+	goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+//line apache.go:1003
+		if data[p] == 84 {
+			goto st61
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 84 {
+			goto st62
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 80 {
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 47 {
+			goto st64
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st65
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 46 {
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 34 {
+			goto tr90
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if 48 <= data[p] && data[p] <= 51 {
+			goto st22
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 103 {
+			goto st14
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 101 {
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 99 {
+			goto st14
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 101 {
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 98 {
+			goto st14
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 97:
+			goto st75
+		case 117:
+			goto st76
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 110 {
+			goto st14
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 108:
+			goto st14
+		case 110:
+			goto st14
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 97 {
+			goto st78
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 114:
+			goto st14
+		case 121:
+			goto st14
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 111 {
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 118 {
+			goto st14
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 99 {
+			goto st82
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 116 {
+			goto st14
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 101 {
+			goto st84
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 112 {
+			goto st14
+		}
+		goto st0
+tr12:
+//line apache.rl:52
+ mark = p 
+	goto st85
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+//line apache.go:1242
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto st0
+tr13:
+//line apache.rl:52
+ mark = p 
+	goto st86
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+//line apache.go:1256
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st10
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line apache.rl:88
+
+
+	if cs < apache_first_final {
+		return ApacheLogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,95 @@
+package main
+
+// ApacheLogEntry holds the fields of an Apache Combined Log Format line.
+// The byte slices point into the parsed line.
+type ApacheLogEntry struct {
+	RemoteHost []byte
+	Ident      []byte
+	AuthUser   []byte
+	Time       []byte
+	Method     []byte
+	URI        []byte
+	Proto      []byte
+	Status     int
+	Bytes      int
+	Referer    []byte
+	UserAgent  []byte
+}
+
+// ParseApacheCombined parses a line written with the format
+//
+//	%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-agent}i"
+//
+// A "-" in the %l, %u, %b, or referer fields is accepted as a placeholder;
+// %b of "-" is reported as zero bytes.  A request of "-" (logged by Apache
+// when the request line was never received) leaves Method, URI, and Proto
+// empty.
+func ParseApacheCombined(line []byte) (ApacheLogEntry, bool) {
+	e, ok, _ := ParseApacheCombinedAt(line)
+	return e, ok
+}
+
+// ParseApacheCombinedAt is like ParseApacheCombined but also reports where
+// the line stopped matching: the offset of the first unexpected byte, or
+// len(line) if the line is cut short.  It is -1 on success.
+func ParseApacheCombinedAt(line []byte) (ApacheLogEntry, bool, int) {
+
+%% machine apache;
+%% write data;
+
+	var e ApacheLogEntry
+
+	data := line
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+
+	%%{
+	    include timestamp "../patterns/timestamp.rl";
+
+	    action mark        { mark = p }
+	    action remote_host { e.RemoteHost = data[mark:p] }
+	    action ident       { e.Ident = data[mark:p] }
+	    action auth_user   { e.AuthUser = data[mark:p] }
+	    action time        { e.Time = data[mark:p] }
+	    action method      { e.Method = data[mark:p] }
+	    action uri         { e.URI = data[mark:p] }
+	    action proto       { e.Proto = data[mark:p] }
+	    action status      { e.Status = e.Status*10 + int(fc-'0') }
+	    action bytes       { e.Bytes = e.Bytes*10 + int(fc-'0') }
+	    action referer     { e.Referer = data[mark:p] }
+	    action user_agent  { e.UserAgent = data[mark:p] }
+
+	    field = ( any - space )+ ;
+
+	    method = upper+ ;
+	    uri = ( any - ( space | '"' ) )+ ;
+	    proto = 'HTTP/' digit '.' digit ;
+
+	    request = method >mark %method ' ' uri >mark %uri ' ' proto >mark %proto
+	            | '-' ;
+
+	    quoted = ( ( any - ["\\] ) | '\\' any )* ;
+
+	    main := field >mark %remote_host
+	            ' ' field >mark %ident
+	            ' ' field >mark %auth_user
+	            ' [' clf_timestamp >mark %time ']'
+	            ' "' request '"'
+	            ' ' digit{3} $status
+	            ' ' ( '-' | digit+ $bytes )
+	            ' "' quoted >mark %referer '"'
+	            ' "' quoted >mark %user_agent '"' ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < apache_first_final {
+		return ApacheLogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// sample line from the Apache log documentation
+var data = []byte(`127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326 "http://www.example.com/start.html" "Mozilla/4.08 [en] (Win98; I ;Nav)"`)
+
+var hits int
+
+func TestParseApacheCombined(t *testing.T) {
+	tests := []struct {
+		line string
+		want ApacheLogEntry
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: ApacheLogEntry{
+				RemoteHost: []byte("127.0.0.1"),
+				Ident:      []byte("-"),
+				AuthUser:   []byte("frank"),
+				Time:       []byte("10/Oct/2000:13:55:36 -0700"),
+				Method:     []byte("GET"),
+				URI:        []byte("/apache_pb.gif"),
+				Proto:      []byte("HTTP/1.0"),
+				Status:     200,
+				Bytes:      2326,
+				Referer:    []byte("http://www.example.com/start.html"),
+				UserAgent:  []byte("Mozilla/4.08 [en] (Win98; I ;Nav)"),
+			},
+			ok: true,
+		},
+		{
+			// placeholders everywhere they are allowed
+			line: `host.example.com - - [01/Feb/2021:00:00:00 +0000] "HEAD /index.html?a=b HTTP/1.1" 304 - "-" "-"`,
+			want: ApacheLogEntry{
+				RemoteHost: []byte("host.example.com"),
+				Ident:      []byte("-"),
+				AuthUser:   []byte("-"),
+				Time:       []byte("01/Feb/2021:00:00:00 +0000"),
+				Method:     []byte("HEAD"),
+				URI:        []byte("/index.html?a=b"),
+				Proto:      []byte("HTTP/1.1"),
+				Status:     304,
+				Bytes:      0,
+				Referer:    []byte("-"),
+				UserAgent:  []byte("-"),
+			},
+			ok: true,
+		},
+		{
+			// request line timed out before it was read
+			line: `192.0.2.7 - - [28/Aug/2019:07:14:02 +0200] "-" 408 - "-" "-"`,
+			want: ApacheLogEntry{
+				RemoteHost: []byte("192.0.2.7"),
+				Ident:      []byte("-"),
+				AuthUser:   []byte("-"),
+				Time:       []byte("28/Aug/2019:07:14:02 +0200"),
+				Status:     408,
+				Referer:    []byte("-"),
+				UserAgent:  []byte("-"),
+			},
+			ok: true,
+		},
+		{
+			line: `2001:db8::1 - bob [05/Mar/2022:10:11:12 -0500] "POST /login HTTP/1.1" 302 15 "https://example.org/" "Mozilla/5.0 (\"quoted\")"`,
+			want: ApacheLogEntry{
+				RemoteHost: []byte("2001:db8::1"),
+				Ident:      []byte("-"),
+				AuthUser:   []byte("bob"),
+				Time:       []byte("05/Mar/2022:10:11:12 -0500"),
+				Method:     []byte("POST"),
+				URI:        []byte("/login"),
+				Proto:      []byte("HTTP/1.1"),
+				Status:     302,
+				Bytes:      15,
+				Referer:    []byte("https://example.org/"),
+				UserAgent:  []byte(`Mozilla/5.0 (\"quoted\")`),
+			},
+			ok: true,
+		},
+		// common log format has no referer or user agent
+		{line: `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`},
+		// lowercase method
+		{line: `127.0.0.1 - - [10/Oct/2000:13:55:36 -0700] "get / HTTP/1.0" 200 1 "-" "-"`},
+		// missing protocol
+		{line: `127.0.0.1 - - [10/Oct/2000:13:55:36 -0700] "GET /" 200 1 "-" "-"`},
+		// nginx style brackets are the same, but the timezone is required
+		{line: `127.0.0.1 - - [10/Oct/2000:13:55:36] "GET / HTTP/1.0" 200 1 "-" "-"`},
+		{line: `127.0.0.1 - - [10/Oct/2000:13:60:36 -0700] "GET / HTTP/1.0" 200 1 "-" "-"`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseApacheCombined([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseApacheCombined(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseApacheCombined(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseApacheCombinedAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{`127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 2000 2326 "-" "-"`, 80},
+		{`127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`, 85},
+		{`127.0.0.1 - frank [10/Okt/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326 "-" "-"`, 23},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseApacheCombinedAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseApacheCombinedAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var reApache = regexp.MustCompile(`^(\S+) (\S+) (\S+) \[([^\]]+)\] "(?:([A-Z]+) ([^\s"]+) (HTTP/\d\.\d)|-)" (\d{3}) (\d+|-) "((?:[^"\\]|\\.)*)" "((?:[^"\\]|\\.)*)"$`)
+
+func regexApache(line []byte) (ApacheLogEntry, bool) {
+	m := reApache.FindSubmatch(line)
+	if m == nil {
+		return ApacheLogEntry{}, false
+	}
+	status, _ := strconv.Atoi(string(m[8]))
+	n, _ := strconv.Atoi(string(m[9]))
+	return ApacheLogEntry{
+		RemoteHost: m[1],
+		Ident:      m[2],
+		AuthUser:   m[3],
+		Time:       m[4],
+		Method:     m[5],
+		URI:        m[6],
+		Proto:      m[7],
+		Status:     status,
+		Bytes:      n,
+		Referer:    m[10],
+		UserAgent:  m[11],
+	}, true
+}
+
+// sscanfApache leans on %q to pull out the quoted fields, so it only works
+// when they happen to also be valid Go string literals.
+func sscanfApache(line string) (ApacheLogEntry, bool) {
+	var host, ident, user, t1, t2, req, bytes, ref, ua string
+	var status int
+	_, err := fmt.Sscanf(line, "%s %s %s [%s %s %q %d %s %q %q", &host, &ident, &user, &t1, &t2, &req, &status, &bytes, &ref, &ua)
+	if err != nil {
+		return ApacheLogEntry{}, false
+	}
+	e := ApacheLogEntry{
+		RemoteHost: []byte(host),
+		Ident:      []byte(ident),
+		AuthUser:   []byte(user),
+		Time:       []byte(t1 + " " + strings.TrimSuffix(t2, "]")),
+		Status:     status,
+		Referer:    []byte(ref),
+		UserAgent:  []byte(ua),
+	}
+	if r := strings.Split(req, " "); len(r) == 3 {
+		e.Method, e.URI, e.Proto = []byte(r[0]), []byte(r[1]), []byte(r[2])
+	}
+	if bytes != "-" {
+		e.Bytes, _ = strconv.Atoi(bytes)
+	}
+	return e, true
+}
+
+func TestApacheAlternatives(t *testing.T) {
+	want, _ := ParseApacheCombined(data)
+	if got, ok := regexApache(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexApache=%+v, want %+v", got, want)
+	}
+	if got, ok := sscanfApache(string(data)); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("sscanfApache=%+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexApache(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkSscanf(b *testing.B) {
+	line := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := sscanfApache(line); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseApacheCombined(data); ok {
+			hits++
+		}
+	}
+}
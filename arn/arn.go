@@ -0,0 +1,759 @@
+
+//line arn.rl:1
+package main
+
+// ARN holds the fields of an Amazon Resource Name.  The resource part is
+// split into ResourceType and ResourceID when it has one of the forms
+// "type/id" or "type:id"; otherwise ResourceType is nil.  All fields point
+// into the parsed input.
+type ARN struct {
+	Partition    []byte
+	Service      []byte
+	Region       []byte
+	AccountID    []byte
+	ResourceType []byte
+	ResourceID   []byte
+}
+
+// ParseARN parses an ARN of the form
+//
+//	arn:partition:service:region:account-id:resource
+//
+// Region and account ID may be empty, as they are for global services and
+// S3 buckets.  Only the first '/' or ':' in the resource separates the type
+// from the ID, so "function:name:alias" has ID "name:alias".
+func ParseARN(data []byte) (ARN, bool) {
+	a, ok, _ := ParseARNAt(data)
+	return a, ok
+}
+
+// ParseARNAt is like ParseARN, and also returns the offset of the first
+// byte that can't be part of an ARN, or -1 if data is one.
+func ParseARNAt(data []byte) (ARN, bool, int) {
+
+
+//line arn.rl:33
+
+//line arn.go:38
+const arn_start int = 1
+const arn_first_final int = 42
+const arn_error int = 0
+
+const arn_en_main int = 1
+
+
+//line arn.rl:34
+
+	var a ARN
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line arn.go:55
+	{
+	cs = arn_start
+	}
+
+//line arn.go:60
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 42:
+		goto st_case_42
+	case 30:
+		goto st_case_30
+	case 43:
+		goto st_case_43
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 97 {
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 114 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 110 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 58 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 97 {
+			goto tr5
+		}
+		goto st0
+tr5:
+//line arn.rl:42
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line arn.go:210
+		if data[p] == 119 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 115 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 45:
+			goto st9
+		case 58:
+			goto tr9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 99:
+			goto st10
+		case 105:
+			goto st33
+		case 117:
+			goto st37
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 110 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 58 {
+			goto tr9
+		}
+		goto st0
+tr9:
+//line arn.rl:43
+ a.Partition = data[mark:p] 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line arn.go:277
+		if data[p] == 45 {
+			goto tr14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr14
+			}
+		case data[p] >= 48:
+			goto tr14
+		}
+		goto st0
+tr14:
+//line arn.rl:42
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line arn.go:299
+		switch data[p] {
+		case 45:
+			goto st13
+		case 58:
+			goto tr16
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st13
+			}
+		case data[p] >= 48:
+			goto st13
+		}
+		goto st0
+tr16:
+//line arn.rl:44
+ a.Service = data[mark:p] 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line arn.go:324
+		switch data[p] {
+		case 45:
+			goto tr17
+		case 58:
+			goto tr18
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr17
+			}
+		case data[p] >= 48:
+			goto tr17
+		}
+		goto st0
+tr17:
+//line arn.rl:42
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line arn.go:349
+		switch data[p] {
+		case 45:
+			goto st15
+		case 58:
+			goto tr20
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st15
+			}
+		case data[p] >= 48:
+			goto st15
+		}
+		goto st0
+tr18:
+//line arn.rl:42
+ mark = p 
+//line arn.rl:45
+ a.Region = data[mark:p] 
+	goto st16
+tr20:
+//line arn.rl:45
+ a.Region = data[mark:p] 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line arn.go:380
+		switch data[p] {
+		case 58:
+			goto tr22
+		case 97:
+			goto tr23
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr21
+		}
+		goto st0
+tr21:
+//line arn.rl:42
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line arn.go:400
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 58 {
+			goto tr35
+		}
+		goto st0
+tr22:
+//line arn.rl:42
+ mark = p 
+//line arn.rl:46
+ a.AccountID = data[mark:p] 
+	goto st29
+tr35:
+//line arn.rl:46
+ a.AccountID = data[mark:p] 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line arn.go:519
+		switch data[p] {
+		case 47:
+			goto st0
+		case 58:
+			goto st0
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr36
+tr36:
+//line arn.rl:42
+ mark = p 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line arn.go:541
+		switch data[p] {
+		case 47:
+			goto tr47
+		case 58:
+			goto tr47
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto st42
+tr47:
+//line arn.rl:47
+ a.ResourceType = data[mark:p] 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line arn.go:563
+		if data[p] == 127 {
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr37
+tr37:
+//line arn.rl:42
+ mark = p 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line arn.go:580
+		if data[p] == 127 {
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto st43
+tr23:
+//line arn.rl:42
+ mark = p 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line arn.go:597
+		if data[p] == 119 {
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 115 {
+			goto st28
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 115 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 111 {
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 45:
+			goto st36
+		case 58:
+			goto tr9
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 98 {
+			goto st11
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 115 {
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 45 {
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 103 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 111 {
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 118 {
+			goto st11
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 42, 43:
+//line arn.rl:48
+ a.ResourceID = data[mark:p] 
+//line arn.go:745
+		}
+	}
+
+	_out: {}
+	}
+
+//line arn.rl:68
+
+
+	if cs < arn_first_final {
+		return ARN{}, false, p
+	}
+
+	return a, true, -1
+}
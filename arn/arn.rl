@@ -0,0 +1,75 @@
+package main
+
+// ARN holds the fields of an Amazon Resource Name.  The resource part is
+// split into ResourceType and ResourceID when it has one of the forms
+// "type/id" or "type:id"; otherwise ResourceType is nil.  All fields point
+// into the parsed input.
+type ARN struct {
+	Partition    []byte
+	Service      []byte
+	Region       []byte
+	AccountID    []byte
+	ResourceType []byte
+	ResourceID   []byte
+}
+
+// ParseARN parses an ARN of the form
+//
+//	arn:partition:service:region:account-id:resource
+//
+// Region and account ID may be empty, as they are for global services and
+// S3 buckets.  Only the first '/' or ':' in the resource separates the type
+// from the ID, so "function:name:alias" has ID "name:alias".
+func ParseARN(data []byte) (ARN, bool) {
+	a, ok, _ := ParseARNAt(data)
+	return a, ok
+}
+
+// ParseARNAt is like ParseARN, and also returns the offset of the first
+// byte that can't be part of an ARN, or -1 if data is one.
+func ParseARNAt(data []byte) (ARN, bool, int) {
+
+%% machine arn;
+%% write data;
+
+	var a ARN
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark          { mark = p }
+	    action partition     { a.Partition = data[mark:p] }
+	    action service       { a.Service = data[mark:p] }
+	    action region        { a.Region = data[mark:p] }
+	    action account_id    { a.AccountID = data[mark:p] }
+	    action resource_type { a.ResourceType = data[mark:p] }
+	    action resource_id   { a.ResourceID = data[mark:p] }
+
+	    partition = 'aws' | 'aws-cn' | 'aws-us-gov' | 'aws-iso' | 'aws-iso-b' ;
+	    service = ( lower | digit | '-' )+ ;
+	    region = ( lower | digit | '-' )* ;
+	    # AWS managed policies belong to the account "aws"
+	    account_id = ( digit{12} | 'aws' )? ;
+
+	    id = ( any - ( cntrl | 0x7f ) )+ ;
+	    resource = ( any - ( cntrl | 0x7f | [/:] ) )+ >mark %resource_type [/:] id >mark %resource_id
+	             | ( any - ( cntrl | 0x7f | [/:] ) )+ >mark %resource_id ;
+
+	    main := 'arn:' partition >mark %partition
+	            ':' service >mark %service
+	            ':' region >mark %region
+	            ':' account_id >mark %account_id
+	            ':' resource ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < arn_first_final {
+		return ARN{}, false, p
+	}
+
+	return a, true, -1
+}
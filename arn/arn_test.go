@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+var data = []byte("arn:aws:lambda:us-east-1:123456789012:function:my-function:PROD")
+
+var hits int
+
+func TestParseARN(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want ARN
+		ok   bool
+	}{
+		{
+			arn: string(data),
+			want: ARN{
+				Partition:    []byte("aws"),
+				Service:      []byte("lambda"),
+				Region:       []byte("us-east-1"),
+				AccountID:    []byte("123456789012"),
+				ResourceType: []byte("function"),
+				ResourceID:   []byte("my-function:PROD"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-0abcd1234efgh5678",
+			want: ARN{
+				Partition:    []byte("aws-cn"),
+				Service:      []byte("ec2"),
+				Region:       []byte("cn-north-1"),
+				AccountID:    []byte("123456789012"),
+				ResourceType: []byte("instance"),
+				ResourceID:   []byte("i-0abcd1234efgh5678"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws:s3:::my-bucket",
+			want: ARN{
+				Partition:  []byte("aws"),
+				Service:    []byte("s3"),
+				Region:     []byte(""),
+				AccountID:  []byte(""),
+				ResourceID: []byte("my-bucket"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws-us-gov:s3:::my-bucket/path/to/key.txt",
+			want: ARN{
+				Partition:    []byte("aws-us-gov"),
+				Service:      []byte("s3"),
+				Region:       []byte(""),
+				AccountID:    []byte(""),
+				ResourceType: []byte("my-bucket"),
+				ResourceID:   []byte("path/to/key.txt"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws:iam::aws:policy/AdministratorAccess",
+			want: ARN{
+				Partition:    []byte("aws"),
+				Service:      []byte("iam"),
+				Region:       []byte(""),
+				AccountID:    []byte("aws"),
+				ResourceType: []byte("policy"),
+				ResourceID:   []byte("AdministratorAccess"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws-iso-b:sns:us-isob-east-1:123456789012:my-topic",
+			want: ARN{
+				Partition:  []byte("aws-iso-b"),
+				Service:    []byte("sns"),
+				Region:     []byte("us-isob-east-1"),
+				AccountID:  []byte("123456789012"),
+				ResourceID: []byte("my-topic"),
+			},
+			ok: true,
+		},
+		{
+			arn: "arn:aws-iso:kms:us-iso-east-1:123456789012:key/*",
+			want: ARN{
+				Partition:    []byte("aws-iso"),
+				Service:      []byte("kms"),
+				Region:       []byte("us-iso-east-1"),
+				AccountID:    []byte("123456789012"),
+				ResourceType: []byte("key"),
+				ResourceID:   []byte("*"),
+			},
+			ok: true,
+		},
+
+		{arn: "arn:gcp:s3:::my-bucket"},
+		{arn: "arn:aws-eu:s3:::my-bucket"},
+		{arn: "arn:aws::us-east-1:123456789012:x"},
+		{arn: "arn:aws:S3:::my-bucket"},
+		{arn: "arn:aws:iam::12345:user/bob"},
+		{arn: "arn:aws:s3:::"},
+		{arn: "arn:aws:s3:::/key"},
+		{arn: "arn:aws:s3:::bucket/"},
+		{arn: "arn:aws:s3::"},
+		{arn: "ARN:aws:s3:::my-bucket"},
+		{arn: "arn:aws:s3:::bad\nbucket"},
+		{arn: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseARN([]byte(tt.arn))
+		if ok != tt.ok {
+			t.Errorf("ParseARN(%q) ok=%v, want %v", tt.arn, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseARN(%q)=%q, want %q", tt.arn, got, tt.want)
+		}
+	}
+}
+
+func TestParseARNAt(t *testing.T) {
+	tests := []struct {
+		arn    string
+		errPos int
+	}{
+		{string(data), -1},
+		{"urn:aws:s3:::bucket", 0},
+		{"arn:aws:lambda:us-east-1:12345678901:function:f", 36},
+		{"arn:aws", 7},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseARNAt([]byte(tt.arn))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseARNAt(%q)=%v, %d, want errPos %d", tt.arn, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// splitARN does the usual SplitN and only checks the partition.
+func splitARN(arn []byte) (ARN, bool) {
+	parts := bytes.SplitN(arn, []byte(":"), 6)
+	if len(parts) != 6 || string(parts[0]) != "arn" || len(parts[5]) == 0 {
+		return ARN{}, false
+	}
+	switch string(parts[1]) {
+	case "aws", "aws-cn", "aws-us-gov", "aws-iso", "aws-iso-b":
+	default:
+		return ARN{}, false
+	}
+	a := ARN{Partition: parts[1], Service: parts[2], Region: parts[3], AccountID: parts[4], ResourceID: parts[5]}
+	if i := bytes.IndexAny(parts[5], "/:"); i >= 0 {
+		a.ResourceType, a.ResourceID = parts[5][:i], parts[5][i+1:]
+	}
+	return a, true
+}
+
+func TestARNAlternatives(t *testing.T) {
+	want, _ := ParseARN(data)
+	if got, ok := splitARN(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("splitARN=%q, want %q", got, want)
+	}
+}
+
+func BenchmarkSplitN(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := splitARN(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseARN(data); ok {
+			hits++
+		}
+	}
+}
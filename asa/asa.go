@@ -0,0 +1,17809 @@
+
+//line asa.rl:1
+package main
+
+import "time"
+
+// ASAEndpoint is one side of a connection in a Cisco ASA log message.
+// Interface is the nameif of the interface, such as "outside".  Messages
+// that only give the interface a packet arrived on set it on Src.
+type ASAEndpoint struct {
+	Interface []byte
+	Addr      []byte
+	Port      int
+}
+
+// ASALogEntry is a Cisco ASA syslog message.  Severity, MessageID, and Text,
+// the message after the "%ASA-6-302014: " tag, are set for every message.
+// The remaining fields are only filled in for the message IDs that
+// ParseCiscoASALog knows the layout of, and then only those the message
+// carries.  All byte slices point into the parsed line.
+type ASALogEntry struct {
+	Severity  int
+	MessageID int
+	Text      []byte
+
+	// Verb is the word saying what happened, such as Built, Teardown,
+	// Deny, or permitted.
+	Verb      []byte
+	Direction []byte
+	Protocol  []byte
+	ConnID    int64
+	Src, Dst  ASAEndpoint
+	Duration  time.Duration
+	Bytes     int64
+	ACL       []byte
+	Reason    []byte
+	User      []byte
+}
+
+// ParseCiscoASALog parses a Cisco ASA log message such as
+//
+//	%ASA-6-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs
+//
+// starting from the "%ASA" tag, so any syslog header in front of it must be
+// cut off first.  After the tag the message ID picks the machine used for
+// the rest of the line.  Messages with an ID it has no machine for are
+// accepted with only the envelope fields set, but a known message that
+// does not match its layout is rejected.
+func ParseCiscoASALog(data []byte) (ASALogEntry, bool) {
+	e, ok, _ := ParseCiscoASALogAt(data)
+	return e, ok
+}
+
+// ParseCiscoASALogAt is like ParseCiscoASALog but also returns the offset
+// of the first byte that doesn't fit the envelope or the layout for the
+// message ID, len(data) if the message stops short, and -1 on success.
+func ParseCiscoASALogAt(data []byte) (ASALogEntry, bool, int) {
+
+
+//line asa.rl:58
+
+//line asa.go:63
+const asa_start int = 1
+const asa_first_final int = 1048
+const asa_error int = 0
+
+const asa_en_main int = 1
+const asa_en_built int = 16
+const asa_en_teardown int = 86
+const asa_en_icmp int = 163
+const asa_en_xlate int = 251
+const asa_en_conn_denied int = 329
+const asa_en_deny_inbound int = 405
+const asa_en_deny_icmp int = 468
+const asa_en_deny_no_conn int = 530
+const asa_en_deny_acl int = 599
+const asa_en_acl_log int = 682
+const asa_en_no_route int = 756
+const asa_en_aaa int = 820
+const asa_en_icmp_denied int = 851
+const asa_en_login int = 897
+const asa_en_access_denied int = 955
+const asa_en_discarded int = 1006
+
+
+//line asa.rl:59
+
+	var e ASALogEntry
+	var ep *ASAEndpoint
+
+	var hours, mins, secs int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line asa.go:99
+	{
+	cs = asa_start
+	}
+
+//line asa.go:104
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 1048:
+		goto st_case_1048
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 1049:
+		goto st_case_1049
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 1050:
+		goto st_case_1050
+	case 153:
+		goto st_case_153
+	case 1051:
+		goto st_case_1051
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 180:
+		goto st_case_180
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 185:
+		goto st_case_185
+	case 186:
+		goto st_case_186
+	case 187:
+		goto st_case_187
+	case 188:
+		goto st_case_188
+	case 189:
+		goto st_case_189
+	case 190:
+		goto st_case_190
+	case 191:
+		goto st_case_191
+	case 192:
+		goto st_case_192
+	case 193:
+		goto st_case_193
+	case 194:
+		goto st_case_194
+	case 195:
+		goto st_case_195
+	case 196:
+		goto st_case_196
+	case 197:
+		goto st_case_197
+	case 198:
+		goto st_case_198
+	case 199:
+		goto st_case_199
+	case 200:
+		goto st_case_200
+	case 201:
+		goto st_case_201
+	case 202:
+		goto st_case_202
+	case 203:
+		goto st_case_203
+	case 204:
+		goto st_case_204
+	case 205:
+		goto st_case_205
+	case 206:
+		goto st_case_206
+	case 207:
+		goto st_case_207
+	case 208:
+		goto st_case_208
+	case 209:
+		goto st_case_209
+	case 210:
+		goto st_case_210
+	case 211:
+		goto st_case_211
+	case 212:
+		goto st_case_212
+	case 213:
+		goto st_case_213
+	case 214:
+		goto st_case_214
+	case 215:
+		goto st_case_215
+	case 216:
+		goto st_case_216
+	case 217:
+		goto st_case_217
+	case 218:
+		goto st_case_218
+	case 219:
+		goto st_case_219
+	case 220:
+		goto st_case_220
+	case 221:
+		goto st_case_221
+	case 222:
+		goto st_case_222
+	case 1052:
+		goto st_case_1052
+	case 223:
+		goto st_case_223
+	case 224:
+		goto st_case_224
+	case 225:
+		goto st_case_225
+	case 226:
+		goto st_case_226
+	case 227:
+		goto st_case_227
+	case 228:
+		goto st_case_228
+	case 229:
+		goto st_case_229
+	case 230:
+		goto st_case_230
+	case 231:
+		goto st_case_231
+	case 232:
+		goto st_case_232
+	case 233:
+		goto st_case_233
+	case 234:
+		goto st_case_234
+	case 235:
+		goto st_case_235
+	case 1053:
+		goto st_case_1053
+	case 1054:
+		goto st_case_1054
+	case 1055:
+		goto st_case_1055
+	case 1056:
+		goto st_case_1056
+	case 1057:
+		goto st_case_1057
+	case 236:
+		goto st_case_236
+	case 237:
+		goto st_case_237
+	case 238:
+		goto st_case_238
+	case 239:
+		goto st_case_239
+	case 240:
+		goto st_case_240
+	case 241:
+		goto st_case_241
+	case 242:
+		goto st_case_242
+	case 243:
+		goto st_case_243
+	case 244:
+		goto st_case_244
+	case 245:
+		goto st_case_245
+	case 246:
+		goto st_case_246
+	case 247:
+		goto st_case_247
+	case 248:
+		goto st_case_248
+	case 249:
+		goto st_case_249
+	case 250:
+		goto st_case_250
+	case 251:
+		goto st_case_251
+	case 252:
+		goto st_case_252
+	case 253:
+		goto st_case_253
+	case 254:
+		goto st_case_254
+	case 255:
+		goto st_case_255
+	case 256:
+		goto st_case_256
+	case 257:
+		goto st_case_257
+	case 258:
+		goto st_case_258
+	case 259:
+		goto st_case_259
+	case 260:
+		goto st_case_260
+	case 261:
+		goto st_case_261
+	case 262:
+		goto st_case_262
+	case 263:
+		goto st_case_263
+	case 264:
+		goto st_case_264
+	case 265:
+		goto st_case_265
+	case 266:
+		goto st_case_266
+	case 267:
+		goto st_case_267
+	case 268:
+		goto st_case_268
+	case 269:
+		goto st_case_269
+	case 270:
+		goto st_case_270
+	case 271:
+		goto st_case_271
+	case 272:
+		goto st_case_272
+	case 273:
+		goto st_case_273
+	case 274:
+		goto st_case_274
+	case 275:
+		goto st_case_275
+	case 276:
+		goto st_case_276
+	case 277:
+		goto st_case_277
+	case 278:
+		goto st_case_278
+	case 279:
+		goto st_case_279
+	case 280:
+		goto st_case_280
+	case 281:
+		goto st_case_281
+	case 282:
+		goto st_case_282
+	case 283:
+		goto st_case_283
+	case 284:
+		goto st_case_284
+	case 285:
+		goto st_case_285
+	case 286:
+		goto st_case_286
+	case 287:
+		goto st_case_287
+	case 288:
+		goto st_case_288
+	case 289:
+		goto st_case_289
+	case 290:
+		goto st_case_290
+	case 291:
+		goto st_case_291
+	case 292:
+		goto st_case_292
+	case 293:
+		goto st_case_293
+	case 294:
+		goto st_case_294
+	case 295:
+		goto st_case_295
+	case 296:
+		goto st_case_296
+	case 297:
+		goto st_case_297
+	case 1058:
+		goto st_case_1058
+	case 298:
+		goto st_case_298
+	case 299:
+		goto st_case_299
+	case 300:
+		goto st_case_300
+	case 301:
+		goto st_case_301
+	case 302:
+		goto st_case_302
+	case 303:
+		goto st_case_303
+	case 304:
+		goto st_case_304
+	case 305:
+		goto st_case_305
+	case 306:
+		goto st_case_306
+	case 307:
+		goto st_case_307
+	case 308:
+		goto st_case_308
+	case 309:
+		goto st_case_309
+	case 310:
+		goto st_case_310
+	case 311:
+		goto st_case_311
+	case 312:
+		goto st_case_312
+	case 313:
+		goto st_case_313
+	case 1059:
+		goto st_case_1059
+	case 1060:
+		goto st_case_1060
+	case 1061:
+		goto st_case_1061
+	case 1062:
+		goto st_case_1062
+	case 1063:
+		goto st_case_1063
+	case 314:
+		goto st_case_314
+	case 315:
+		goto st_case_315
+	case 316:
+		goto st_case_316
+	case 317:
+		goto st_case_317
+	case 318:
+		goto st_case_318
+	case 319:
+		goto st_case_319
+	case 320:
+		goto st_case_320
+	case 321:
+		goto st_case_321
+	case 322:
+		goto st_case_322
+	case 323:
+		goto st_case_323
+	case 324:
+		goto st_case_324
+	case 325:
+		goto st_case_325
+	case 326:
+		goto st_case_326
+	case 327:
+		goto st_case_327
+	case 328:
+		goto st_case_328
+	case 329:
+		goto st_case_329
+	case 330:
+		goto st_case_330
+	case 331:
+		goto st_case_331
+	case 332:
+		goto st_case_332
+	case 333:
+		goto st_case_333
+	case 334:
+		goto st_case_334
+	case 335:
+		goto st_case_335
+	case 336:
+		goto st_case_336
+	case 337:
+		goto st_case_337
+	case 338:
+		goto st_case_338
+	case 339:
+		goto st_case_339
+	case 340:
+		goto st_case_340
+	case 341:
+		goto st_case_341
+	case 342:
+		goto st_case_342
+	case 343:
+		goto st_case_343
+	case 344:
+		goto st_case_344
+	case 345:
+		goto st_case_345
+	case 346:
+		goto st_case_346
+	case 347:
+		goto st_case_347
+	case 348:
+		goto st_case_348
+	case 349:
+		goto st_case_349
+	case 350:
+		goto st_case_350
+	case 351:
+		goto st_case_351
+	case 352:
+		goto st_case_352
+	case 353:
+		goto st_case_353
+	case 354:
+		goto st_case_354
+	case 355:
+		goto st_case_355
+	case 356:
+		goto st_case_356
+	case 357:
+		goto st_case_357
+	case 358:
+		goto st_case_358
+	case 359:
+		goto st_case_359
+	case 360:
+		goto st_case_360
+	case 361:
+		goto st_case_361
+	case 362:
+		goto st_case_362
+	case 363:
+		goto st_case_363
+	case 364:
+		goto st_case_364
+	case 365:
+		goto st_case_365
+	case 366:
+		goto st_case_366
+	case 367:
+		goto st_case_367
+	case 368:
+		goto st_case_368
+	case 369:
+		goto st_case_369
+	case 370:
+		goto st_case_370
+	case 371:
+		goto st_case_371
+	case 372:
+		goto st_case_372
+	case 373:
+		goto st_case_373
+	case 374:
+		goto st_case_374
+	case 375:
+		goto st_case_375
+	case 376:
+		goto st_case_376
+	case 377:
+		goto st_case_377
+	case 378:
+		goto st_case_378
+	case 379:
+		goto st_case_379
+	case 380:
+		goto st_case_380
+	case 381:
+		goto st_case_381
+	case 382:
+		goto st_case_382
+	case 383:
+		goto st_case_383
+	case 384:
+		goto st_case_384
+	case 385:
+		goto st_case_385
+	case 386:
+		goto st_case_386
+	case 387:
+		goto st_case_387
+	case 388:
+		goto st_case_388
+	case 389:
+		goto st_case_389
+	case 390:
+		goto st_case_390
+	case 391:
+		goto st_case_391
+	case 392:
+		goto st_case_392
+	case 393:
+		goto st_case_393
+	case 1064:
+		goto st_case_1064
+	case 394:
+		goto st_case_394
+	case 395:
+		goto st_case_395
+	case 396:
+		goto st_case_396
+	case 397:
+		goto st_case_397
+	case 398:
+		goto st_case_398
+	case 399:
+		goto st_case_399
+	case 400:
+		goto st_case_400
+	case 401:
+		goto st_case_401
+	case 402:
+		goto st_case_402
+	case 403:
+		goto st_case_403
+	case 404:
+		goto st_case_404
+	case 405:
+		goto st_case_405
+	case 406:
+		goto st_case_406
+	case 407:
+		goto st_case_407
+	case 408:
+		goto st_case_408
+	case 409:
+		goto st_case_409
+	case 410:
+		goto st_case_410
+	case 411:
+		goto st_case_411
+	case 412:
+		goto st_case_412
+	case 413:
+		goto st_case_413
+	case 414:
+		goto st_case_414
+	case 415:
+		goto st_case_415
+	case 416:
+		goto st_case_416
+	case 417:
+		goto st_case_417
+	case 418:
+		goto st_case_418
+	case 419:
+		goto st_case_419
+	case 420:
+		goto st_case_420
+	case 421:
+		goto st_case_421
+	case 422:
+		goto st_case_422
+	case 423:
+		goto st_case_423
+	case 424:
+		goto st_case_424
+	case 425:
+		goto st_case_425
+	case 426:
+		goto st_case_426
+	case 427:
+		goto st_case_427
+	case 428:
+		goto st_case_428
+	case 429:
+		goto st_case_429
+	case 430:
+		goto st_case_430
+	case 431:
+		goto st_case_431
+	case 432:
+		goto st_case_432
+	case 433:
+		goto st_case_433
+	case 434:
+		goto st_case_434
+	case 435:
+		goto st_case_435
+	case 436:
+		goto st_case_436
+	case 437:
+		goto st_case_437
+	case 438:
+		goto st_case_438
+	case 439:
+		goto st_case_439
+	case 440:
+		goto st_case_440
+	case 441:
+		goto st_case_441
+	case 442:
+		goto st_case_442
+	case 443:
+		goto st_case_443
+	case 1065:
+		goto st_case_1065
+	case 444:
+		goto st_case_444
+	case 445:
+		goto st_case_445
+	case 446:
+		goto st_case_446
+	case 447:
+		goto st_case_447
+	case 448:
+		goto st_case_448
+	case 449:
+		goto st_case_449
+	case 450:
+		goto st_case_450
+	case 451:
+		goto st_case_451
+	case 452:
+		goto st_case_452
+	case 453:
+		goto st_case_453
+	case 454:
+		goto st_case_454
+	case 455:
+		goto st_case_455
+	case 456:
+		goto st_case_456
+	case 1066:
+		goto st_case_1066
+	case 457:
+		goto st_case_457
+	case 458:
+		goto st_case_458
+	case 459:
+		goto st_case_459
+	case 460:
+		goto st_case_460
+	case 461:
+		goto st_case_461
+	case 462:
+		goto st_case_462
+	case 463:
+		goto st_case_463
+	case 464:
+		goto st_case_464
+	case 465:
+		goto st_case_465
+	case 466:
+		goto st_case_466
+	case 467:
+		goto st_case_467
+	case 468:
+		goto st_case_468
+	case 469:
+		goto st_case_469
+	case 470:
+		goto st_case_470
+	case 471:
+		goto st_case_471
+	case 472:
+		goto st_case_472
+	case 473:
+		goto st_case_473
+	case 474:
+		goto st_case_474
+	case 475:
+		goto st_case_475
+	case 476:
+		goto st_case_476
+	case 477:
+		goto st_case_477
+	case 478:
+		goto st_case_478
+	case 479:
+		goto st_case_479
+	case 480:
+		goto st_case_480
+	case 481:
+		goto st_case_481
+	case 482:
+		goto st_case_482
+	case 483:
+		goto st_case_483
+	case 484:
+		goto st_case_484
+	case 485:
+		goto st_case_485
+	case 486:
+		goto st_case_486
+	case 487:
+		goto st_case_487
+	case 488:
+		goto st_case_488
+	case 489:
+		goto st_case_489
+	case 490:
+		goto st_case_490
+	case 491:
+		goto st_case_491
+	case 492:
+		goto st_case_492
+	case 493:
+		goto st_case_493
+	case 494:
+		goto st_case_494
+	case 495:
+		goto st_case_495
+	case 496:
+		goto st_case_496
+	case 497:
+		goto st_case_497
+	case 498:
+		goto st_case_498
+	case 499:
+		goto st_case_499
+	case 500:
+		goto st_case_500
+	case 501:
+		goto st_case_501
+	case 502:
+		goto st_case_502
+	case 503:
+		goto st_case_503
+	case 504:
+		goto st_case_504
+	case 505:
+		goto st_case_505
+	case 506:
+		goto st_case_506
+	case 507:
+		goto st_case_507
+	case 508:
+		goto st_case_508
+	case 509:
+		goto st_case_509
+	case 510:
+		goto st_case_510
+	case 511:
+		goto st_case_511
+	case 512:
+		goto st_case_512
+	case 513:
+		goto st_case_513
+	case 514:
+		goto st_case_514
+	case 1067:
+		goto st_case_1067
+	case 515:
+		goto st_case_515
+	case 516:
+		goto st_case_516
+	case 517:
+		goto st_case_517
+	case 518:
+		goto st_case_518
+	case 519:
+		goto st_case_519
+	case 520:
+		goto st_case_520
+	case 521:
+		goto st_case_521
+	case 522:
+		goto st_case_522
+	case 523:
+		goto st_case_523
+	case 524:
+		goto st_case_524
+	case 525:
+		goto st_case_525
+	case 526:
+		goto st_case_526
+	case 527:
+		goto st_case_527
+	case 528:
+		goto st_case_528
+	case 529:
+		goto st_case_529
+	case 530:
+		goto st_case_530
+	case 531:
+		goto st_case_531
+	case 532:
+		goto st_case_532
+	case 533:
+		goto st_case_533
+	case 534:
+		goto st_case_534
+	case 535:
+		goto st_case_535
+	case 536:
+		goto st_case_536
+	case 537:
+		goto st_case_537
+	case 538:
+		goto st_case_538
+	case 539:
+		goto st_case_539
+	case 540:
+		goto st_case_540
+	case 541:
+		goto st_case_541
+	case 542:
+		goto st_case_542
+	case 543:
+		goto st_case_543
+	case 544:
+		goto st_case_544
+	case 545:
+		goto st_case_545
+	case 546:
+		goto st_case_546
+	case 547:
+		goto st_case_547
+	case 548:
+		goto st_case_548
+	case 549:
+		goto st_case_549
+	case 550:
+		goto st_case_550
+	case 551:
+		goto st_case_551
+	case 552:
+		goto st_case_552
+	case 553:
+		goto st_case_553
+	case 554:
+		goto st_case_554
+	case 555:
+		goto st_case_555
+	case 556:
+		goto st_case_556
+	case 557:
+		goto st_case_557
+	case 558:
+		goto st_case_558
+	case 559:
+		goto st_case_559
+	case 560:
+		goto st_case_560
+	case 561:
+		goto st_case_561
+	case 562:
+		goto st_case_562
+	case 563:
+		goto st_case_563
+	case 564:
+		goto st_case_564
+	case 565:
+		goto st_case_565
+	case 566:
+		goto st_case_566
+	case 567:
+		goto st_case_567
+	case 568:
+		goto st_case_568
+	case 569:
+		goto st_case_569
+	case 570:
+		goto st_case_570
+	case 571:
+		goto st_case_571
+	case 572:
+		goto st_case_572
+	case 573:
+		goto st_case_573
+	case 574:
+		goto st_case_574
+	case 575:
+		goto st_case_575
+	case 576:
+		goto st_case_576
+	case 577:
+		goto st_case_577
+	case 578:
+		goto st_case_578
+	case 579:
+		goto st_case_579
+	case 580:
+		goto st_case_580
+	case 581:
+		goto st_case_581
+	case 582:
+		goto st_case_582
+	case 583:
+		goto st_case_583
+	case 584:
+		goto st_case_584
+	case 585:
+		goto st_case_585
+	case 586:
+		goto st_case_586
+	case 587:
+		goto st_case_587
+	case 588:
+		goto st_case_588
+	case 589:
+		goto st_case_589
+	case 1068:
+		goto st_case_1068
+	case 590:
+		goto st_case_590
+	case 591:
+		goto st_case_591
+	case 592:
+		goto st_case_592
+	case 593:
+		goto st_case_593
+	case 594:
+		goto st_case_594
+	case 595:
+		goto st_case_595
+	case 596:
+		goto st_case_596
+	case 597:
+		goto st_case_597
+	case 598:
+		goto st_case_598
+	case 599:
+		goto st_case_599
+	case 600:
+		goto st_case_600
+	case 601:
+		goto st_case_601
+	case 602:
+		goto st_case_602
+	case 603:
+		goto st_case_603
+	case 604:
+		goto st_case_604
+	case 605:
+		goto st_case_605
+	case 606:
+		goto st_case_606
+	case 607:
+		goto st_case_607
+	case 608:
+		goto st_case_608
+	case 609:
+		goto st_case_609
+	case 610:
+		goto st_case_610
+	case 611:
+		goto st_case_611
+	case 612:
+		goto st_case_612
+	case 613:
+		goto st_case_613
+	case 614:
+		goto st_case_614
+	case 615:
+		goto st_case_615
+	case 616:
+		goto st_case_616
+	case 617:
+		goto st_case_617
+	case 618:
+		goto st_case_618
+	case 619:
+		goto st_case_619
+	case 620:
+		goto st_case_620
+	case 621:
+		goto st_case_621
+	case 622:
+		goto st_case_622
+	case 623:
+		goto st_case_623
+	case 624:
+		goto st_case_624
+	case 625:
+		goto st_case_625
+	case 626:
+		goto st_case_626
+	case 627:
+		goto st_case_627
+	case 628:
+		goto st_case_628
+	case 629:
+		goto st_case_629
+	case 630:
+		goto st_case_630
+	case 631:
+		goto st_case_631
+	case 632:
+		goto st_case_632
+	case 633:
+		goto st_case_633
+	case 634:
+		goto st_case_634
+	case 635:
+		goto st_case_635
+	case 636:
+		goto st_case_636
+	case 637:
+		goto st_case_637
+	case 638:
+		goto st_case_638
+	case 639:
+		goto st_case_639
+	case 640:
+		goto st_case_640
+	case 641:
+		goto st_case_641
+	case 642:
+		goto st_case_642
+	case 643:
+		goto st_case_643
+	case 644:
+		goto st_case_644
+	case 645:
+		goto st_case_645
+	case 646:
+		goto st_case_646
+	case 647:
+		goto st_case_647
+	case 648:
+		goto st_case_648
+	case 649:
+		goto st_case_649
+	case 650:
+		goto st_case_650
+	case 651:
+		goto st_case_651
+	case 652:
+		goto st_case_652
+	case 653:
+		goto st_case_653
+	case 654:
+		goto st_case_654
+	case 655:
+		goto st_case_655
+	case 656:
+		goto st_case_656
+	case 657:
+		goto st_case_657
+	case 658:
+		goto st_case_658
+	case 659:
+		goto st_case_659
+	case 660:
+		goto st_case_660
+	case 661:
+		goto st_case_661
+	case 662:
+		goto st_case_662
+	case 663:
+		goto st_case_663
+	case 664:
+		goto st_case_664
+	case 665:
+		goto st_case_665
+	case 666:
+		goto st_case_666
+	case 667:
+		goto st_case_667
+	case 668:
+		goto st_case_668
+	case 1069:
+		goto st_case_1069
+	case 669:
+		goto st_case_669
+	case 670:
+		goto st_case_670
+	case 671:
+		goto st_case_671
+	case 672:
+		goto st_case_672
+	case 673:
+		goto st_case_673
+	case 674:
+		goto st_case_674
+	case 675:
+		goto st_case_675
+	case 676:
+		goto st_case_676
+	case 677:
+		goto st_case_677
+	case 678:
+		goto st_case_678
+	case 679:
+		goto st_case_679
+	case 680:
+		goto st_case_680
+	case 681:
+		goto st_case_681
+	case 682:
+		goto st_case_682
+	case 683:
+		goto st_case_683
+	case 684:
+		goto st_case_684
+	case 685:
+		goto st_case_685
+	case 686:
+		goto st_case_686
+	case 687:
+		goto st_case_687
+	case 688:
+		goto st_case_688
+	case 689:
+		goto st_case_689
+	case 690:
+		goto st_case_690
+	case 691:
+		goto st_case_691
+	case 692:
+		goto st_case_692
+	case 693:
+		goto st_case_693
+	case 694:
+		goto st_case_694
+	case 695:
+		goto st_case_695
+	case 696:
+		goto st_case_696
+	case 697:
+		goto st_case_697
+	case 698:
+		goto st_case_698
+	case 699:
+		goto st_case_699
+	case 700:
+		goto st_case_700
+	case 701:
+		goto st_case_701
+	case 702:
+		goto st_case_702
+	case 703:
+		goto st_case_703
+	case 704:
+		goto st_case_704
+	case 705:
+		goto st_case_705
+	case 706:
+		goto st_case_706
+	case 707:
+		goto st_case_707
+	case 708:
+		goto st_case_708
+	case 709:
+		goto st_case_709
+	case 710:
+		goto st_case_710
+	case 711:
+		goto st_case_711
+	case 712:
+		goto st_case_712
+	case 713:
+		goto st_case_713
+	case 714:
+		goto st_case_714
+	case 715:
+		goto st_case_715
+	case 716:
+		goto st_case_716
+	case 717:
+		goto st_case_717
+	case 718:
+		goto st_case_718
+	case 719:
+		goto st_case_719
+	case 720:
+		goto st_case_720
+	case 721:
+		goto st_case_721
+	case 722:
+		goto st_case_722
+	case 723:
+		goto st_case_723
+	case 724:
+		goto st_case_724
+	case 725:
+		goto st_case_725
+	case 726:
+		goto st_case_726
+	case 727:
+		goto st_case_727
+	case 728:
+		goto st_case_728
+	case 729:
+		goto st_case_729
+	case 730:
+		goto st_case_730
+	case 731:
+		goto st_case_731
+	case 732:
+		goto st_case_732
+	case 1070:
+		goto st_case_1070
+	case 733:
+		goto st_case_733
+	case 734:
+		goto st_case_734
+	case 735:
+		goto st_case_735
+	case 736:
+		goto st_case_736
+	case 737:
+		goto st_case_737
+	case 738:
+		goto st_case_738
+	case 739:
+		goto st_case_739
+	case 740:
+		goto st_case_740
+	case 741:
+		goto st_case_741
+	case 742:
+		goto st_case_742
+	case 743:
+		goto st_case_743
+	case 744:
+		goto st_case_744
+	case 745:
+		goto st_case_745
+	case 746:
+		goto st_case_746
+	case 747:
+		goto st_case_747
+	case 748:
+		goto st_case_748
+	case 749:
+		goto st_case_749
+	case 750:
+		goto st_case_750
+	case 751:
+		goto st_case_751
+	case 752:
+		goto st_case_752
+	case 753:
+		goto st_case_753
+	case 754:
+		goto st_case_754
+	case 755:
+		goto st_case_755
+	case 756:
+		goto st_case_756
+	case 757:
+		goto st_case_757
+	case 758:
+		goto st_case_758
+	case 759:
+		goto st_case_759
+	case 760:
+		goto st_case_760
+	case 761:
+		goto st_case_761
+	case 762:
+		goto st_case_762
+	case 763:
+		goto st_case_763
+	case 764:
+		goto st_case_764
+	case 765:
+		goto st_case_765
+	case 766:
+		goto st_case_766
+	case 767:
+		goto st_case_767
+	case 768:
+		goto st_case_768
+	case 769:
+		goto st_case_769
+	case 770:
+		goto st_case_770
+	case 771:
+		goto st_case_771
+	case 772:
+		goto st_case_772
+	case 773:
+		goto st_case_773
+	case 774:
+		goto st_case_774
+	case 775:
+		goto st_case_775
+	case 776:
+		goto st_case_776
+	case 777:
+		goto st_case_777
+	case 778:
+		goto st_case_778
+	case 779:
+		goto st_case_779
+	case 780:
+		goto st_case_780
+	case 781:
+		goto st_case_781
+	case 782:
+		goto st_case_782
+	case 783:
+		goto st_case_783
+	case 784:
+		goto st_case_784
+	case 785:
+		goto st_case_785
+	case 786:
+		goto st_case_786
+	case 787:
+		goto st_case_787
+	case 788:
+		goto st_case_788
+	case 789:
+		goto st_case_789
+	case 790:
+		goto st_case_790
+	case 791:
+		goto st_case_791
+	case 792:
+		goto st_case_792
+	case 793:
+		goto st_case_793
+	case 794:
+		goto st_case_794
+	case 795:
+		goto st_case_795
+	case 796:
+		goto st_case_796
+	case 797:
+		goto st_case_797
+	case 798:
+		goto st_case_798
+	case 799:
+		goto st_case_799
+	case 800:
+		goto st_case_800
+	case 801:
+		goto st_case_801
+	case 802:
+		goto st_case_802
+	case 803:
+		goto st_case_803
+	case 804:
+		goto st_case_804
+	case 805:
+		goto st_case_805
+	case 806:
+		goto st_case_806
+	case 807:
+		goto st_case_807
+	case 808:
+		goto st_case_808
+	case 809:
+		goto st_case_809
+	case 810:
+		goto st_case_810
+	case 811:
+		goto st_case_811
+	case 812:
+		goto st_case_812
+	case 813:
+		goto st_case_813
+	case 814:
+		goto st_case_814
+	case 1071:
+		goto st_case_1071
+	case 1072:
+		goto st_case_1072
+	case 1073:
+		goto st_case_1073
+	case 1074:
+		goto st_case_1074
+	case 1075:
+		goto st_case_1075
+	case 815:
+		goto st_case_815
+	case 816:
+		goto st_case_816
+	case 817:
+		goto st_case_817
+	case 818:
+		goto st_case_818
+	case 819:
+		goto st_case_819
+	case 820:
+		goto st_case_820
+	case 821:
+		goto st_case_821
+	case 822:
+		goto st_case_822
+	case 823:
+		goto st_case_823
+	case 824:
+		goto st_case_824
+	case 825:
+		goto st_case_825
+	case 826:
+		goto st_case_826
+	case 827:
+		goto st_case_827
+	case 828:
+		goto st_case_828
+	case 829:
+		goto st_case_829
+	case 830:
+		goto st_case_830
+	case 831:
+		goto st_case_831
+	case 832:
+		goto st_case_832
+	case 833:
+		goto st_case_833
+	case 834:
+		goto st_case_834
+	case 835:
+		goto st_case_835
+	case 836:
+		goto st_case_836
+	case 837:
+		goto st_case_837
+	case 838:
+		goto st_case_838
+	case 1076:
+		goto st_case_1076
+	case 839:
+		goto st_case_839
+	case 840:
+		goto st_case_840
+	case 841:
+		goto st_case_841
+	case 1077:
+		goto st_case_1077
+	case 1078:
+		goto st_case_1078
+	case 1079:
+		goto st_case_1079
+	case 1080:
+		goto st_case_1080
+	case 1081:
+		goto st_case_1081
+	case 1082:
+		goto st_case_1082
+	case 1083:
+		goto st_case_1083
+	case 1084:
+		goto st_case_1084
+	case 1085:
+		goto st_case_1085
+	case 1086:
+		goto st_case_1086
+	case 1087:
+		goto st_case_1087
+	case 1088:
+		goto st_case_1088
+	case 1089:
+		goto st_case_1089
+	case 1090:
+		goto st_case_1090
+	case 1091:
+		goto st_case_1091
+	case 1092:
+		goto st_case_1092
+	case 1093:
+		goto st_case_1093
+	case 1094:
+		goto st_case_1094
+	case 1095:
+		goto st_case_1095
+	case 1096:
+		goto st_case_1096
+	case 1097:
+		goto st_case_1097
+	case 1098:
+		goto st_case_1098
+	case 842:
+		goto st_case_842
+	case 843:
+		goto st_case_843
+	case 844:
+		goto st_case_844
+	case 845:
+		goto st_case_845
+	case 846:
+		goto st_case_846
+	case 847:
+		goto st_case_847
+	case 848:
+		goto st_case_848
+	case 849:
+		goto st_case_849
+	case 850:
+		goto st_case_850
+	case 851:
+		goto st_case_851
+	case 852:
+		goto st_case_852
+	case 853:
+		goto st_case_853
+	case 854:
+		goto st_case_854
+	case 855:
+		goto st_case_855
+	case 856:
+		goto st_case_856
+	case 857:
+		goto st_case_857
+	case 858:
+		goto st_case_858
+	case 859:
+		goto st_case_859
+	case 860:
+		goto st_case_860
+	case 861:
+		goto st_case_861
+	case 862:
+		goto st_case_862
+	case 863:
+		goto st_case_863
+	case 864:
+		goto st_case_864
+	case 865:
+		goto st_case_865
+	case 866:
+		goto st_case_866
+	case 867:
+		goto st_case_867
+	case 868:
+		goto st_case_868
+	case 869:
+		goto st_case_869
+	case 870:
+		goto st_case_870
+	case 871:
+		goto st_case_871
+	case 872:
+		goto st_case_872
+	case 873:
+		goto st_case_873
+	case 874:
+		goto st_case_874
+	case 875:
+		goto st_case_875
+	case 876:
+		goto st_case_876
+	case 877:
+		goto st_case_877
+	case 878:
+		goto st_case_878
+	case 879:
+		goto st_case_879
+	case 880:
+		goto st_case_880
+	case 881:
+		goto st_case_881
+	case 882:
+		goto st_case_882
+	case 883:
+		goto st_case_883
+	case 884:
+		goto st_case_884
+	case 885:
+		goto st_case_885
+	case 886:
+		goto st_case_886
+	case 887:
+		goto st_case_887
+	case 888:
+		goto st_case_888
+	case 889:
+		goto st_case_889
+	case 890:
+		goto st_case_890
+	case 891:
+		goto st_case_891
+	case 892:
+		goto st_case_892
+	case 893:
+		goto st_case_893
+	case 894:
+		goto st_case_894
+	case 895:
+		goto st_case_895
+	case 1099:
+		goto st_case_1099
+	case 896:
+		goto st_case_896
+	case 897:
+		goto st_case_897
+	case 898:
+		goto st_case_898
+	case 899:
+		goto st_case_899
+	case 900:
+		goto st_case_900
+	case 901:
+		goto st_case_901
+	case 902:
+		goto st_case_902
+	case 903:
+		goto st_case_903
+	case 904:
+		goto st_case_904
+	case 905:
+		goto st_case_905
+	case 906:
+		goto st_case_906
+	case 907:
+		goto st_case_907
+	case 908:
+		goto st_case_908
+	case 909:
+		goto st_case_909
+	case 910:
+		goto st_case_910
+	case 911:
+		goto st_case_911
+	case 912:
+		goto st_case_912
+	case 913:
+		goto st_case_913
+	case 914:
+		goto st_case_914
+	case 915:
+		goto st_case_915
+	case 916:
+		goto st_case_916
+	case 917:
+		goto st_case_917
+	case 918:
+		goto st_case_918
+	case 919:
+		goto st_case_919
+	case 920:
+		goto st_case_920
+	case 921:
+		goto st_case_921
+	case 922:
+		goto st_case_922
+	case 923:
+		goto st_case_923
+	case 924:
+		goto st_case_924
+	case 925:
+		goto st_case_925
+	case 926:
+		goto st_case_926
+	case 927:
+		goto st_case_927
+	case 928:
+		goto st_case_928
+	case 929:
+		goto st_case_929
+	case 930:
+		goto st_case_930
+	case 931:
+		goto st_case_931
+	case 932:
+		goto st_case_932
+	case 933:
+		goto st_case_933
+	case 934:
+		goto st_case_934
+	case 935:
+		goto st_case_935
+	case 936:
+		goto st_case_936
+	case 937:
+		goto st_case_937
+	case 938:
+		goto st_case_938
+	case 939:
+		goto st_case_939
+	case 1100:
+		goto st_case_1100
+	case 940:
+		goto st_case_940
+	case 941:
+		goto st_case_941
+	case 942:
+		goto st_case_942
+	case 943:
+		goto st_case_943
+	case 944:
+		goto st_case_944
+	case 945:
+		goto st_case_945
+	case 946:
+		goto st_case_946
+	case 947:
+		goto st_case_947
+	case 948:
+		goto st_case_948
+	case 949:
+		goto st_case_949
+	case 950:
+		goto st_case_950
+	case 951:
+		goto st_case_951
+	case 952:
+		goto st_case_952
+	case 953:
+		goto st_case_953
+	case 954:
+		goto st_case_954
+	case 955:
+		goto st_case_955
+	case 956:
+		goto st_case_956
+	case 957:
+		goto st_case_957
+	case 958:
+		goto st_case_958
+	case 959:
+		goto st_case_959
+	case 960:
+		goto st_case_960
+	case 961:
+		goto st_case_961
+	case 962:
+		goto st_case_962
+	case 963:
+		goto st_case_963
+	case 964:
+		goto st_case_964
+	case 965:
+		goto st_case_965
+	case 966:
+		goto st_case_966
+	case 967:
+		goto st_case_967
+	case 968:
+		goto st_case_968
+	case 969:
+		goto st_case_969
+	case 970:
+		goto st_case_970
+	case 971:
+		goto st_case_971
+	case 972:
+		goto st_case_972
+	case 973:
+		goto st_case_973
+	case 974:
+		goto st_case_974
+	case 975:
+		goto st_case_975
+	case 976:
+		goto st_case_976
+	case 977:
+		goto st_case_977
+	case 978:
+		goto st_case_978
+	case 979:
+		goto st_case_979
+	case 980:
+		goto st_case_980
+	case 981:
+		goto st_case_981
+	case 982:
+		goto st_case_982
+	case 983:
+		goto st_case_983
+	case 984:
+		goto st_case_984
+	case 985:
+		goto st_case_985
+	case 986:
+		goto st_case_986
+	case 987:
+		goto st_case_987
+	case 988:
+		goto st_case_988
+	case 989:
+		goto st_case_989
+	case 990:
+		goto st_case_990
+	case 991:
+		goto st_case_991
+	case 992:
+		goto st_case_992
+	case 993:
+		goto st_case_993
+	case 994:
+		goto st_case_994
+	case 1101:
+		goto st_case_1101
+	case 1102:
+		goto st_case_1102
+	case 1103:
+		goto st_case_1103
+	case 1104:
+		goto st_case_1104
+	case 1105:
+		goto st_case_1105
+	case 995:
+		goto st_case_995
+	case 996:
+		goto st_case_996
+	case 997:
+		goto st_case_997
+	case 998:
+		goto st_case_998
+	case 999:
+		goto st_case_999
+	case 1000:
+		goto st_case_1000
+	case 1001:
+		goto st_case_1001
+	case 1002:
+		goto st_case_1002
+	case 1003:
+		goto st_case_1003
+	case 1004:
+		goto st_case_1004
+	case 1005:
+		goto st_case_1005
+	case 1006:
+		goto st_case_1006
+	case 1007:
+		goto st_case_1007
+	case 1008:
+		goto st_case_1008
+	case 1009:
+		goto st_case_1009
+	case 1010:
+		goto st_case_1010
+	case 1011:
+		goto st_case_1011
+	case 1012:
+		goto st_case_1012
+	case 1013:
+		goto st_case_1013
+	case 1014:
+		goto st_case_1014
+	case 1015:
+		goto st_case_1015
+	case 1016:
+		goto st_case_1016
+	case 1017:
+		goto st_case_1017
+	case 1018:
+		goto st_case_1018
+	case 1019:
+		goto st_case_1019
+	case 1020:
+		goto st_case_1020
+	case 1021:
+		goto st_case_1021
+	case 1022:
+		goto st_case_1022
+	case 1023:
+		goto st_case_1023
+	case 1024:
+		goto st_case_1024
+	case 1025:
+		goto st_case_1025
+	case 1026:
+		goto st_case_1026
+	case 1027:
+		goto st_case_1027
+	case 1028:
+		goto st_case_1028
+	case 1029:
+		goto st_case_1029
+	case 1030:
+		goto st_case_1030
+	case 1031:
+		goto st_case_1031
+	case 1032:
+		goto st_case_1032
+	case 1033:
+		goto st_case_1033
+	case 1034:
+		goto st_case_1034
+	case 1035:
+		goto st_case_1035
+	case 1036:
+		goto st_case_1036
+	case 1037:
+		goto st_case_1037
+	case 1038:
+		goto st_case_1038
+	case 1039:
+		goto st_case_1039
+	case 1040:
+		goto st_case_1040
+	case 1041:
+		goto st_case_1041
+	case 1042:
+		goto st_case_1042
+	case 1106:
+		goto st_case_1106
+	case 1107:
+		goto st_case_1107
+	case 1108:
+		goto st_case_1108
+	case 1109:
+		goto st_case_1109
+	case 1110:
+		goto st_case_1110
+	case 1043:
+		goto st_case_1043
+	case 1044:
+		goto st_case_1044
+	case 1045:
+		goto st_case_1045
+	case 1046:
+		goto st_case_1046
+	case 1047:
+		goto st_case_1047
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 37 {
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 65 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 83 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 65 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 45 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if 48 <= data[p] && data[p] <= 55 {
+			goto tr6
+		}
+		goto st0
+tr6:
+//line asa.rl:71
+ e.Severity = int((data[p]) - '0') 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line asa.go:2397
+		if data[p] == 45 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+tr8:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line asa.go:2420
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr9
+		}
+		goto st0
+tr9:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line asa.go:2434
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr10
+		}
+		goto st0
+tr10:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line asa.go:2448
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr11
+		}
+		goto st0
+tr11:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line asa.go:2462
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr12
+		}
+		goto st0
+tr12:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line asa.go:2476
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr13
+		}
+		goto st0
+tr13:
+//line asa.rl:72
+ e.MessageID = e.MessageID*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line asa.go:2490
+		if data[p] == 58 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if data[p] == 32 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line asa.rl:94
+
+	        e.Text = data[p+1:]
+	        switch e.MessageID {
+	        case 302013, 302015:
+	            goto st16
+
+	        case 302014, 302016:
+	            goto st86
+
+	        case 302020, 302021:
+	            goto st163
+
+	        case 305011, 305012:
+	            goto st251
+
+	        case 106001:
+	            goto st329
+
+	        case 106006, 106007:
+	            goto st405
+
+	        case 106014:
+	            goto st468
+
+	        case 106015:
+	            goto st530
+
+	        case 106023:
+	            goto st599
+
+	        case 106100:
+	            goto st682
+
+	        case 110003:
+	            goto st756
+
+	        case 113004, 113005, 113015:
+	            goto st820
+
+	        case 313001:
+	            goto st851
+
+	        case 605005:
+	            goto st897
+
+	        case 710003:
+	            goto st955
+
+	        case 710005:
+	            goto st1006
+
+	        }
+	    
+	goto st1048
+	st1048:
+		if p++; p == pe {
+			goto _test_eof1048
+		}
+	st_case_1048:
+//line asa.go:2565
+		goto st1048
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 66 {
+			goto tr16
+		}
+		goto st0
+tr16:
+//line asa.rl:70
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line asa.go:2585
+		if data[p] == 117 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 105 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 108 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 116 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 32 {
+			goto tr21
+		}
+		goto st0
+tr21:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line asa.go:2635
+		switch data[p] {
+		case 73:
+			goto tr22
+		case 79:
+			goto tr23
+		case 105:
+			goto tr22
+		case 111:
+			goto tr23
+		}
+		goto st0
+tr22:
+//line asa.rl:70
+ mark = p 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line asa.go:2656
+		if data[p] == 110 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 98 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 111 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 117 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 110 {
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 100 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 32 {
+			goto tr30
+		}
+		goto st0
+tr30:
+//line asa.rl:79
+ e.Direction = data[mark:p] 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line asa.go:2724
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr31
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr32
+			}
+		default:
+			goto tr32
+		}
+		goto st0
+tr31:
+//line asa.rl:70
+ mark = p 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line asa.go:2747
+		if data[p] == 32 {
+			goto tr33
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st31
+		}
+		goto st0
+tr33:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line asa.go:2764
+		if data[p] == 99 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 111 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 110 {
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 110 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 101 {
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 99 {
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 116 {
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 105 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 111 {
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 110 {
+			goto st42
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		if data[p] == 32 {
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr46
+		}
+		goto st0
+tr46:
+//line asa.rl:81
+ e.ConnID = e.ConnID*10 + int64((data[p])-'0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line asa.go:2877
+		if data[p] == 32 {
+			goto st45
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr46
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 102 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 111 {
+			goto st47
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		if data[p] == 114 {
+			goto st48
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 32 {
+			goto st49
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 95 {
+			goto tr52
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr52
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr52
+				}
+			case data[p] >= 65:
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr52:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line asa.go:2958
+		switch data[p] {
+		case 58:
+			goto tr54
+		case 95:
+			goto st50
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st50
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st50
+				}
+			case data[p] >= 65:
+				goto st50
+			}
+		default:
+			goto st50
+		}
+		goto st0
+tr54:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line asa.go:2992
+		if data[p] == 46 {
+			goto tr55
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr55
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr55
+			}
+		default:
+			goto tr55
+		}
+		goto st0
+tr55:
+//line asa.rl:70
+ mark = p 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line asa.go:3018
+		if data[p] == 47 {
+			goto tr57
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st52
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st52
+			}
+		default:
+			goto st52
+		}
+		goto st0
+tr57:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line asa.go:3044
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr58
+		}
+		goto st0
+tr58:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line asa.go:3058
+		if data[p] == 32 {
+			goto st55
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr60
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 40 {
+			goto st56
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		if data[p] == 46 {
+			goto st57
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st57
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st57
+			}
+		default:
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 47 {
+			goto st58
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st57
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st57
+			}
+		default:
+			goto st57
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st59
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 41 {
+			goto st60
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st59
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 32 {
+			goto st61
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 116 {
+			goto st62
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 111 {
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 32 {
+			goto st64
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 95 {
+			goto tr70
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr70
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr70
+				}
+			case data[p] >= 65:
+				goto tr70
+			}
+		default:
+			goto tr70
+		}
+		goto st0
+tr70:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line asa.go:3211
+		switch data[p] {
+		case 58:
+			goto tr72
+		case 95:
+			goto st65
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st65
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st65
+				}
+			case data[p] >= 65:
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto st0
+tr72:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st66
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+//line asa.go:3245
+		if data[p] == 46 {
+			goto tr73
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr73
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr73
+			}
+		default:
+			goto tr73
+		}
+		goto st0
+tr73:
+//line asa.rl:70
+ mark = p 
+	goto st67
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+//line asa.go:3271
+		if data[p] == 47 {
+			goto tr75
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto st0
+tr75:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st68
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+//line asa.go:3297
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr76
+		}
+		goto st0
+tr76:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st69
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+//line asa.go:3311
+		if data[p] == 32 {
+			goto st70
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr78
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 40 {
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 46 {
+			goto st72
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st72
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st72
+			}
+		default:
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 47 {
+			goto st73
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st72
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st72
+			}
+		default:
+			goto st72
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st74
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 41 {
+			goto st1049
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st74
+		}
+		goto st0
+	st1049:
+		if p++; p == pe {
+			goto _test_eof1049
+		}
+	st_case_1049:
+		goto st0
+tr78:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+//line asa.go:3406
+		if data[p] == 32 {
+			goto st70
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr84
+		}
+		goto st0
+tr84:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line asa.go:3423
+		if data[p] == 32 {
+			goto st70
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr85
+		}
+		goto st0
+tr85:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st77
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+//line asa.go:3440
+		if data[p] == 32 {
+			goto st70
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr86
+		}
+		goto st0
+tr86:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st78
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+//line asa.go:3457
+		if data[p] == 32 {
+			goto st70
+		}
+		goto st0
+tr60:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st79
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+//line asa.go:3471
+		if data[p] == 32 {
+			goto st55
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr87
+		}
+		goto st0
+tr87:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st80
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+//line asa.go:3488
+		if data[p] == 32 {
+			goto st55
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr88
+		}
+		goto st0
+tr88:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st81
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+//line asa.go:3505
+		if data[p] == 32 {
+			goto st55
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr89
+		}
+		goto st0
+tr89:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st82
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+//line asa.go:3522
+		if data[p] == 32 {
+			goto st55
+		}
+		goto st0
+tr32:
+//line asa.rl:70
+ mark = p 
+	goto st83
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+//line asa.go:3536
+		if data[p] == 32 {
+			goto tr33
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st83
+			}
+		case data[p] >= 65:
+			goto st83
+		}
+		goto st0
+tr23:
+//line asa.rl:70
+ mark = p 
+	goto st84
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+//line asa.go:3558
+		if data[p] == 117 {
+			goto st85
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		if data[p] == 116 {
+			goto st24
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 84 {
+			goto tr92
+		}
+		goto st0
+tr92:
+//line asa.rl:70
+ mark = p 
+	goto st87
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+//line asa.go:3590
+		if data[p] == 101 {
+			goto st88
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 97 {
+			goto st89
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		if data[p] == 114 {
+			goto st90
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		if data[p] == 100 {
+			goto st91
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 111 {
+			goto st92
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 119 {
+			goto st93
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		if data[p] == 110 {
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		if data[p] == 32 {
+			goto tr100
+		}
+		goto st0
+tr100:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st95
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+//line asa.go:3667
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr101
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr102
+			}
+		default:
+			goto tr102
+		}
+		goto st0
+tr101:
+//line asa.rl:70
+ mark = p 
+	goto st96
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+//line asa.go:3690
+		if data[p] == 32 {
+			goto tr103
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st96
+		}
+		goto st0
+tr103:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st97
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+//line asa.go:3707
+		if data[p] == 99 {
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 111 {
+			goto st99
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		if data[p] == 110 {
+			goto st100
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if data[p] == 110 {
+			goto st101
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 101 {
+			goto st102
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 99 {
+			goto st103
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 116 {
+			goto st104
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 105 {
+			goto st105
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		if data[p] == 111 {
+			goto st106
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 110 {
+			goto st107
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 32 {
+			goto st108
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr116
+		}
+		goto st0
+tr116:
+//line asa.rl:81
+ e.ConnID = e.ConnID*10 + int64((data[p])-'0') 
+	goto st109
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+//line asa.go:3820
+		if data[p] == 32 {
+			goto st110
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr116
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 102 {
+			goto st111
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 111 {
+			goto st112
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 114 {
+			goto st113
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 32 {
+			goto st114
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 95 {
+			goto tr122
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr122
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr122
+				}
+			case data[p] >= 65:
+				goto tr122
+			}
+		default:
+			goto tr122
+		}
+		goto st0
+tr122:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st115
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+//line asa.go:3901
+		switch data[p] {
+		case 58:
+			goto tr124
+		case 95:
+			goto st115
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st115
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st115
+				}
+			case data[p] >= 65:
+				goto st115
+			}
+		default:
+			goto st115
+		}
+		goto st0
+tr124:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st116
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+//line asa.go:3935
+		if data[p] == 46 {
+			goto tr125
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr125
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr125
+			}
+		default:
+			goto tr125
+		}
+		goto st0
+tr125:
+//line asa.rl:70
+ mark = p 
+	goto st117
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+//line asa.go:3961
+		if data[p] == 47 {
+			goto tr127
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st117
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st117
+			}
+		default:
+			goto st117
+		}
+		goto st0
+tr127:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st118
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+//line asa.go:3987
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr128
+		}
+		goto st0
+tr128:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st119
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+//line asa.go:4001
+		if data[p] == 32 {
+			goto st120
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr130
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 116 {
+			goto st121
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 111 {
+			goto st122
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 32 {
+			goto st123
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 95 {
+			goto tr134
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr134
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr134
+				}
+			case data[p] >= 65:
+				goto tr134
+			}
+		default:
+			goto tr134
+		}
+		goto st0
+tr134:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st124
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+//line asa.go:4073
+		switch data[p] {
+		case 58:
+			goto tr136
+		case 95:
+			goto st124
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st124
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st124
+				}
+			case data[p] >= 65:
+				goto st124
+			}
+		default:
+			goto st124
+		}
+		goto st0
+tr136:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st125
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+//line asa.go:4107
+		if data[p] == 46 {
+			goto tr137
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr137
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr137
+			}
+		default:
+			goto tr137
+		}
+		goto st0
+tr137:
+//line asa.rl:70
+ mark = p 
+	goto st126
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+//line asa.go:4133
+		if data[p] == 47 {
+			goto tr139
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st126
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+tr139:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st127
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+//line asa.go:4159
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr140
+		}
+		goto st0
+tr140:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st128
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+//line asa.go:4173
+		if data[p] == 32 {
+			goto st129
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr142
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 100 {
+			goto st130
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if data[p] == 117 {
+			goto st131
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		if data[p] == 114 {
+			goto st132
+		}
+		goto st0
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		if data[p] == 97 {
+			goto st133
+		}
+		goto st0
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		if data[p] == 116 {
+			goto st134
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if data[p] == 105 {
+			goto st135
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if data[p] == 111 {
+			goto st136
+		}
+		goto st0
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		if data[p] == 110 {
+			goto st137
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		if data[p] == 32 {
+			goto st138
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr152
+		}
+		goto st0
+tr152:
+//line asa.rl:82
+ hours = hours*10 + int((data[p])-'0') 
+	goto st139
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+//line asa.go:4280
+		if data[p] == 58 {
+			goto st140
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr152
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr154
+		}
+		goto st0
+tr154:
+//line asa.rl:83
+ mins = mins*10 + int((data[p])-'0') 
+	goto st141
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+//line asa.go:4306
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr155
+		}
+		goto st0
+tr155:
+//line asa.rl:83
+ mins = mins*10 + int((data[p])-'0') 
+	goto st142
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+//line asa.go:4320
+		if data[p] == 58 {
+			goto st143
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr157
+		}
+		goto st0
+tr157:
+//line asa.rl:84
+ secs = secs*10 + int((data[p])-'0') 
+	goto st144
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+//line asa.go:4343
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr158
+		}
+		goto st0
+tr158:
+//line asa.rl:84
+ secs = secs*10 + int((data[p])-'0') 
+	goto st145
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+//line asa.go:4357
+		if data[p] == 32 {
+			goto tr159
+		}
+		goto st0
+tr159:
+//line asa.rl:90
+
+	        e.Duration = time.Duration(hours*3600+mins*60+secs) * time.Second
+	    
+	goto st146
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+//line asa.go:4373
+		if data[p] == 98 {
+			goto st147
+		}
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		if data[p] == 121 {
+			goto st148
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 116 {
+			goto st149
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		if data[p] == 101 {
+			goto st150
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		if data[p] == 115 {
+			goto st151
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		if data[p] == 32 {
+			goto st152
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr166
+		}
+		goto st0
+tr166:
+//line asa.rl:85
+ e.Bytes = e.Bytes*10 + int64((data[p])-'0') 
+	goto st1050
+	st1050:
+		if p++; p == pe {
+			goto _test_eof1050
+		}
+	st_case_1050:
+//line asa.go:4441
+		if data[p] == 32 {
+			goto st153
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr166
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		goto tr167
+tr167:
+//line asa.rl:70
+ mark = p 
+	goto st1051
+	st1051:
+		if p++; p == pe {
+			goto _test_eof1051
+		}
+	st_case_1051:
+//line asa.go:4464
+		goto st1051
+tr142:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st154
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+//line asa.go:4475
+		if data[p] == 32 {
+			goto st129
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr168
+		}
+		goto st0
+tr168:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st155
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+//line asa.go:4492
+		if data[p] == 32 {
+			goto st129
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr169
+		}
+		goto st0
+tr169:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st156
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+//line asa.go:4509
+		if data[p] == 32 {
+			goto st129
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr170
+		}
+		goto st0
+tr170:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st157
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+//line asa.go:4526
+		if data[p] == 32 {
+			goto st129
+		}
+		goto st0
+tr130:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st158
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+//line asa.go:4540
+		if data[p] == 32 {
+			goto st120
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr171
+		}
+		goto st0
+tr171:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st159
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+//line asa.go:4557
+		if data[p] == 32 {
+			goto st120
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr172
+		}
+		goto st0
+tr172:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st160
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+//line asa.go:4574
+		if data[p] == 32 {
+			goto st120
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr173
+		}
+		goto st0
+tr173:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st161
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+//line asa.go:4591
+		if data[p] == 32 {
+			goto st120
+		}
+		goto st0
+tr102:
+//line asa.rl:70
+ mark = p 
+	goto st162
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+//line asa.go:4605
+		if data[p] == 32 {
+			goto tr103
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st162
+			}
+		case data[p] >= 65:
+			goto st162
+		}
+		goto st0
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		switch data[p] {
+		case 66:
+			goto tr175
+		case 84:
+			goto tr176
+		}
+		goto st0
+tr175:
+//line asa.rl:70
+ mark = p 
+	goto st164
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+//line asa.go:4639
+		if data[p] == 117 {
+			goto st165
+		}
+		goto st0
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		if data[p] == 105 {
+			goto st166
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		if data[p] == 108 {
+			goto st167
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 116 {
+			goto st168
+		}
+		goto st0
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 32 {
+			goto tr181
+		}
+		goto st0
+tr181:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st169
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+//line asa.go:4689
+		switch data[p] {
+		case 73:
+			goto tr182
+		case 79:
+			goto tr183
+		case 105:
+			goto tr182
+		case 111:
+			goto tr183
+		}
+		goto st0
+tr182:
+//line asa.rl:70
+ mark = p 
+	goto st170
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+//line asa.go:4710
+		if data[p] == 110 {
+			goto st171
+		}
+		goto st0
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if data[p] == 98 {
+			goto st172
+		}
+		goto st0
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		if data[p] == 111 {
+			goto st173
+		}
+		goto st0
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		if data[p] == 117 {
+			goto st174
+		}
+		goto st0
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		if data[p] == 110 {
+			goto st175
+		}
+		goto st0
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		if data[p] == 100 {
+			goto st176
+		}
+		goto st0
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		if data[p] == 32 {
+			goto tr190
+		}
+		goto st0
+tr190:
+//line asa.rl:79
+ e.Direction = data[mark:p] 
+	goto st177
+tr267:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st177
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+//line asa.go:4782
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr191
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr192
+			}
+		default:
+			goto tr192
+		}
+		goto st0
+tr191:
+//line asa.rl:70
+ mark = p 
+	goto st178
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+//line asa.go:4805
+		if data[p] == 32 {
+			goto tr193
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st178
+		}
+		goto st0
+tr193:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st179
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+//line asa.go:4822
+		if data[p] == 99 {
+			goto st180
+		}
+		goto st0
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		if data[p] == 111 {
+			goto st181
+		}
+		goto st0
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		if data[p] == 110 {
+			goto st182
+		}
+		goto st0
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 110 {
+			goto st183
+		}
+		goto st0
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 101 {
+			goto st184
+		}
+		goto st0
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		if data[p] == 99 {
+			goto st185
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		if data[p] == 116 {
+			goto st186
+		}
+		goto st0
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		if data[p] == 105 {
+			goto st187
+		}
+		goto st0
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 111 {
+			goto st188
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		if data[p] == 110 {
+			goto st189
+		}
+		goto st0
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		if data[p] == 32 {
+			goto st190
+		}
+		goto st0
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		if data[p] == 102 {
+			goto st191
+		}
+		goto st0
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		if data[p] == 111 {
+			goto st192
+		}
+		goto st0
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		if data[p] == 114 {
+			goto st193
+		}
+		goto st0
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		if data[p] == 32 {
+			goto st194
+		}
+		goto st0
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		if data[p] == 102 {
+			goto st195
+		}
+		goto st0
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		if data[p] == 97 {
+			goto st196
+		}
+		goto st0
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		if data[p] == 100 {
+			goto st197
+		}
+		goto st0
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		if data[p] == 100 {
+			goto st198
+		}
+		goto st0
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		if data[p] == 114 {
+			goto st199
+		}
+		goto st0
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		if data[p] == 32 {
+			goto st200
+		}
+		goto st0
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		if data[p] == 46 {
+			goto tr216
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr216
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr216
+			}
+		default:
+			goto tr216
+		}
+		goto st0
+tr216:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st201
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+//line asa.go:5039
+		if data[p] == 47 {
+			goto tr218
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st201
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st201
+			}
+		default:
+			goto st201
+		}
+		goto st0
+tr218:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st202
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+//line asa.go:5065
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr219
+		}
+		goto st0
+tr219:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st203
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+//line asa.go:5079
+		if data[p] == 32 {
+			goto st204
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr221
+		}
+		goto st0
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		if data[p] == 103 {
+			goto st205
+		}
+		goto st0
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		if data[p] == 97 {
+			goto st206
+		}
+		goto st0
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		if data[p] == 100 {
+			goto st207
+		}
+		goto st0
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		if data[p] == 100 {
+			goto st208
+		}
+		goto st0
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		if data[p] == 114 {
+			goto st209
+		}
+		goto st0
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		if data[p] == 32 {
+			goto st210
+		}
+		goto st0
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		if data[p] == 46 {
+			goto st211
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st211
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st211
+			}
+		default:
+			goto st211
+		}
+		goto st0
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		if data[p] == 47 {
+			goto st212
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st211
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st211
+			}
+		default:
+			goto st211
+		}
+		goto st0
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st213
+		}
+		goto st0
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		if data[p] == 32 {
+			goto st214
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st213
+		}
+		goto st0
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		if data[p] == 108 {
+			goto st215
+		}
+		goto st0
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		if data[p] == 97 {
+			goto st216
+		}
+		goto st0
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		if data[p] == 100 {
+			goto st217
+		}
+		goto st0
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		if data[p] == 100 {
+			goto st218
+		}
+		goto st0
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		if data[p] == 114 {
+			goto st219
+		}
+		goto st0
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		if data[p] == 32 {
+			goto st220
+		}
+		goto st0
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		if data[p] == 46 {
+			goto tr238
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr238
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr238
+			}
+		default:
+			goto tr238
+		}
+		goto st0
+tr238:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st221
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+//line asa.go:5290
+		if data[p] == 47 {
+			goto tr240
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st221
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st221
+			}
+		default:
+			goto st221
+		}
+		goto st0
+tr240:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st222
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+//line asa.go:5316
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr241
+		}
+		goto st0
+tr241:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1052
+	st1052:
+		if p++; p == pe {
+			goto _test_eof1052
+		}
+	st_case_1052:
+//line asa.go:5330
+		if data[p] == 32 {
+			goto st223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1138
+		}
+		goto st0
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		if data[p] == 116 {
+			goto st224
+		}
+		goto st0
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		if data[p] == 121 {
+			goto st225
+		}
+		goto st0
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 112 {
+			goto st226
+		}
+		goto st0
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 101 {
+			goto st227
+		}
+		goto st0
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		if data[p] == 32 {
+			goto st228
+		}
+		goto st0
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st229
+		}
+		goto st0
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 32 {
+			goto st230
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st229
+		}
+		goto st0
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		if data[p] == 99 {
+			goto st231
+		}
+		goto st0
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 111 {
+			goto st232
+		}
+		goto st0
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		if data[p] == 100 {
+			goto st233
+		}
+		goto st0
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		if data[p] == 101 {
+			goto st234
+		}
+		goto st0
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 32 {
+			goto st235
+		}
+		goto st0
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st1053
+		}
+		goto st0
+	st1053:
+		if p++; p == pe {
+			goto _test_eof1053
+		}
+	st_case_1053:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st1053
+		}
+		goto st0
+tr1138:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1054
+	st1054:
+		if p++; p == pe {
+			goto _test_eof1054
+		}
+	st_case_1054:
+//line asa.go:5476
+		if data[p] == 32 {
+			goto st223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1139
+		}
+		goto st0
+tr1139:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1055
+	st1055:
+		if p++; p == pe {
+			goto _test_eof1055
+		}
+	st_case_1055:
+//line asa.go:5493
+		if data[p] == 32 {
+			goto st223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1140
+		}
+		goto st0
+tr1140:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1056
+	st1056:
+		if p++; p == pe {
+			goto _test_eof1056
+		}
+	st_case_1056:
+//line asa.go:5510
+		if data[p] == 32 {
+			goto st223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1141
+		}
+		goto st0
+tr1141:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1057
+	st1057:
+		if p++; p == pe {
+			goto _test_eof1057
+		}
+	st_case_1057:
+//line asa.go:5527
+		if data[p] == 32 {
+			goto st223
+		}
+		goto st0
+tr221:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st236
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+//line asa.go:5541
+		if data[p] == 32 {
+			goto st204
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr255
+		}
+		goto st0
+tr255:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st237
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+//line asa.go:5558
+		if data[p] == 32 {
+			goto st204
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr256
+		}
+		goto st0
+tr256:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st238
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+//line asa.go:5575
+		if data[p] == 32 {
+			goto st204
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr257
+		}
+		goto st0
+tr257:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st239
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+//line asa.go:5592
+		if data[p] == 32 {
+			goto st204
+		}
+		goto st0
+tr192:
+//line asa.rl:70
+ mark = p 
+	goto st240
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+//line asa.go:5606
+		if data[p] == 32 {
+			goto tr193
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st240
+			}
+		case data[p] >= 65:
+			goto st240
+		}
+		goto st0
+tr183:
+//line asa.rl:70
+ mark = p 
+	goto st241
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+//line asa.go:5628
+		if data[p] == 117 {
+			goto st242
+		}
+		goto st0
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		if data[p] == 116 {
+			goto st171
+		}
+		goto st0
+tr176:
+//line asa.rl:70
+ mark = p 
+	goto st243
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+//line asa.go:5651
+		if data[p] == 101 {
+			goto st244
+		}
+		goto st0
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		if data[p] == 97 {
+			goto st245
+		}
+		goto st0
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 114 {
+			goto st246
+		}
+		goto st0
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		if data[p] == 100 {
+			goto st247
+		}
+		goto st0
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		if data[p] == 111 {
+			goto st248
+		}
+		goto st0
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		if data[p] == 119 {
+			goto st249
+		}
+		goto st0
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		if data[p] == 110 {
+			goto st250
+		}
+		goto st0
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		if data[p] == 32 {
+			goto tr267
+		}
+		goto st0
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		switch data[p] {
+		case 66:
+			goto tr268
+		case 84:
+			goto tr269
+		}
+		goto st0
+tr268:
+//line asa.rl:70
+ mark = p 
+	goto st252
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+//line asa.go:5740
+		if data[p] == 117 {
+			goto st253
+		}
+		goto st0
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		if data[p] == 105 {
+			goto st254
+		}
+		goto st0
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		if data[p] == 108 {
+			goto st255
+		}
+		goto st0
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		if data[p] == 116 {
+			goto st256
+		}
+		goto st0
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		if data[p] == 32 {
+			goto tr274
+		}
+		goto st0
+tr274:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st257
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+//line asa.go:5790
+		switch data[p] {
+		case 100:
+			goto st258
+		case 115:
+			goto st319
+		}
+		goto st0
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		if data[p] == 121 {
+			goto st259
+		}
+		goto st0
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		if data[p] == 110 {
+			goto st260
+		}
+		goto st0
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		if data[p] == 97 {
+			goto st261
+		}
+		goto st0
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		if data[p] == 109 {
+			goto st262
+		}
+		goto st0
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+		if data[p] == 105 {
+			goto st263
+		}
+		goto st0
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		if data[p] == 99 {
+			goto st264
+		}
+		goto st0
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		if data[p] == 32 {
+			goto st265
+		}
+		goto st0
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr284
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr285
+			}
+		default:
+			goto tr285
+		}
+		goto st0
+tr284:
+//line asa.rl:70
+ mark = p 
+	goto st266
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+//line asa.go:5888
+		if data[p] == 32 {
+			goto tr286
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st266
+		}
+		goto st0
+tr286:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st267
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+//line asa.go:5905
+		if data[p] == 116 {
+			goto st268
+		}
+		goto st0
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		if data[p] == 114 {
+			goto st269
+		}
+		goto st0
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		if data[p] == 97 {
+			goto st270
+		}
+		goto st0
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		if data[p] == 110 {
+			goto st271
+		}
+		goto st0
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		if data[p] == 115 {
+			goto st272
+		}
+		goto st0
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		if data[p] == 108 {
+			goto st273
+		}
+		goto st0
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		if data[p] == 97 {
+			goto st274
+		}
+		goto st0
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		if data[p] == 116 {
+			goto st275
+		}
+		goto st0
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		if data[p] == 105 {
+			goto st276
+		}
+		goto st0
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		if data[p] == 111 {
+			goto st277
+		}
+		goto st0
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		if data[p] == 110 {
+			goto st278
+		}
+		goto st0
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		if data[p] == 32 {
+			goto st279
+		}
+		goto st0
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		if data[p] == 102 {
+			goto st280
+		}
+		goto st0
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		if data[p] == 114 {
+			goto st281
+		}
+		goto st0
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		if data[p] == 111 {
+			goto st282
+		}
+		goto st0
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		if data[p] == 109 {
+			goto st283
+		}
+		goto st0
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		if data[p] == 32 {
+			goto st284
+		}
+		goto st0
+	st284:
+		if p++; p == pe {
+			goto _test_eof284
+		}
+	st_case_284:
+		if data[p] == 95 {
+			goto tr305
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr305
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr305
+				}
+			case data[p] >= 65:
+				goto tr305
+			}
+		default:
+			goto tr305
+		}
+		goto st0
+tr305:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st285
+	st285:
+		if p++; p == pe {
+			goto _test_eof285
+		}
+	st_case_285:
+//line asa.go:6091
+		switch data[p] {
+		case 58:
+			goto tr307
+		case 95:
+			goto st285
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st285
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st285
+				}
+			case data[p] >= 65:
+				goto st285
+			}
+		default:
+			goto st285
+		}
+		goto st0
+tr307:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st286
+	st286:
+		if p++; p == pe {
+			goto _test_eof286
+		}
+	st_case_286:
+//line asa.go:6125
+		if data[p] == 46 {
+			goto tr308
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr308
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr308
+			}
+		default:
+			goto tr308
+		}
+		goto st0
+tr308:
+//line asa.rl:70
+ mark = p 
+	goto st287
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
+//line asa.go:6151
+		if data[p] == 47 {
+			goto tr310
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st287
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st287
+			}
+		default:
+			goto st287
+		}
+		goto st0
+tr310:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st288
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+//line asa.go:6177
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr311
+		}
+		goto st0
+tr311:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st289
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+//line asa.go:6191
+		if data[p] == 32 {
+			goto st290
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr313
+		}
+		goto st0
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		if data[p] == 116 {
+			goto st291
+		}
+		goto st0
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+		if data[p] == 111 {
+			goto st292
+		}
+		goto st0
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+		if data[p] == 32 {
+			goto st293
+		}
+		goto st0
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		if data[p] == 95 {
+			goto tr317
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr317
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr317
+				}
+			case data[p] >= 65:
+				goto tr317
+			}
+		default:
+			goto tr317
+		}
+		goto st0
+tr317:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st294
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+//line asa.go:6263
+		switch data[p] {
+		case 58:
+			goto tr319
+		case 95:
+			goto st294
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st294
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st294
+				}
+			case data[p] >= 65:
+				goto st294
+			}
+		default:
+			goto st294
+		}
+		goto st0
+tr319:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st295
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+//line asa.go:6297
+		if data[p] == 46 {
+			goto tr320
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr320
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr320
+			}
+		default:
+			goto tr320
+		}
+		goto st0
+tr320:
+//line asa.rl:70
+ mark = p 
+	goto st296
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+//line asa.go:6323
+		if data[p] == 47 {
+			goto tr322
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st296
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto st0
+tr322:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st297
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+//line asa.go:6349
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr323
+		}
+		goto st0
+tr323:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1058
+	st1058:
+		if p++; p == pe {
+			goto _test_eof1058
+		}
+	st_case_1058:
+//line asa.go:6363
+		if data[p] == 32 {
+			goto st298
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1143
+		}
+		goto st0
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		if data[p] == 100 {
+			goto st299
+		}
+		goto st0
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		if data[p] == 117 {
+			goto st300
+		}
+		goto st0
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		if data[p] == 114 {
+			goto st301
+		}
+		goto st0
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		if data[p] == 97 {
+			goto st302
+		}
+		goto st0
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		if data[p] == 116 {
+			goto st303
+		}
+		goto st0
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		if data[p] == 105 {
+			goto st304
+		}
+		goto st0
+	st304:
+		if p++; p == pe {
+			goto _test_eof304
+		}
+	st_case_304:
+		if data[p] == 111 {
+			goto st305
+		}
+		goto st0
+	st305:
+		if p++; p == pe {
+			goto _test_eof305
+		}
+	st_case_305:
+		if data[p] == 110 {
+			goto st306
+		}
+		goto st0
+	st306:
+		if p++; p == pe {
+			goto _test_eof306
+		}
+	st_case_306:
+		if data[p] == 32 {
+			goto st307
+		}
+		goto st0
+	st307:
+		if p++; p == pe {
+			goto _test_eof307
+		}
+	st_case_307:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr333
+		}
+		goto st0
+tr333:
+//line asa.rl:82
+ hours = hours*10 + int((data[p])-'0') 
+	goto st308
+	st308:
+		if p++; p == pe {
+			goto _test_eof308
+		}
+	st_case_308:
+//line asa.go:6470
+		if data[p] == 58 {
+			goto st309
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr333
+		}
+		goto st0
+	st309:
+		if p++; p == pe {
+			goto _test_eof309
+		}
+	st_case_309:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr335
+		}
+		goto st0
+tr335:
+//line asa.rl:83
+ mins = mins*10 + int((data[p])-'0') 
+	goto st310
+	st310:
+		if p++; p == pe {
+			goto _test_eof310
+		}
+	st_case_310:
+//line asa.go:6496
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr336
+		}
+		goto st0
+tr336:
+//line asa.rl:83
+ mins = mins*10 + int((data[p])-'0') 
+	goto st311
+	st311:
+		if p++; p == pe {
+			goto _test_eof311
+		}
+	st_case_311:
+//line asa.go:6510
+		if data[p] == 58 {
+			goto st312
+		}
+		goto st0
+	st312:
+		if p++; p == pe {
+			goto _test_eof312
+		}
+	st_case_312:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr338
+		}
+		goto st0
+tr338:
+//line asa.rl:84
+ secs = secs*10 + int((data[p])-'0') 
+	goto st313
+	st313:
+		if p++; p == pe {
+			goto _test_eof313
+		}
+	st_case_313:
+//line asa.go:6533
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr339
+		}
+		goto st0
+tr339:
+//line asa.rl:84
+ secs = secs*10 + int((data[p])-'0') 
+	goto st1059
+	st1059:
+		if p++; p == pe {
+			goto _test_eof1059
+		}
+	st_case_1059:
+//line asa.go:6547
+		goto st0
+tr1143:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1060
+	st1060:
+		if p++; p == pe {
+			goto _test_eof1060
+		}
+	st_case_1060:
+//line asa.go:6558
+		if data[p] == 32 {
+			goto st298
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1144
+		}
+		goto st0
+tr1144:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1061
+	st1061:
+		if p++; p == pe {
+			goto _test_eof1061
+		}
+	st_case_1061:
+//line asa.go:6575
+		if data[p] == 32 {
+			goto st298
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1145
+		}
+		goto st0
+tr1145:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1062
+	st1062:
+		if p++; p == pe {
+			goto _test_eof1062
+		}
+	st_case_1062:
+//line asa.go:6592
+		if data[p] == 32 {
+			goto st298
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1146
+		}
+		goto st0
+tr1146:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1063
+	st1063:
+		if p++; p == pe {
+			goto _test_eof1063
+		}
+	st_case_1063:
+//line asa.go:6609
+		if data[p] == 32 {
+			goto st298
+		}
+		goto st0
+tr313:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st314
+	st314:
+		if p++; p == pe {
+			goto _test_eof314
+		}
+	st_case_314:
+//line asa.go:6623
+		if data[p] == 32 {
+			goto st290
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr340
+		}
+		goto st0
+tr340:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st315
+	st315:
+		if p++; p == pe {
+			goto _test_eof315
+		}
+	st_case_315:
+//line asa.go:6640
+		if data[p] == 32 {
+			goto st290
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr341
+		}
+		goto st0
+tr341:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st316
+	st316:
+		if p++; p == pe {
+			goto _test_eof316
+		}
+	st_case_316:
+//line asa.go:6657
+		if data[p] == 32 {
+			goto st290
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr342
+		}
+		goto st0
+tr342:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st317
+	st317:
+		if p++; p == pe {
+			goto _test_eof317
+		}
+	st_case_317:
+//line asa.go:6674
+		if data[p] == 32 {
+			goto st290
+		}
+		goto st0
+tr285:
+//line asa.rl:70
+ mark = p 
+	goto st318
+	st318:
+		if p++; p == pe {
+			goto _test_eof318
+		}
+	st_case_318:
+//line asa.go:6688
+		if data[p] == 32 {
+			goto tr286
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st318
+			}
+		case data[p] >= 65:
+			goto st318
+		}
+		goto st0
+	st319:
+		if p++; p == pe {
+			goto _test_eof319
+		}
+	st_case_319:
+		if data[p] == 116 {
+			goto st320
+		}
+		goto st0
+	st320:
+		if p++; p == pe {
+			goto _test_eof320
+		}
+	st_case_320:
+		if data[p] == 97 {
+			goto st321
+		}
+		goto st0
+	st321:
+		if p++; p == pe {
+			goto _test_eof321
+		}
+	st_case_321:
+		if data[p] == 116 {
+			goto st262
+		}
+		goto st0
+tr269:
+//line asa.rl:70
+ mark = p 
+	goto st322
+	st322:
+		if p++; p == pe {
+			goto _test_eof322
+		}
+	st_case_322:
+//line asa.go:6737
+		if data[p] == 101 {
+			goto st323
+		}
+		goto st0
+	st323:
+		if p++; p == pe {
+			goto _test_eof323
+		}
+	st_case_323:
+		if data[p] == 97 {
+			goto st324
+		}
+		goto st0
+	st324:
+		if p++; p == pe {
+			goto _test_eof324
+		}
+	st_case_324:
+		if data[p] == 114 {
+			goto st325
+		}
+		goto st0
+	st325:
+		if p++; p == pe {
+			goto _test_eof325
+		}
+	st_case_325:
+		if data[p] == 100 {
+			goto st326
+		}
+		goto st0
+	st326:
+		if p++; p == pe {
+			goto _test_eof326
+		}
+	st_case_326:
+		if data[p] == 111 {
+			goto st327
+		}
+		goto st0
+	st327:
+		if p++; p == pe {
+			goto _test_eof327
+		}
+	st_case_327:
+		if data[p] == 119 {
+			goto st328
+		}
+		goto st0
+	st328:
+		if p++; p == pe {
+			goto _test_eof328
+		}
+	st_case_328:
+		if data[p] == 110 {
+			goto st256
+		}
+		goto st0
+	st329:
+		if p++; p == pe {
+			goto _test_eof329
+		}
+	st_case_329:
+		switch data[p] {
+		case 73:
+			goto tr352
+		case 79:
+			goto tr353
+		case 105:
+			goto tr352
+		case 111:
+			goto tr353
+		}
+		goto st0
+tr352:
+//line asa.rl:70
+ mark = p 
+	goto st330
+	st330:
+		if p++; p == pe {
+			goto _test_eof330
+		}
+	st_case_330:
+//line asa.go:6821
+		if data[p] == 110 {
+			goto st331
+		}
+		goto st0
+	st331:
+		if p++; p == pe {
+			goto _test_eof331
+		}
+	st_case_331:
+		if data[p] == 98 {
+			goto st332
+		}
+		goto st0
+	st332:
+		if p++; p == pe {
+			goto _test_eof332
+		}
+	st_case_332:
+		if data[p] == 111 {
+			goto st333
+		}
+		goto st0
+	st333:
+		if p++; p == pe {
+			goto _test_eof333
+		}
+	st_case_333:
+		if data[p] == 117 {
+			goto st334
+		}
+		goto st0
+	st334:
+		if p++; p == pe {
+			goto _test_eof334
+		}
+	st_case_334:
+		if data[p] == 110 {
+			goto st335
+		}
+		goto st0
+	st335:
+		if p++; p == pe {
+			goto _test_eof335
+		}
+	st_case_335:
+		if data[p] == 100 {
+			goto st336
+		}
+		goto st0
+	st336:
+		if p++; p == pe {
+			goto _test_eof336
+		}
+	st_case_336:
+		if data[p] == 32 {
+			goto tr360
+		}
+		goto st0
+tr360:
+//line asa.rl:79
+ e.Direction = data[mark:p] 
+	goto st337
+	st337:
+		if p++; p == pe {
+			goto _test_eof337
+		}
+	st_case_337:
+//line asa.go:6889
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr361
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr362
+			}
+		default:
+			goto tr362
+		}
+		goto st0
+tr361:
+//line asa.rl:70
+ mark = p 
+	goto st338
+	st338:
+		if p++; p == pe {
+			goto _test_eof338
+		}
+	st_case_338:
+//line asa.go:6912
+		if data[p] == 32 {
+			goto tr363
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st338
+		}
+		goto st0
+tr363:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st339
+	st339:
+		if p++; p == pe {
+			goto _test_eof339
+		}
+	st_case_339:
+//line asa.go:6929
+		if data[p] == 99 {
+			goto st340
+		}
+		goto st0
+	st340:
+		if p++; p == pe {
+			goto _test_eof340
+		}
+	st_case_340:
+		if data[p] == 111 {
+			goto st341
+		}
+		goto st0
+	st341:
+		if p++; p == pe {
+			goto _test_eof341
+		}
+	st_case_341:
+		if data[p] == 110 {
+			goto st342
+		}
+		goto st0
+	st342:
+		if p++; p == pe {
+			goto _test_eof342
+		}
+	st_case_342:
+		if data[p] == 110 {
+			goto st343
+		}
+		goto st0
+	st343:
+		if p++; p == pe {
+			goto _test_eof343
+		}
+	st_case_343:
+		if data[p] == 101 {
+			goto st344
+		}
+		goto st0
+	st344:
+		if p++; p == pe {
+			goto _test_eof344
+		}
+	st_case_344:
+		if data[p] == 99 {
+			goto st345
+		}
+		goto st0
+	st345:
+		if p++; p == pe {
+			goto _test_eof345
+		}
+	st_case_345:
+		if data[p] == 116 {
+			goto st346
+		}
+		goto st0
+	st346:
+		if p++; p == pe {
+			goto _test_eof346
+		}
+	st_case_346:
+		if data[p] == 105 {
+			goto st347
+		}
+		goto st0
+	st347:
+		if p++; p == pe {
+			goto _test_eof347
+		}
+	st_case_347:
+		if data[p] == 111 {
+			goto st348
+		}
+		goto st0
+	st348:
+		if p++; p == pe {
+			goto _test_eof348
+		}
+	st_case_348:
+		if data[p] == 110 {
+			goto st349
+		}
+		goto st0
+	st349:
+		if p++; p == pe {
+			goto _test_eof349
+		}
+	st_case_349:
+		if data[p] == 32 {
+			goto st350
+		}
+		goto st0
+	st350:
+		if p++; p == pe {
+			goto _test_eof350
+		}
+	st_case_350:
+		if data[p] == 100 {
+			goto tr376
+		}
+		goto st0
+tr376:
+//line asa.rl:70
+ mark = p 
+	goto st351
+	st351:
+		if p++; p == pe {
+			goto _test_eof351
+		}
+	st_case_351:
+//line asa.go:7042
+		if data[p] == 101 {
+			goto st352
+		}
+		goto st0
+	st352:
+		if p++; p == pe {
+			goto _test_eof352
+		}
+	st_case_352:
+		if data[p] == 110 {
+			goto st353
+		}
+		goto st0
+	st353:
+		if p++; p == pe {
+			goto _test_eof353
+		}
+	st_case_353:
+		if data[p] == 105 {
+			goto st354
+		}
+		goto st0
+	st354:
+		if p++; p == pe {
+			goto _test_eof354
+		}
+	st_case_354:
+		if data[p] == 101 {
+			goto st355
+		}
+		goto st0
+	st355:
+		if p++; p == pe {
+			goto _test_eof355
+		}
+	st_case_355:
+		if data[p] == 100 {
+			goto st356
+		}
+		goto st0
+	st356:
+		if p++; p == pe {
+			goto _test_eof356
+		}
+	st_case_356:
+		if data[p] == 32 {
+			goto tr382
+		}
+		goto st0
+tr382:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st357
+	st357:
+		if p++; p == pe {
+			goto _test_eof357
+		}
+	st_case_357:
+//line asa.go:7101
+		if data[p] == 102 {
+			goto st358
+		}
+		goto st0
+	st358:
+		if p++; p == pe {
+			goto _test_eof358
+		}
+	st_case_358:
+		if data[p] == 114 {
+			goto st359
+		}
+		goto st0
+	st359:
+		if p++; p == pe {
+			goto _test_eof359
+		}
+	st_case_359:
+		if data[p] == 111 {
+			goto st360
+		}
+		goto st0
+	st360:
+		if p++; p == pe {
+			goto _test_eof360
+		}
+	st_case_360:
+		if data[p] == 109 {
+			goto st361
+		}
+		goto st0
+	st361:
+		if p++; p == pe {
+			goto _test_eof361
+		}
+	st_case_361:
+		if data[p] == 32 {
+			goto st362
+		}
+		goto st0
+	st362:
+		if p++; p == pe {
+			goto _test_eof362
+		}
+	st_case_362:
+		if data[p] == 46 {
+			goto tr388
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr388
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr388
+			}
+		default:
+			goto tr388
+		}
+		goto st0
+tr388:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st363
+	st363:
+		if p++; p == pe {
+			goto _test_eof363
+		}
+	st_case_363:
+//line asa.go:7174
+		if data[p] == 47 {
+			goto tr390
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st363
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st363
+			}
+		default:
+			goto st363
+		}
+		goto st0
+tr390:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st364
+	st364:
+		if p++; p == pe {
+			goto _test_eof364
+		}
+	st_case_364:
+//line asa.go:7200
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr391
+		}
+		goto st0
+tr391:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st365
+	st365:
+		if p++; p == pe {
+			goto _test_eof365
+		}
+	st_case_365:
+//line asa.go:7214
+		if data[p] == 32 {
+			goto st366
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr393
+		}
+		goto st0
+	st366:
+		if p++; p == pe {
+			goto _test_eof366
+		}
+	st_case_366:
+		if data[p] == 116 {
+			goto st367
+		}
+		goto st0
+	st367:
+		if p++; p == pe {
+			goto _test_eof367
+		}
+	st_case_367:
+		if data[p] == 111 {
+			goto st368
+		}
+		goto st0
+	st368:
+		if p++; p == pe {
+			goto _test_eof368
+		}
+	st_case_368:
+		if data[p] == 32 {
+			goto st369
+		}
+		goto st0
+	st369:
+		if p++; p == pe {
+			goto _test_eof369
+		}
+	st_case_369:
+		if data[p] == 46 {
+			goto tr397
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr397
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr397
+			}
+		default:
+			goto tr397
+		}
+		goto st0
+tr397:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st370
+	st370:
+		if p++; p == pe {
+			goto _test_eof370
+		}
+	st_case_370:
+//line asa.go:7281
+		if data[p] == 47 {
+			goto tr399
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st370
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st370
+			}
+		default:
+			goto st370
+		}
+		goto st0
+tr399:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st371
+	st371:
+		if p++; p == pe {
+			goto _test_eof371
+		}
+	st_case_371:
+//line asa.go:7307
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr400
+		}
+		goto st0
+tr400:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st372
+	st372:
+		if p++; p == pe {
+			goto _test_eof372
+		}
+	st_case_372:
+//line asa.go:7321
+		if data[p] == 32 {
+			goto st373
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr402
+		}
+		goto st0
+	st373:
+		if p++; p == pe {
+			goto _test_eof373
+		}
+	st_case_373:
+		if data[p] == 102 {
+			goto st374
+		}
+		goto st0
+	st374:
+		if p++; p == pe {
+			goto _test_eof374
+		}
+	st_case_374:
+		if data[p] == 108 {
+			goto st375
+		}
+		goto st0
+	st375:
+		if p++; p == pe {
+			goto _test_eof375
+		}
+	st_case_375:
+		if data[p] == 97 {
+			goto st376
+		}
+		goto st0
+	st376:
+		if p++; p == pe {
+			goto _test_eof376
+		}
+	st_case_376:
+		if data[p] == 103 {
+			goto st377
+		}
+		goto st0
+	st377:
+		if p++; p == pe {
+			goto _test_eof377
+		}
+	st_case_377:
+		if data[p] == 115 {
+			goto st378
+		}
+		goto st0
+	st378:
+		if p++; p == pe {
+			goto _test_eof378
+		}
+	st_case_378:
+		if data[p] == 32 {
+			goto st379
+		}
+		goto st0
+	st379:
+		if p++; p == pe {
+			goto _test_eof379
+		}
+	st_case_379:
+		if data[p] == 32 {
+			goto st380
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st380
+		}
+		goto st0
+	st380:
+		if p++; p == pe {
+			goto _test_eof380
+		}
+	st_case_380:
+		switch data[p] {
+		case 32:
+			goto st380
+		case 111:
+			goto st381
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st380
+		}
+		goto st0
+	st381:
+		if p++; p == pe {
+			goto _test_eof381
+		}
+	st_case_381:
+		if data[p] == 110 {
+			goto st382
+		}
+		goto st0
+	st382:
+		if p++; p == pe {
+			goto _test_eof382
+		}
+	st_case_382:
+		if data[p] == 32 {
+			goto st383
+		}
+		goto st0
+	st383:
+		if p++; p == pe {
+			goto _test_eof383
+		}
+	st_case_383:
+		if data[p] == 105 {
+			goto st384
+		}
+		goto st0
+	st384:
+		if p++; p == pe {
+			goto _test_eof384
+		}
+	st_case_384:
+		if data[p] == 110 {
+			goto st385
+		}
+		goto st0
+	st385:
+		if p++; p == pe {
+			goto _test_eof385
+		}
+	st_case_385:
+		if data[p] == 116 {
+			goto st386
+		}
+		goto st0
+	st386:
+		if p++; p == pe {
+			goto _test_eof386
+		}
+	st_case_386:
+		if data[p] == 101 {
+			goto st387
+		}
+		goto st0
+	st387:
+		if p++; p == pe {
+			goto _test_eof387
+		}
+	st_case_387:
+		if data[p] == 114 {
+			goto st388
+		}
+		goto st0
+	st388:
+		if p++; p == pe {
+			goto _test_eof388
+		}
+	st_case_388:
+		if data[p] == 102 {
+			goto st389
+		}
+		goto st0
+	st389:
+		if p++; p == pe {
+			goto _test_eof389
+		}
+	st_case_389:
+		if data[p] == 97 {
+			goto st390
+		}
+		goto st0
+	st390:
+		if p++; p == pe {
+			goto _test_eof390
+		}
+	st_case_390:
+		if data[p] == 99 {
+			goto st391
+		}
+		goto st0
+	st391:
+		if p++; p == pe {
+			goto _test_eof391
+		}
+	st_case_391:
+		if data[p] == 101 {
+			goto st392
+		}
+		goto st0
+	st392:
+		if p++; p == pe {
+			goto _test_eof392
+		}
+	st_case_392:
+		if data[p] == 32 {
+			goto st393
+		}
+		goto st0
+	st393:
+		if p++; p == pe {
+			goto _test_eof393
+		}
+	st_case_393:
+		if data[p] == 95 {
+			goto tr423
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr423
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr423
+				}
+			case data[p] >= 65:
+				goto tr423
+			}
+		default:
+			goto tr423
+		}
+		goto st0
+tr423:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st1064
+	st1064:
+		if p++; p == pe {
+			goto _test_eof1064
+		}
+	st_case_1064:
+//line asa.go:7555
+		if data[p] == 95 {
+			goto st1064
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st1064
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st1064
+				}
+			case data[p] >= 65:
+				goto st1064
+			}
+		default:
+			goto st1064
+		}
+		goto st0
+tr402:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st394
+	st394:
+		if p++; p == pe {
+			goto _test_eof394
+		}
+	st_case_394:
+//line asa.go:7586
+		if data[p] == 32 {
+			goto st373
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr424
+		}
+		goto st0
+tr424:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st395
+	st395:
+		if p++; p == pe {
+			goto _test_eof395
+		}
+	st_case_395:
+//line asa.go:7603
+		if data[p] == 32 {
+			goto st373
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr425
+		}
+		goto st0
+tr425:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st396
+	st396:
+		if p++; p == pe {
+			goto _test_eof396
+		}
+	st_case_396:
+//line asa.go:7620
+		if data[p] == 32 {
+			goto st373
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr426
+		}
+		goto st0
+tr426:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st397
+	st397:
+		if p++; p == pe {
+			goto _test_eof397
+		}
+	st_case_397:
+//line asa.go:7637
+		if data[p] == 32 {
+			goto st373
+		}
+		goto st0
+tr393:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st398
+	st398:
+		if p++; p == pe {
+			goto _test_eof398
+		}
+	st_case_398:
+//line asa.go:7651
+		if data[p] == 32 {
+			goto st366
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr427
+		}
+		goto st0
+tr427:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st399
+	st399:
+		if p++; p == pe {
+			goto _test_eof399
+		}
+	st_case_399:
+//line asa.go:7668
+		if data[p] == 32 {
+			goto st366
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr428
+		}
+		goto st0
+tr428:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st400
+	st400:
+		if p++; p == pe {
+			goto _test_eof400
+		}
+	st_case_400:
+//line asa.go:7685
+		if data[p] == 32 {
+			goto st366
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr429
+		}
+		goto st0
+tr429:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st401
+	st401:
+		if p++; p == pe {
+			goto _test_eof401
+		}
+	st_case_401:
+//line asa.go:7702
+		if data[p] == 32 {
+			goto st366
+		}
+		goto st0
+tr362:
+//line asa.rl:70
+ mark = p 
+	goto st402
+	st402:
+		if p++; p == pe {
+			goto _test_eof402
+		}
+	st_case_402:
+//line asa.go:7716
+		if data[p] == 32 {
+			goto tr363
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st402
+			}
+		case data[p] >= 65:
+			goto st402
+		}
+		goto st0
+tr353:
+//line asa.rl:70
+ mark = p 
+	goto st403
+	st403:
+		if p++; p == pe {
+			goto _test_eof403
+		}
+	st_case_403:
+//line asa.go:7738
+		if data[p] == 117 {
+			goto st404
+		}
+		goto st0
+	st404:
+		if p++; p == pe {
+			goto _test_eof404
+		}
+	st_case_404:
+		if data[p] == 116 {
+			goto st331
+		}
+		goto st0
+	st405:
+		if p++; p == pe {
+			goto _test_eof405
+		}
+	st_case_405:
+		if data[p] == 68 {
+			goto tr432
+		}
+		goto st0
+tr432:
+//line asa.rl:70
+ mark = p 
+	goto st406
+	st406:
+		if p++; p == pe {
+			goto _test_eof406
+		}
+	st_case_406:
+//line asa.go:7770
+		if data[p] == 101 {
+			goto st407
+		}
+		goto st0
+	st407:
+		if p++; p == pe {
+			goto _test_eof407
+		}
+	st_case_407:
+		if data[p] == 110 {
+			goto st408
+		}
+		goto st0
+	st408:
+		if p++; p == pe {
+			goto _test_eof408
+		}
+	st_case_408:
+		if data[p] == 121 {
+			goto st409
+		}
+		goto st0
+	st409:
+		if p++; p == pe {
+			goto _test_eof409
+		}
+	st_case_409:
+		if data[p] == 32 {
+			goto tr436
+		}
+		goto st0
+tr436:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st410
+	st410:
+		if p++; p == pe {
+			goto _test_eof410
+		}
+	st_case_410:
+//line asa.go:7811
+		switch data[p] {
+		case 73:
+			goto tr437
+		case 79:
+			goto tr438
+		case 105:
+			goto tr437
+		case 111:
+			goto tr438
+		}
+		goto st0
+tr437:
+//line asa.rl:70
+ mark = p 
+	goto st411
+	st411:
+		if p++; p == pe {
+			goto _test_eof411
+		}
+	st_case_411:
+//line asa.go:7832
+		if data[p] == 110 {
+			goto st412
+		}
+		goto st0
+	st412:
+		if p++; p == pe {
+			goto _test_eof412
+		}
+	st_case_412:
+		if data[p] == 98 {
+			goto st413
+		}
+		goto st0
+	st413:
+		if p++; p == pe {
+			goto _test_eof413
+		}
+	st_case_413:
+		if data[p] == 111 {
+			goto st414
+		}
+		goto st0
+	st414:
+		if p++; p == pe {
+			goto _test_eof414
+		}
+	st_case_414:
+		if data[p] == 117 {
+			goto st415
+		}
+		goto st0
+	st415:
+		if p++; p == pe {
+			goto _test_eof415
+		}
+	st_case_415:
+		if data[p] == 110 {
+			goto st416
+		}
+		goto st0
+	st416:
+		if p++; p == pe {
+			goto _test_eof416
+		}
+	st_case_416:
+		if data[p] == 100 {
+			goto st417
+		}
+		goto st0
+	st417:
+		if p++; p == pe {
+			goto _test_eof417
+		}
+	st_case_417:
+		if data[p] == 32 {
+			goto tr445
+		}
+		goto st0
+tr445:
+//line asa.rl:79
+ e.Direction = data[mark:p] 
+	goto st418
+	st418:
+		if p++; p == pe {
+			goto _test_eof418
+		}
+	st_case_418:
+//line asa.go:7900
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr446
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr447
+			}
+		default:
+			goto tr447
+		}
+		goto st0
+tr446:
+//line asa.rl:70
+ mark = p 
+	goto st419
+	st419:
+		if p++; p == pe {
+			goto _test_eof419
+		}
+	st_case_419:
+//line asa.go:7923
+		if data[p] == 32 {
+			goto tr448
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st419
+		}
+		goto st0
+tr448:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st420
+	st420:
+		if p++; p == pe {
+			goto _test_eof420
+		}
+	st_case_420:
+//line asa.go:7940
+		if data[p] == 102 {
+			goto st421
+		}
+		goto st0
+	st421:
+		if p++; p == pe {
+			goto _test_eof421
+		}
+	st_case_421:
+		if data[p] == 114 {
+			goto st422
+		}
+		goto st0
+	st422:
+		if p++; p == pe {
+			goto _test_eof422
+		}
+	st_case_422:
+		if data[p] == 111 {
+			goto st423
+		}
+		goto st0
+	st423:
+		if p++; p == pe {
+			goto _test_eof423
+		}
+	st_case_423:
+		if data[p] == 109 {
+			goto st424
+		}
+		goto st0
+	st424:
+		if p++; p == pe {
+			goto _test_eof424
+		}
+	st_case_424:
+		if data[p] == 32 {
+			goto st425
+		}
+		goto st0
+	st425:
+		if p++; p == pe {
+			goto _test_eof425
+		}
+	st_case_425:
+		if data[p] == 46 {
+			goto tr455
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr455
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr455
+			}
+		default:
+			goto tr455
+		}
+		goto st0
+tr455:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st426
+	st426:
+		if p++; p == pe {
+			goto _test_eof426
+		}
+	st_case_426:
+//line asa.go:8013
+		if data[p] == 47 {
+			goto tr457
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st426
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st426
+			}
+		default:
+			goto st426
+		}
+		goto st0
+tr457:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st427
+	st427:
+		if p++; p == pe {
+			goto _test_eof427
+		}
+	st_case_427:
+//line asa.go:8039
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr458
+		}
+		goto st0
+tr458:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st428
+	st428:
+		if p++; p == pe {
+			goto _test_eof428
+		}
+	st_case_428:
+//line asa.go:8053
+		if data[p] == 32 {
+			goto st429
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr460
+		}
+		goto st0
+	st429:
+		if p++; p == pe {
+			goto _test_eof429
+		}
+	st_case_429:
+		if data[p] == 116 {
+			goto st430
+		}
+		goto st0
+	st430:
+		if p++; p == pe {
+			goto _test_eof430
+		}
+	st_case_430:
+		if data[p] == 111 {
+			goto st431
+		}
+		goto st0
+	st431:
+		if p++; p == pe {
+			goto _test_eof431
+		}
+	st_case_431:
+		if data[p] == 32 {
+			goto st432
+		}
+		goto st0
+	st432:
+		if p++; p == pe {
+			goto _test_eof432
+		}
+	st_case_432:
+		if data[p] == 46 {
+			goto tr464
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr464
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr464
+			}
+		default:
+			goto tr464
+		}
+		goto st0
+tr464:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st433
+	st433:
+		if p++; p == pe {
+			goto _test_eof433
+		}
+	st_case_433:
+//line asa.go:8120
+		if data[p] == 47 {
+			goto tr466
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st433
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st433
+			}
+		default:
+			goto st433
+		}
+		goto st0
+tr466:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st434
+	st434:
+		if p++; p == pe {
+			goto _test_eof434
+		}
+	st_case_434:
+//line asa.go:8146
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr467
+		}
+		goto st0
+tr467:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st435
+	st435:
+		if p++; p == pe {
+			goto _test_eof435
+		}
+	st_case_435:
+//line asa.go:8160
+		if data[p] == 32 {
+			goto st436
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr469
+		}
+		goto st0
+	st436:
+		if p++; p == pe {
+			goto _test_eof436
+		}
+	st_case_436:
+		switch data[p] {
+		case 100:
+			goto st437
+		case 111:
+			goto st444
+		}
+		goto st0
+	st437:
+		if p++; p == pe {
+			goto _test_eof437
+		}
+	st_case_437:
+		if data[p] == 117 {
+			goto st438
+		}
+		goto st0
+	st438:
+		if p++; p == pe {
+			goto _test_eof438
+		}
+	st_case_438:
+		if data[p] == 101 {
+			goto st439
+		}
+		goto st0
+	st439:
+		if p++; p == pe {
+			goto _test_eof439
+		}
+	st_case_439:
+		if data[p] == 32 {
+			goto st440
+		}
+		goto st0
+	st440:
+		if p++; p == pe {
+			goto _test_eof440
+		}
+	st_case_440:
+		if data[p] == 116 {
+			goto st441
+		}
+		goto st0
+	st441:
+		if p++; p == pe {
+			goto _test_eof441
+		}
+	st_case_441:
+		if data[p] == 111 {
+			goto st442
+		}
+		goto st0
+	st442:
+		if p++; p == pe {
+			goto _test_eof442
+		}
+	st_case_442:
+		if data[p] == 32 {
+			goto st443
+		}
+		goto st0
+	st443:
+		if p++; p == pe {
+			goto _test_eof443
+		}
+	st_case_443:
+		goto tr478
+tr478:
+//line asa.rl:70
+ mark = p 
+	goto st1065
+	st1065:
+		if p++; p == pe {
+			goto _test_eof1065
+		}
+	st_case_1065:
+//line asa.go:8249
+		goto st1065
+	st444:
+		if p++; p == pe {
+			goto _test_eof444
+		}
+	st_case_444:
+		if data[p] == 110 {
+			goto st445
+		}
+		goto st0
+	st445:
+		if p++; p == pe {
+			goto _test_eof445
+		}
+	st_case_445:
+		if data[p] == 32 {
+			goto st446
+		}
+		goto st0
+	st446:
+		if p++; p == pe {
+			goto _test_eof446
+		}
+	st_case_446:
+		if data[p] == 105 {
+			goto st447
+		}
+		goto st0
+	st447:
+		if p++; p == pe {
+			goto _test_eof447
+		}
+	st_case_447:
+		if data[p] == 110 {
+			goto st448
+		}
+		goto st0
+	st448:
+		if p++; p == pe {
+			goto _test_eof448
+		}
+	st_case_448:
+		if data[p] == 116 {
+			goto st449
+		}
+		goto st0
+	st449:
+		if p++; p == pe {
+			goto _test_eof449
+		}
+	st_case_449:
+		if data[p] == 101 {
+			goto st450
+		}
+		goto st0
+	st450:
+		if p++; p == pe {
+			goto _test_eof450
+		}
+	st_case_450:
+		if data[p] == 114 {
+			goto st451
+		}
+		goto st0
+	st451:
+		if p++; p == pe {
+			goto _test_eof451
+		}
+	st_case_451:
+		if data[p] == 102 {
+			goto st452
+		}
+		goto st0
+	st452:
+		if p++; p == pe {
+			goto _test_eof452
+		}
+	st_case_452:
+		if data[p] == 97 {
+			goto st453
+		}
+		goto st0
+	st453:
+		if p++; p == pe {
+			goto _test_eof453
+		}
+	st_case_453:
+		if data[p] == 99 {
+			goto st454
+		}
+		goto st0
+	st454:
+		if p++; p == pe {
+			goto _test_eof454
+		}
+	st_case_454:
+		if data[p] == 101 {
+			goto st455
+		}
+		goto st0
+	st455:
+		if p++; p == pe {
+			goto _test_eof455
+		}
+	st_case_455:
+		if data[p] == 32 {
+			goto st456
+		}
+		goto st0
+	st456:
+		if p++; p == pe {
+			goto _test_eof456
+		}
+	st_case_456:
+		if data[p] == 95 {
+			goto tr491
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr491
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr491
+				}
+			case data[p] >= 65:
+				goto tr491
+			}
+		default:
+			goto tr491
+		}
+		goto st0
+tr491:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st1066
+	st1066:
+		if p++; p == pe {
+			goto _test_eof1066
+		}
+	st_case_1066:
+//line asa.go:8396
+		if data[p] == 95 {
+			goto st1066
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st1066
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st1066
+				}
+			case data[p] >= 65:
+				goto st1066
+			}
+		default:
+			goto st1066
+		}
+		goto st0
+tr469:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st457
+	st457:
+		if p++; p == pe {
+			goto _test_eof457
+		}
+	st_case_457:
+//line asa.go:8427
+		if data[p] == 32 {
+			goto st436
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr492
+		}
+		goto st0
+tr492:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st458
+	st458:
+		if p++; p == pe {
+			goto _test_eof458
+		}
+	st_case_458:
+//line asa.go:8444
+		if data[p] == 32 {
+			goto st436
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr493
+		}
+		goto st0
+tr493:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st459
+	st459:
+		if p++; p == pe {
+			goto _test_eof459
+		}
+	st_case_459:
+//line asa.go:8461
+		if data[p] == 32 {
+			goto st436
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr494
+		}
+		goto st0
+tr494:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st460
+	st460:
+		if p++; p == pe {
+			goto _test_eof460
+		}
+	st_case_460:
+//line asa.go:8478
+		if data[p] == 32 {
+			goto st436
+		}
+		goto st0
+tr460:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st461
+	st461:
+		if p++; p == pe {
+			goto _test_eof461
+		}
+	st_case_461:
+//line asa.go:8492
+		if data[p] == 32 {
+			goto st429
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr495
+		}
+		goto st0
+tr495:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st462
+	st462:
+		if p++; p == pe {
+			goto _test_eof462
+		}
+	st_case_462:
+//line asa.go:8509
+		if data[p] == 32 {
+			goto st429
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr496
+		}
+		goto st0
+tr496:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st463
+	st463:
+		if p++; p == pe {
+			goto _test_eof463
+		}
+	st_case_463:
+//line asa.go:8526
+		if data[p] == 32 {
+			goto st429
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr497
+		}
+		goto st0
+tr497:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st464
+	st464:
+		if p++; p == pe {
+			goto _test_eof464
+		}
+	st_case_464:
+//line asa.go:8543
+		if data[p] == 32 {
+			goto st429
+		}
+		goto st0
+tr447:
+//line asa.rl:70
+ mark = p 
+	goto st465
+	st465:
+		if p++; p == pe {
+			goto _test_eof465
+		}
+	st_case_465:
+//line asa.go:8557
+		if data[p] == 32 {
+			goto tr448
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st465
+			}
+		case data[p] >= 65:
+			goto st465
+		}
+		goto st0
+tr438:
+//line asa.rl:70
+ mark = p 
+	goto st466
+	st466:
+		if p++; p == pe {
+			goto _test_eof466
+		}
+	st_case_466:
+//line asa.go:8579
+		if data[p] == 117 {
+			goto st467
+		}
+		goto st0
+	st467:
+		if p++; p == pe {
+			goto _test_eof467
+		}
+	st_case_467:
+		if data[p] == 116 {
+			goto st412
+		}
+		goto st0
+	st468:
+		if p++; p == pe {
+			goto _test_eof468
+		}
+	st_case_468:
+		if data[p] == 68 {
+			goto tr500
+		}
+		goto st0
+tr500:
+//line asa.rl:70
+ mark = p 
+	goto st469
+	st469:
+		if p++; p == pe {
+			goto _test_eof469
+		}
+	st_case_469:
+//line asa.go:8611
+		if data[p] == 101 {
+			goto st470
+		}
+		goto st0
+	st470:
+		if p++; p == pe {
+			goto _test_eof470
+		}
+	st_case_470:
+		if data[p] == 110 {
+			goto st471
+		}
+		goto st0
+	st471:
+		if p++; p == pe {
+			goto _test_eof471
+		}
+	st_case_471:
+		if data[p] == 121 {
+			goto st472
+		}
+		goto st0
+	st472:
+		if p++; p == pe {
+			goto _test_eof472
+		}
+	st_case_472:
+		if data[p] == 32 {
+			goto tr504
+		}
+		goto st0
+tr504:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st473
+	st473:
+		if p++; p == pe {
+			goto _test_eof473
+		}
+	st_case_473:
+//line asa.go:8652
+		switch data[p] {
+		case 73:
+			goto tr505
+		case 79:
+			goto tr506
+		case 105:
+			goto tr505
+		case 111:
+			goto tr506
+		}
+		goto st0
+tr505:
+//line asa.rl:70
+ mark = p 
+	goto st474
+	st474:
+		if p++; p == pe {
+			goto _test_eof474
+		}
+	st_case_474:
+//line asa.go:8673
+		if data[p] == 110 {
+			goto st475
+		}
+		goto st0
+	st475:
+		if p++; p == pe {
+			goto _test_eof475
+		}
+	st_case_475:
+		if data[p] == 98 {
+			goto st476
+		}
+		goto st0
+	st476:
+		if p++; p == pe {
+			goto _test_eof476
+		}
+	st_case_476:
+		if data[p] == 111 {
+			goto st477
+		}
+		goto st0
+	st477:
+		if p++; p == pe {
+			goto _test_eof477
+		}
+	st_case_477:
+		if data[p] == 117 {
+			goto st478
+		}
+		goto st0
+	st478:
+		if p++; p == pe {
+			goto _test_eof478
+		}
+	st_case_478:
+		if data[p] == 110 {
+			goto st479
+		}
+		goto st0
+	st479:
+		if p++; p == pe {
+			goto _test_eof479
+		}
+	st_case_479:
+		if data[p] == 100 {
+			goto st480
+		}
+		goto st0
+	st480:
+		if p++; p == pe {
+			goto _test_eof480
+		}
+	st_case_480:
+		if data[p] == 32 {
+			goto tr513
+		}
+		goto st0
+tr513:
+//line asa.rl:79
+ e.Direction = data[mark:p] 
+	goto st481
+	st481:
+		if p++; p == pe {
+			goto _test_eof481
+		}
+	st_case_481:
+//line asa.go:8741
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr514
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr515
+			}
+		default:
+			goto tr515
+		}
+		goto st0
+tr514:
+//line asa.rl:70
+ mark = p 
+	goto st482
+	st482:
+		if p++; p == pe {
+			goto _test_eof482
+		}
+	st_case_482:
+//line asa.go:8764
+		if data[p] == 32 {
+			goto tr516
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st482
+		}
+		goto st0
+tr516:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st483
+	st483:
+		if p++; p == pe {
+			goto _test_eof483
+		}
+	st_case_483:
+//line asa.go:8781
+		if data[p] == 115 {
+			goto st484
+		}
+		goto st0
+	st484:
+		if p++; p == pe {
+			goto _test_eof484
+		}
+	st_case_484:
+		if data[p] == 114 {
+			goto st485
+		}
+		goto st0
+	st485:
+		if p++; p == pe {
+			goto _test_eof485
+		}
+	st_case_485:
+		if data[p] == 99 {
+			goto st486
+		}
+		goto st0
+	st486:
+		if p++; p == pe {
+			goto _test_eof486
+		}
+	st_case_486:
+		if data[p] == 32 {
+			goto st487
+		}
+		goto st0
+	st487:
+		if p++; p == pe {
+			goto _test_eof487
+		}
+	st_case_487:
+		if data[p] == 95 {
+			goto tr522
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr522
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr522
+				}
+			case data[p] >= 65:
+				goto tr522
+			}
+		default:
+			goto tr522
+		}
+		goto st0
+tr522:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st488
+	st488:
+		if p++; p == pe {
+			goto _test_eof488
+		}
+	st_case_488:
+//line asa.go:8850
+		switch data[p] {
+		case 58:
+			goto tr524
+		case 95:
+			goto st488
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st488
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st488
+				}
+			case data[p] >= 65:
+				goto st488
+			}
+		default:
+			goto st488
+		}
+		goto st0
+tr524:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st489
+	st489:
+		if p++; p == pe {
+			goto _test_eof489
+		}
+	st_case_489:
+//line asa.go:8884
+		if data[p] == 46 {
+			goto tr525
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr525
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr525
+			}
+		default:
+			goto tr525
+		}
+		goto st0
+tr525:
+//line asa.rl:70
+ mark = p 
+	goto st490
+	st490:
+		if p++; p == pe {
+			goto _test_eof490
+		}
+	st_case_490:
+//line asa.go:8910
+		switch data[p] {
+		case 32:
+			goto tr526
+		case 47:
+			goto tr528
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st490
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st490
+			}
+		default:
+			goto st490
+		}
+		goto st0
+tr526:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st491
+	st491:
+		if p++; p == pe {
+			goto _test_eof491
+		}
+	st_case_491:
+//line asa.go:8939
+		if data[p] == 100 {
+			goto st492
+		}
+		goto st0
+	st492:
+		if p++; p == pe {
+			goto _test_eof492
+		}
+	st_case_492:
+		if data[p] == 115 {
+			goto st493
+		}
+		goto st0
+	st493:
+		if p++; p == pe {
+			goto _test_eof493
+		}
+	st_case_493:
+		if data[p] == 116 {
+			goto st494
+		}
+		goto st0
+	st494:
+		if p++; p == pe {
+			goto _test_eof494
+		}
+	st_case_494:
+		if data[p] == 32 {
+			goto st495
+		}
+		goto st0
+	st495:
+		if p++; p == pe {
+			goto _test_eof495
+		}
+	st_case_495:
+		if data[p] == 95 {
+			goto tr533
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr533
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr533
+				}
+			case data[p] >= 65:
+				goto tr533
+			}
+		default:
+			goto tr533
+		}
+		goto st0
+tr533:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st496
+	st496:
+		if p++; p == pe {
+			goto _test_eof496
+		}
+	st_case_496:
+//line asa.go:9008
+		switch data[p] {
+		case 58:
+			goto tr535
+		case 95:
+			goto st496
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st496
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st496
+				}
+			case data[p] >= 65:
+				goto st496
+			}
+		default:
+			goto st496
+		}
+		goto st0
+tr535:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st497
+	st497:
+		if p++; p == pe {
+			goto _test_eof497
+		}
+	st_case_497:
+//line asa.go:9042
+		if data[p] == 46 {
+			goto tr536
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr536
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr536
+			}
+		default:
+			goto tr536
+		}
+		goto st0
+tr536:
+//line asa.rl:70
+ mark = p 
+	goto st498
+	st498:
+		if p++; p == pe {
+			goto _test_eof498
+		}
+	st_case_498:
+//line asa.go:9068
+		switch data[p] {
+		case 32:
+			goto tr537
+		case 47:
+			goto tr539
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st498
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st498
+			}
+		default:
+			goto st498
+		}
+		goto st0
+tr537:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st499
+	st499:
+		if p++; p == pe {
+			goto _test_eof499
+		}
+	st_case_499:
+//line asa.go:9097
+		if data[p] == 40 {
+			goto st500
+		}
+		goto st0
+	st500:
+		if p++; p == pe {
+			goto _test_eof500
+		}
+	st_case_500:
+		if data[p] == 116 {
+			goto st501
+		}
+		goto st0
+	st501:
+		if p++; p == pe {
+			goto _test_eof501
+		}
+	st_case_501:
+		if data[p] == 121 {
+			goto st502
+		}
+		goto st0
+	st502:
+		if p++; p == pe {
+			goto _test_eof502
+		}
+	st_case_502:
+		if data[p] == 112 {
+			goto st503
+		}
+		goto st0
+	st503:
+		if p++; p == pe {
+			goto _test_eof503
+		}
+	st_case_503:
+		if data[p] == 101 {
+			goto st504
+		}
+		goto st0
+	st504:
+		if p++; p == pe {
+			goto _test_eof504
+		}
+	st_case_504:
+		if data[p] == 32 {
+			goto st505
+		}
+		goto st0
+	st505:
+		if p++; p == pe {
+			goto _test_eof505
+		}
+	st_case_505:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st506
+		}
+		goto st0
+	st506:
+		if p++; p == pe {
+			goto _test_eof506
+		}
+	st_case_506:
+		if data[p] == 44 {
+			goto st507
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st506
+		}
+		goto st0
+	st507:
+		if p++; p == pe {
+			goto _test_eof507
+		}
+	st_case_507:
+		if data[p] == 32 {
+			goto st508
+		}
+		goto st0
+	st508:
+		if p++; p == pe {
+			goto _test_eof508
+		}
+	st_case_508:
+		if data[p] == 99 {
+			goto st509
+		}
+		goto st0
+	st509:
+		if p++; p == pe {
+			goto _test_eof509
+		}
+	st_case_509:
+		if data[p] == 111 {
+			goto st510
+		}
+		goto st0
+	st510:
+		if p++; p == pe {
+			goto _test_eof510
+		}
+	st_case_510:
+		if data[p] == 100 {
+			goto st511
+		}
+		goto st0
+	st511:
+		if p++; p == pe {
+			goto _test_eof511
+		}
+	st_case_511:
+		if data[p] == 101 {
+			goto st512
+		}
+		goto st0
+	st512:
+		if p++; p == pe {
+			goto _test_eof512
+		}
+	st_case_512:
+		if data[p] == 32 {
+			goto st513
+		}
+		goto st0
+	st513:
+		if p++; p == pe {
+			goto _test_eof513
+		}
+	st_case_513:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st514
+		}
+		goto st0
+	st514:
+		if p++; p == pe {
+			goto _test_eof514
+		}
+	st_case_514:
+		if data[p] == 41 {
+			goto st1067
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st514
+		}
+		goto st0
+	st1067:
+		if p++; p == pe {
+			goto _test_eof1067
+		}
+	st_case_1067:
+		goto st0
+tr539:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st515
+	st515:
+		if p++; p == pe {
+			goto _test_eof515
+		}
+	st_case_515:
+//line asa.go:9258
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr556
+		}
+		goto st0
+tr556:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st516
+	st516:
+		if p++; p == pe {
+			goto _test_eof516
+		}
+	st_case_516:
+//line asa.go:9272
+		if data[p] == 32 {
+			goto st499
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr558
+		}
+		goto st0
+tr558:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st517
+	st517:
+		if p++; p == pe {
+			goto _test_eof517
+		}
+	st_case_517:
+//line asa.go:9289
+		if data[p] == 32 {
+			goto st499
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr559
+		}
+		goto st0
+tr559:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st518
+	st518:
+		if p++; p == pe {
+			goto _test_eof518
+		}
+	st_case_518:
+//line asa.go:9306
+		if data[p] == 32 {
+			goto st499
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr560
+		}
+		goto st0
+tr560:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st519
+	st519:
+		if p++; p == pe {
+			goto _test_eof519
+		}
+	st_case_519:
+//line asa.go:9323
+		if data[p] == 32 {
+			goto st499
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr561
+		}
+		goto st0
+tr561:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st520
+	st520:
+		if p++; p == pe {
+			goto _test_eof520
+		}
+	st_case_520:
+//line asa.go:9340
+		if data[p] == 32 {
+			goto st499
+		}
+		goto st0
+tr528:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st521
+	st521:
+		if p++; p == pe {
+			goto _test_eof521
+		}
+	st_case_521:
+//line asa.go:9354
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr562
+		}
+		goto st0
+tr562:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st522
+	st522:
+		if p++; p == pe {
+			goto _test_eof522
+		}
+	st_case_522:
+//line asa.go:9368
+		if data[p] == 32 {
+			goto st491
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr564
+		}
+		goto st0
+tr564:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st523
+	st523:
+		if p++; p == pe {
+			goto _test_eof523
+		}
+	st_case_523:
+//line asa.go:9385
+		if data[p] == 32 {
+			goto st491
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr565
+		}
+		goto st0
+tr565:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st524
+	st524:
+		if p++; p == pe {
+			goto _test_eof524
+		}
+	st_case_524:
+//line asa.go:9402
+		if data[p] == 32 {
+			goto st491
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr566
+		}
+		goto st0
+tr566:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st525
+	st525:
+		if p++; p == pe {
+			goto _test_eof525
+		}
+	st_case_525:
+//line asa.go:9419
+		if data[p] == 32 {
+			goto st491
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr567
+		}
+		goto st0
+tr567:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st526
+	st526:
+		if p++; p == pe {
+			goto _test_eof526
+		}
+	st_case_526:
+//line asa.go:9436
+		if data[p] == 32 {
+			goto st491
+		}
+		goto st0
+tr515:
+//line asa.rl:70
+ mark = p 
+	goto st527
+	st527:
+		if p++; p == pe {
+			goto _test_eof527
+		}
+	st_case_527:
+//line asa.go:9450
+		if data[p] == 32 {
+			goto tr516
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st527
+			}
+		case data[p] >= 65:
+			goto st527
+		}
+		goto st0
+tr506:
+//line asa.rl:70
+ mark = p 
+	goto st528
+	st528:
+		if p++; p == pe {
+			goto _test_eof528
+		}
+	st_case_528:
+//line asa.go:9472
+		if data[p] == 117 {
+			goto st529
+		}
+		goto st0
+	st529:
+		if p++; p == pe {
+			goto _test_eof529
+		}
+	st_case_529:
+		if data[p] == 116 {
+			goto st475
+		}
+		goto st0
+	st530:
+		if p++; p == pe {
+			goto _test_eof530
+		}
+	st_case_530:
+		if data[p] == 68 {
+			goto tr570
+		}
+		goto st0
+tr570:
+//line asa.rl:70
+ mark = p 
+	goto st531
+	st531:
+		if p++; p == pe {
+			goto _test_eof531
+		}
+	st_case_531:
+//line asa.go:9504
+		if data[p] == 101 {
+			goto st532
+		}
+		goto st0
+	st532:
+		if p++; p == pe {
+			goto _test_eof532
+		}
+	st_case_532:
+		if data[p] == 110 {
+			goto st533
+		}
+		goto st0
+	st533:
+		if p++; p == pe {
+			goto _test_eof533
+		}
+	st_case_533:
+		if data[p] == 121 {
+			goto st534
+		}
+		goto st0
+	st534:
+		if p++; p == pe {
+			goto _test_eof534
+		}
+	st_case_534:
+		if data[p] == 32 {
+			goto tr574
+		}
+		goto st0
+tr574:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st535
+	st535:
+		if p++; p == pe {
+			goto _test_eof535
+		}
+	st_case_535:
+//line asa.go:9545
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr575
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr576
+			}
+		default:
+			goto tr576
+		}
+		goto st0
+tr575:
+//line asa.rl:70
+ mark = p 
+	goto st536
+	st536:
+		if p++; p == pe {
+			goto _test_eof536
+		}
+	st_case_536:
+//line asa.go:9568
+		if data[p] == 32 {
+			goto tr577
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st536
+		}
+		goto st0
+tr577:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st537
+	st537:
+		if p++; p == pe {
+			goto _test_eof537
+		}
+	st_case_537:
+//line asa.go:9585
+		if data[p] == 40 {
+			goto st538
+		}
+		goto st0
+	st538:
+		if p++; p == pe {
+			goto _test_eof538
+		}
+	st_case_538:
+		if data[p] == 110 {
+			goto st539
+		}
+		goto st0
+	st539:
+		if p++; p == pe {
+			goto _test_eof539
+		}
+	st_case_539:
+		if data[p] == 111 {
+			goto st540
+		}
+		goto st0
+	st540:
+		if p++; p == pe {
+			goto _test_eof540
+		}
+	st_case_540:
+		if data[p] == 32 {
+			goto st541
+		}
+		goto st0
+	st541:
+		if p++; p == pe {
+			goto _test_eof541
+		}
+	st_case_541:
+		if data[p] == 99 {
+			goto st542
+		}
+		goto st0
+	st542:
+		if p++; p == pe {
+			goto _test_eof542
+		}
+	st_case_542:
+		if data[p] == 111 {
+			goto st543
+		}
+		goto st0
+	st543:
+		if p++; p == pe {
+			goto _test_eof543
+		}
+	st_case_543:
+		if data[p] == 110 {
+			goto st544
+		}
+		goto st0
+	st544:
+		if p++; p == pe {
+			goto _test_eof544
+		}
+	st_case_544:
+		if data[p] == 110 {
+			goto st545
+		}
+		goto st0
+	st545:
+		if p++; p == pe {
+			goto _test_eof545
+		}
+	st_case_545:
+		if data[p] == 101 {
+			goto st546
+		}
+		goto st0
+	st546:
+		if p++; p == pe {
+			goto _test_eof546
+		}
+	st_case_546:
+		if data[p] == 99 {
+			goto st547
+		}
+		goto st0
+	st547:
+		if p++; p == pe {
+			goto _test_eof547
+		}
+	st_case_547:
+		if data[p] == 116 {
+			goto st548
+		}
+		goto st0
+	st548:
+		if p++; p == pe {
+			goto _test_eof548
+		}
+	st_case_548:
+		if data[p] == 105 {
+			goto st549
+		}
+		goto st0
+	st549:
+		if p++; p == pe {
+			goto _test_eof549
+		}
+	st_case_549:
+		if data[p] == 111 {
+			goto st550
+		}
+		goto st0
+	st550:
+		if p++; p == pe {
+			goto _test_eof550
+		}
+	st_case_550:
+		if data[p] == 110 {
+			goto st551
+		}
+		goto st0
+	st551:
+		if p++; p == pe {
+			goto _test_eof551
+		}
+	st_case_551:
+		if data[p] == 41 {
+			goto st552
+		}
+		goto st0
+	st552:
+		if p++; p == pe {
+			goto _test_eof552
+		}
+	st_case_552:
+		if data[p] == 32 {
+			goto st553
+		}
+		goto st0
+	st553:
+		if p++; p == pe {
+			goto _test_eof553
+		}
+	st_case_553:
+		if data[p] == 102 {
+			goto st554
+		}
+		goto st0
+	st554:
+		if p++; p == pe {
+			goto _test_eof554
+		}
+	st_case_554:
+		if data[p] == 114 {
+			goto st555
+		}
+		goto st0
+	st555:
+		if p++; p == pe {
+			goto _test_eof555
+		}
+	st_case_555:
+		if data[p] == 111 {
+			goto st556
+		}
+		goto st0
+	st556:
+		if p++; p == pe {
+			goto _test_eof556
+		}
+	st_case_556:
+		if data[p] == 109 {
+			goto st557
+		}
+		goto st0
+	st557:
+		if p++; p == pe {
+			goto _test_eof557
+		}
+	st_case_557:
+		if data[p] == 32 {
+			goto st558
+		}
+		goto st0
+	st558:
+		if p++; p == pe {
+			goto _test_eof558
+		}
+	st_case_558:
+		if data[p] == 46 {
+			goto tr600
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr600
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr600
+			}
+		default:
+			goto tr600
+		}
+		goto st0
+tr600:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st559
+	st559:
+		if p++; p == pe {
+			goto _test_eof559
+		}
+	st_case_559:
+//line asa.go:9802
+		if data[p] == 47 {
+			goto tr602
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st559
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st559
+			}
+		default:
+			goto st559
+		}
+		goto st0
+tr602:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st560
+	st560:
+		if p++; p == pe {
+			goto _test_eof560
+		}
+	st_case_560:
+//line asa.go:9828
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr603
+		}
+		goto st0
+tr603:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st561
+	st561:
+		if p++; p == pe {
+			goto _test_eof561
+		}
+	st_case_561:
+//line asa.go:9842
+		if data[p] == 32 {
+			goto st562
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr605
+		}
+		goto st0
+	st562:
+		if p++; p == pe {
+			goto _test_eof562
+		}
+	st_case_562:
+		if data[p] == 116 {
+			goto st563
+		}
+		goto st0
+	st563:
+		if p++; p == pe {
+			goto _test_eof563
+		}
+	st_case_563:
+		if data[p] == 111 {
+			goto st564
+		}
+		goto st0
+	st564:
+		if p++; p == pe {
+			goto _test_eof564
+		}
+	st_case_564:
+		if data[p] == 32 {
+			goto st565
+		}
+		goto st0
+	st565:
+		if p++; p == pe {
+			goto _test_eof565
+		}
+	st_case_565:
+		if data[p] == 46 {
+			goto tr609
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr609
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr609
+			}
+		default:
+			goto tr609
+		}
+		goto st0
+tr609:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st566
+	st566:
+		if p++; p == pe {
+			goto _test_eof566
+		}
+	st_case_566:
+//line asa.go:9909
+		if data[p] == 47 {
+			goto tr611
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st566
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st566
+			}
+		default:
+			goto st566
+		}
+		goto st0
+tr611:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st567
+	st567:
+		if p++; p == pe {
+			goto _test_eof567
+		}
+	st_case_567:
+//line asa.go:9935
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr612
+		}
+		goto st0
+tr612:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st568
+	st568:
+		if p++; p == pe {
+			goto _test_eof568
+		}
+	st_case_568:
+//line asa.go:9949
+		if data[p] == 32 {
+			goto st569
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr614
+		}
+		goto st0
+	st569:
+		if p++; p == pe {
+			goto _test_eof569
+		}
+	st_case_569:
+		if data[p] == 102 {
+			goto st570
+		}
+		goto st0
+	st570:
+		if p++; p == pe {
+			goto _test_eof570
+		}
+	st_case_570:
+		if data[p] == 108 {
+			goto st571
+		}
+		goto st0
+	st571:
+		if p++; p == pe {
+			goto _test_eof571
+		}
+	st_case_571:
+		if data[p] == 97 {
+			goto st572
+		}
+		goto st0
+	st572:
+		if p++; p == pe {
+			goto _test_eof572
+		}
+	st_case_572:
+		if data[p] == 103 {
+			goto st573
+		}
+		goto st0
+	st573:
+		if p++; p == pe {
+			goto _test_eof573
+		}
+	st_case_573:
+		if data[p] == 115 {
+			goto st574
+		}
+		goto st0
+	st574:
+		if p++; p == pe {
+			goto _test_eof574
+		}
+	st_case_574:
+		if data[p] == 32 {
+			goto st575
+		}
+		goto st0
+	st575:
+		if p++; p == pe {
+			goto _test_eof575
+		}
+	st_case_575:
+		if data[p] == 32 {
+			goto st576
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st576
+		}
+		goto st0
+	st576:
+		if p++; p == pe {
+			goto _test_eof576
+		}
+	st_case_576:
+		switch data[p] {
+		case 32:
+			goto st576
+		case 111:
+			goto st577
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st576
+		}
+		goto st0
+	st577:
+		if p++; p == pe {
+			goto _test_eof577
+		}
+	st_case_577:
+		if data[p] == 110 {
+			goto st578
+		}
+		goto st0
+	st578:
+		if p++; p == pe {
+			goto _test_eof578
+		}
+	st_case_578:
+		if data[p] == 32 {
+			goto st579
+		}
+		goto st0
+	st579:
+		if p++; p == pe {
+			goto _test_eof579
+		}
+	st_case_579:
+		if data[p] == 105 {
+			goto st580
+		}
+		goto st0
+	st580:
+		if p++; p == pe {
+			goto _test_eof580
+		}
+	st_case_580:
+		if data[p] == 110 {
+			goto st581
+		}
+		goto st0
+	st581:
+		if p++; p == pe {
+			goto _test_eof581
+		}
+	st_case_581:
+		if data[p] == 116 {
+			goto st582
+		}
+		goto st0
+	st582:
+		if p++; p == pe {
+			goto _test_eof582
+		}
+	st_case_582:
+		if data[p] == 101 {
+			goto st583
+		}
+		goto st0
+	st583:
+		if p++; p == pe {
+			goto _test_eof583
+		}
+	st_case_583:
+		if data[p] == 114 {
+			goto st584
+		}
+		goto st0
+	st584:
+		if p++; p == pe {
+			goto _test_eof584
+		}
+	st_case_584:
+		if data[p] == 102 {
+			goto st585
+		}
+		goto st0
+	st585:
+		if p++; p == pe {
+			goto _test_eof585
+		}
+	st_case_585:
+		if data[p] == 97 {
+			goto st586
+		}
+		goto st0
+	st586:
+		if p++; p == pe {
+			goto _test_eof586
+		}
+	st_case_586:
+		if data[p] == 99 {
+			goto st587
+		}
+		goto st0
+	st587:
+		if p++; p == pe {
+			goto _test_eof587
+		}
+	st_case_587:
+		if data[p] == 101 {
+			goto st588
+		}
+		goto st0
+	st588:
+		if p++; p == pe {
+			goto _test_eof588
+		}
+	st_case_588:
+		if data[p] == 32 {
+			goto st589
+		}
+		goto st0
+	st589:
+		if p++; p == pe {
+			goto _test_eof589
+		}
+	st_case_589:
+		if data[p] == 95 {
+			goto tr635
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr635
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr635
+				}
+			case data[p] >= 65:
+				goto tr635
+			}
+		default:
+			goto tr635
+		}
+		goto st0
+tr635:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st1068
+	st1068:
+		if p++; p == pe {
+			goto _test_eof1068
+		}
+	st_case_1068:
+//line asa.go:10183
+		if data[p] == 95 {
+			goto st1068
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st1068
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st1068
+				}
+			case data[p] >= 65:
+				goto st1068
+			}
+		default:
+			goto st1068
+		}
+		goto st0
+tr614:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st590
+	st590:
+		if p++; p == pe {
+			goto _test_eof590
+		}
+	st_case_590:
+//line asa.go:10214
+		if data[p] == 32 {
+			goto st569
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr636
+		}
+		goto st0
+tr636:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st591
+	st591:
+		if p++; p == pe {
+			goto _test_eof591
+		}
+	st_case_591:
+//line asa.go:10231
+		if data[p] == 32 {
+			goto st569
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr637
+		}
+		goto st0
+tr637:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st592
+	st592:
+		if p++; p == pe {
+			goto _test_eof592
+		}
+	st_case_592:
+//line asa.go:10248
+		if data[p] == 32 {
+			goto st569
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr638
+		}
+		goto st0
+tr638:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st593
+	st593:
+		if p++; p == pe {
+			goto _test_eof593
+		}
+	st_case_593:
+//line asa.go:10265
+		if data[p] == 32 {
+			goto st569
+		}
+		goto st0
+tr605:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st594
+	st594:
+		if p++; p == pe {
+			goto _test_eof594
+		}
+	st_case_594:
+//line asa.go:10279
+		if data[p] == 32 {
+			goto st562
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr639
+		}
+		goto st0
+tr639:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st595
+	st595:
+		if p++; p == pe {
+			goto _test_eof595
+		}
+	st_case_595:
+//line asa.go:10296
+		if data[p] == 32 {
+			goto st562
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr640
+		}
+		goto st0
+tr640:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st596
+	st596:
+		if p++; p == pe {
+			goto _test_eof596
+		}
+	st_case_596:
+//line asa.go:10313
+		if data[p] == 32 {
+			goto st562
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr641
+		}
+		goto st0
+tr641:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st597
+	st597:
+		if p++; p == pe {
+			goto _test_eof597
+		}
+	st_case_597:
+//line asa.go:10330
+		if data[p] == 32 {
+			goto st562
+		}
+		goto st0
+tr576:
+//line asa.rl:70
+ mark = p 
+	goto st598
+	st598:
+		if p++; p == pe {
+			goto _test_eof598
+		}
+	st_case_598:
+//line asa.go:10344
+		if data[p] == 32 {
+			goto tr577
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st598
+			}
+		case data[p] >= 65:
+			goto st598
+		}
+		goto st0
+	st599:
+		if p++; p == pe {
+			goto _test_eof599
+		}
+	st_case_599:
+		if data[p] == 68 {
+			goto tr643
+		}
+		goto st0
+tr643:
+//line asa.rl:70
+ mark = p 
+	goto st600
+	st600:
+		if p++; p == pe {
+			goto _test_eof600
+		}
+	st_case_600:
+//line asa.go:10375
+		if data[p] == 101 {
+			goto st601
+		}
+		goto st0
+	st601:
+		if p++; p == pe {
+			goto _test_eof601
+		}
+	st_case_601:
+		if data[p] == 110 {
+			goto st602
+		}
+		goto st0
+	st602:
+		if p++; p == pe {
+			goto _test_eof602
+		}
+	st_case_602:
+		if data[p] == 121 {
+			goto st603
+		}
+		goto st0
+	st603:
+		if p++; p == pe {
+			goto _test_eof603
+		}
+	st_case_603:
+		if data[p] == 32 {
+			goto tr647
+		}
+		goto st0
+tr647:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st604
+	st604:
+		if p++; p == pe {
+			goto _test_eof604
+		}
+	st_case_604:
+//line asa.go:10416
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr648
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr649
+			}
+		default:
+			goto tr649
+		}
+		goto st0
+tr648:
+//line asa.rl:70
+ mark = p 
+	goto st605
+	st605:
+		if p++; p == pe {
+			goto _test_eof605
+		}
+	st_case_605:
+//line asa.go:10439
+		if data[p] == 32 {
+			goto tr650
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st605
+		}
+		goto st0
+tr650:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st606
+	st606:
+		if p++; p == pe {
+			goto _test_eof606
+		}
+	st_case_606:
+//line asa.go:10456
+		if data[p] == 115 {
+			goto st607
+		}
+		goto st0
+	st607:
+		if p++; p == pe {
+			goto _test_eof607
+		}
+	st_case_607:
+		if data[p] == 114 {
+			goto st608
+		}
+		goto st0
+	st608:
+		if p++; p == pe {
+			goto _test_eof608
+		}
+	st_case_608:
+		if data[p] == 99 {
+			goto st609
+		}
+		goto st0
+	st609:
+		if p++; p == pe {
+			goto _test_eof609
+		}
+	st_case_609:
+		if data[p] == 32 {
+			goto st610
+		}
+		goto st0
+	st610:
+		if p++; p == pe {
+			goto _test_eof610
+		}
+	st_case_610:
+		if data[p] == 95 {
+			goto tr656
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr656
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr656
+				}
+			case data[p] >= 65:
+				goto tr656
+			}
+		default:
+			goto tr656
+		}
+		goto st0
+tr656:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st611
+	st611:
+		if p++; p == pe {
+			goto _test_eof611
+		}
+	st_case_611:
+//line asa.go:10525
+		switch data[p] {
+		case 58:
+			goto tr658
+		case 95:
+			goto st611
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st611
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st611
+				}
+			case data[p] >= 65:
+				goto st611
+			}
+		default:
+			goto st611
+		}
+		goto st0
+tr658:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st612
+	st612:
+		if p++; p == pe {
+			goto _test_eof612
+		}
+	st_case_612:
+//line asa.go:10559
+		if data[p] == 46 {
+			goto tr659
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr659
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr659
+			}
+		default:
+			goto tr659
+		}
+		goto st0
+tr659:
+//line asa.rl:70
+ mark = p 
+	goto st613
+	st613:
+		if p++; p == pe {
+			goto _test_eof613
+		}
+	st_case_613:
+//line asa.go:10585
+		switch data[p] {
+		case 32:
+			goto tr660
+		case 47:
+			goto tr662
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st613
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st613
+			}
+		default:
+			goto st613
+		}
+		goto st0
+tr660:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st614
+	st614:
+		if p++; p == pe {
+			goto _test_eof614
+		}
+	st_case_614:
+//line asa.go:10614
+		if data[p] == 100 {
+			goto st615
+		}
+		goto st0
+	st615:
+		if p++; p == pe {
+			goto _test_eof615
+		}
+	st_case_615:
+		if data[p] == 115 {
+			goto st616
+		}
+		goto st0
+	st616:
+		if p++; p == pe {
+			goto _test_eof616
+		}
+	st_case_616:
+		if data[p] == 116 {
+			goto st617
+		}
+		goto st0
+	st617:
+		if p++; p == pe {
+			goto _test_eof617
+		}
+	st_case_617:
+		if data[p] == 32 {
+			goto st618
+		}
+		goto st0
+	st618:
+		if p++; p == pe {
+			goto _test_eof618
+		}
+	st_case_618:
+		if data[p] == 95 {
+			goto tr667
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr667
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr667
+				}
+			case data[p] >= 65:
+				goto tr667
+			}
+		default:
+			goto tr667
+		}
+		goto st0
+tr667:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st619
+	st619:
+		if p++; p == pe {
+			goto _test_eof619
+		}
+	st_case_619:
+//line asa.go:10683
+		switch data[p] {
+		case 58:
+			goto tr669
+		case 95:
+			goto st619
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st619
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st619
+				}
+			case data[p] >= 65:
+				goto st619
+			}
+		default:
+			goto st619
+		}
+		goto st0
+tr669:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st620
+	st620:
+		if p++; p == pe {
+			goto _test_eof620
+		}
+	st_case_620:
+//line asa.go:10717
+		if data[p] == 46 {
+			goto tr670
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr670
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr670
+			}
+		default:
+			goto tr670
+		}
+		goto st0
+tr670:
+//line asa.rl:70
+ mark = p 
+	goto st621
+	st621:
+		if p++; p == pe {
+			goto _test_eof621
+		}
+	st_case_621:
+//line asa.go:10743
+		switch data[p] {
+		case 32:
+			goto tr671
+		case 47:
+			goto tr673
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st621
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st621
+			}
+		default:
+			goto st621
+		}
+		goto st0
+tr671:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st622
+	st622:
+		if p++; p == pe {
+			goto _test_eof622
+		}
+	st_case_622:
+//line asa.go:10772
+		switch data[p] {
+		case 40:
+			goto st623
+		case 98:
+			goto st640
+		}
+		goto st0
+	st623:
+		if p++; p == pe {
+			goto _test_eof623
+		}
+	st_case_623:
+		if data[p] == 116 {
+			goto st624
+		}
+		goto st0
+	st624:
+		if p++; p == pe {
+			goto _test_eof624
+		}
+	st_case_624:
+		if data[p] == 121 {
+			goto st625
+		}
+		goto st0
+	st625:
+		if p++; p == pe {
+			goto _test_eof625
+		}
+	st_case_625:
+		if data[p] == 112 {
+			goto st626
+		}
+		goto st0
+	st626:
+		if p++; p == pe {
+			goto _test_eof626
+		}
+	st_case_626:
+		if data[p] == 101 {
+			goto st627
+		}
+		goto st0
+	st627:
+		if p++; p == pe {
+			goto _test_eof627
+		}
+	st_case_627:
+		if data[p] == 32 {
+			goto st628
+		}
+		goto st0
+	st628:
+		if p++; p == pe {
+			goto _test_eof628
+		}
+	st_case_628:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st629
+		}
+		goto st0
+	st629:
+		if p++; p == pe {
+			goto _test_eof629
+		}
+	st_case_629:
+		if data[p] == 44 {
+			goto st630
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st629
+		}
+		goto st0
+	st630:
+		if p++; p == pe {
+			goto _test_eof630
+		}
+	st_case_630:
+		if data[p] == 32 {
+			goto st631
+		}
+		goto st0
+	st631:
+		if p++; p == pe {
+			goto _test_eof631
+		}
+	st_case_631:
+		if data[p] == 99 {
+			goto st632
+		}
+		goto st0
+	st632:
+		if p++; p == pe {
+			goto _test_eof632
+		}
+	st_case_632:
+		if data[p] == 111 {
+			goto st633
+		}
+		goto st0
+	st633:
+		if p++; p == pe {
+			goto _test_eof633
+		}
+	st_case_633:
+		if data[p] == 100 {
+			goto st634
+		}
+		goto st0
+	st634:
+		if p++; p == pe {
+			goto _test_eof634
+		}
+	st_case_634:
+		if data[p] == 101 {
+			goto st635
+		}
+		goto st0
+	st635:
+		if p++; p == pe {
+			goto _test_eof635
+		}
+	st_case_635:
+		if data[p] == 32 {
+			goto st636
+		}
+		goto st0
+	st636:
+		if p++; p == pe {
+			goto _test_eof636
+		}
+	st_case_636:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st637
+		}
+		goto st0
+	st637:
+		if p++; p == pe {
+			goto _test_eof637
+		}
+	st_case_637:
+		if data[p] == 41 {
+			goto st638
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st637
+		}
+		goto st0
+	st638:
+		if p++; p == pe {
+			goto _test_eof638
+		}
+	st_case_638:
+		if data[p] == 32 {
+			goto st639
+		}
+		goto st0
+	st639:
+		if p++; p == pe {
+			goto _test_eof639
+		}
+	st_case_639:
+		if data[p] == 98 {
+			goto st640
+		}
+		goto st0
+	st640:
+		if p++; p == pe {
+			goto _test_eof640
+		}
+	st_case_640:
+		if data[p] == 121 {
+			goto st641
+		}
+		goto st0
+	st641:
+		if p++; p == pe {
+			goto _test_eof641
+		}
+	st_case_641:
+		if data[p] == 32 {
+			goto st642
+		}
+		goto st0
+	st642:
+		if p++; p == pe {
+			goto _test_eof642
+		}
+	st_case_642:
+		if data[p] == 97 {
+			goto st643
+		}
+		goto st0
+	st643:
+		if p++; p == pe {
+			goto _test_eof643
+		}
+	st_case_643:
+		if data[p] == 99 {
+			goto st644
+		}
+		goto st0
+	st644:
+		if p++; p == pe {
+			goto _test_eof644
+		}
+	st_case_644:
+		if data[p] == 99 {
+			goto st645
+		}
+		goto st0
+	st645:
+		if p++; p == pe {
+			goto _test_eof645
+		}
+	st_case_645:
+		if data[p] == 101 {
+			goto st646
+		}
+		goto st0
+	st646:
+		if p++; p == pe {
+			goto _test_eof646
+		}
+	st_case_646:
+		if data[p] == 115 {
+			goto st647
+		}
+		goto st0
+	st647:
+		if p++; p == pe {
+			goto _test_eof647
+		}
+	st_case_647:
+		if data[p] == 115 {
+			goto st648
+		}
+		goto st0
+	st648:
+		if p++; p == pe {
+			goto _test_eof648
+		}
+	st_case_648:
+		if data[p] == 45 {
+			goto st649
+		}
+		goto st0
+	st649:
+		if p++; p == pe {
+			goto _test_eof649
+		}
+	st_case_649:
+		if data[p] == 103 {
+			goto st650
+		}
+		goto st0
+	st650:
+		if p++; p == pe {
+			goto _test_eof650
+		}
+	st_case_650:
+		if data[p] == 114 {
+			goto st651
+		}
+		goto st0
+	st651:
+		if p++; p == pe {
+			goto _test_eof651
+		}
+	st_case_651:
+		if data[p] == 111 {
+			goto st652
+		}
+		goto st0
+	st652:
+		if p++; p == pe {
+			goto _test_eof652
+		}
+	st_case_652:
+		if data[p] == 117 {
+			goto st653
+		}
+		goto st0
+	st653:
+		if p++; p == pe {
+			goto _test_eof653
+		}
+	st_case_653:
+		if data[p] == 112 {
+			goto st654
+		}
+		goto st0
+	st654:
+		if p++; p == pe {
+			goto _test_eof654
+		}
+	st_case_654:
+		if data[p] == 32 {
+			goto st655
+		}
+		goto st0
+	st655:
+		if p++; p == pe {
+			goto _test_eof655
+		}
+	st_case_655:
+		if data[p] == 34 {
+			goto st656
+		}
+		goto st0
+	st656:
+		if p++; p == pe {
+			goto _test_eof656
+		}
+	st_case_656:
+		if data[p] == 34 {
+			goto st0
+		}
+		goto tr708
+tr708:
+//line asa.rl:70
+ mark = p 
+	goto st657
+	st657:
+		if p++; p == pe {
+			goto _test_eof657
+		}
+	st_case_657:
+//line asa.go:11101
+		if data[p] == 34 {
+			goto tr710
+		}
+		goto st657
+tr710:
+//line asa.rl:86
+ e.ACL = data[mark:p] 
+	goto st658
+	st658:
+		if p++; p == pe {
+			goto _test_eof658
+		}
+	st_case_658:
+//line asa.go:11115
+		if data[p] == 32 {
+			goto st659
+		}
+		goto st0
+	st659:
+		if p++; p == pe {
+			goto _test_eof659
+		}
+	st_case_659:
+		if data[p] == 91 {
+			goto st660
+		}
+		goto st0
+	st660:
+		if p++; p == pe {
+			goto _test_eof660
+		}
+	st_case_660:
+		if data[p] == 48 {
+			goto st661
+		}
+		goto st0
+	st661:
+		if p++; p == pe {
+			goto _test_eof661
+		}
+	st_case_661:
+		if data[p] == 120 {
+			goto st662
+		}
+		goto st0
+	st662:
+		if p++; p == pe {
+			goto _test_eof662
+		}
+	st_case_662:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st663
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st663
+			}
+		default:
+			goto st663
+		}
+		goto st0
+	st663:
+		if p++; p == pe {
+			goto _test_eof663
+		}
+	st_case_663:
+		if data[p] == 44 {
+			goto st664
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st663
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st663
+			}
+		default:
+			goto st663
+		}
+		goto st0
+	st664:
+		if p++; p == pe {
+			goto _test_eof664
+		}
+	st_case_664:
+		if data[p] == 32 {
+			goto st665
+		}
+		goto st0
+	st665:
+		if p++; p == pe {
+			goto _test_eof665
+		}
+	st_case_665:
+		if data[p] == 48 {
+			goto st666
+		}
+		goto st0
+	st666:
+		if p++; p == pe {
+			goto _test_eof666
+		}
+	st_case_666:
+		if data[p] == 120 {
+			goto st667
+		}
+		goto st0
+	st667:
+		if p++; p == pe {
+			goto _test_eof667
+		}
+	st_case_667:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st668
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st668
+			}
+		default:
+			goto st668
+		}
+		goto st0
+	st668:
+		if p++; p == pe {
+			goto _test_eof668
+		}
+	st_case_668:
+		if data[p] == 93 {
+			goto st1069
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st668
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st668
+			}
+		default:
+			goto st668
+		}
+		goto st0
+	st1069:
+		if p++; p == pe {
+			goto _test_eof1069
+		}
+	st_case_1069:
+		goto st0
+tr673:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st669
+	st669:
+		if p++; p == pe {
+			goto _test_eof669
+		}
+	st_case_669:
+//line asa.go:11267
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr722
+		}
+		goto st0
+tr722:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st670
+	st670:
+		if p++; p == pe {
+			goto _test_eof670
+		}
+	st_case_670:
+//line asa.go:11281
+		if data[p] == 32 {
+			goto st622
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr724
+		}
+		goto st0
+tr724:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st671
+	st671:
+		if p++; p == pe {
+			goto _test_eof671
+		}
+	st_case_671:
+//line asa.go:11298
+		if data[p] == 32 {
+			goto st622
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr725
+		}
+		goto st0
+tr725:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st672
+	st672:
+		if p++; p == pe {
+			goto _test_eof672
+		}
+	st_case_672:
+//line asa.go:11315
+		if data[p] == 32 {
+			goto st622
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr726
+		}
+		goto st0
+tr726:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st673
+	st673:
+		if p++; p == pe {
+			goto _test_eof673
+		}
+	st_case_673:
+//line asa.go:11332
+		if data[p] == 32 {
+			goto st622
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr727
+		}
+		goto st0
+tr727:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st674
+	st674:
+		if p++; p == pe {
+			goto _test_eof674
+		}
+	st_case_674:
+//line asa.go:11349
+		if data[p] == 32 {
+			goto st622
+		}
+		goto st0
+tr662:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st675
+	st675:
+		if p++; p == pe {
+			goto _test_eof675
+		}
+	st_case_675:
+//line asa.go:11363
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr728
+		}
+		goto st0
+tr728:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st676
+	st676:
+		if p++; p == pe {
+			goto _test_eof676
+		}
+	st_case_676:
+//line asa.go:11377
+		if data[p] == 32 {
+			goto st614
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr730
+		}
+		goto st0
+tr730:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st677
+	st677:
+		if p++; p == pe {
+			goto _test_eof677
+		}
+	st_case_677:
+//line asa.go:11394
+		if data[p] == 32 {
+			goto st614
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr731
+		}
+		goto st0
+tr731:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st678
+	st678:
+		if p++; p == pe {
+			goto _test_eof678
+		}
+	st_case_678:
+//line asa.go:11411
+		if data[p] == 32 {
+			goto st614
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr732
+		}
+		goto st0
+tr732:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st679
+	st679:
+		if p++; p == pe {
+			goto _test_eof679
+		}
+	st_case_679:
+//line asa.go:11428
+		if data[p] == 32 {
+			goto st614
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr733
+		}
+		goto st0
+tr733:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st680
+	st680:
+		if p++; p == pe {
+			goto _test_eof680
+		}
+	st_case_680:
+//line asa.go:11445
+		if data[p] == 32 {
+			goto st614
+		}
+		goto st0
+tr649:
+//line asa.rl:70
+ mark = p 
+	goto st681
+	st681:
+		if p++; p == pe {
+			goto _test_eof681
+		}
+	st_case_681:
+//line asa.go:11459
+		if data[p] == 32 {
+			goto tr650
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st681
+			}
+		case data[p] >= 65:
+			goto st681
+		}
+		goto st0
+	st682:
+		if p++; p == pe {
+			goto _test_eof682
+		}
+	st_case_682:
+		if data[p] == 97 {
+			goto st683
+		}
+		goto st0
+	st683:
+		if p++; p == pe {
+			goto _test_eof683
+		}
+	st_case_683:
+		if data[p] == 99 {
+			goto st684
+		}
+		goto st0
+	st684:
+		if p++; p == pe {
+			goto _test_eof684
+		}
+	st_case_684:
+		if data[p] == 99 {
+			goto st685
+		}
+		goto st0
+	st685:
+		if p++; p == pe {
+			goto _test_eof685
+		}
+	st_case_685:
+		if data[p] == 101 {
+			goto st686
+		}
+		goto st0
+	st686:
+		if p++; p == pe {
+			goto _test_eof686
+		}
+	st_case_686:
+		if data[p] == 115 {
+			goto st687
+		}
+		goto st0
+	st687:
+		if p++; p == pe {
+			goto _test_eof687
+		}
+	st_case_687:
+		if data[p] == 115 {
+			goto st688
+		}
+		goto st0
+	st688:
+		if p++; p == pe {
+			goto _test_eof688
+		}
+	st_case_688:
+		if data[p] == 45 {
+			goto st689
+		}
+		goto st0
+	st689:
+		if p++; p == pe {
+			goto _test_eof689
+		}
+	st_case_689:
+		if data[p] == 108 {
+			goto st690
+		}
+		goto st0
+	st690:
+		if p++; p == pe {
+			goto _test_eof690
+		}
+	st_case_690:
+		if data[p] == 105 {
+			goto st691
+		}
+		goto st0
+	st691:
+		if p++; p == pe {
+			goto _test_eof691
+		}
+	st_case_691:
+		if data[p] == 115 {
+			goto st692
+		}
+		goto st0
+	st692:
+		if p++; p == pe {
+			goto _test_eof692
+		}
+	st_case_692:
+		if data[p] == 116 {
+			goto st693
+		}
+		goto st0
+	st693:
+		if p++; p == pe {
+			goto _test_eof693
+		}
+	st_case_693:
+		if data[p] == 32 {
+			goto st694
+		}
+		goto st0
+	st694:
+		if p++; p == pe {
+			goto _test_eof694
+		}
+	st_case_694:
+		if data[p] == 32 {
+			goto st0
+		}
+		goto tr747
+tr747:
+//line asa.rl:70
+ mark = p 
+	goto st695
+	st695:
+		if p++; p == pe {
+			goto _test_eof695
+		}
+	st_case_695:
+//line asa.go:11598
+		if data[p] == 32 {
+			goto tr749
+		}
+		goto st695
+tr749:
+//line asa.rl:86
+ e.ACL = data[mark:p] 
+	goto st696
+	st696:
+		if p++; p == pe {
+			goto _test_eof696
+		}
+	st_case_696:
+//line asa.go:11612
+		switch data[p] {
+		case 100:
+			goto tr750
+		case 101:
+			goto tr751
+		case 112:
+			goto tr752
+		}
+		goto st0
+tr750:
+//line asa.rl:70
+ mark = p 
+	goto st697
+	st697:
+		if p++; p == pe {
+			goto _test_eof697
+		}
+	st_case_697:
+//line asa.go:11631
+		if data[p] == 101 {
+			goto st698
+		}
+		goto st0
+	st698:
+		if p++; p == pe {
+			goto _test_eof698
+		}
+	st_case_698:
+		if data[p] == 110 {
+			goto st699
+		}
+		goto st0
+	st699:
+		if p++; p == pe {
+			goto _test_eof699
+		}
+	st_case_699:
+		if data[p] == 105 {
+			goto st700
+		}
+		goto st0
+	st700:
+		if p++; p == pe {
+			goto _test_eof700
+		}
+	st_case_700:
+		if data[p] == 101 {
+			goto st701
+		}
+		goto st0
+	st701:
+		if p++; p == pe {
+			goto _test_eof701
+		}
+	st_case_701:
+		if data[p] == 100 {
+			goto st702
+		}
+		goto st0
+	st702:
+		if p++; p == pe {
+			goto _test_eof702
+		}
+	st_case_702:
+		if data[p] == 32 {
+			goto tr758
+		}
+		goto st0
+tr758:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st703
+	st703:
+		if p++; p == pe {
+			goto _test_eof703
+		}
+	st_case_703:
+//line asa.go:11690
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr759
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr760
+			}
+		default:
+			goto tr760
+		}
+		goto st0
+tr759:
+//line asa.rl:70
+ mark = p 
+	goto st704
+	st704:
+		if p++; p == pe {
+			goto _test_eof704
+		}
+	st_case_704:
+//line asa.go:11713
+		if data[p] == 32 {
+			goto tr761
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st704
+		}
+		goto st0
+tr761:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st705
+	st705:
+		if p++; p == pe {
+			goto _test_eof705
+		}
+	st_case_705:
+//line asa.go:11730
+		if data[p] == 95 {
+			goto tr763
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr763
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr763
+				}
+			case data[p] >= 65:
+				goto tr763
+			}
+		default:
+			goto tr763
+		}
+		goto st0
+tr763:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st706
+	st706:
+		if p++; p == pe {
+			goto _test_eof706
+		}
+	st_case_706:
+//line asa.go:11763
+		switch data[p] {
+		case 47:
+			goto tr765
+		case 95:
+			goto st706
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st706
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st706
+			}
+		default:
+			goto st706
+		}
+		goto st0
+tr765:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st707
+	st707:
+		if p++; p == pe {
+			goto _test_eof707
+		}
+	st_case_707:
+//line asa.go:11792
+		if data[p] == 46 {
+			goto tr766
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr766
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr766
+			}
+		default:
+			goto tr766
+		}
+		goto st0
+tr766:
+//line asa.rl:70
+ mark = p 
+	goto st708
+	st708:
+		if p++; p == pe {
+			goto _test_eof708
+		}
+	st_case_708:
+//line asa.go:11818
+		switch data[p] {
+		case 40:
+			goto tr767
+		case 46:
+			goto st708
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st708
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st708
+			}
+		default:
+			goto st708
+		}
+		goto st0
+tr767:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st709
+	st709:
+		if p++; p == pe {
+			goto _test_eof709
+		}
+	st_case_709:
+//line asa.go:11847
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr769
+		}
+		goto st0
+tr769:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st710
+	st710:
+		if p++; p == pe {
+			goto _test_eof710
+		}
+	st_case_710:
+//line asa.go:11861
+		if data[p] == 41 {
+			goto st711
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr771
+		}
+		goto st0
+	st711:
+		if p++; p == pe {
+			goto _test_eof711
+		}
+	st_case_711:
+		if data[p] == 32 {
+			goto st712
+		}
+		goto st0
+	st712:
+		if p++; p == pe {
+			goto _test_eof712
+		}
+	st_case_712:
+		if data[p] == 45 {
+			goto st713
+		}
+		goto st0
+	st713:
+		if p++; p == pe {
+			goto _test_eof713
+		}
+	st_case_713:
+		if data[p] == 62 {
+			goto st714
+		}
+		goto st0
+	st714:
+		if p++; p == pe {
+			goto _test_eof714
+		}
+	st_case_714:
+		if data[p] == 32 {
+			goto st715
+		}
+		goto st0
+	st715:
+		if p++; p == pe {
+			goto _test_eof715
+		}
+	st_case_715:
+		if data[p] == 95 {
+			goto tr776
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr776
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr776
+				}
+			case data[p] >= 65:
+				goto tr776
+			}
+		default:
+			goto tr776
+		}
+		goto st0
+tr776:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st716
+	st716:
+		if p++; p == pe {
+			goto _test_eof716
+		}
+	st_case_716:
+//line asa.go:11942
+		switch data[p] {
+		case 47:
+			goto tr778
+		case 95:
+			goto st716
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st716
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st716
+			}
+		default:
+			goto st716
+		}
+		goto st0
+tr778:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st717
+	st717:
+		if p++; p == pe {
+			goto _test_eof717
+		}
+	st_case_717:
+//line asa.go:11971
+		if data[p] == 46 {
+			goto tr779
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr779
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr779
+			}
+		default:
+			goto tr779
+		}
+		goto st0
+tr779:
+//line asa.rl:70
+ mark = p 
+	goto st718
+	st718:
+		if p++; p == pe {
+			goto _test_eof718
+		}
+	st_case_718:
+//line asa.go:11997
+		switch data[p] {
+		case 40:
+			goto tr780
+		case 46:
+			goto st718
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st718
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st718
+			}
+		default:
+			goto st718
+		}
+		goto st0
+tr780:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st719
+	st719:
+		if p++; p == pe {
+			goto _test_eof719
+		}
+	st_case_719:
+//line asa.go:12026
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr782
+		}
+		goto st0
+tr782:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st720
+	st720:
+		if p++; p == pe {
+			goto _test_eof720
+		}
+	st_case_720:
+//line asa.go:12040
+		if data[p] == 41 {
+			goto st721
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr784
+		}
+		goto st0
+	st721:
+		if p++; p == pe {
+			goto _test_eof721
+		}
+	st_case_721:
+		if data[p] == 32 {
+			goto st722
+		}
+		goto st0
+	st722:
+		if p++; p == pe {
+			goto _test_eof722
+		}
+	st_case_722:
+		if data[p] == 104 {
+			goto st723
+		}
+		goto st0
+	st723:
+		if p++; p == pe {
+			goto _test_eof723
+		}
+	st_case_723:
+		if data[p] == 105 {
+			goto st724
+		}
+		goto st0
+	st724:
+		if p++; p == pe {
+			goto _test_eof724
+		}
+	st_case_724:
+		if data[p] == 116 {
+			goto st725
+		}
+		goto st0
+	st725:
+		if p++; p == pe {
+			goto _test_eof725
+		}
+	st_case_725:
+		if data[p] == 45 {
+			goto st726
+		}
+		goto st0
+	st726:
+		if p++; p == pe {
+			goto _test_eof726
+		}
+	st_case_726:
+		if data[p] == 99 {
+			goto st727
+		}
+		goto st0
+	st727:
+		if p++; p == pe {
+			goto _test_eof727
+		}
+	st_case_727:
+		if data[p] == 110 {
+			goto st728
+		}
+		goto st0
+	st728:
+		if p++; p == pe {
+			goto _test_eof728
+		}
+	st_case_728:
+		if data[p] == 116 {
+			goto st729
+		}
+		goto st0
+	st729:
+		if p++; p == pe {
+			goto _test_eof729
+		}
+	st_case_729:
+		if data[p] == 32 {
+			goto st730
+		}
+		goto st0
+	st730:
+		if p++; p == pe {
+			goto _test_eof730
+		}
+	st_case_730:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st731
+		}
+		goto st0
+	st731:
+		if p++; p == pe {
+			goto _test_eof731
+		}
+	st_case_731:
+		if data[p] == 32 {
+			goto st732
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st731
+		}
+		goto st0
+	st732:
+		if p++; p == pe {
+			goto _test_eof732
+		}
+	st_case_732:
+		goto st1070
+	st1070:
+		if p++; p == pe {
+			goto _test_eof1070
+		}
+	st_case_1070:
+		goto st1070
+tr784:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st733
+	st733:
+		if p++; p == pe {
+			goto _test_eof733
+		}
+	st_case_733:
+//line asa.go:12171
+		if data[p] == 41 {
+			goto st721
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr797
+		}
+		goto st0
+tr797:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st734
+	st734:
+		if p++; p == pe {
+			goto _test_eof734
+		}
+	st_case_734:
+//line asa.go:12188
+		if data[p] == 41 {
+			goto st721
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr798
+		}
+		goto st0
+tr798:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st735
+	st735:
+		if p++; p == pe {
+			goto _test_eof735
+		}
+	st_case_735:
+//line asa.go:12205
+		if data[p] == 41 {
+			goto st721
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr799
+		}
+		goto st0
+tr799:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st736
+	st736:
+		if p++; p == pe {
+			goto _test_eof736
+		}
+	st_case_736:
+//line asa.go:12222
+		if data[p] == 41 {
+			goto st721
+		}
+		goto st0
+tr771:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st737
+	st737:
+		if p++; p == pe {
+			goto _test_eof737
+		}
+	st_case_737:
+//line asa.go:12236
+		if data[p] == 41 {
+			goto st711
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr800
+		}
+		goto st0
+tr800:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st738
+	st738:
+		if p++; p == pe {
+			goto _test_eof738
+		}
+	st_case_738:
+//line asa.go:12253
+		if data[p] == 41 {
+			goto st711
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr801
+		}
+		goto st0
+tr801:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st739
+	st739:
+		if p++; p == pe {
+			goto _test_eof739
+		}
+	st_case_739:
+//line asa.go:12270
+		if data[p] == 41 {
+			goto st711
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr802
+		}
+		goto st0
+tr802:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st740
+	st740:
+		if p++; p == pe {
+			goto _test_eof740
+		}
+	st_case_740:
+//line asa.go:12287
+		if data[p] == 41 {
+			goto st711
+		}
+		goto st0
+tr760:
+//line asa.rl:70
+ mark = p 
+	goto st741
+	st741:
+		if p++; p == pe {
+			goto _test_eof741
+		}
+	st_case_741:
+//line asa.go:12301
+		if data[p] == 32 {
+			goto tr761
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st741
+			}
+		case data[p] >= 65:
+			goto st741
+		}
+		goto st0
+tr751:
+//line asa.rl:70
+ mark = p 
+	goto st742
+	st742:
+		if p++; p == pe {
+			goto _test_eof742
+		}
+	st_case_742:
+//line asa.go:12323
+		if data[p] == 115 {
+			goto st743
+		}
+		goto st0
+	st743:
+		if p++; p == pe {
+			goto _test_eof743
+		}
+	st_case_743:
+		if data[p] == 116 {
+			goto st744
+		}
+		goto st0
+	st744:
+		if p++; p == pe {
+			goto _test_eof744
+		}
+	st_case_744:
+		if data[p] == 45 {
+			goto st745
+		}
+		goto st0
+	st745:
+		if p++; p == pe {
+			goto _test_eof745
+		}
+	st_case_745:
+		if data[p] == 97 {
+			goto st746
+		}
+		goto st0
+	st746:
+		if p++; p == pe {
+			goto _test_eof746
+		}
+	st_case_746:
+		if data[p] == 108 {
+			goto st747
+		}
+		goto st0
+	st747:
+		if p++; p == pe {
+			goto _test_eof747
+		}
+	st_case_747:
+		if data[p] == 108 {
+			goto st748
+		}
+		goto st0
+	st748:
+		if p++; p == pe {
+			goto _test_eof748
+		}
+	st_case_748:
+		if data[p] == 111 {
+			goto st749
+		}
+		goto st0
+	st749:
+		if p++; p == pe {
+			goto _test_eof749
+		}
+	st_case_749:
+		if data[p] == 119 {
+			goto st700
+		}
+		goto st0
+tr752:
+//line asa.rl:70
+ mark = p 
+	goto st750
+	st750:
+		if p++; p == pe {
+			goto _test_eof750
+		}
+	st_case_750:
+//line asa.go:12400
+		if data[p] == 101 {
+			goto st751
+		}
+		goto st0
+	st751:
+		if p++; p == pe {
+			goto _test_eof751
+		}
+	st_case_751:
+		if data[p] == 114 {
+			goto st752
+		}
+		goto st0
+	st752:
+		if p++; p == pe {
+			goto _test_eof752
+		}
+	st_case_752:
+		if data[p] == 109 {
+			goto st753
+		}
+		goto st0
+	st753:
+		if p++; p == pe {
+			goto _test_eof753
+		}
+	st_case_753:
+		if data[p] == 105 {
+			goto st754
+		}
+		goto st0
+	st754:
+		if p++; p == pe {
+			goto _test_eof754
+		}
+	st_case_754:
+		if data[p] == 116 {
+			goto st755
+		}
+		goto st0
+	st755:
+		if p++; p == pe {
+			goto _test_eof755
+		}
+	st_case_755:
+		if data[p] == 116 {
+			goto st700
+		}
+		goto st0
+	st756:
+		if p++; p == pe {
+			goto _test_eof756
+		}
+	st_case_756:
+		if data[p] == 82 {
+			goto st757
+		}
+		goto st0
+	st757:
+		if p++; p == pe {
+			goto _test_eof757
+		}
+	st_case_757:
+		if data[p] == 111 {
+			goto st758
+		}
+		goto st0
+	st758:
+		if p++; p == pe {
+			goto _test_eof758
+		}
+	st_case_758:
+		if data[p] == 117 {
+			goto st759
+		}
+		goto st0
+	st759:
+		if p++; p == pe {
+			goto _test_eof759
+		}
+	st_case_759:
+		if data[p] == 116 {
+			goto st760
+		}
+		goto st0
+	st760:
+		if p++; p == pe {
+			goto _test_eof760
+		}
+	st_case_760:
+		if data[p] == 105 {
+			goto st761
+		}
+		goto st0
+	st761:
+		if p++; p == pe {
+			goto _test_eof761
+		}
+	st_case_761:
+		if data[p] == 110 {
+			goto st762
+		}
+		goto st0
+	st762:
+		if p++; p == pe {
+			goto _test_eof762
+		}
+	st_case_762:
+		if data[p] == 103 {
+			goto st763
+		}
+		goto st0
+	st763:
+		if p++; p == pe {
+			goto _test_eof763
+		}
+	st_case_763:
+		if data[p] == 32 {
+			goto st764
+		}
+		goto st0
+	st764:
+		if p++; p == pe {
+			goto _test_eof764
+		}
+	st_case_764:
+		if data[p] == 102 {
+			goto st765
+		}
+		goto st0
+	st765:
+		if p++; p == pe {
+			goto _test_eof765
+		}
+	st_case_765:
+		if data[p] == 97 {
+			goto st766
+		}
+		goto st0
+	st766:
+		if p++; p == pe {
+			goto _test_eof766
+		}
+	st_case_766:
+		if data[p] == 105 {
+			goto st767
+		}
+		goto st0
+	st767:
+		if p++; p == pe {
+			goto _test_eof767
+		}
+	st_case_767:
+		if data[p] == 108 {
+			goto st768
+		}
+		goto st0
+	st768:
+		if p++; p == pe {
+			goto _test_eof768
+		}
+	st_case_768:
+		if data[p] == 101 {
+			goto st769
+		}
+		goto st0
+	st769:
+		if p++; p == pe {
+			goto _test_eof769
+		}
+	st_case_769:
+		if data[p] == 100 {
+			goto st770
+		}
+		goto st0
+	st770:
+		if p++; p == pe {
+			goto _test_eof770
+		}
+	st_case_770:
+		if data[p] == 32 {
+			goto st771
+		}
+		goto st0
+	st771:
+		if p++; p == pe {
+			goto _test_eof771
+		}
+	st_case_771:
+		if data[p] == 116 {
+			goto st772
+		}
+		goto st0
+	st772:
+		if p++; p == pe {
+			goto _test_eof772
+		}
+	st_case_772:
+		if data[p] == 111 {
+			goto st773
+		}
+		goto st0
+	st773:
+		if p++; p == pe {
+			goto _test_eof773
+		}
+	st_case_773:
+		if data[p] == 32 {
+			goto st774
+		}
+		goto st0
+	st774:
+		if p++; p == pe {
+			goto _test_eof774
+		}
+	st_case_774:
+		if data[p] == 108 {
+			goto st775
+		}
+		goto st0
+	st775:
+		if p++; p == pe {
+			goto _test_eof775
+		}
+	st_case_775:
+		if data[p] == 111 {
+			goto st776
+		}
+		goto st0
+	st776:
+		if p++; p == pe {
+			goto _test_eof776
+		}
+	st_case_776:
+		if data[p] == 99 {
+			goto st777
+		}
+		goto st0
+	st777:
+		if p++; p == pe {
+			goto _test_eof777
+		}
+	st_case_777:
+		if data[p] == 97 {
+			goto st778
+		}
+		goto st0
+	st778:
+		if p++; p == pe {
+			goto _test_eof778
+		}
+	st_case_778:
+		if data[p] == 116 {
+			goto st779
+		}
+		goto st0
+	st779:
+		if p++; p == pe {
+			goto _test_eof779
+		}
+	st_case_779:
+		if data[p] == 101 {
+			goto st780
+		}
+		goto st0
+	st780:
+		if p++; p == pe {
+			goto _test_eof780
+		}
+	st_case_780:
+		if data[p] == 32 {
+			goto st781
+		}
+		goto st0
+	st781:
+		if p++; p == pe {
+			goto _test_eof781
+		}
+	st_case_781:
+		if data[p] == 110 {
+			goto st782
+		}
+		goto st0
+	st782:
+		if p++; p == pe {
+			goto _test_eof782
+		}
+	st_case_782:
+		if data[p] == 101 {
+			goto st783
+		}
+		goto st0
+	st783:
+		if p++; p == pe {
+			goto _test_eof783
+		}
+	st_case_783:
+		if data[p] == 120 {
+			goto st784
+		}
+		goto st0
+	st784:
+		if p++; p == pe {
+			goto _test_eof784
+		}
+	st_case_784:
+		if data[p] == 116 {
+			goto st785
+		}
+		goto st0
+	st785:
+		if p++; p == pe {
+			goto _test_eof785
+		}
+	st_case_785:
+		if data[p] == 32 {
+			goto st786
+		}
+		goto st0
+	st786:
+		if p++; p == pe {
+			goto _test_eof786
+		}
+	st_case_786:
+		if data[p] == 104 {
+			goto st787
+		}
+		goto st0
+	st787:
+		if p++; p == pe {
+			goto _test_eof787
+		}
+	st_case_787:
+		if data[p] == 111 {
+			goto st788
+		}
+		goto st0
+	st788:
+		if p++; p == pe {
+			goto _test_eof788
+		}
+	st_case_788:
+		if data[p] == 112 {
+			goto st789
+		}
+		goto st0
+	st789:
+		if p++; p == pe {
+			goto _test_eof789
+		}
+	st_case_789:
+		if data[p] == 32 {
+			goto st790
+		}
+		goto st0
+	st790:
+		if p++; p == pe {
+			goto _test_eof790
+		}
+	st_case_790:
+		if data[p] == 102 {
+			goto st791
+		}
+		goto st0
+	st791:
+		if p++; p == pe {
+			goto _test_eof791
+		}
+	st_case_791:
+		if data[p] == 111 {
+			goto st792
+		}
+		goto st0
+	st792:
+		if p++; p == pe {
+			goto _test_eof792
+		}
+	st_case_792:
+		if data[p] == 114 {
+			goto st793
+		}
+		goto st0
+	st793:
+		if p++; p == pe {
+			goto _test_eof793
+		}
+	st_case_793:
+		if data[p] == 32 {
+			goto st794
+		}
+		goto st0
+	st794:
+		if p++; p == pe {
+			goto _test_eof794
+		}
+	st_case_794:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr854
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr855
+			}
+		default:
+			goto tr855
+		}
+		goto st0
+tr854:
+//line asa.rl:70
+ mark = p 
+	goto st795
+	st795:
+		if p++; p == pe {
+			goto _test_eof795
+		}
+	st_case_795:
+//line asa.go:12819
+		if data[p] == 32 {
+			goto tr856
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st795
+		}
+		goto st0
+tr856:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st796
+	st796:
+		if p++; p == pe {
+			goto _test_eof796
+		}
+	st_case_796:
+//line asa.go:12836
+		if data[p] == 102 {
+			goto st797
+		}
+		goto st0
+	st797:
+		if p++; p == pe {
+			goto _test_eof797
+		}
+	st_case_797:
+		if data[p] == 114 {
+			goto st798
+		}
+		goto st0
+	st798:
+		if p++; p == pe {
+			goto _test_eof798
+		}
+	st_case_798:
+		if data[p] == 111 {
+			goto st799
+		}
+		goto st0
+	st799:
+		if p++; p == pe {
+			goto _test_eof799
+		}
+	st_case_799:
+		if data[p] == 109 {
+			goto st800
+		}
+		goto st0
+	st800:
+		if p++; p == pe {
+			goto _test_eof800
+		}
+	st_case_800:
+		if data[p] == 32 {
+			goto st801
+		}
+		goto st0
+	st801:
+		if p++; p == pe {
+			goto _test_eof801
+		}
+	st_case_801:
+		if data[p] == 95 {
+			goto tr863
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr863
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr863
+				}
+			case data[p] >= 65:
+				goto tr863
+			}
+		default:
+			goto tr863
+		}
+		goto st0
+tr863:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st802
+	st802:
+		if p++; p == pe {
+			goto _test_eof802
+		}
+	st_case_802:
+//line asa.go:12914
+		switch data[p] {
+		case 58:
+			goto tr865
+		case 95:
+			goto st802
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st802
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st802
+				}
+			case data[p] >= 65:
+				goto st802
+			}
+		default:
+			goto st802
+		}
+		goto st0
+tr865:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st803
+	st803:
+		if p++; p == pe {
+			goto _test_eof803
+		}
+	st_case_803:
+//line asa.go:12948
+		if data[p] == 46 {
+			goto tr866
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr866
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr866
+			}
+		default:
+			goto tr866
+		}
+		goto st0
+tr866:
+//line asa.rl:70
+ mark = p 
+	goto st804
+	st804:
+		if p++; p == pe {
+			goto _test_eof804
+		}
+	st_case_804:
+//line asa.go:12974
+		if data[p] == 47 {
+			goto tr868
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st804
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st804
+			}
+		default:
+			goto st804
+		}
+		goto st0
+tr868:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st805
+	st805:
+		if p++; p == pe {
+			goto _test_eof805
+		}
+	st_case_805:
+//line asa.go:13000
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr869
+		}
+		goto st0
+tr869:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st806
+	st806:
+		if p++; p == pe {
+			goto _test_eof806
+		}
+	st_case_806:
+//line asa.go:13014
+		if data[p] == 32 {
+			goto st807
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr871
+		}
+		goto st0
+	st807:
+		if p++; p == pe {
+			goto _test_eof807
+		}
+	st_case_807:
+		if data[p] == 116 {
+			goto st808
+		}
+		goto st0
+	st808:
+		if p++; p == pe {
+			goto _test_eof808
+		}
+	st_case_808:
+		if data[p] == 111 {
+			goto st809
+		}
+		goto st0
+	st809:
+		if p++; p == pe {
+			goto _test_eof809
+		}
+	st_case_809:
+		if data[p] == 32 {
+			goto st810
+		}
+		goto st0
+	st810:
+		if p++; p == pe {
+			goto _test_eof810
+		}
+	st_case_810:
+		if data[p] == 95 {
+			goto tr875
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr875
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr875
+				}
+			case data[p] >= 65:
+				goto tr875
+			}
+		default:
+			goto tr875
+		}
+		goto st0
+tr875:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st811
+	st811:
+		if p++; p == pe {
+			goto _test_eof811
+		}
+	st_case_811:
+//line asa.go:13086
+		switch data[p] {
+		case 58:
+			goto tr877
+		case 95:
+			goto st811
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st811
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st811
+				}
+			case data[p] >= 65:
+				goto st811
+			}
+		default:
+			goto st811
+		}
+		goto st0
+tr877:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st812
+	st812:
+		if p++; p == pe {
+			goto _test_eof812
+		}
+	st_case_812:
+//line asa.go:13120
+		if data[p] == 46 {
+			goto tr878
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr878
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr878
+			}
+		default:
+			goto tr878
+		}
+		goto st0
+tr878:
+//line asa.rl:70
+ mark = p 
+	goto st813
+	st813:
+		if p++; p == pe {
+			goto _test_eof813
+		}
+	st_case_813:
+//line asa.go:13146
+		if data[p] == 47 {
+			goto tr880
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st813
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st813
+			}
+		default:
+			goto st813
+		}
+		goto st0
+tr880:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st814
+	st814:
+		if p++; p == pe {
+			goto _test_eof814
+		}
+	st_case_814:
+//line asa.go:13172
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr881
+		}
+		goto st0
+tr881:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1071
+	st1071:
+		if p++; p == pe {
+			goto _test_eof1071
+		}
+	st_case_1071:
+//line asa.go:13186
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1151
+		}
+		goto st0
+tr1151:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1072
+	st1072:
+		if p++; p == pe {
+			goto _test_eof1072
+		}
+	st_case_1072:
+//line asa.go:13200
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1152
+		}
+		goto st0
+tr1152:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1073
+	st1073:
+		if p++; p == pe {
+			goto _test_eof1073
+		}
+	st_case_1073:
+//line asa.go:13214
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1153
+		}
+		goto st0
+tr1153:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1074
+	st1074:
+		if p++; p == pe {
+			goto _test_eof1074
+		}
+	st_case_1074:
+//line asa.go:13228
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1154
+		}
+		goto st0
+tr1154:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1075
+	st1075:
+		if p++; p == pe {
+			goto _test_eof1075
+		}
+	st_case_1075:
+//line asa.go:13242
+		goto st0
+tr871:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st815
+	st815:
+		if p++; p == pe {
+			goto _test_eof815
+		}
+	st_case_815:
+//line asa.go:13253
+		if data[p] == 32 {
+			goto st807
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr882
+		}
+		goto st0
+tr882:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st816
+	st816:
+		if p++; p == pe {
+			goto _test_eof816
+		}
+	st_case_816:
+//line asa.go:13270
+		if data[p] == 32 {
+			goto st807
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr883
+		}
+		goto st0
+tr883:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st817
+	st817:
+		if p++; p == pe {
+			goto _test_eof817
+		}
+	st_case_817:
+//line asa.go:13287
+		if data[p] == 32 {
+			goto st807
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr884
+		}
+		goto st0
+tr884:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st818
+	st818:
+		if p++; p == pe {
+			goto _test_eof818
+		}
+	st_case_818:
+//line asa.go:13304
+		if data[p] == 32 {
+			goto st807
+		}
+		goto st0
+tr855:
+//line asa.rl:70
+ mark = p 
+	goto st819
+	st819:
+		if p++; p == pe {
+			goto _test_eof819
+		}
+	st_case_819:
+//line asa.go:13318
+		if data[p] == 32 {
+			goto tr856
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st819
+			}
+		case data[p] >= 65:
+			goto st819
+		}
+		goto st0
+	st820:
+		if p++; p == pe {
+			goto _test_eof820
+		}
+	st_case_820:
+		if data[p] == 65 {
+			goto st821
+		}
+		goto st0
+	st821:
+		if p++; p == pe {
+			goto _test_eof821
+		}
+	st_case_821:
+		if data[p] == 65 {
+			goto st822
+		}
+		goto st0
+	st822:
+		if p++; p == pe {
+			goto _test_eof822
+		}
+	st_case_822:
+		if data[p] == 65 {
+			goto st823
+		}
+		goto st0
+	st823:
+		if p++; p == pe {
+			goto _test_eof823
+		}
+	st_case_823:
+		if data[p] == 32 {
+			goto st824
+		}
+		goto st0
+	st824:
+		if p++; p == pe {
+			goto _test_eof824
+		}
+	st_case_824:
+		if data[p] == 117 {
+			goto st825
+		}
+		goto st0
+	st825:
+		if p++; p == pe {
+			goto _test_eof825
+		}
+	st_case_825:
+		if data[p] == 115 {
+			goto st826
+		}
+		goto st0
+	st826:
+		if p++; p == pe {
+			goto _test_eof826
+		}
+	st_case_826:
+		if data[p] == 101 {
+			goto st827
+		}
+		goto st0
+	st827:
+		if p++; p == pe {
+			goto _test_eof827
+		}
+	st_case_827:
+		if data[p] == 114 {
+			goto st828
+		}
+		goto st0
+	st828:
+		if p++; p == pe {
+			goto _test_eof828
+		}
+	st_case_828:
+		if data[p] == 32 {
+			goto st829
+		}
+		goto st0
+	st829:
+		if p++; p == pe {
+			goto _test_eof829
+		}
+	st_case_829:
+		if 97 <= data[p] && data[p] <= 122 {
+			goto st830
+		}
+		goto st0
+	st830:
+		if p++; p == pe {
+			goto _test_eof830
+		}
+	st_case_830:
+		if data[p] == 32 {
+			goto st831
+		}
+		if 97 <= data[p] && data[p] <= 122 {
+			goto st830
+		}
+		goto st0
+	st831:
+		if p++; p == pe {
+			goto _test_eof831
+		}
+	st_case_831:
+		switch data[p] {
+		case 82:
+			goto tr897
+		case 83:
+			goto tr898
+		}
+		goto st0
+tr897:
+//line asa.rl:70
+ mark = p 
+	goto st832
+	st832:
+		if p++; p == pe {
+			goto _test_eof832
+		}
+	st_case_832:
+//line asa.go:13454
+		if data[p] == 101 {
+			goto st833
+		}
+		goto st0
+	st833:
+		if p++; p == pe {
+			goto _test_eof833
+		}
+	st_case_833:
+		if data[p] == 106 {
+			goto st834
+		}
+		goto st0
+	st834:
+		if p++; p == pe {
+			goto _test_eof834
+		}
+	st_case_834:
+		if data[p] == 101 {
+			goto st835
+		}
+		goto st0
+	st835:
+		if p++; p == pe {
+			goto _test_eof835
+		}
+	st_case_835:
+		if data[p] == 99 {
+			goto st836
+		}
+		goto st0
+	st836:
+		if p++; p == pe {
+			goto _test_eof836
+		}
+	st_case_836:
+		if data[p] == 116 {
+			goto st837
+		}
+		goto st0
+	st837:
+		if p++; p == pe {
+			goto _test_eof837
+		}
+	st_case_837:
+		if data[p] == 101 {
+			goto st838
+		}
+		goto st0
+	st838:
+		if p++; p == pe {
+			goto _test_eof838
+		}
+	st_case_838:
+		if data[p] == 100 {
+			goto st1076
+		}
+		goto st0
+	st1076:
+		if p++; p == pe {
+			goto _test_eof1076
+		}
+	st_case_1076:
+		if data[p] == 32 {
+			goto tr1155
+		}
+		goto st0
+tr1155:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st839
+	st839:
+		if p++; p == pe {
+			goto _test_eof839
+		}
+	st_case_839:
+//line asa.go:13531
+		if data[p] == 58 {
+			goto st840
+		}
+		goto st0
+	st840:
+		if p++; p == pe {
+			goto _test_eof840
+		}
+	st_case_840:
+		if data[p] == 32 {
+			goto st841
+		}
+		goto st0
+	st841:
+		if p++; p == pe {
+			goto _test_eof841
+		}
+	st_case_841:
+		switch data[p] {
+		case 58:
+			goto st0
+		case 114:
+			goto st1079
+		case 117:
+			goto st1090
+		}
+		goto st1077
+	st1077:
+		if p++; p == pe {
+			goto _test_eof1077
+		}
+	st_case_1077:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		}
+		goto st1077
+	st1078:
+		if p++; p == pe {
+			goto _test_eof1078
+		}
+	st_case_1078:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st840
+		}
+		goto st1077
+	st1079:
+		if p++; p == pe {
+			goto _test_eof1079
+		}
+	st_case_1079:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 101:
+			goto st1080
+		}
+		goto st1077
+	st1080:
+		if p++; p == pe {
+			goto _test_eof1080
+		}
+	st_case_1080:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 97:
+			goto st1081
+		}
+		goto st1077
+	st1081:
+		if p++; p == pe {
+			goto _test_eof1081
+		}
+	st_case_1081:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 115:
+			goto st1082
+		}
+		goto st1077
+	st1082:
+		if p++; p == pe {
+			goto _test_eof1082
+		}
+	st_case_1082:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 111:
+			goto st1083
+		}
+		goto st1077
+	st1083:
+		if p++; p == pe {
+			goto _test_eof1083
+		}
+	st_case_1083:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 110:
+			goto st1084
+		}
+		goto st1077
+	st1084:
+		if p++; p == pe {
+			goto _test_eof1084
+		}
+	st_case_1084:
+		switch data[p] {
+		case 32:
+			goto st1085
+		case 58:
+			goto st0
+		}
+		goto st1077
+	st1085:
+		if p++; p == pe {
+			goto _test_eof1085
+		}
+	st_case_1085:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st840
+		case 61:
+			goto st1086
+		}
+		goto st1077
+	st1086:
+		if p++; p == pe {
+			goto _test_eof1086
+		}
+	st_case_1086:
+		switch data[p] {
+		case 32:
+			goto st1087
+		case 58:
+			goto st0
+		}
+		goto st1077
+	st1087:
+		if p++; p == pe {
+			goto _test_eof1087
+		}
+	st_case_1087:
+		switch data[p] {
+		case 32:
+			goto tr1166
+		case 58:
+			goto st840
+		}
+		goto tr1165
+tr1165:
+//line asa.rl:70
+ mark = p 
+	goto st1088
+	st1088:
+		if p++; p == pe {
+			goto _test_eof1088
+		}
+	st_case_1088:
+//line asa.go:13712
+		switch data[p] {
+		case 32:
+			goto tr1168
+		case 58:
+			goto st0
+		}
+		goto st1088
+tr1166:
+//line asa.rl:70
+ mark = p 
+	goto st1089
+tr1168:
+//line asa.rl:87
+ e.Reason = data[mark:p] 
+	goto st1089
+	st1089:
+		if p++; p == pe {
+			goto _test_eof1089
+		}
+	st_case_1089:
+//line asa.go:13733
+		switch data[p] {
+		case 32:
+			goto tr1168
+		case 58:
+			goto st840
+		}
+		goto st1088
+	st1090:
+		if p++; p == pe {
+			goto _test_eof1090
+		}
+	st_case_1090:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 115:
+			goto st1091
+		}
+		goto st1077
+	st1091:
+		if p++; p == pe {
+			goto _test_eof1091
+		}
+	st_case_1091:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 101:
+			goto st1092
+		}
+		goto st1077
+	st1092:
+		if p++; p == pe {
+			goto _test_eof1092
+		}
+	st_case_1092:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st0
+		case 114:
+			goto st1093
+		}
+		goto st1077
+	st1093:
+		if p++; p == pe {
+			goto _test_eof1093
+		}
+	st_case_1093:
+		switch data[p] {
+		case 32:
+			goto st1094
+		case 58:
+			goto st0
+		}
+		goto st1077
+	st1094:
+		if p++; p == pe {
+			goto _test_eof1094
+		}
+	st_case_1094:
+		switch data[p] {
+		case 32:
+			goto st1078
+		case 58:
+			goto st840
+		case 61:
+			goto st1095
+		}
+		goto st1077
+	st1095:
+		if p++; p == pe {
+			goto _test_eof1095
+		}
+	st_case_1095:
+		switch data[p] {
+		case 32:
+			goto st1096
+		case 58:
+			goto st0
+		}
+		goto st1077
+	st1096:
+		if p++; p == pe {
+			goto _test_eof1096
+		}
+	st_case_1096:
+		switch data[p] {
+		case 32:
+			goto tr1176
+		case 58:
+			goto st840
+		}
+		goto tr1175
+tr1175:
+//line asa.rl:70
+ mark = p 
+	goto st1097
+	st1097:
+		if p++; p == pe {
+			goto _test_eof1097
+		}
+	st_case_1097:
+//line asa.go:13842
+		switch data[p] {
+		case 32:
+			goto tr1178
+		case 58:
+			goto st0
+		}
+		goto st1097
+tr1176:
+//line asa.rl:70
+ mark = p 
+	goto st1098
+tr1178:
+//line asa.rl:88
+ e.User = data[mark:p] 
+	goto st1098
+	st1098:
+		if p++; p == pe {
+			goto _test_eof1098
+		}
+	st_case_1098:
+//line asa.go:13863
+		switch data[p] {
+		case 32:
+			goto tr1178
+		case 58:
+			goto st840
+		}
+		goto st1097
+tr898:
+//line asa.rl:70
+ mark = p 
+	goto st842
+	st842:
+		if p++; p == pe {
+			goto _test_eof842
+		}
+	st_case_842:
+//line asa.go:13880
+		if data[p] == 117 {
+			goto st843
+		}
+		goto st0
+	st843:
+		if p++; p == pe {
+			goto _test_eof843
+		}
+	st_case_843:
+		if data[p] == 99 {
+			goto st844
+		}
+		goto st0
+	st844:
+		if p++; p == pe {
+			goto _test_eof844
+		}
+	st_case_844:
+		if data[p] == 99 {
+			goto st845
+		}
+		goto st0
+	st845:
+		if p++; p == pe {
+			goto _test_eof845
+		}
+	st_case_845:
+		if data[p] == 101 {
+			goto st846
+		}
+		goto st0
+	st846:
+		if p++; p == pe {
+			goto _test_eof846
+		}
+	st_case_846:
+		if data[p] == 115 {
+			goto st847
+		}
+		goto st0
+	st847:
+		if p++; p == pe {
+			goto _test_eof847
+		}
+	st_case_847:
+		if data[p] == 115 {
+			goto st848
+		}
+		goto st0
+	st848:
+		if p++; p == pe {
+			goto _test_eof848
+		}
+	st_case_848:
+		if data[p] == 102 {
+			goto st849
+		}
+		goto st0
+	st849:
+		if p++; p == pe {
+			goto _test_eof849
+		}
+	st_case_849:
+		if data[p] == 117 {
+			goto st850
+		}
+		goto st0
+	st850:
+		if p++; p == pe {
+			goto _test_eof850
+		}
+	st_case_850:
+		if data[p] == 108 {
+			goto st1076
+		}
+		goto st0
+	st851:
+		if p++; p == pe {
+			goto _test_eof851
+		}
+	st_case_851:
+		if data[p] == 68 {
+			goto tr919
+		}
+		goto st0
+tr919:
+//line asa.rl:70
+ mark = p 
+	goto st852
+	st852:
+		if p++; p == pe {
+			goto _test_eof852
+		}
+	st_case_852:
+//line asa.go:13975
+		if data[p] == 101 {
+			goto st853
+		}
+		goto st0
+	st853:
+		if p++; p == pe {
+			goto _test_eof853
+		}
+	st_case_853:
+		if data[p] == 110 {
+			goto st854
+		}
+		goto st0
+	st854:
+		if p++; p == pe {
+			goto _test_eof854
+		}
+	st_case_854:
+		if data[p] == 105 {
+			goto st855
+		}
+		goto st0
+	st855:
+		if p++; p == pe {
+			goto _test_eof855
+		}
+	st_case_855:
+		if data[p] == 101 {
+			goto st856
+		}
+		goto st0
+	st856:
+		if p++; p == pe {
+			goto _test_eof856
+		}
+	st_case_856:
+		if data[p] == 100 {
+			goto st857
+		}
+		goto st0
+	st857:
+		if p++; p == pe {
+			goto _test_eof857
+		}
+	st_case_857:
+		if data[p] == 32 {
+			goto tr925
+		}
+		goto st0
+tr925:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st858
+	st858:
+		if p++; p == pe {
+			goto _test_eof858
+		}
+	st_case_858:
+//line asa.go:14034
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr926
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr927
+			}
+		default:
+			goto tr927
+		}
+		goto st0
+tr926:
+//line asa.rl:70
+ mark = p 
+	goto st859
+	st859:
+		if p++; p == pe {
+			goto _test_eof859
+		}
+	st_case_859:
+//line asa.go:14057
+		if data[p] == 32 {
+			goto tr928
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st859
+		}
+		goto st0
+tr928:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st860
+	st860:
+		if p++; p == pe {
+			goto _test_eof860
+		}
+	st_case_860:
+//line asa.go:14074
+		if data[p] == 116 {
+			goto st861
+		}
+		goto st0
+	st861:
+		if p++; p == pe {
+			goto _test_eof861
+		}
+	st_case_861:
+		if data[p] == 121 {
+			goto st862
+		}
+		goto st0
+	st862:
+		if p++; p == pe {
+			goto _test_eof862
+		}
+	st_case_862:
+		if data[p] == 112 {
+			goto st863
+		}
+		goto st0
+	st863:
+		if p++; p == pe {
+			goto _test_eof863
+		}
+	st_case_863:
+		if data[p] == 101 {
+			goto st864
+		}
+		goto st0
+	st864:
+		if p++; p == pe {
+			goto _test_eof864
+		}
+	st_case_864:
+		if data[p] == 61 {
+			goto st865
+		}
+		goto st0
+	st865:
+		if p++; p == pe {
+			goto _test_eof865
+		}
+	st_case_865:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st866
+		}
+		goto st0
+	st866:
+		if p++; p == pe {
+			goto _test_eof866
+		}
+	st_case_866:
+		if data[p] == 44 {
+			goto st867
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st866
+		}
+		goto st0
+	st867:
+		if p++; p == pe {
+			goto _test_eof867
+		}
+	st_case_867:
+		if data[p] == 32 {
+			goto st868
+		}
+		goto st0
+	st868:
+		if p++; p == pe {
+			goto _test_eof868
+		}
+	st_case_868:
+		if data[p] == 99 {
+			goto st869
+		}
+		goto st0
+	st869:
+		if p++; p == pe {
+			goto _test_eof869
+		}
+	st_case_869:
+		if data[p] == 111 {
+			goto st870
+		}
+		goto st0
+	st870:
+		if p++; p == pe {
+			goto _test_eof870
+		}
+	st_case_870:
+		if data[p] == 100 {
+			goto st871
+		}
+		goto st0
+	st871:
+		if p++; p == pe {
+			goto _test_eof871
+		}
+	st_case_871:
+		if data[p] == 101 {
+			goto st872
+		}
+		goto st0
+	st872:
+		if p++; p == pe {
+			goto _test_eof872
+		}
+	st_case_872:
+		if data[p] == 61 {
+			goto st873
+		}
+		goto st0
+	st873:
+		if p++; p == pe {
+			goto _test_eof873
+		}
+	st_case_873:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st874
+		}
+		goto st0
+	st874:
+		if p++; p == pe {
+			goto _test_eof874
+		}
+	st_case_874:
+		if data[p] == 32 {
+			goto st875
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st874
+		}
+		goto st0
+	st875:
+		if p++; p == pe {
+			goto _test_eof875
+		}
+	st_case_875:
+		if data[p] == 102 {
+			goto st876
+		}
+		goto st0
+	st876:
+		if p++; p == pe {
+			goto _test_eof876
+		}
+	st_case_876:
+		if data[p] == 114 {
+			goto st877
+		}
+		goto st0
+	st877:
+		if p++; p == pe {
+			goto _test_eof877
+		}
+	st_case_877:
+		if data[p] == 111 {
+			goto st878
+		}
+		goto st0
+	st878:
+		if p++; p == pe {
+			goto _test_eof878
+		}
+	st_case_878:
+		if data[p] == 109 {
+			goto st879
+		}
+		goto st0
+	st879:
+		if p++; p == pe {
+			goto _test_eof879
+		}
+	st_case_879:
+		if data[p] == 32 {
+			goto st880
+		}
+		goto st0
+	st880:
+		if p++; p == pe {
+			goto _test_eof880
+		}
+	st_case_880:
+		if data[p] == 46 {
+			goto tr950
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr950
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr950
+			}
+		default:
+			goto tr950
+		}
+		goto st0
+tr950:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st881
+	st881:
+		if p++; p == pe {
+			goto _test_eof881
+		}
+	st_case_881:
+//line asa.go:14288
+		switch data[p] {
+		case 32:
+			goto tr951
+		case 46:
+			goto st881
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st881
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st881
+			}
+		default:
+			goto st881
+		}
+		goto st0
+tr951:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st882
+	st882:
+		if p++; p == pe {
+			goto _test_eof882
+		}
+	st_case_882:
+//line asa.go:14317
+		if data[p] == 111 {
+			goto st883
+		}
+		goto st0
+	st883:
+		if p++; p == pe {
+			goto _test_eof883
+		}
+	st_case_883:
+		if data[p] == 110 {
+			goto st884
+		}
+		goto st0
+	st884:
+		if p++; p == pe {
+			goto _test_eof884
+		}
+	st_case_884:
+		if data[p] == 32 {
+			goto st885
+		}
+		goto st0
+	st885:
+		if p++; p == pe {
+			goto _test_eof885
+		}
+	st_case_885:
+		if data[p] == 105 {
+			goto st886
+		}
+		goto st0
+	st886:
+		if p++; p == pe {
+			goto _test_eof886
+		}
+	st_case_886:
+		if data[p] == 110 {
+			goto st887
+		}
+		goto st0
+	st887:
+		if p++; p == pe {
+			goto _test_eof887
+		}
+	st_case_887:
+		if data[p] == 116 {
+			goto st888
+		}
+		goto st0
+	st888:
+		if p++; p == pe {
+			goto _test_eof888
+		}
+	st_case_888:
+		if data[p] == 101 {
+			goto st889
+		}
+		goto st0
+	st889:
+		if p++; p == pe {
+			goto _test_eof889
+		}
+	st_case_889:
+		if data[p] == 114 {
+			goto st890
+		}
+		goto st0
+	st890:
+		if p++; p == pe {
+			goto _test_eof890
+		}
+	st_case_890:
+		if data[p] == 102 {
+			goto st891
+		}
+		goto st0
+	st891:
+		if p++; p == pe {
+			goto _test_eof891
+		}
+	st_case_891:
+		if data[p] == 97 {
+			goto st892
+		}
+		goto st0
+	st892:
+		if p++; p == pe {
+			goto _test_eof892
+		}
+	st_case_892:
+		if data[p] == 99 {
+			goto st893
+		}
+		goto st0
+	st893:
+		if p++; p == pe {
+			goto _test_eof893
+		}
+	st_case_893:
+		if data[p] == 101 {
+			goto st894
+		}
+		goto st0
+	st894:
+		if p++; p == pe {
+			goto _test_eof894
+		}
+	st_case_894:
+		if data[p] == 32 {
+			goto st895
+		}
+		goto st0
+	st895:
+		if p++; p == pe {
+			goto _test_eof895
+		}
+	st_case_895:
+		if data[p] == 95 {
+			goto tr966
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr966
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr966
+				}
+			case data[p] >= 65:
+				goto tr966
+			}
+		default:
+			goto tr966
+		}
+		goto st0
+tr966:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st1099
+	st1099:
+		if p++; p == pe {
+			goto _test_eof1099
+		}
+	st_case_1099:
+//line asa.go:14467
+		if data[p] == 95 {
+			goto st1099
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st1099
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st1099
+				}
+			case data[p] >= 65:
+				goto st1099
+			}
+		default:
+			goto st1099
+		}
+		goto st0
+tr927:
+//line asa.rl:70
+ mark = p 
+	goto st896
+	st896:
+		if p++; p == pe {
+			goto _test_eof896
+		}
+	st_case_896:
+//line asa.go:14498
+		if data[p] == 32 {
+			goto tr928
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st896
+			}
+		case data[p] >= 65:
+			goto st896
+		}
+		goto st0
+	st897:
+		if p++; p == pe {
+			goto _test_eof897
+		}
+	st_case_897:
+		if data[p] == 76 {
+			goto st898
+		}
+		goto st0
+	st898:
+		if p++; p == pe {
+			goto _test_eof898
+		}
+	st_case_898:
+		if data[p] == 111 {
+			goto st899
+		}
+		goto st0
+	st899:
+		if p++; p == pe {
+			goto _test_eof899
+		}
+	st_case_899:
+		if data[p] == 103 {
+			goto st900
+		}
+		goto st0
+	st900:
+		if p++; p == pe {
+			goto _test_eof900
+		}
+	st_case_900:
+		if data[p] == 105 {
+			goto st901
+		}
+		goto st0
+	st901:
+		if p++; p == pe {
+			goto _test_eof901
+		}
+	st_case_901:
+		if data[p] == 110 {
+			goto st902
+		}
+		goto st0
+	st902:
+		if p++; p == pe {
+			goto _test_eof902
+		}
+	st_case_902:
+		if data[p] == 32 {
+			goto st903
+		}
+		goto st0
+	st903:
+		if p++; p == pe {
+			goto _test_eof903
+		}
+	st_case_903:
+		switch data[p] {
+		case 100:
+			goto tr974
+		case 112:
+			goto tr975
+		}
+		goto st0
+tr974:
+//line asa.rl:70
+ mark = p 
+	goto st904
+	st904:
+		if p++; p == pe {
+			goto _test_eof904
+		}
+	st_case_904:
+//line asa.go:14586
+		if data[p] == 101 {
+			goto st905
+		}
+		goto st0
+	st905:
+		if p++; p == pe {
+			goto _test_eof905
+		}
+	st_case_905:
+		if data[p] == 110 {
+			goto st906
+		}
+		goto st0
+	st906:
+		if p++; p == pe {
+			goto _test_eof906
+		}
+	st_case_906:
+		if data[p] == 105 {
+			goto st907
+		}
+		goto st0
+	st907:
+		if p++; p == pe {
+			goto _test_eof907
+		}
+	st_case_907:
+		if data[p] == 101 {
+			goto st908
+		}
+		goto st0
+	st908:
+		if p++; p == pe {
+			goto _test_eof908
+		}
+	st_case_908:
+		if data[p] == 100 {
+			goto st909
+		}
+		goto st0
+	st909:
+		if p++; p == pe {
+			goto _test_eof909
+		}
+	st_case_909:
+		if data[p] == 32 {
+			goto tr981
+		}
+		goto st0
+tr981:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st910
+	st910:
+		if p++; p == pe {
+			goto _test_eof910
+		}
+	st_case_910:
+//line asa.go:14645
+		if data[p] == 102 {
+			goto st911
+		}
+		goto st0
+	st911:
+		if p++; p == pe {
+			goto _test_eof911
+		}
+	st_case_911:
+		if data[p] == 114 {
+			goto st912
+		}
+		goto st0
+	st912:
+		if p++; p == pe {
+			goto _test_eof912
+		}
+	st_case_912:
+		if data[p] == 111 {
+			goto st913
+		}
+		goto st0
+	st913:
+		if p++; p == pe {
+			goto _test_eof913
+		}
+	st_case_913:
+		if data[p] == 109 {
+			goto st914
+		}
+		goto st0
+	st914:
+		if p++; p == pe {
+			goto _test_eof914
+		}
+	st_case_914:
+		if data[p] == 32 {
+			goto st915
+		}
+		goto st0
+	st915:
+		if p++; p == pe {
+			goto _test_eof915
+		}
+	st_case_915:
+		if data[p] == 46 {
+			goto tr987
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr987
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr987
+			}
+		default:
+			goto tr987
+		}
+		goto st0
+tr987:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st916
+	st916:
+		if p++; p == pe {
+			goto _test_eof916
+		}
+	st_case_916:
+//line asa.go:14718
+		if data[p] == 47 {
+			goto tr989
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st916
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st916
+			}
+		default:
+			goto st916
+		}
+		goto st0
+tr989:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st917
+	st917:
+		if p++; p == pe {
+			goto _test_eof917
+		}
+	st_case_917:
+//line asa.go:14744
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr990
+		}
+		goto st0
+tr990:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st918
+	st918:
+		if p++; p == pe {
+			goto _test_eof918
+		}
+	st_case_918:
+//line asa.go:14758
+		if data[p] == 32 {
+			goto st919
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr992
+		}
+		goto st0
+	st919:
+		if p++; p == pe {
+			goto _test_eof919
+		}
+	st_case_919:
+		if data[p] == 116 {
+			goto st920
+		}
+		goto st0
+	st920:
+		if p++; p == pe {
+			goto _test_eof920
+		}
+	st_case_920:
+		if data[p] == 111 {
+			goto st921
+		}
+		goto st0
+	st921:
+		if p++; p == pe {
+			goto _test_eof921
+		}
+	st_case_921:
+		if data[p] == 32 {
+			goto st922
+		}
+		goto st0
+	st922:
+		if p++; p == pe {
+			goto _test_eof922
+		}
+	st_case_922:
+		if data[p] == 95 {
+			goto tr996
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr996
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr996
+				}
+			case data[p] >= 65:
+				goto tr996
+			}
+		default:
+			goto tr996
+		}
+		goto st0
+tr996:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st923
+	st923:
+		if p++; p == pe {
+			goto _test_eof923
+		}
+	st_case_923:
+//line asa.go:14830
+		switch data[p] {
+		case 58:
+			goto tr998
+		case 95:
+			goto st923
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st923
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st923
+				}
+			case data[p] >= 65:
+				goto st923
+			}
+		default:
+			goto st923
+		}
+		goto st0
+tr998:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st924
+	st924:
+		if p++; p == pe {
+			goto _test_eof924
+		}
+	st_case_924:
+//line asa.go:14864
+		if data[p] == 46 {
+			goto tr999
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr999
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr999
+			}
+		default:
+			goto tr999
+		}
+		goto st0
+tr999:
+//line asa.rl:70
+ mark = p 
+	goto st925
+	st925:
+		if p++; p == pe {
+			goto _test_eof925
+		}
+	st_case_925:
+//line asa.go:14890
+		if data[p] == 47 {
+			goto tr1001
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st925
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st925
+			}
+		default:
+			goto st925
+		}
+		goto st0
+tr1001:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st926
+	st926:
+		if p++; p == pe {
+			goto _test_eof926
+		}
+	st_case_926:
+//line asa.go:14916
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1002
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st944
+			}
+		default:
+			goto st944
+		}
+		goto st0
+tr1002:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st927
+	st927:
+		if p++; p == pe {
+			goto _test_eof927
+		}
+	st_case_927:
+//line asa.go:14939
+		if data[p] == 32 {
+			goto st928
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1005
+		}
+		goto st0
+	st928:
+		if p++; p == pe {
+			goto _test_eof928
+		}
+	st_case_928:
+		if data[p] == 102 {
+			goto st929
+		}
+		goto st0
+	st929:
+		if p++; p == pe {
+			goto _test_eof929
+		}
+	st_case_929:
+		if data[p] == 111 {
+			goto st930
+		}
+		goto st0
+	st930:
+		if p++; p == pe {
+			goto _test_eof930
+		}
+	st_case_930:
+		if data[p] == 114 {
+			goto st931
+		}
+		goto st0
+	st931:
+		if p++; p == pe {
+			goto _test_eof931
+		}
+	st_case_931:
+		if data[p] == 32 {
+			goto st932
+		}
+		goto st0
+	st932:
+		if p++; p == pe {
+			goto _test_eof932
+		}
+	st_case_932:
+		if data[p] == 117 {
+			goto st933
+		}
+		goto st0
+	st933:
+		if p++; p == pe {
+			goto _test_eof933
+		}
+	st_case_933:
+		if data[p] == 115 {
+			goto st934
+		}
+		goto st0
+	st934:
+		if p++; p == pe {
+			goto _test_eof934
+		}
+	st_case_934:
+		if data[p] == 101 {
+			goto st935
+		}
+		goto st0
+	st935:
+		if p++; p == pe {
+			goto _test_eof935
+		}
+	st_case_935:
+		if data[p] == 114 {
+			goto st936
+		}
+		goto st0
+	st936:
+		if p++; p == pe {
+			goto _test_eof936
+		}
+	st_case_936:
+		if data[p] == 32 {
+			goto st937
+		}
+		goto st0
+	st937:
+		if p++; p == pe {
+			goto _test_eof937
+		}
+	st_case_937:
+		if data[p] == 34 {
+			goto st938
+		}
+		goto st0
+	st938:
+		if p++; p == pe {
+			goto _test_eof938
+		}
+	st_case_938:
+		if data[p] == 34 {
+			goto tr1017
+		}
+		goto tr1016
+tr1016:
+//line asa.rl:70
+ mark = p 
+	goto st939
+	st939:
+		if p++; p == pe {
+			goto _test_eof939
+		}
+	st_case_939:
+//line asa.go:15055
+		if data[p] == 34 {
+			goto tr1019
+		}
+		goto st939
+tr1017:
+//line asa.rl:70
+ mark = p 
+//line asa.rl:88
+ e.User = data[mark:p] 
+	goto st1100
+tr1019:
+//line asa.rl:88
+ e.User = data[mark:p] 
+	goto st1100
+	st1100:
+		if p++; p == pe {
+			goto _test_eof1100
+		}
+	st_case_1100:
+//line asa.go:15075
+		goto st0
+tr1005:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st940
+	st940:
+		if p++; p == pe {
+			goto _test_eof940
+		}
+	st_case_940:
+//line asa.go:15086
+		if data[p] == 32 {
+			goto st928
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1020
+		}
+		goto st0
+tr1020:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st941
+	st941:
+		if p++; p == pe {
+			goto _test_eof941
+		}
+	st_case_941:
+//line asa.go:15103
+		if data[p] == 32 {
+			goto st928
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1021
+		}
+		goto st0
+tr1021:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st942
+	st942:
+		if p++; p == pe {
+			goto _test_eof942
+		}
+	st_case_942:
+//line asa.go:15120
+		if data[p] == 32 {
+			goto st928
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1022
+		}
+		goto st0
+tr1022:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st943
+	st943:
+		if p++; p == pe {
+			goto _test_eof943
+		}
+	st_case_943:
+//line asa.go:15137
+		if data[p] == 32 {
+			goto st928
+		}
+		goto st0
+	st944:
+		if p++; p == pe {
+			goto _test_eof944
+		}
+	st_case_944:
+		if data[p] == 32 {
+			goto st928
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st944
+			}
+		case data[p] >= 65:
+			goto st944
+		}
+		goto st0
+tr992:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st945
+	st945:
+		if p++; p == pe {
+			goto _test_eof945
+		}
+	st_case_945:
+//line asa.go:15168
+		if data[p] == 32 {
+			goto st919
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1023
+		}
+		goto st0
+tr1023:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st946
+	st946:
+		if p++; p == pe {
+			goto _test_eof946
+		}
+	st_case_946:
+//line asa.go:15185
+		if data[p] == 32 {
+			goto st919
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1024
+		}
+		goto st0
+tr1024:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st947
+	st947:
+		if p++; p == pe {
+			goto _test_eof947
+		}
+	st_case_947:
+//line asa.go:15202
+		if data[p] == 32 {
+			goto st919
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1025
+		}
+		goto st0
+tr1025:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st948
+	st948:
+		if p++; p == pe {
+			goto _test_eof948
+		}
+	st_case_948:
+//line asa.go:15219
+		if data[p] == 32 {
+			goto st919
+		}
+		goto st0
+tr975:
+//line asa.rl:70
+ mark = p 
+	goto st949
+	st949:
+		if p++; p == pe {
+			goto _test_eof949
+		}
+	st_case_949:
+//line asa.go:15233
+		if data[p] == 101 {
+			goto st950
+		}
+		goto st0
+	st950:
+		if p++; p == pe {
+			goto _test_eof950
+		}
+	st_case_950:
+		if data[p] == 114 {
+			goto st951
+		}
+		goto st0
+	st951:
+		if p++; p == pe {
+			goto _test_eof951
+		}
+	st_case_951:
+		if data[p] == 109 {
+			goto st952
+		}
+		goto st0
+	st952:
+		if p++; p == pe {
+			goto _test_eof952
+		}
+	st_case_952:
+		if data[p] == 105 {
+			goto st953
+		}
+		goto st0
+	st953:
+		if p++; p == pe {
+			goto _test_eof953
+		}
+	st_case_953:
+		if data[p] == 116 {
+			goto st954
+		}
+		goto st0
+	st954:
+		if p++; p == pe {
+			goto _test_eof954
+		}
+	st_case_954:
+		if data[p] == 116 {
+			goto st907
+		}
+		goto st0
+	st955:
+		if p++; p == pe {
+			goto _test_eof955
+		}
+	st_case_955:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1031
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr1032
+			}
+		default:
+			goto tr1032
+		}
+		goto st0
+tr1031:
+//line asa.rl:70
+ mark = p 
+	goto st956
+	st956:
+		if p++; p == pe {
+			goto _test_eof956
+		}
+	st_case_956:
+//line asa.go:15310
+		if data[p] == 32 {
+			goto tr1033
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st956
+		}
+		goto st0
+tr1033:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st957
+	st957:
+		if p++; p == pe {
+			goto _test_eof957
+		}
+	st_case_957:
+//line asa.go:15327
+		if data[p] == 97 {
+			goto st958
+		}
+		goto st0
+	st958:
+		if p++; p == pe {
+			goto _test_eof958
+		}
+	st_case_958:
+		if data[p] == 99 {
+			goto st959
+		}
+		goto st0
+	st959:
+		if p++; p == pe {
+			goto _test_eof959
+		}
+	st_case_959:
+		if data[p] == 99 {
+			goto st960
+		}
+		goto st0
+	st960:
+		if p++; p == pe {
+			goto _test_eof960
+		}
+	st_case_960:
+		if data[p] == 101 {
+			goto st961
+		}
+		goto st0
+	st961:
+		if p++; p == pe {
+			goto _test_eof961
+		}
+	st_case_961:
+		if data[p] == 115 {
+			goto st962
+		}
+		goto st0
+	st962:
+		if p++; p == pe {
+			goto _test_eof962
+		}
+	st_case_962:
+		if data[p] == 115 {
+			goto st963
+		}
+		goto st0
+	st963:
+		if p++; p == pe {
+			goto _test_eof963
+		}
+	st_case_963:
+		if data[p] == 32 {
+			goto st964
+		}
+		goto st0
+	st964:
+		if p++; p == pe {
+			goto _test_eof964
+		}
+	st_case_964:
+		switch data[p] {
+		case 100:
+			goto tr1042
+		case 112:
+			goto tr1043
+		}
+		goto st0
+tr1042:
+//line asa.rl:70
+ mark = p 
+	goto st965
+	st965:
+		if p++; p == pe {
+			goto _test_eof965
+		}
+	st_case_965:
+//line asa.go:15407
+		if data[p] == 101 {
+			goto st966
+		}
+		goto st0
+	st966:
+		if p++; p == pe {
+			goto _test_eof966
+		}
+	st_case_966:
+		if data[p] == 110 {
+			goto st967
+		}
+		goto st0
+	st967:
+		if p++; p == pe {
+			goto _test_eof967
+		}
+	st_case_967:
+		if data[p] == 105 {
+			goto st968
+		}
+		goto st0
+	st968:
+		if p++; p == pe {
+			goto _test_eof968
+		}
+	st_case_968:
+		if data[p] == 101 {
+			goto st969
+		}
+		goto st0
+	st969:
+		if p++; p == pe {
+			goto _test_eof969
+		}
+	st_case_969:
+		if data[p] == 100 {
+			goto st970
+		}
+		goto st0
+	st970:
+		if p++; p == pe {
+			goto _test_eof970
+		}
+	st_case_970:
+		if data[p] == 32 {
+			goto tr1049
+		}
+		goto st0
+tr1049:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st971
+	st971:
+		if p++; p == pe {
+			goto _test_eof971
+		}
+	st_case_971:
+//line asa.go:15466
+		if data[p] == 98 {
+			goto st972
+		}
+		goto st0
+	st972:
+		if p++; p == pe {
+			goto _test_eof972
+		}
+	st_case_972:
+		if data[p] == 121 {
+			goto st973
+		}
+		goto st0
+	st973:
+		if p++; p == pe {
+			goto _test_eof973
+		}
+	st_case_973:
+		if data[p] == 32 {
+			goto st974
+		}
+		goto st0
+	st974:
+		if p++; p == pe {
+			goto _test_eof974
+		}
+	st_case_974:
+		if data[p] == 65 {
+			goto st975
+		}
+		goto st0
+	st975:
+		if p++; p == pe {
+			goto _test_eof975
+		}
+	st_case_975:
+		if data[p] == 67 {
+			goto st976
+		}
+		goto st0
+	st976:
+		if p++; p == pe {
+			goto _test_eof976
+		}
+	st_case_976:
+		if data[p] == 76 {
+			goto st977
+		}
+		goto st0
+	st977:
+		if p++; p == pe {
+			goto _test_eof977
+		}
+	st_case_977:
+		if data[p] == 32 {
+			goto st978
+		}
+		goto st0
+	st978:
+		if p++; p == pe {
+			goto _test_eof978
+		}
+	st_case_978:
+		if data[p] == 102 {
+			goto st979
+		}
+		goto st0
+	st979:
+		if p++; p == pe {
+			goto _test_eof979
+		}
+	st_case_979:
+		if data[p] == 114 {
+			goto st980
+		}
+		goto st0
+	st980:
+		if p++; p == pe {
+			goto _test_eof980
+		}
+	st_case_980:
+		if data[p] == 111 {
+			goto st981
+		}
+		goto st0
+	st981:
+		if p++; p == pe {
+			goto _test_eof981
+		}
+	st_case_981:
+		if data[p] == 109 {
+			goto st982
+		}
+		goto st0
+	st982:
+		if p++; p == pe {
+			goto _test_eof982
+		}
+	st_case_982:
+		if data[p] == 32 {
+			goto st983
+		}
+		goto st0
+	st983:
+		if p++; p == pe {
+			goto _test_eof983
+		}
+	st_case_983:
+		if data[p] == 46 {
+			goto tr1062
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr1062
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1062
+			}
+		default:
+			goto tr1062
+		}
+		goto st0
+tr1062:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st984
+	st984:
+		if p++; p == pe {
+			goto _test_eof984
+		}
+	st_case_984:
+//line asa.go:15602
+		if data[p] == 47 {
+			goto tr1064
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st984
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st984
+			}
+		default:
+			goto st984
+		}
+		goto st0
+tr1064:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st985
+	st985:
+		if p++; p == pe {
+			goto _test_eof985
+		}
+	st_case_985:
+//line asa.go:15628
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1065
+		}
+		goto st0
+tr1065:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st986
+	st986:
+		if p++; p == pe {
+			goto _test_eof986
+		}
+	st_case_986:
+//line asa.go:15642
+		if data[p] == 32 {
+			goto st987
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1067
+		}
+		goto st0
+	st987:
+		if p++; p == pe {
+			goto _test_eof987
+		}
+	st_case_987:
+		if data[p] == 116 {
+			goto st988
+		}
+		goto st0
+	st988:
+		if p++; p == pe {
+			goto _test_eof988
+		}
+	st_case_988:
+		if data[p] == 111 {
+			goto st989
+		}
+		goto st0
+	st989:
+		if p++; p == pe {
+			goto _test_eof989
+		}
+	st_case_989:
+		if data[p] == 32 {
+			goto st990
+		}
+		goto st0
+	st990:
+		if p++; p == pe {
+			goto _test_eof990
+		}
+	st_case_990:
+		if data[p] == 95 {
+			goto tr1071
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr1071
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr1071
+				}
+			case data[p] >= 65:
+				goto tr1071
+			}
+		default:
+			goto tr1071
+		}
+		goto st0
+tr1071:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st991
+	st991:
+		if p++; p == pe {
+			goto _test_eof991
+		}
+	st_case_991:
+//line asa.go:15714
+		switch data[p] {
+		case 58:
+			goto tr1073
+		case 95:
+			goto st991
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st991
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st991
+				}
+			case data[p] >= 65:
+				goto st991
+			}
+		default:
+			goto st991
+		}
+		goto st0
+tr1073:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st992
+	st992:
+		if p++; p == pe {
+			goto _test_eof992
+		}
+	st_case_992:
+//line asa.go:15748
+		if data[p] == 46 {
+			goto tr1074
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr1074
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1074
+			}
+		default:
+			goto tr1074
+		}
+		goto st0
+tr1074:
+//line asa.rl:70
+ mark = p 
+	goto st993
+	st993:
+		if p++; p == pe {
+			goto _test_eof993
+		}
+	st_case_993:
+//line asa.go:15774
+		if data[p] == 47 {
+			goto tr1076
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st993
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st993
+			}
+		default:
+			goto st993
+		}
+		goto st0
+tr1076:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st994
+	st994:
+		if p++; p == pe {
+			goto _test_eof994
+		}
+	st_case_994:
+//line asa.go:15800
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1077
+		}
+		goto st0
+tr1077:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1101
+	st1101:
+		if p++; p == pe {
+			goto _test_eof1101
+		}
+	st_case_1101:
+//line asa.go:15814
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1180
+		}
+		goto st0
+tr1180:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1102
+	st1102:
+		if p++; p == pe {
+			goto _test_eof1102
+		}
+	st_case_1102:
+//line asa.go:15828
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1181
+		}
+		goto st0
+tr1181:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1103
+	st1103:
+		if p++; p == pe {
+			goto _test_eof1103
+		}
+	st_case_1103:
+//line asa.go:15842
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1182
+		}
+		goto st0
+tr1182:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1104
+	st1104:
+		if p++; p == pe {
+			goto _test_eof1104
+		}
+	st_case_1104:
+//line asa.go:15856
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1183
+		}
+		goto st0
+tr1183:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1105
+	st1105:
+		if p++; p == pe {
+			goto _test_eof1105
+		}
+	st_case_1105:
+//line asa.go:15870
+		goto st0
+tr1067:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st995
+	st995:
+		if p++; p == pe {
+			goto _test_eof995
+		}
+	st_case_995:
+//line asa.go:15881
+		if data[p] == 32 {
+			goto st987
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1078
+		}
+		goto st0
+tr1078:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st996
+	st996:
+		if p++; p == pe {
+			goto _test_eof996
+		}
+	st_case_996:
+//line asa.go:15898
+		if data[p] == 32 {
+			goto st987
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1079
+		}
+		goto st0
+tr1079:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st997
+	st997:
+		if p++; p == pe {
+			goto _test_eof997
+		}
+	st_case_997:
+//line asa.go:15915
+		if data[p] == 32 {
+			goto st987
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1080
+		}
+		goto st0
+tr1080:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st998
+	st998:
+		if p++; p == pe {
+			goto _test_eof998
+		}
+	st_case_998:
+//line asa.go:15932
+		if data[p] == 32 {
+			goto st987
+		}
+		goto st0
+tr1043:
+//line asa.rl:70
+ mark = p 
+	goto st999
+	st999:
+		if p++; p == pe {
+			goto _test_eof999
+		}
+	st_case_999:
+//line asa.go:15946
+		if data[p] == 101 {
+			goto st1000
+		}
+		goto st0
+	st1000:
+		if p++; p == pe {
+			goto _test_eof1000
+		}
+	st_case_1000:
+		if data[p] == 114 {
+			goto st1001
+		}
+		goto st0
+	st1001:
+		if p++; p == pe {
+			goto _test_eof1001
+		}
+	st_case_1001:
+		if data[p] == 109 {
+			goto st1002
+		}
+		goto st0
+	st1002:
+		if p++; p == pe {
+			goto _test_eof1002
+		}
+	st_case_1002:
+		if data[p] == 105 {
+			goto st1003
+		}
+		goto st0
+	st1003:
+		if p++; p == pe {
+			goto _test_eof1003
+		}
+	st_case_1003:
+		if data[p] == 116 {
+			goto st1004
+		}
+		goto st0
+	st1004:
+		if p++; p == pe {
+			goto _test_eof1004
+		}
+	st_case_1004:
+		if data[p] == 116 {
+			goto st968
+		}
+		goto st0
+tr1032:
+//line asa.rl:70
+ mark = p 
+	goto st1005
+	st1005:
+		if p++; p == pe {
+			goto _test_eof1005
+		}
+	st_case_1005:
+//line asa.go:16005
+		if data[p] == 32 {
+			goto tr1033
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st1005
+			}
+		case data[p] >= 65:
+			goto st1005
+		}
+		goto st0
+	st1006:
+		if p++; p == pe {
+			goto _test_eof1006
+		}
+	st_case_1006:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1087
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr1088
+			}
+		default:
+			goto tr1088
+		}
+		goto st0
+tr1087:
+//line asa.rl:70
+ mark = p 
+	goto st1007
+	st1007:
+		if p++; p == pe {
+			goto _test_eof1007
+		}
+	st_case_1007:
+//line asa.go:16045
+		if data[p] == 32 {
+			goto tr1089
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st1007
+		}
+		goto st0
+tr1089:
+//line asa.rl:80
+ e.Protocol = data[mark:p] 
+	goto st1008
+	st1008:
+		if p++; p == pe {
+			goto _test_eof1008
+		}
+	st_case_1008:
+//line asa.go:16062
+		if data[p] == 114 {
+			goto st1009
+		}
+		goto st0
+	st1009:
+		if p++; p == pe {
+			goto _test_eof1009
+		}
+	st_case_1009:
+		if data[p] == 101 {
+			goto st1010
+		}
+		goto st0
+	st1010:
+		if p++; p == pe {
+			goto _test_eof1010
+		}
+	st_case_1010:
+		if data[p] == 113 {
+			goto st1011
+		}
+		goto st0
+	st1011:
+		if p++; p == pe {
+			goto _test_eof1011
+		}
+	st_case_1011:
+		if data[p] == 117 {
+			goto st1012
+		}
+		goto st0
+	st1012:
+		if p++; p == pe {
+			goto _test_eof1012
+		}
+	st_case_1012:
+		if data[p] == 101 {
+			goto st1013
+		}
+		goto st0
+	st1013:
+		if p++; p == pe {
+			goto _test_eof1013
+		}
+	st_case_1013:
+		if data[p] == 115 {
+			goto st1014
+		}
+		goto st0
+	st1014:
+		if p++; p == pe {
+			goto _test_eof1014
+		}
+	st_case_1014:
+		if data[p] == 116 {
+			goto st1015
+		}
+		goto st0
+	st1015:
+		if p++; p == pe {
+			goto _test_eof1015
+		}
+	st_case_1015:
+		if data[p] == 32 {
+			goto st1016
+		}
+		goto st0
+	st1016:
+		if p++; p == pe {
+			goto _test_eof1016
+		}
+	st_case_1016:
+		if data[p] == 100 {
+			goto tr1099
+		}
+		goto st0
+tr1099:
+//line asa.rl:70
+ mark = p 
+	goto st1017
+	st1017:
+		if p++; p == pe {
+			goto _test_eof1017
+		}
+	st_case_1017:
+//line asa.go:16148
+		if data[p] == 105 {
+			goto st1018
+		}
+		goto st0
+	st1018:
+		if p++; p == pe {
+			goto _test_eof1018
+		}
+	st_case_1018:
+		if data[p] == 115 {
+			goto st1019
+		}
+		goto st0
+	st1019:
+		if p++; p == pe {
+			goto _test_eof1019
+		}
+	st_case_1019:
+		if data[p] == 99 {
+			goto st1020
+		}
+		goto st0
+	st1020:
+		if p++; p == pe {
+			goto _test_eof1020
+		}
+	st_case_1020:
+		if data[p] == 97 {
+			goto st1021
+		}
+		goto st0
+	st1021:
+		if p++; p == pe {
+			goto _test_eof1021
+		}
+	st_case_1021:
+		if data[p] == 114 {
+			goto st1022
+		}
+		goto st0
+	st1022:
+		if p++; p == pe {
+			goto _test_eof1022
+		}
+	st_case_1022:
+		if data[p] == 100 {
+			goto st1023
+		}
+		goto st0
+	st1023:
+		if p++; p == pe {
+			goto _test_eof1023
+		}
+	st_case_1023:
+		if data[p] == 101 {
+			goto st1024
+		}
+		goto st0
+	st1024:
+		if p++; p == pe {
+			goto _test_eof1024
+		}
+	st_case_1024:
+		if data[p] == 100 {
+			goto st1025
+		}
+		goto st0
+	st1025:
+		if p++; p == pe {
+			goto _test_eof1025
+		}
+	st_case_1025:
+		if data[p] == 32 {
+			goto tr1108
+		}
+		goto st0
+tr1108:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+	goto st1026
+	st1026:
+		if p++; p == pe {
+			goto _test_eof1026
+		}
+	st_case_1026:
+//line asa.go:16234
+		if data[p] == 102 {
+			goto st1027
+		}
+		goto st0
+	st1027:
+		if p++; p == pe {
+			goto _test_eof1027
+		}
+	st_case_1027:
+		if data[p] == 114 {
+			goto st1028
+		}
+		goto st0
+	st1028:
+		if p++; p == pe {
+			goto _test_eof1028
+		}
+	st_case_1028:
+		if data[p] == 111 {
+			goto st1029
+		}
+		goto st0
+	st1029:
+		if p++; p == pe {
+			goto _test_eof1029
+		}
+	st_case_1029:
+		if data[p] == 109 {
+			goto st1030
+		}
+		goto st0
+	st1030:
+		if p++; p == pe {
+			goto _test_eof1030
+		}
+	st_case_1030:
+		if data[p] == 32 {
+			goto st1031
+		}
+		goto st0
+	st1031:
+		if p++; p == pe {
+			goto _test_eof1031
+		}
+	st_case_1031:
+		if data[p] == 46 {
+			goto tr1114
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr1114
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1114
+			}
+		default:
+			goto tr1114
+		}
+		goto st0
+tr1114:
+//line asa.rl:73
+ ep = &e.Src 
+//line asa.rl:70
+ mark = p 
+	goto st1032
+	st1032:
+		if p++; p == pe {
+			goto _test_eof1032
+		}
+	st_case_1032:
+//line asa.go:16307
+		if data[p] == 47 {
+			goto tr1116
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st1032
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st1032
+			}
+		default:
+			goto st1032
+		}
+		goto st0
+tr1116:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st1033
+	st1033:
+		if p++; p == pe {
+			goto _test_eof1033
+		}
+	st_case_1033:
+//line asa.go:16333
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1117
+		}
+		goto st0
+tr1117:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1034
+	st1034:
+		if p++; p == pe {
+			goto _test_eof1034
+		}
+	st_case_1034:
+//line asa.go:16347
+		if data[p] == 32 {
+			goto st1035
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1119
+		}
+		goto st0
+	st1035:
+		if p++; p == pe {
+			goto _test_eof1035
+		}
+	st_case_1035:
+		if data[p] == 116 {
+			goto st1036
+		}
+		goto st0
+	st1036:
+		if p++; p == pe {
+			goto _test_eof1036
+		}
+	st_case_1036:
+		if data[p] == 111 {
+			goto st1037
+		}
+		goto st0
+	st1037:
+		if p++; p == pe {
+			goto _test_eof1037
+		}
+	st_case_1037:
+		if data[p] == 32 {
+			goto st1038
+		}
+		goto st0
+	st1038:
+		if p++; p == pe {
+			goto _test_eof1038
+		}
+	st_case_1038:
+		if data[p] == 95 {
+			goto tr1123
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto tr1123
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr1123
+				}
+			case data[p] >= 65:
+				goto tr1123
+			}
+		default:
+			goto tr1123
+		}
+		goto st0
+tr1123:
+//line asa.rl:74
+ ep = &e.Dst 
+//line asa.rl:70
+ mark = p 
+	goto st1039
+	st1039:
+		if p++; p == pe {
+			goto _test_eof1039
+		}
+	st_case_1039:
+//line asa.go:16419
+		switch data[p] {
+		case 58:
+			goto tr1125
+		case 95:
+			goto st1039
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st1039
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st1039
+				}
+			case data[p] >= 65:
+				goto st1039
+			}
+		default:
+			goto st1039
+		}
+		goto st0
+tr1125:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+	goto st1040
+	st1040:
+		if p++; p == pe {
+			goto _test_eof1040
+		}
+	st_case_1040:
+//line asa.go:16453
+		if data[p] == 46 {
+			goto tr1126
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto tr1126
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1126
+			}
+		default:
+			goto tr1126
+		}
+		goto st0
+tr1126:
+//line asa.rl:70
+ mark = p 
+	goto st1041
+	st1041:
+		if p++; p == pe {
+			goto _test_eof1041
+		}
+	st_case_1041:
+//line asa.go:16479
+		if data[p] == 47 {
+			goto tr1128
+		}
+		switch {
+		case data[p] < 65:
+			if 46 <= data[p] && data[p] <= 58 {
+				goto st1041
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st1041
+			}
+		default:
+			goto st1041
+		}
+		goto st0
+tr1128:
+//line asa.rl:76
+ ep.Addr = data[mark:p] 
+	goto st1042
+	st1042:
+		if p++; p == pe {
+			goto _test_eof1042
+		}
+	st_case_1042:
+//line asa.go:16505
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1129
+		}
+		goto st0
+tr1129:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1106
+	st1106:
+		if p++; p == pe {
+			goto _test_eof1106
+		}
+	st_case_1106:
+//line asa.go:16519
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1184
+		}
+		goto st0
+tr1184:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1107
+	st1107:
+		if p++; p == pe {
+			goto _test_eof1107
+		}
+	st_case_1107:
+//line asa.go:16533
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1185
+		}
+		goto st0
+tr1185:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1108
+	st1108:
+		if p++; p == pe {
+			goto _test_eof1108
+		}
+	st_case_1108:
+//line asa.go:16547
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1186
+		}
+		goto st0
+tr1186:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1109
+	st1109:
+		if p++; p == pe {
+			goto _test_eof1109
+		}
+	st_case_1109:
+//line asa.go:16561
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1187
+		}
+		goto st0
+tr1187:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1110
+	st1110:
+		if p++; p == pe {
+			goto _test_eof1110
+		}
+	st_case_1110:
+//line asa.go:16575
+		goto st0
+tr1119:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1043
+	st1043:
+		if p++; p == pe {
+			goto _test_eof1043
+		}
+	st_case_1043:
+//line asa.go:16586
+		if data[p] == 32 {
+			goto st1035
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1130
+		}
+		goto st0
+tr1130:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1044
+	st1044:
+		if p++; p == pe {
+			goto _test_eof1044
+		}
+	st_case_1044:
+//line asa.go:16603
+		if data[p] == 32 {
+			goto st1035
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1131
+		}
+		goto st0
+tr1131:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1045
+	st1045:
+		if p++; p == pe {
+			goto _test_eof1045
+		}
+	st_case_1045:
+//line asa.go:16620
+		if data[p] == 32 {
+			goto st1035
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1132
+		}
+		goto st0
+tr1132:
+//line asa.rl:77
+ ep.Port = ep.Port*10 + int((data[p])-'0') 
+	goto st1046
+	st1046:
+		if p++; p == pe {
+			goto _test_eof1046
+		}
+	st_case_1046:
+//line asa.go:16637
+		if data[p] == 32 {
+			goto st1035
+		}
+		goto st0
+tr1088:
+//line asa.rl:70
+ mark = p 
+	goto st1047
+	st1047:
+		if p++; p == pe {
+			goto _test_eof1047
+		}
+	st_case_1047:
+//line asa.go:16651
+		if data[p] == 32 {
+			goto tr1089
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st1047
+			}
+		case data[p] >= 65:
+			goto st1047
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof1048: cs = 1048; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof1049: cs = 1049; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof132: cs = 132; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof1050: cs = 1050; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof1051: cs = 1051; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+	_test_eof187: cs = 187; goto _test_eof
+	_test_eof188: cs = 188; goto _test_eof
+	_test_eof189: cs = 189; goto _test_eof
+	_test_eof190: cs = 190; goto _test_eof
+	_test_eof191: cs = 191; goto _test_eof
+	_test_eof192: cs = 192; goto _test_eof
+	_test_eof193: cs = 193; goto _test_eof
+	_test_eof194: cs = 194; goto _test_eof
+	_test_eof195: cs = 195; goto _test_eof
+	_test_eof196: cs = 196; goto _test_eof
+	_test_eof197: cs = 197; goto _test_eof
+	_test_eof198: cs = 198; goto _test_eof
+	_test_eof199: cs = 199; goto _test_eof
+	_test_eof200: cs = 200; goto _test_eof
+	_test_eof201: cs = 201; goto _test_eof
+	_test_eof202: cs = 202; goto _test_eof
+	_test_eof203: cs = 203; goto _test_eof
+	_test_eof204: cs = 204; goto _test_eof
+	_test_eof205: cs = 205; goto _test_eof
+	_test_eof206: cs = 206; goto _test_eof
+	_test_eof207: cs = 207; goto _test_eof
+	_test_eof208: cs = 208; goto _test_eof
+	_test_eof209: cs = 209; goto _test_eof
+	_test_eof210: cs = 210; goto _test_eof
+	_test_eof211: cs = 211; goto _test_eof
+	_test_eof212: cs = 212; goto _test_eof
+	_test_eof213: cs = 213; goto _test_eof
+	_test_eof214: cs = 214; goto _test_eof
+	_test_eof215: cs = 215; goto _test_eof
+	_test_eof216: cs = 216; goto _test_eof
+	_test_eof217: cs = 217; goto _test_eof
+	_test_eof218: cs = 218; goto _test_eof
+	_test_eof219: cs = 219; goto _test_eof
+	_test_eof220: cs = 220; goto _test_eof
+	_test_eof221: cs = 221; goto _test_eof
+	_test_eof222: cs = 222; goto _test_eof
+	_test_eof1052: cs = 1052; goto _test_eof
+	_test_eof223: cs = 223; goto _test_eof
+	_test_eof224: cs = 224; goto _test_eof
+	_test_eof225: cs = 225; goto _test_eof
+	_test_eof226: cs = 226; goto _test_eof
+	_test_eof227: cs = 227; goto _test_eof
+	_test_eof228: cs = 228; goto _test_eof
+	_test_eof229: cs = 229; goto _test_eof
+	_test_eof230: cs = 230; goto _test_eof
+	_test_eof231: cs = 231; goto _test_eof
+	_test_eof232: cs = 232; goto _test_eof
+	_test_eof233: cs = 233; goto _test_eof
+	_test_eof234: cs = 234; goto _test_eof
+	_test_eof235: cs = 235; goto _test_eof
+	_test_eof1053: cs = 1053; goto _test_eof
+	_test_eof1054: cs = 1054; goto _test_eof
+	_test_eof1055: cs = 1055; goto _test_eof
+	_test_eof1056: cs = 1056; goto _test_eof
+	_test_eof1057: cs = 1057; goto _test_eof
+	_test_eof236: cs = 236; goto _test_eof
+	_test_eof237: cs = 237; goto _test_eof
+	_test_eof238: cs = 238; goto _test_eof
+	_test_eof239: cs = 239; goto _test_eof
+	_test_eof240: cs = 240; goto _test_eof
+	_test_eof241: cs = 241; goto _test_eof
+	_test_eof242: cs = 242; goto _test_eof
+	_test_eof243: cs = 243; goto _test_eof
+	_test_eof244: cs = 244; goto _test_eof
+	_test_eof245: cs = 245; goto _test_eof
+	_test_eof246: cs = 246; goto _test_eof
+	_test_eof247: cs = 247; goto _test_eof
+	_test_eof248: cs = 248; goto _test_eof
+	_test_eof249: cs = 249; goto _test_eof
+	_test_eof250: cs = 250; goto _test_eof
+	_test_eof251: cs = 251; goto _test_eof
+	_test_eof252: cs = 252; goto _test_eof
+	_test_eof253: cs = 253; goto _test_eof
+	_test_eof254: cs = 254; goto _test_eof
+	_test_eof255: cs = 255; goto _test_eof
+	_test_eof256: cs = 256; goto _test_eof
+	_test_eof257: cs = 257; goto _test_eof
+	_test_eof258: cs = 258; goto _test_eof
+	_test_eof259: cs = 259; goto _test_eof
+	_test_eof260: cs = 260; goto _test_eof
+	_test_eof261: cs = 261; goto _test_eof
+	_test_eof262: cs = 262; goto _test_eof
+	_test_eof263: cs = 263; goto _test_eof
+	_test_eof264: cs = 264; goto _test_eof
+	_test_eof265: cs = 265; goto _test_eof
+	_test_eof266: cs = 266; goto _test_eof
+	_test_eof267: cs = 267; goto _test_eof
+	_test_eof268: cs = 268; goto _test_eof
+	_test_eof269: cs = 269; goto _test_eof
+	_test_eof270: cs = 270; goto _test_eof
+	_test_eof271: cs = 271; goto _test_eof
+	_test_eof272: cs = 272; goto _test_eof
+	_test_eof273: cs = 273; goto _test_eof
+	_test_eof274: cs = 274; goto _test_eof
+	_test_eof275: cs = 275; goto _test_eof
+	_test_eof276: cs = 276; goto _test_eof
+	_test_eof277: cs = 277; goto _test_eof
+	_test_eof278: cs = 278; goto _test_eof
+	_test_eof279: cs = 279; goto _test_eof
+	_test_eof280: cs = 280; goto _test_eof
+	_test_eof281: cs = 281; goto _test_eof
+	_test_eof282: cs = 282; goto _test_eof
+	_test_eof283: cs = 283; goto _test_eof
+	_test_eof284: cs = 284; goto _test_eof
+	_test_eof285: cs = 285; goto _test_eof
+	_test_eof286: cs = 286; goto _test_eof
+	_test_eof287: cs = 287; goto _test_eof
+	_test_eof288: cs = 288; goto _test_eof
+	_test_eof289: cs = 289; goto _test_eof
+	_test_eof290: cs = 290; goto _test_eof
+	_test_eof291: cs = 291; goto _test_eof
+	_test_eof292: cs = 292; goto _test_eof
+	_test_eof293: cs = 293; goto _test_eof
+	_test_eof294: cs = 294; goto _test_eof
+	_test_eof295: cs = 295; goto _test_eof
+	_test_eof296: cs = 296; goto _test_eof
+	_test_eof297: cs = 297; goto _test_eof
+	_test_eof1058: cs = 1058; goto _test_eof
+	_test_eof298: cs = 298; goto _test_eof
+	_test_eof299: cs = 299; goto _test_eof
+	_test_eof300: cs = 300; goto _test_eof
+	_test_eof301: cs = 301; goto _test_eof
+	_test_eof302: cs = 302; goto _test_eof
+	_test_eof303: cs = 303; goto _test_eof
+	_test_eof304: cs = 304; goto _test_eof
+	_test_eof305: cs = 305; goto _test_eof
+	_test_eof306: cs = 306; goto _test_eof
+	_test_eof307: cs = 307; goto _test_eof
+	_test_eof308: cs = 308; goto _test_eof
+	_test_eof309: cs = 309; goto _test_eof
+	_test_eof310: cs = 310; goto _test_eof
+	_test_eof311: cs = 311; goto _test_eof
+	_test_eof312: cs = 312; goto _test_eof
+	_test_eof313: cs = 313; goto _test_eof
+	_test_eof1059: cs = 1059; goto _test_eof
+	_test_eof1060: cs = 1060; goto _test_eof
+	_test_eof1061: cs = 1061; goto _test_eof
+	_test_eof1062: cs = 1062; goto _test_eof
+	_test_eof1063: cs = 1063; goto _test_eof
+	_test_eof314: cs = 314; goto _test_eof
+	_test_eof315: cs = 315; goto _test_eof
+	_test_eof316: cs = 316; goto _test_eof
+	_test_eof317: cs = 317; goto _test_eof
+	_test_eof318: cs = 318; goto _test_eof
+	_test_eof319: cs = 319; goto _test_eof
+	_test_eof320: cs = 320; goto _test_eof
+	_test_eof321: cs = 321; goto _test_eof
+	_test_eof322: cs = 322; goto _test_eof
+	_test_eof323: cs = 323; goto _test_eof
+	_test_eof324: cs = 324; goto _test_eof
+	_test_eof325: cs = 325; goto _test_eof
+	_test_eof326: cs = 326; goto _test_eof
+	_test_eof327: cs = 327; goto _test_eof
+	_test_eof328: cs = 328; goto _test_eof
+	_test_eof329: cs = 329; goto _test_eof
+	_test_eof330: cs = 330; goto _test_eof
+	_test_eof331: cs = 331; goto _test_eof
+	_test_eof332: cs = 332; goto _test_eof
+	_test_eof333: cs = 333; goto _test_eof
+	_test_eof334: cs = 334; goto _test_eof
+	_test_eof335: cs = 335; goto _test_eof
+	_test_eof336: cs = 336; goto _test_eof
+	_test_eof337: cs = 337; goto _test_eof
+	_test_eof338: cs = 338; goto _test_eof
+	_test_eof339: cs = 339; goto _test_eof
+	_test_eof340: cs = 340; goto _test_eof
+	_test_eof341: cs = 341; goto _test_eof
+	_test_eof342: cs = 342; goto _test_eof
+	_test_eof343: cs = 343; goto _test_eof
+	_test_eof344: cs = 344; goto _test_eof
+	_test_eof345: cs = 345; goto _test_eof
+	_test_eof346: cs = 346; goto _test_eof
+	_test_eof347: cs = 347; goto _test_eof
+	_test_eof348: cs = 348; goto _test_eof
+	_test_eof349: cs = 349; goto _test_eof
+	_test_eof350: cs = 350; goto _test_eof
+	_test_eof351: cs = 351; goto _test_eof
+	_test_eof352: cs = 352; goto _test_eof
+	_test_eof353: cs = 353; goto _test_eof
+	_test_eof354: cs = 354; goto _test_eof
+	_test_eof355: cs = 355; goto _test_eof
+	_test_eof356: cs = 356; goto _test_eof
+	_test_eof357: cs = 357; goto _test_eof
+	_test_eof358: cs = 358; goto _test_eof
+	_test_eof359: cs = 359; goto _test_eof
+	_test_eof360: cs = 360; goto _test_eof
+	_test_eof361: cs = 361; goto _test_eof
+	_test_eof362: cs = 362; goto _test_eof
+	_test_eof363: cs = 363; goto _test_eof
+	_test_eof364: cs = 364; goto _test_eof
+	_test_eof365: cs = 365; goto _test_eof
+	_test_eof366: cs = 366; goto _test_eof
+	_test_eof367: cs = 367; goto _test_eof
+	_test_eof368: cs = 368; goto _test_eof
+	_test_eof369: cs = 369; goto _test_eof
+	_test_eof370: cs = 370; goto _test_eof
+	_test_eof371: cs = 371; goto _test_eof
+	_test_eof372: cs = 372; goto _test_eof
+	_test_eof373: cs = 373; goto _test_eof
+	_test_eof374: cs = 374; goto _test_eof
+	_test_eof375: cs = 375; goto _test_eof
+	_test_eof376: cs = 376; goto _test_eof
+	_test_eof377: cs = 377; goto _test_eof
+	_test_eof378: cs = 378; goto _test_eof
+	_test_eof379: cs = 379; goto _test_eof
+	_test_eof380: cs = 380; goto _test_eof
+	_test_eof381: cs = 381; goto _test_eof
+	_test_eof382: cs = 382; goto _test_eof
+	_test_eof383: cs = 383; goto _test_eof
+	_test_eof384: cs = 384; goto _test_eof
+	_test_eof385: cs = 385; goto _test_eof
+	_test_eof386: cs = 386; goto _test_eof
+	_test_eof387: cs = 387; goto _test_eof
+	_test_eof388: cs = 388; goto _test_eof
+	_test_eof389: cs = 389; goto _test_eof
+	_test_eof390: cs = 390; goto _test_eof
+	_test_eof391: cs = 391; goto _test_eof
+	_test_eof392: cs = 392; goto _test_eof
+	_test_eof393: cs = 393; goto _test_eof
+	_test_eof1064: cs = 1064; goto _test_eof
+	_test_eof394: cs = 394; goto _test_eof
+	_test_eof395: cs = 395; goto _test_eof
+	_test_eof396: cs = 396; goto _test_eof
+	_test_eof397: cs = 397; goto _test_eof
+	_test_eof398: cs = 398; goto _test_eof
+	_test_eof399: cs = 399; goto _test_eof
+	_test_eof400: cs = 400; goto _test_eof
+	_test_eof401: cs = 401; goto _test_eof
+	_test_eof402: cs = 402; goto _test_eof
+	_test_eof403: cs = 403; goto _test_eof
+	_test_eof404: cs = 404; goto _test_eof
+	_test_eof405: cs = 405; goto _test_eof
+	_test_eof406: cs = 406; goto _test_eof
+	_test_eof407: cs = 407; goto _test_eof
+	_test_eof408: cs = 408; goto _test_eof
+	_test_eof409: cs = 409; goto _test_eof
+	_test_eof410: cs = 410; goto _test_eof
+	_test_eof411: cs = 411; goto _test_eof
+	_test_eof412: cs = 412; goto _test_eof
+	_test_eof413: cs = 413; goto _test_eof
+	_test_eof414: cs = 414; goto _test_eof
+	_test_eof415: cs = 415; goto _test_eof
+	_test_eof416: cs = 416; goto _test_eof
+	_test_eof417: cs = 417; goto _test_eof
+	_test_eof418: cs = 418; goto _test_eof
+	_test_eof419: cs = 419; goto _test_eof
+	_test_eof420: cs = 420; goto _test_eof
+	_test_eof421: cs = 421; goto _test_eof
+	_test_eof422: cs = 422; goto _test_eof
+	_test_eof423: cs = 423; goto _test_eof
+	_test_eof424: cs = 424; goto _test_eof
+	_test_eof425: cs = 425; goto _test_eof
+	_test_eof426: cs = 426; goto _test_eof
+	_test_eof427: cs = 427; goto _test_eof
+	_test_eof428: cs = 428; goto _test_eof
+	_test_eof429: cs = 429; goto _test_eof
+	_test_eof430: cs = 430; goto _test_eof
+	_test_eof431: cs = 431; goto _test_eof
+	_test_eof432: cs = 432; goto _test_eof
+	_test_eof433: cs = 433; goto _test_eof
+	_test_eof434: cs = 434; goto _test_eof
+	_test_eof435: cs = 435; goto _test_eof
+	_test_eof436: cs = 436; goto _test_eof
+	_test_eof437: cs = 437; goto _test_eof
+	_test_eof438: cs = 438; goto _test_eof
+	_test_eof439: cs = 439; goto _test_eof
+	_test_eof440: cs = 440; goto _test_eof
+	_test_eof441: cs = 441; goto _test_eof
+	_test_eof442: cs = 442; goto _test_eof
+	_test_eof443: cs = 443; goto _test_eof
+	_test_eof1065: cs = 1065; goto _test_eof
+	_test_eof444: cs = 444; goto _test_eof
+	_test_eof445: cs = 445; goto _test_eof
+	_test_eof446: cs = 446; goto _test_eof
+	_test_eof447: cs = 447; goto _test_eof
+	_test_eof448: cs = 448; goto _test_eof
+	_test_eof449: cs = 449; goto _test_eof
+	_test_eof450: cs = 450; goto _test_eof
+	_test_eof451: cs = 451; goto _test_eof
+	_test_eof452: cs = 452; goto _test_eof
+	_test_eof453: cs = 453; goto _test_eof
+	_test_eof454: cs = 454; goto _test_eof
+	_test_eof455: cs = 455; goto _test_eof
+	_test_eof456: cs = 456; goto _test_eof
+	_test_eof1066: cs = 1066; goto _test_eof
+	_test_eof457: cs = 457; goto _test_eof
+	_test_eof458: cs = 458; goto _test_eof
+	_test_eof459: cs = 459; goto _test_eof
+	_test_eof460: cs = 460; goto _test_eof
+	_test_eof461: cs = 461; goto _test_eof
+	_test_eof462: cs = 462; goto _test_eof
+	_test_eof463: cs = 463; goto _test_eof
+	_test_eof464: cs = 464; goto _test_eof
+	_test_eof465: cs = 465; goto _test_eof
+	_test_eof466: cs = 466; goto _test_eof
+	_test_eof467: cs = 467; goto _test_eof
+	_test_eof468: cs = 468; goto _test_eof
+	_test_eof469: cs = 469; goto _test_eof
+	_test_eof470: cs = 470; goto _test_eof
+	_test_eof471: cs = 471; goto _test_eof
+	_test_eof472: cs = 472; goto _test_eof
+	_test_eof473: cs = 473; goto _test_eof
+	_test_eof474: cs = 474; goto _test_eof
+	_test_eof475: cs = 475; goto _test_eof
+	_test_eof476: cs = 476; goto _test_eof
+	_test_eof477: cs = 477; goto _test_eof
+	_test_eof478: cs = 478; goto _test_eof
+	_test_eof479: cs = 479; goto _test_eof
+	_test_eof480: cs = 480; goto _test_eof
+	_test_eof481: cs = 481; goto _test_eof
+	_test_eof482: cs = 482; goto _test_eof
+	_test_eof483: cs = 483; goto _test_eof
+	_test_eof484: cs = 484; goto _test_eof
+	_test_eof485: cs = 485; goto _test_eof
+	_test_eof486: cs = 486; goto _test_eof
+	_test_eof487: cs = 487; goto _test_eof
+	_test_eof488: cs = 488; goto _test_eof
+	_test_eof489: cs = 489; goto _test_eof
+	_test_eof490: cs = 490; goto _test_eof
+	_test_eof491: cs = 491; goto _test_eof
+	_test_eof492: cs = 492; goto _test_eof
+	_test_eof493: cs = 493; goto _test_eof
+	_test_eof494: cs = 494; goto _test_eof
+	_test_eof495: cs = 495; goto _test_eof
+	_test_eof496: cs = 496; goto _test_eof
+	_test_eof497: cs = 497; goto _test_eof
+	_test_eof498: cs = 498; goto _test_eof
+	_test_eof499: cs = 499; goto _test_eof
+	_test_eof500: cs = 500; goto _test_eof
+	_test_eof501: cs = 501; goto _test_eof
+	_test_eof502: cs = 502; goto _test_eof
+	_test_eof503: cs = 503; goto _test_eof
+	_test_eof504: cs = 504; goto _test_eof
+	_test_eof505: cs = 505; goto _test_eof
+	_test_eof506: cs = 506; goto _test_eof
+	_test_eof507: cs = 507; goto _test_eof
+	_test_eof508: cs = 508; goto _test_eof
+	_test_eof509: cs = 509; goto _test_eof
+	_test_eof510: cs = 510; goto _test_eof
+	_test_eof511: cs = 511; goto _test_eof
+	_test_eof512: cs = 512; goto _test_eof
+	_test_eof513: cs = 513; goto _test_eof
+	_test_eof514: cs = 514; goto _test_eof
+	_test_eof1067: cs = 1067; goto _test_eof
+	_test_eof515: cs = 515; goto _test_eof
+	_test_eof516: cs = 516; goto _test_eof
+	_test_eof517: cs = 517; goto _test_eof
+	_test_eof518: cs = 518; goto _test_eof
+	_test_eof519: cs = 519; goto _test_eof
+	_test_eof520: cs = 520; goto _test_eof
+	_test_eof521: cs = 521; goto _test_eof
+	_test_eof522: cs = 522; goto _test_eof
+	_test_eof523: cs = 523; goto _test_eof
+	_test_eof524: cs = 524; goto _test_eof
+	_test_eof525: cs = 525; goto _test_eof
+	_test_eof526: cs = 526; goto _test_eof
+	_test_eof527: cs = 527; goto _test_eof
+	_test_eof528: cs = 528; goto _test_eof
+	_test_eof529: cs = 529; goto _test_eof
+	_test_eof530: cs = 530; goto _test_eof
+	_test_eof531: cs = 531; goto _test_eof
+	_test_eof532: cs = 532; goto _test_eof
+	_test_eof533: cs = 533; goto _test_eof
+	_test_eof534: cs = 534; goto _test_eof
+	_test_eof535: cs = 535; goto _test_eof
+	_test_eof536: cs = 536; goto _test_eof
+	_test_eof537: cs = 537; goto _test_eof
+	_test_eof538: cs = 538; goto _test_eof
+	_test_eof539: cs = 539; goto _test_eof
+	_test_eof540: cs = 540; goto _test_eof
+	_test_eof541: cs = 541; goto _test_eof
+	_test_eof542: cs = 542; goto _test_eof
+	_test_eof543: cs = 543; goto _test_eof
+	_test_eof544: cs = 544; goto _test_eof
+	_test_eof545: cs = 545; goto _test_eof
+	_test_eof546: cs = 546; goto _test_eof
+	_test_eof547: cs = 547; goto _test_eof
+	_test_eof548: cs = 548; goto _test_eof
+	_test_eof549: cs = 549; goto _test_eof
+	_test_eof550: cs = 550; goto _test_eof
+	_test_eof551: cs = 551; goto _test_eof
+	_test_eof552: cs = 552; goto _test_eof
+	_test_eof553: cs = 553; goto _test_eof
+	_test_eof554: cs = 554; goto _test_eof
+	_test_eof555: cs = 555; goto _test_eof
+	_test_eof556: cs = 556; goto _test_eof
+	_test_eof557: cs = 557; goto _test_eof
+	_test_eof558: cs = 558; goto _test_eof
+	_test_eof559: cs = 559; goto _test_eof
+	_test_eof560: cs = 560; goto _test_eof
+	_test_eof561: cs = 561; goto _test_eof
+	_test_eof562: cs = 562; goto _test_eof
+	_test_eof563: cs = 563; goto _test_eof
+	_test_eof564: cs = 564; goto _test_eof
+	_test_eof565: cs = 565; goto _test_eof
+	_test_eof566: cs = 566; goto _test_eof
+	_test_eof567: cs = 567; goto _test_eof
+	_test_eof568: cs = 568; goto _test_eof
+	_test_eof569: cs = 569; goto _test_eof
+	_test_eof570: cs = 570; goto _test_eof
+	_test_eof571: cs = 571; goto _test_eof
+	_test_eof572: cs = 572; goto _test_eof
+	_test_eof573: cs = 573; goto _test_eof
+	_test_eof574: cs = 574; goto _test_eof
+	_test_eof575: cs = 575; goto _test_eof
+	_test_eof576: cs = 576; goto _test_eof
+	_test_eof577: cs = 577; goto _test_eof
+	_test_eof578: cs = 578; goto _test_eof
+	_test_eof579: cs = 579; goto _test_eof
+	_test_eof580: cs = 580; goto _test_eof
+	_test_eof581: cs = 581; goto _test_eof
+	_test_eof582: cs = 582; goto _test_eof
+	_test_eof583: cs = 583; goto _test_eof
+	_test_eof584: cs = 584; goto _test_eof
+	_test_eof585: cs = 585; goto _test_eof
+	_test_eof586: cs = 586; goto _test_eof
+	_test_eof587: cs = 587; goto _test_eof
+	_test_eof588: cs = 588; goto _test_eof
+	_test_eof589: cs = 589; goto _test_eof
+	_test_eof1068: cs = 1068; goto _test_eof
+	_test_eof590: cs = 590; goto _test_eof
+	_test_eof591: cs = 591; goto _test_eof
+	_test_eof592: cs = 592; goto _test_eof
+	_test_eof593: cs = 593; goto _test_eof
+	_test_eof594: cs = 594; goto _test_eof
+	_test_eof595: cs = 595; goto _test_eof
+	_test_eof596: cs = 596; goto _test_eof
+	_test_eof597: cs = 597; goto _test_eof
+	_test_eof598: cs = 598; goto _test_eof
+	_test_eof599: cs = 599; goto _test_eof
+	_test_eof600: cs = 600; goto _test_eof
+	_test_eof601: cs = 601; goto _test_eof
+	_test_eof602: cs = 602; goto _test_eof
+	_test_eof603: cs = 603; goto _test_eof
+	_test_eof604: cs = 604; goto _test_eof
+	_test_eof605: cs = 605; goto _test_eof
+	_test_eof606: cs = 606; goto _test_eof
+	_test_eof607: cs = 607; goto _test_eof
+	_test_eof608: cs = 608; goto _test_eof
+	_test_eof609: cs = 609; goto _test_eof
+	_test_eof610: cs = 610; goto _test_eof
+	_test_eof611: cs = 611; goto _test_eof
+	_test_eof612: cs = 612; goto _test_eof
+	_test_eof613: cs = 613; goto _test_eof
+	_test_eof614: cs = 614; goto _test_eof
+	_test_eof615: cs = 615; goto _test_eof
+	_test_eof616: cs = 616; goto _test_eof
+	_test_eof617: cs = 617; goto _test_eof
+	_test_eof618: cs = 618; goto _test_eof
+	_test_eof619: cs = 619; goto _test_eof
+	_test_eof620: cs = 620; goto _test_eof
+	_test_eof621: cs = 621; goto _test_eof
+	_test_eof622: cs = 622; goto _test_eof
+	_test_eof623: cs = 623; goto _test_eof
+	_test_eof624: cs = 624; goto _test_eof
+	_test_eof625: cs = 625; goto _test_eof
+	_test_eof626: cs = 626; goto _test_eof
+	_test_eof627: cs = 627; goto _test_eof
+	_test_eof628: cs = 628; goto _test_eof
+	_test_eof629: cs = 629; goto _test_eof
+	_test_eof630: cs = 630; goto _test_eof
+	_test_eof631: cs = 631; goto _test_eof
+	_test_eof632: cs = 632; goto _test_eof
+	_test_eof633: cs = 633; goto _test_eof
+	_test_eof634: cs = 634; goto _test_eof
+	_test_eof635: cs = 635; goto _test_eof
+	_test_eof636: cs = 636; goto _test_eof
+	_test_eof637: cs = 637; goto _test_eof
+	_test_eof638: cs = 638; goto _test_eof
+	_test_eof639: cs = 639; goto _test_eof
+	_test_eof640: cs = 640; goto _test_eof
+	_test_eof641: cs = 641; goto _test_eof
+	_test_eof642: cs = 642; goto _test_eof
+	_test_eof643: cs = 643; goto _test_eof
+	_test_eof644: cs = 644; goto _test_eof
+	_test_eof645: cs = 645; goto _test_eof
+	_test_eof646: cs = 646; goto _test_eof
+	_test_eof647: cs = 647; goto _test_eof
+	_test_eof648: cs = 648; goto _test_eof
+	_test_eof649: cs = 649; goto _test_eof
+	_test_eof650: cs = 650; goto _test_eof
+	_test_eof651: cs = 651; goto _test_eof
+	_test_eof652: cs = 652; goto _test_eof
+	_test_eof653: cs = 653; goto _test_eof
+	_test_eof654: cs = 654; goto _test_eof
+	_test_eof655: cs = 655; goto _test_eof
+	_test_eof656: cs = 656; goto _test_eof
+	_test_eof657: cs = 657; goto _test_eof
+	_test_eof658: cs = 658; goto _test_eof
+	_test_eof659: cs = 659; goto _test_eof
+	_test_eof660: cs = 660; goto _test_eof
+	_test_eof661: cs = 661; goto _test_eof
+	_test_eof662: cs = 662; goto _test_eof
+	_test_eof663: cs = 663; goto _test_eof
+	_test_eof664: cs = 664; goto _test_eof
+	_test_eof665: cs = 665; goto _test_eof
+	_test_eof666: cs = 666; goto _test_eof
+	_test_eof667: cs = 667; goto _test_eof
+	_test_eof668: cs = 668; goto _test_eof
+	_test_eof1069: cs = 1069; goto _test_eof
+	_test_eof669: cs = 669; goto _test_eof
+	_test_eof670: cs = 670; goto _test_eof
+	_test_eof671: cs = 671; goto _test_eof
+	_test_eof672: cs = 672; goto _test_eof
+	_test_eof673: cs = 673; goto _test_eof
+	_test_eof674: cs = 674; goto _test_eof
+	_test_eof675: cs = 675; goto _test_eof
+	_test_eof676: cs = 676; goto _test_eof
+	_test_eof677: cs = 677; goto _test_eof
+	_test_eof678: cs = 678; goto _test_eof
+	_test_eof679: cs = 679; goto _test_eof
+	_test_eof680: cs = 680; goto _test_eof
+	_test_eof681: cs = 681; goto _test_eof
+	_test_eof682: cs = 682; goto _test_eof
+	_test_eof683: cs = 683; goto _test_eof
+	_test_eof684: cs = 684; goto _test_eof
+	_test_eof685: cs = 685; goto _test_eof
+	_test_eof686: cs = 686; goto _test_eof
+	_test_eof687: cs = 687; goto _test_eof
+	_test_eof688: cs = 688; goto _test_eof
+	_test_eof689: cs = 689; goto _test_eof
+	_test_eof690: cs = 690; goto _test_eof
+	_test_eof691: cs = 691; goto _test_eof
+	_test_eof692: cs = 692; goto _test_eof
+	_test_eof693: cs = 693; goto _test_eof
+	_test_eof694: cs = 694; goto _test_eof
+	_test_eof695: cs = 695; goto _test_eof
+	_test_eof696: cs = 696; goto _test_eof
+	_test_eof697: cs = 697; goto _test_eof
+	_test_eof698: cs = 698; goto _test_eof
+	_test_eof699: cs = 699; goto _test_eof
+	_test_eof700: cs = 700; goto _test_eof
+	_test_eof701: cs = 701; goto _test_eof
+	_test_eof702: cs = 702; goto _test_eof
+	_test_eof703: cs = 703; goto _test_eof
+	_test_eof704: cs = 704; goto _test_eof
+	_test_eof705: cs = 705; goto _test_eof
+	_test_eof706: cs = 706; goto _test_eof
+	_test_eof707: cs = 707; goto _test_eof
+	_test_eof708: cs = 708; goto _test_eof
+	_test_eof709: cs = 709; goto _test_eof
+	_test_eof710: cs = 710; goto _test_eof
+	_test_eof711: cs = 711; goto _test_eof
+	_test_eof712: cs = 712; goto _test_eof
+	_test_eof713: cs = 713; goto _test_eof
+	_test_eof714: cs = 714; goto _test_eof
+	_test_eof715: cs = 715; goto _test_eof
+	_test_eof716: cs = 716; goto _test_eof
+	_test_eof717: cs = 717; goto _test_eof
+	_test_eof718: cs = 718; goto _test_eof
+	_test_eof719: cs = 719; goto _test_eof
+	_test_eof720: cs = 720; goto _test_eof
+	_test_eof721: cs = 721; goto _test_eof
+	_test_eof722: cs = 722; goto _test_eof
+	_test_eof723: cs = 723; goto _test_eof
+	_test_eof724: cs = 724; goto _test_eof
+	_test_eof725: cs = 725; goto _test_eof
+	_test_eof726: cs = 726; goto _test_eof
+	_test_eof727: cs = 727; goto _test_eof
+	_test_eof728: cs = 728; goto _test_eof
+	_test_eof729: cs = 729; goto _test_eof
+	_test_eof730: cs = 730; goto _test_eof
+	_test_eof731: cs = 731; goto _test_eof
+	_test_eof732: cs = 732; goto _test_eof
+	_test_eof1070: cs = 1070; goto _test_eof
+	_test_eof733: cs = 733; goto _test_eof
+	_test_eof734: cs = 734; goto _test_eof
+	_test_eof735: cs = 735; goto _test_eof
+	_test_eof736: cs = 736; goto _test_eof
+	_test_eof737: cs = 737; goto _test_eof
+	_test_eof738: cs = 738; goto _test_eof
+	_test_eof739: cs = 739; goto _test_eof
+	_test_eof740: cs = 740; goto _test_eof
+	_test_eof741: cs = 741; goto _test_eof
+	_test_eof742: cs = 742; goto _test_eof
+	_test_eof743: cs = 743; goto _test_eof
+	_test_eof744: cs = 744; goto _test_eof
+	_test_eof745: cs = 745; goto _test_eof
+	_test_eof746: cs = 746; goto _test_eof
+	_test_eof747: cs = 747; goto _test_eof
+	_test_eof748: cs = 748; goto _test_eof
+	_test_eof749: cs = 749; goto _test_eof
+	_test_eof750: cs = 750; goto _test_eof
+	_test_eof751: cs = 751; goto _test_eof
+	_test_eof752: cs = 752; goto _test_eof
+	_test_eof753: cs = 753; goto _test_eof
+	_test_eof754: cs = 754; goto _test_eof
+	_test_eof755: cs = 755; goto _test_eof
+	_test_eof756: cs = 756; goto _test_eof
+	_test_eof757: cs = 757; goto _test_eof
+	_test_eof758: cs = 758; goto _test_eof
+	_test_eof759: cs = 759; goto _test_eof
+	_test_eof760: cs = 760; goto _test_eof
+	_test_eof761: cs = 761; goto _test_eof
+	_test_eof762: cs = 762; goto _test_eof
+	_test_eof763: cs = 763; goto _test_eof
+	_test_eof764: cs = 764; goto _test_eof
+	_test_eof765: cs = 765; goto _test_eof
+	_test_eof766: cs = 766; goto _test_eof
+	_test_eof767: cs = 767; goto _test_eof
+	_test_eof768: cs = 768; goto _test_eof
+	_test_eof769: cs = 769; goto _test_eof
+	_test_eof770: cs = 770; goto _test_eof
+	_test_eof771: cs = 771; goto _test_eof
+	_test_eof772: cs = 772; goto _test_eof
+	_test_eof773: cs = 773; goto _test_eof
+	_test_eof774: cs = 774; goto _test_eof
+	_test_eof775: cs = 775; goto _test_eof
+	_test_eof776: cs = 776; goto _test_eof
+	_test_eof777: cs = 777; goto _test_eof
+	_test_eof778: cs = 778; goto _test_eof
+	_test_eof779: cs = 779; goto _test_eof
+	_test_eof780: cs = 780; goto _test_eof
+	_test_eof781: cs = 781; goto _test_eof
+	_test_eof782: cs = 782; goto _test_eof
+	_test_eof783: cs = 783; goto _test_eof
+	_test_eof784: cs = 784; goto _test_eof
+	_test_eof785: cs = 785; goto _test_eof
+	_test_eof786: cs = 786; goto _test_eof
+	_test_eof787: cs = 787; goto _test_eof
+	_test_eof788: cs = 788; goto _test_eof
+	_test_eof789: cs = 789; goto _test_eof
+	_test_eof790: cs = 790; goto _test_eof
+	_test_eof791: cs = 791; goto _test_eof
+	_test_eof792: cs = 792; goto _test_eof
+	_test_eof793: cs = 793; goto _test_eof
+	_test_eof794: cs = 794; goto _test_eof
+	_test_eof795: cs = 795; goto _test_eof
+	_test_eof796: cs = 796; goto _test_eof
+	_test_eof797: cs = 797; goto _test_eof
+	_test_eof798: cs = 798; goto _test_eof
+	_test_eof799: cs = 799; goto _test_eof
+	_test_eof800: cs = 800; goto _test_eof
+	_test_eof801: cs = 801; goto _test_eof
+	_test_eof802: cs = 802; goto _test_eof
+	_test_eof803: cs = 803; goto _test_eof
+	_test_eof804: cs = 804; goto _test_eof
+	_test_eof805: cs = 805; goto _test_eof
+	_test_eof806: cs = 806; goto _test_eof
+	_test_eof807: cs = 807; goto _test_eof
+	_test_eof808: cs = 808; goto _test_eof
+	_test_eof809: cs = 809; goto _test_eof
+	_test_eof810: cs = 810; goto _test_eof
+	_test_eof811: cs = 811; goto _test_eof
+	_test_eof812: cs = 812; goto _test_eof
+	_test_eof813: cs = 813; goto _test_eof
+	_test_eof814: cs = 814; goto _test_eof
+	_test_eof1071: cs = 1071; goto _test_eof
+	_test_eof1072: cs = 1072; goto _test_eof
+	_test_eof1073: cs = 1073; goto _test_eof
+	_test_eof1074: cs = 1074; goto _test_eof
+	_test_eof1075: cs = 1075; goto _test_eof
+	_test_eof815: cs = 815; goto _test_eof
+	_test_eof816: cs = 816; goto _test_eof
+	_test_eof817: cs = 817; goto _test_eof
+	_test_eof818: cs = 818; goto _test_eof
+	_test_eof819: cs = 819; goto _test_eof
+	_test_eof820: cs = 820; goto _test_eof
+	_test_eof821: cs = 821; goto _test_eof
+	_test_eof822: cs = 822; goto _test_eof
+	_test_eof823: cs = 823; goto _test_eof
+	_test_eof824: cs = 824; goto _test_eof
+	_test_eof825: cs = 825; goto _test_eof
+	_test_eof826: cs = 826; goto _test_eof
+	_test_eof827: cs = 827; goto _test_eof
+	_test_eof828: cs = 828; goto _test_eof
+	_test_eof829: cs = 829; goto _test_eof
+	_test_eof830: cs = 830; goto _test_eof
+	_test_eof831: cs = 831; goto _test_eof
+	_test_eof832: cs = 832; goto _test_eof
+	_test_eof833: cs = 833; goto _test_eof
+	_test_eof834: cs = 834; goto _test_eof
+	_test_eof835: cs = 835; goto _test_eof
+	_test_eof836: cs = 836; goto _test_eof
+	_test_eof837: cs = 837; goto _test_eof
+	_test_eof838: cs = 838; goto _test_eof
+	_test_eof1076: cs = 1076; goto _test_eof
+	_test_eof839: cs = 839; goto _test_eof
+	_test_eof840: cs = 840; goto _test_eof
+	_test_eof841: cs = 841; goto _test_eof
+	_test_eof1077: cs = 1077; goto _test_eof
+	_test_eof1078: cs = 1078; goto _test_eof
+	_test_eof1079: cs = 1079; goto _test_eof
+	_test_eof1080: cs = 1080; goto _test_eof
+	_test_eof1081: cs = 1081; goto _test_eof
+	_test_eof1082: cs = 1082; goto _test_eof
+	_test_eof1083: cs = 1083; goto _test_eof
+	_test_eof1084: cs = 1084; goto _test_eof
+	_test_eof1085: cs = 1085; goto _test_eof
+	_test_eof1086: cs = 1086; goto _test_eof
+	_test_eof1087: cs = 1087; goto _test_eof
+	_test_eof1088: cs = 1088; goto _test_eof
+	_test_eof1089: cs = 1089; goto _test_eof
+	_test_eof1090: cs = 1090; goto _test_eof
+	_test_eof1091: cs = 1091; goto _test_eof
+	_test_eof1092: cs = 1092; goto _test_eof
+	_test_eof1093: cs = 1093; goto _test_eof
+	_test_eof1094: cs = 1094; goto _test_eof
+	_test_eof1095: cs = 1095; goto _test_eof
+	_test_eof1096: cs = 1096; goto _test_eof
+	_test_eof1097: cs = 1097; goto _test_eof
+	_test_eof1098: cs = 1098; goto _test_eof
+	_test_eof842: cs = 842; goto _test_eof
+	_test_eof843: cs = 843; goto _test_eof
+	_test_eof844: cs = 844; goto _test_eof
+	_test_eof845: cs = 845; goto _test_eof
+	_test_eof846: cs = 846; goto _test_eof
+	_test_eof847: cs = 847; goto _test_eof
+	_test_eof848: cs = 848; goto _test_eof
+	_test_eof849: cs = 849; goto _test_eof
+	_test_eof850: cs = 850; goto _test_eof
+	_test_eof851: cs = 851; goto _test_eof
+	_test_eof852: cs = 852; goto _test_eof
+	_test_eof853: cs = 853; goto _test_eof
+	_test_eof854: cs = 854; goto _test_eof
+	_test_eof855: cs = 855; goto _test_eof
+	_test_eof856: cs = 856; goto _test_eof
+	_test_eof857: cs = 857; goto _test_eof
+	_test_eof858: cs = 858; goto _test_eof
+	_test_eof859: cs = 859; goto _test_eof
+	_test_eof860: cs = 860; goto _test_eof
+	_test_eof861: cs = 861; goto _test_eof
+	_test_eof862: cs = 862; goto _test_eof
+	_test_eof863: cs = 863; goto _test_eof
+	_test_eof864: cs = 864; goto _test_eof
+	_test_eof865: cs = 865; goto _test_eof
+	_test_eof866: cs = 866; goto _test_eof
+	_test_eof867: cs = 867; goto _test_eof
+	_test_eof868: cs = 868; goto _test_eof
+	_test_eof869: cs = 869; goto _test_eof
+	_test_eof870: cs = 870; goto _test_eof
+	_test_eof871: cs = 871; goto _test_eof
+	_test_eof872: cs = 872; goto _test_eof
+	_test_eof873: cs = 873; goto _test_eof
+	_test_eof874: cs = 874; goto _test_eof
+	_test_eof875: cs = 875; goto _test_eof
+	_test_eof876: cs = 876; goto _test_eof
+	_test_eof877: cs = 877; goto _test_eof
+	_test_eof878: cs = 878; goto _test_eof
+	_test_eof879: cs = 879; goto _test_eof
+	_test_eof880: cs = 880; goto _test_eof
+	_test_eof881: cs = 881; goto _test_eof
+	_test_eof882: cs = 882; goto _test_eof
+	_test_eof883: cs = 883; goto _test_eof
+	_test_eof884: cs = 884; goto _test_eof
+	_test_eof885: cs = 885; goto _test_eof
+	_test_eof886: cs = 886; goto _test_eof
+	_test_eof887: cs = 887; goto _test_eof
+	_test_eof888: cs = 888; goto _test_eof
+	_test_eof889: cs = 889; goto _test_eof
+	_test_eof890: cs = 890; goto _test_eof
+	_test_eof891: cs = 891; goto _test_eof
+	_test_eof892: cs = 892; goto _test_eof
+	_test_eof893: cs = 893; goto _test_eof
+	_test_eof894: cs = 894; goto _test_eof
+	_test_eof895: cs = 895; goto _test_eof
+	_test_eof1099: cs = 1099; goto _test_eof
+	_test_eof896: cs = 896; goto _test_eof
+	_test_eof897: cs = 897; goto _test_eof
+	_test_eof898: cs = 898; goto _test_eof
+	_test_eof899: cs = 899; goto _test_eof
+	_test_eof900: cs = 900; goto _test_eof
+	_test_eof901: cs = 901; goto _test_eof
+	_test_eof902: cs = 902; goto _test_eof
+	_test_eof903: cs = 903; goto _test_eof
+	_test_eof904: cs = 904; goto _test_eof
+	_test_eof905: cs = 905; goto _test_eof
+	_test_eof906: cs = 906; goto _test_eof
+	_test_eof907: cs = 907; goto _test_eof
+	_test_eof908: cs = 908; goto _test_eof
+	_test_eof909: cs = 909; goto _test_eof
+	_test_eof910: cs = 910; goto _test_eof
+	_test_eof911: cs = 911; goto _test_eof
+	_test_eof912: cs = 912; goto _test_eof
+	_test_eof913: cs = 913; goto _test_eof
+	_test_eof914: cs = 914; goto _test_eof
+	_test_eof915: cs = 915; goto _test_eof
+	_test_eof916: cs = 916; goto _test_eof
+	_test_eof917: cs = 917; goto _test_eof
+	_test_eof918: cs = 918; goto _test_eof
+	_test_eof919: cs = 919; goto _test_eof
+	_test_eof920: cs = 920; goto _test_eof
+	_test_eof921: cs = 921; goto _test_eof
+	_test_eof922: cs = 922; goto _test_eof
+	_test_eof923: cs = 923; goto _test_eof
+	_test_eof924: cs = 924; goto _test_eof
+	_test_eof925: cs = 925; goto _test_eof
+	_test_eof926: cs = 926; goto _test_eof
+	_test_eof927: cs = 927; goto _test_eof
+	_test_eof928: cs = 928; goto _test_eof
+	_test_eof929: cs = 929; goto _test_eof
+	_test_eof930: cs = 930; goto _test_eof
+	_test_eof931: cs = 931; goto _test_eof
+	_test_eof932: cs = 932; goto _test_eof
+	_test_eof933: cs = 933; goto _test_eof
+	_test_eof934: cs = 934; goto _test_eof
+	_test_eof935: cs = 935; goto _test_eof
+	_test_eof936: cs = 936; goto _test_eof
+	_test_eof937: cs = 937; goto _test_eof
+	_test_eof938: cs = 938; goto _test_eof
+	_test_eof939: cs = 939; goto _test_eof
+	_test_eof1100: cs = 1100; goto _test_eof
+	_test_eof940: cs = 940; goto _test_eof
+	_test_eof941: cs = 941; goto _test_eof
+	_test_eof942: cs = 942; goto _test_eof
+	_test_eof943: cs = 943; goto _test_eof
+	_test_eof944: cs = 944; goto _test_eof
+	_test_eof945: cs = 945; goto _test_eof
+	_test_eof946: cs = 946; goto _test_eof
+	_test_eof947: cs = 947; goto _test_eof
+	_test_eof948: cs = 948; goto _test_eof
+	_test_eof949: cs = 949; goto _test_eof
+	_test_eof950: cs = 950; goto _test_eof
+	_test_eof951: cs = 951; goto _test_eof
+	_test_eof952: cs = 952; goto _test_eof
+	_test_eof953: cs = 953; goto _test_eof
+	_test_eof954: cs = 954; goto _test_eof
+	_test_eof955: cs = 955; goto _test_eof
+	_test_eof956: cs = 956; goto _test_eof
+	_test_eof957: cs = 957; goto _test_eof
+	_test_eof958: cs = 958; goto _test_eof
+	_test_eof959: cs = 959; goto _test_eof
+	_test_eof960: cs = 960; goto _test_eof
+	_test_eof961: cs = 961; goto _test_eof
+	_test_eof962: cs = 962; goto _test_eof
+	_test_eof963: cs = 963; goto _test_eof
+	_test_eof964: cs = 964; goto _test_eof
+	_test_eof965: cs = 965; goto _test_eof
+	_test_eof966: cs = 966; goto _test_eof
+	_test_eof967: cs = 967; goto _test_eof
+	_test_eof968: cs = 968; goto _test_eof
+	_test_eof969: cs = 969; goto _test_eof
+	_test_eof970: cs = 970; goto _test_eof
+	_test_eof971: cs = 971; goto _test_eof
+	_test_eof972: cs = 972; goto _test_eof
+	_test_eof973: cs = 973; goto _test_eof
+	_test_eof974: cs = 974; goto _test_eof
+	_test_eof975: cs = 975; goto _test_eof
+	_test_eof976: cs = 976; goto _test_eof
+	_test_eof977: cs = 977; goto _test_eof
+	_test_eof978: cs = 978; goto _test_eof
+	_test_eof979: cs = 979; goto _test_eof
+	_test_eof980: cs = 980; goto _test_eof
+	_test_eof981: cs = 981; goto _test_eof
+	_test_eof982: cs = 982; goto _test_eof
+	_test_eof983: cs = 983; goto _test_eof
+	_test_eof984: cs = 984; goto _test_eof
+	_test_eof985: cs = 985; goto _test_eof
+	_test_eof986: cs = 986; goto _test_eof
+	_test_eof987: cs = 987; goto _test_eof
+	_test_eof988: cs = 988; goto _test_eof
+	_test_eof989: cs = 989; goto _test_eof
+	_test_eof990: cs = 990; goto _test_eof
+	_test_eof991: cs = 991; goto _test_eof
+	_test_eof992: cs = 992; goto _test_eof
+	_test_eof993: cs = 993; goto _test_eof
+	_test_eof994: cs = 994; goto _test_eof
+	_test_eof1101: cs = 1101; goto _test_eof
+	_test_eof1102: cs = 1102; goto _test_eof
+	_test_eof1103: cs = 1103; goto _test_eof
+	_test_eof1104: cs = 1104; goto _test_eof
+	_test_eof1105: cs = 1105; goto _test_eof
+	_test_eof995: cs = 995; goto _test_eof
+	_test_eof996: cs = 996; goto _test_eof
+	_test_eof997: cs = 997; goto _test_eof
+	_test_eof998: cs = 998; goto _test_eof
+	_test_eof999: cs = 999; goto _test_eof
+	_test_eof1000: cs = 1000; goto _test_eof
+	_test_eof1001: cs = 1001; goto _test_eof
+	_test_eof1002: cs = 1002; goto _test_eof
+	_test_eof1003: cs = 1003; goto _test_eof
+	_test_eof1004: cs = 1004; goto _test_eof
+	_test_eof1005: cs = 1005; goto _test_eof
+	_test_eof1006: cs = 1006; goto _test_eof
+	_test_eof1007: cs = 1007; goto _test_eof
+	_test_eof1008: cs = 1008; goto _test_eof
+	_test_eof1009: cs = 1009; goto _test_eof
+	_test_eof1010: cs = 1010; goto _test_eof
+	_test_eof1011: cs = 1011; goto _test_eof
+	_test_eof1012: cs = 1012; goto _test_eof
+	_test_eof1013: cs = 1013; goto _test_eof
+	_test_eof1014: cs = 1014; goto _test_eof
+	_test_eof1015: cs = 1015; goto _test_eof
+	_test_eof1016: cs = 1016; goto _test_eof
+	_test_eof1017: cs = 1017; goto _test_eof
+	_test_eof1018: cs = 1018; goto _test_eof
+	_test_eof1019: cs = 1019; goto _test_eof
+	_test_eof1020: cs = 1020; goto _test_eof
+	_test_eof1021: cs = 1021; goto _test_eof
+	_test_eof1022: cs = 1022; goto _test_eof
+	_test_eof1023: cs = 1023; goto _test_eof
+	_test_eof1024: cs = 1024; goto _test_eof
+	_test_eof1025: cs = 1025; goto _test_eof
+	_test_eof1026: cs = 1026; goto _test_eof
+	_test_eof1027: cs = 1027; goto _test_eof
+	_test_eof1028: cs = 1028; goto _test_eof
+	_test_eof1029: cs = 1029; goto _test_eof
+	_test_eof1030: cs = 1030; goto _test_eof
+	_test_eof1031: cs = 1031; goto _test_eof
+	_test_eof1032: cs = 1032; goto _test_eof
+	_test_eof1033: cs = 1033; goto _test_eof
+	_test_eof1034: cs = 1034; goto _test_eof
+	_test_eof1035: cs = 1035; goto _test_eof
+	_test_eof1036: cs = 1036; goto _test_eof
+	_test_eof1037: cs = 1037; goto _test_eof
+	_test_eof1038: cs = 1038; goto _test_eof
+	_test_eof1039: cs = 1039; goto _test_eof
+	_test_eof1040: cs = 1040; goto _test_eof
+	_test_eof1041: cs = 1041; goto _test_eof
+	_test_eof1042: cs = 1042; goto _test_eof
+	_test_eof1106: cs = 1106; goto _test_eof
+	_test_eof1107: cs = 1107; goto _test_eof
+	_test_eof1108: cs = 1108; goto _test_eof
+	_test_eof1109: cs = 1109; goto _test_eof
+	_test_eof1110: cs = 1110; goto _test_eof
+	_test_eof1043: cs = 1043; goto _test_eof
+	_test_eof1044: cs = 1044; goto _test_eof
+	_test_eof1045: cs = 1045; goto _test_eof
+	_test_eof1046: cs = 1046; goto _test_eof
+	_test_eof1047: cs = 1047; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 1051, 1065, 1088, 1089:
+//line asa.rl:87
+ e.Reason = data[mark:p] 
+		case 1059:
+//line asa.rl:90
+
+	        e.Duration = time.Duration(hours*3600+mins*60+secs) * time.Second
+	    
+		case 1064, 1066, 1068, 1099:
+//line asa.rl:75
+ ep.Interface = data[mark:p] 
+		case 1076:
+//line asa.rl:78
+ e.Verb = data[mark:p] 
+		case 1097, 1098:
+//line asa.rl:88
+ e.User = data[mark:p] 
+//line asa.go:17795
+		}
+	}
+
+	_out: {}
+	}
+
+//line asa.rl:238
+
+
+	if cs < asa_first_final {
+		return ASALogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,245 @@
+package main
+
+import "time"
+
+// ASAEndpoint is one side of a connection in a Cisco ASA log message.
+// Interface is the nameif of the interface, such as "outside".  Messages
+// that only give the interface a packet arrived on set it on Src.
+type ASAEndpoint struct {
+	Interface []byte
+	Addr      []byte
+	Port      int
+}
+
+// ASALogEntry is a Cisco ASA syslog message.  Severity, MessageID, and Text,
+// the message after the "%ASA-6-302014: " tag, are set for every message.
+// The remaining fields are only filled in for the message IDs that
+// ParseCiscoASALog knows the layout of, and then only those the message
+// carries.  All byte slices point into the parsed line.
+type ASALogEntry struct {
+	Severity  int
+	MessageID int
+	Text      []byte
+
+	// Verb is the word saying what happened, such as Built, Teardown,
+	// Deny, or permitted.
+	Verb      []byte
+	Direction []byte
+	Protocol  []byte
+	ConnID    int64
+	Src, Dst  ASAEndpoint
+	Duration  time.Duration
+	Bytes     int64
+	ACL       []byte
+	Reason    []byte
+	User      []byte
+}
+
+// ParseCiscoASALog parses a Cisco ASA log message such as
+//
+//	%ASA-6-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs
+//
+// starting from the "%ASA" tag, so any syslog header in front of it must be
+// cut off first.  After the tag the message ID picks the machine used for
+// the rest of the line.  Messages with an ID it has no machine for are
+// accepted with only the envelope fields set, but a known message that
+// does not match its layout is rejected.
+func ParseCiscoASALog(data []byte) (ASALogEntry, bool) {
+	e, ok, _ := ParseCiscoASALogAt(data)
+	return e, ok
+}
+
+// ParseCiscoASALogAt is like ParseCiscoASALog but also returns the offset
+// of the first byte that doesn't fit the envelope or the layout for the
+// message ID, len(data) if the message stops short, and -1 on success.
+func ParseCiscoASALogAt(data []byte) (ASALogEntry, bool, int) {
+
+%% machine asa;
+%% write data;
+
+	var e ASALogEntry
+	var ep *ASAEndpoint
+
+	var hours, mins, secs int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark      { mark = p }
+	    action severity  { e.Severity = int(fc - '0') }
+	    action msgid     { e.MessageID = e.MessageID*10 + int(fc-'0') }
+	    action src       { ep = &e.Src }
+	    action dst       { ep = &e.Dst }
+	    action iface     { ep.Interface = data[mark:p] }
+	    action addr      { ep.Addr = data[mark:p] }
+	    action port      { ep.Port = ep.Port*10 + int(fc-'0') }
+	    action verb      { e.Verb = data[mark:p] }
+	    action direction { e.Direction = data[mark:p] }
+	    action protocol  { e.Protocol = data[mark:p] }
+	    action conn_id   { e.ConnID = e.ConnID*10 + int64(fc-'0') }
+	    action hours     { hours = hours*10 + int(fc-'0') }
+	    action mins      { mins = mins*10 + int(fc-'0') }
+	    action secs      { secs = secs*10 + int(fc-'0') }
+	    action bytes     { e.Bytes = e.Bytes*10 + int64(fc-'0') }
+	    action acl       { e.ACL = data[mark:p] }
+	    action reason    { e.Reason = data[mark:p] }
+	    action user      { e.User = data[mark:p] }
+
+	    action duration {
+	        e.Duration = time.Duration(hours*3600+mins*60+secs) * time.Second
+	    }
+
+	    action dispatch {
+	        e.Text = data[p+1:]
+	        switch e.MessageID {
+	        case 302013, 302015:
+	            fgoto built;
+	        case 302014, 302016:
+	            fgoto teardown;
+	        case 302020, 302021:
+	            fgoto icmp;
+	        case 305011, 305012:
+	            fgoto xlate;
+	        case 106001:
+	            fgoto conn_denied;
+	        case 106006, 106007:
+	            fgoto deny_inbound;
+	        case 106014:
+	            fgoto deny_icmp;
+	        case 106015:
+	            fgoto deny_no_conn;
+	        case 106023:
+	            fgoto deny_acl;
+	        case 106100:
+	            fgoto acl_log;
+	        case 110003:
+	            fgoto no_route;
+	        case 113004, 113005, 113015:
+	            fgoto aaa;
+	        case 313001:
+	            fgoto icmp_denied;
+	        case 605005:
+	            fgoto login;
+	        case 710003:
+	            fgoto access_denied;
+	        case 710005:
+	            fgoto discarded;
+	        }
+	    }
+
+	    iface = ( alnum | [_.\-] )+ >mark %iface ;
+	    # The ASA only logs well-formed addresses, so rather than pay for the
+	    # full IPv4 and IPv6 grammars in every place an address can appear,
+	    # anything made of the right characters will do.
+	    ip = ( xdigit | [.:] )+ ;
+	    addr = ip >mark %addr ;
+	    port = digit{1,5} $port ;
+	    protocol = ( alpha+ | digit+ ) >mark %protocol ;
+	    direction = ( 'inbound' | 'outbound' | 'Inbound' | 'Outbound' ) >mark %direction ;
+
+	    # 198.51.100.7/4444 and outside:198.51.100.7/4444
+	    ep = addr '/' port ;
+	    iface_ep = iface ':' ep ;
+
+	    # the port is left off for protocols without one
+	    iface_addr = iface ':' addr ( '/' port )? ;
+
+	    # the address as translated by NAT, which we skip over
+	    mapped = ' (' ip '/' digit+ ')' ;
+
+	    duration = digit+ $hours ':' digit{2} $mins ':' digit{2} $secs %duration ;
+
+	    icmp_type = ' (type ' digit+ ', code ' digit+ ')' ;
+
+	    # TCP flags are each followed by a space, so there are two before "on"
+	    flags = ' flags ' [A-Z ]+ ;
+
+	    on_iface = 'on interface ' iface >src ;
+
+	    # Messages we have no machine for are left in main, which takes the
+	    # rest of the line as it is.
+	    main := '%ASA-' [0-7] @severity '-' digit{6} $msgid ':' ' ' @dispatch any* ;
+
+	    # Built outbound TCP connection 4859 for outside:203.0.113.5/443 (203.0.113.5/443) to inside:10.0.0.5/54321 (198.51.100.1/54321)
+	    built := 'Built' >mark %verb ' ' direction ' ' protocol ' connection ' digit+ $conn_id
+	             ' for ' iface_ep >src mapped ' to ' iface_ep >dst mapped ;
+
+	    # Teardown TCP connection 4859 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs
+	    teardown := 'Teardown' >mark %verb ' ' protocol ' connection ' digit+ $conn_id
+	                ' for ' iface_ep >src ' to ' iface_ep >dst
+	                ' duration ' duration ' bytes ' digit+ $bytes ( ' ' any+ >mark %reason )? ;
+
+	    # Built inbound ICMP connection for faddr 198.51.100.7/0 gaddr 203.0.113.1/0 laddr 10.0.0.5/0 type 8 code 0
+	    icmp := ( 'Built' >mark %verb ' ' direction | 'Teardown' >mark %verb ) ' ' protocol
+	            ' connection for faddr ' ep >src ' gaddr ' ip '/' digit+ ' laddr ' ep >dst
+	            ( ' type ' digit+ ' code ' digit+ )? ;
+
+	    # Built dynamic TCP translation from inside:10.0.0.5/54321 to outside:203.0.113.1/54321
+	    xlate := ( 'Built' | 'Teardown' ) >mark %verb ' ' ( 'dynamic' | 'static' ) ' ' protocol
+	             ' translation from ' iface_ep >src ' to ' iface_ep >dst ( ' duration ' duration )? ;
+
+	    # Inbound TCP connection denied from 198.51.100.7/4444 to 10.0.0.5/22 flags SYN  on interface outside
+	    conn_denied := direction ' ' protocol ' connection ' 'denied' >mark %verb
+	                   ' from ' ep >src ' to ' ep >dst flags on_iface ;
+
+	    # Deny inbound UDP from 198.51.100.7/53 to 10.0.0.5/53 on interface outside
+	    deny_inbound := 'Deny' >mark %verb ' ' direction ' ' protocol
+	                    ' from ' ep >src ' to ' ep >dst ( ' ' on_iface | ' due to ' any+ >mark %reason ) ;
+
+	    # Deny inbound icmp src outside:198.51.100.7 dst inside:10.0.0.5 (type 8, code 0)
+	    deny_icmp := 'Deny' >mark %verb ' ' direction ' ' protocol
+	                 ' src ' iface_addr >src ' dst ' iface_addr >dst icmp_type ;
+
+	    # Deny TCP (no connection) from 10.0.0.5/54321 to 203.0.113.5/443 flags RST  on interface inside
+	    deny_no_conn := 'Deny' >mark %verb ' ' protocol ' (no connection) from ' ep >src ' to ' ep >dst flags on_iface ;
+
+	    # Deny tcp src outside:198.51.100.7/4444 dst inside:10.0.0.5/22 by access-group "outside_in" [0x0, 0x0]
+	    deny_acl := 'Deny' >mark %verb ' ' protocol ' src ' iface_addr >src ' dst ' iface_addr >dst icmp_type?
+	                ' by access-group "' [^"]+ >mark %acl '" [0x' xdigit+ ', 0x' xdigit+ ']' ;
+
+	    # access-list outside_in denied tcp outside/198.51.100.7(4444) -> inside/10.0.0.5(22) hit-cnt 1 first hit [0x91d6f1e1, 0x0]
+	    acl_ep = iface '/' addr '(' port ')' ;
+	    acl_log := 'access-list ' ( any - ' ' )+ >mark %acl ' ' ( 'permitted' | 'denied' | 'est-allowed' ) >mark %verb
+	               ' ' protocol ' ' acl_ep >src ' -> ' acl_ep >dst ' hit-cnt ' digit+ ' ' any+ ;
+
+	    # Routing failed to locate next hop for TCP from inside:10.0.0.5/54321 to outside:203.0.113.5/443
+	    no_route := 'Routing failed to locate next hop for ' protocol ' from ' iface_ep >src ' to ' iface_ep >dst ;
+
+	    # AAA user authentication Rejected : reason = Invalid password : local database : user = bob
+	    #
+	    # The field values run up to the next " : ", so the leaving actions
+	    # fire at every space inside them; the last one is the one we keep.
+	    aaa_field = 'reason = ' [^:]+ >mark %reason
+	              | 'user = ' [^:]+ >mark %user
+	              | [^:]+ ;
+	    aaa := 'AAA user ' lower+ ' ' ( 'Successful' | 'Rejected' ) >mark %verb ( ' : ' aaa_field )* ;
+
+	    # Denied ICMP type=8, code=0 from 198.51.100.7 on interface outside
+	    icmp_denied := 'Denied' >mark %verb ' ' protocol ' type=' digit+ ', code=' digit+
+	                   ' from ' addr >src ' ' on_iface ;
+
+	    # Login permitted from 10.0.0.9/51234 to inside:10.0.0.1/ssh for user "admin"
+	    #
+	    # The port is usually given as a service name, which leaves Port zero.
+	    login := 'Login ' ( 'permitted' | 'denied' ) >mark %verb ' from ' ep >src
+	             ' to ' ( iface ':' addr '/' ( port | alpha+ ) ) >dst ' for user "' [^"]* >mark %user '"' ;
+
+	    # TCP access denied by ACL from 198.51.100.7/4444 to outside:203.0.113.1/22
+	    access_denied := protocol ' access ' ( 'denied' | 'permitted' ) >mark %verb
+	                     ' by ACL from ' ep >src ' to ' iface_ep >dst ;
+
+	    # UDP request discarded from 198.51.100.7/137 to outside:203.0.113.255/137
+	    discarded := protocol ' request ' 'discarded' >mark %verb ' from ' ep >src ' to ' iface_ep >dst ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < asa_first_final {
+		return ASALogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
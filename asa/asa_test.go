@@ -0,0 +1,415 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var data = []byte(`%ASA-6-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs`)
+
+var hits int
+
+func TestParseCiscoASALog(t *testing.T) {
+	tests := []struct {
+		line string
+		want ASALogEntry
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: ASALogEntry{
+				Severity:  6,
+				MessageID: 302014,
+				Text:      data[len("%ASA-6-302014: "):],
+				Verb:      []byte("Teardown"),
+				Protocol:  []byte("TCP"),
+				ConnID:    12345,
+				Src:       ASAEndpoint{Interface: []byte("outside"), Addr: []byte("203.0.113.5"), Port: 443},
+				Dst:       ASAEndpoint{Interface: []byte("inside"), Addr: []byte("10.0.0.5"), Port: 54321},
+				Duration:  42 * time.Second,
+				Bytes:     1234,
+				Reason:    []byte("TCP FINs"),
+			},
+			ok: true,
+		},
+		{
+			line: `%ASA-6-302013: Built outbound TCP connection 4859 for outside:203.0.113.5/443 (203.0.113.5/443) to inside:10.0.0.5/54321 (198.51.100.1/54321)`,
+			want: ASALogEntry{
+				Severity:  6,
+				MessageID: 302013,
+				Text:      []byte("Built outbound TCP connection 4859 for outside:203.0.113.5/443 (203.0.113.5/443) to inside:10.0.0.5/54321 (198.51.100.1/54321)"),
+				Verb:      []byte("Built"),
+				Direction: []byte("outbound"),
+				Protocol:  []byte("TCP"),
+				ConnID:    4859,
+				Src:       ASAEndpoint{Interface: []byte("outside"), Addr: []byte("203.0.113.5"), Port: 443},
+				Dst:       ASAEndpoint{Interface: []byte("inside"), Addr: []byte("10.0.0.5"), Port: 54321},
+			},
+			ok: true,
+		},
+		{
+			line: `%ASA-6-302014: Teardown TCP connection 77 for outside:2001:db8::10/443 to inside:2001:db8:1::5/50000 duration 1:02:03 bytes 0 TCP Reset-O`,
+			want: ASALogEntry{
+				Severity:  6,
+				MessageID: 302014,
+				Text:      []byte("Teardown TCP connection 77 for outside:2001:db8::10/443 to inside:2001:db8:1::5/50000 duration 1:02:03 bytes 0 TCP Reset-O"),
+				Verb:      []byte("Teardown"),
+				Protocol:  []byte("TCP"),
+				ConnID:    77,
+				Src:       ASAEndpoint{Interface: []byte("outside"), Addr: []byte("2001:db8::10"), Port: 443},
+				Dst:       ASAEndpoint{Interface: []byte("inside"), Addr: []byte("2001:db8:1::5"), Port: 50000},
+				Duration:  time.Hour + 2*time.Minute + 3*time.Second,
+				Reason:    []byte("TCP Reset-O"),
+			},
+			ok: true,
+		},
+		{
+			line: `%ASA-4-106023: Deny tcp src outside:198.51.100.7/4444 dst inside:10.0.0.5/22 by access-group "outside_in" [0x0, 0x0]`,
+			want: ASALogEntry{
+				Severity:  4,
+				MessageID: 106023,
+				Text:      []byte(`Deny tcp src outside:198.51.100.7/4444 dst inside:10.0.0.5/22 by access-group "outside_in" [0x0, 0x0]`),
+				Verb:      []byte("Deny"),
+				Protocol:  []byte("tcp"),
+				Src:       ASAEndpoint{Interface: []byte("outside"), Addr: []byte("198.51.100.7"), Port: 4444},
+				Dst:       ASAEndpoint{Interface: []byte("inside"), Addr: []byte("10.0.0.5"), Port: 22},
+				ACL:       []byte("outside_in"),
+			},
+			ok: true,
+		},
+		{
+			line: `%ASA-2-106007: Deny inbound UDP from 198.51.100.7/53 to 10.0.0.5/53 due to DNS Query`,
+			want: ASALogEntry{
+				Severity:  2,
+				MessageID: 106007,
+				Text:      []byte("Deny inbound UDP from 198.51.100.7/53 to 10.0.0.5/53 due to DNS Query"),
+				Verb:      []byte("Deny"),
+				Direction: []byte("inbound"),
+				Protocol:  []byte("UDP"),
+				Src:       ASAEndpoint{Addr: []byte("198.51.100.7"), Port: 53},
+				Dst:       ASAEndpoint{Addr: []byte("10.0.0.5"), Port: 53},
+				Reason:    []byte("DNS Query"),
+			},
+			ok: true,
+		},
+		{
+			line: `%ASA-6-113015: AAA user authentication Rejected : reason = Invalid password : local database : user = bob : user IP = 198.51.100.7`,
+			want: ASALogEntry{
+				Severity:  6,
+				MessageID: 113015,
+				Text:      []byte("AAA user authentication Rejected : reason = Invalid password : local database : user = bob : user IP = 198.51.100.7"),
+				Verb:      []byte("Rejected"),
+				Reason:    []byte("Invalid password"),
+				User:      []byte("bob"),
+			},
+			ok: true,
+		},
+		{
+			// the service name leaves the destination port zero
+			line: `%ASA-6-605005: Login permitted from 10.0.0.9/51234 to inside:10.0.0.1/ssh for user "admin"`,
+			want: ASALogEntry{
+				Severity:  6,
+				MessageID: 605005,
+				Text:      []byte(`Login permitted from 10.0.0.9/51234 to inside:10.0.0.1/ssh for user "admin"`),
+				Verb:      []byte("permitted"),
+				Src:       ASAEndpoint{Addr: []byte("10.0.0.9"), Port: 51234},
+				Dst:       ASAEndpoint{Interface: []byte("inside"), Addr: []byte("10.0.0.1")},
+				User:      []byte("admin"),
+			},
+			ok: true,
+		},
+		{
+			// no machine for this one, so only the envelope
+			line: `%ASA-5-111008: User 'admin' executed the 'show run' command.`,
+			want: ASALogEntry{
+				Severity:  5,
+				MessageID: 111008,
+				Text:      []byte("User 'admin' executed the 'show run' command."),
+			},
+			ok: true,
+		},
+
+		// cut off part way through
+		{line: `%ASA-6-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321`},
+		{line: `%ASA-6-302013: `},
+		// a known message ID with the wrong layout
+		{line: `%ASA-6-302013: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs`},
+		{line: `%ASA-8-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs`},
+		{line: `%ASA-6-30201: Teardown TCP connection 12345`},
+		{line: `%ASA-6-302014:Teardown TCP connection 12345`},
+		// syslog header left on
+		{line: `Jan 18 2024 06:41:30: %ASA-6-302014: Teardown TCP connection 12345 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseCiscoASALog([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseCiscoASALog(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCiscoASALog(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseCiscoASALogAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{`%ASA-8-302014: Teardown TCP connection 1 for a:10.0.0.1/1 to b:10.0.0.2/2 duration 0:00:01 bytes 0`, 5},
+		{`%ASA-6-30201: Teardown`, 12},
+		// a known message ID must match its layout
+		{`%ASA-6-302014: Teardown TCP connection 1 for a:10.0.0.1/1 to b:10.0.0.2/2 lasting 0:00:01 bytes 0`, 74},
+		{`%ASA-6-302014: Teardown TCP connection 1 for a:10.0.0.1/1`, 57},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseCiscoASALogAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseCiscoASALogAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// asaLines has a message for each of the twenty message IDs that turn up
+// most often in a typical ASA log.
+var asaLines = []string{
+	`%ASA-6-302013: Built outbound TCP connection 4859 for outside:203.0.113.5/443 (203.0.113.5/443) to inside:10.0.0.5/54321 (198.51.100.1/54321)`,
+	`%ASA-6-302014: Teardown TCP connection 4859 for outside:203.0.113.5/443 to inside:10.0.0.5/54321 duration 0:00:42 bytes 1234 TCP FINs`,
+	`%ASA-6-302015: Built inbound UDP connection 4860 for outside:198.51.100.53/53 (198.51.100.53/53) to inside:10.0.0.2/5353 (203.0.113.1/5353)`,
+	`%ASA-6-302016: Teardown UDP connection 4860 for outside:198.51.100.53/53 to inside:10.0.0.2/5353 duration 0:02:01 bytes 312`,
+	`%ASA-6-302020: Built inbound ICMP connection for faddr 198.51.100.7/0 gaddr 203.0.113.1/0 laddr 10.0.0.5/0 type 8 code 0`,
+	`%ASA-6-302021: Teardown ICMP connection for faddr 198.51.100.7/0 gaddr 203.0.113.1/0 laddr 10.0.0.5/0 type 8 code 0`,
+	`%ASA-6-305011: Built dynamic TCP translation from inside:10.0.0.5/54321 to outside:203.0.113.1/54321`,
+	`%ASA-6-305012: Teardown dynamic TCP translation from inside:10.0.0.5/54321 to outside:203.0.113.1/54321 duration 0:00:30`,
+	`%ASA-2-106001: Inbound TCP connection denied from 198.51.100.7/4444 to 10.0.0.5/22 flags SYN  on interface outside`,
+	`%ASA-2-106006: Deny inbound UDP from 198.51.100.7/137 to 10.0.0.5/137 on interface outside`,
+	`%ASA-3-106014: Deny inbound icmp src outside:198.51.100.7 dst inside:10.0.0.5 (type 8, code 0)`,
+	`%ASA-6-106015: Deny TCP (no connection) from 10.0.0.5/54321 to 203.0.113.5/443 flags RST  on interface inside`,
+	`%ASA-4-106023: Deny tcp src outside:198.51.100.7/4444 dst inside:10.0.0.5/22 by access-group "outside_in" [0x0, 0x0]`,
+	`%ASA-6-106100: access-list outside_in denied tcp outside/198.51.100.7(4444) -> inside/10.0.0.5(22) hit-cnt 1 first hit [0x91d6f1e1, 0x0]`,
+	`%ASA-6-110003: Routing failed to locate next hop for TCP from inside:10.0.0.5/54321 to outside:203.0.113.5/443`,
+	`%ASA-6-113004: AAA user authentication Successful : server =  10.0.0.10 : user = alice`,
+	`%ASA-3-313001: Denied ICMP type=8, code=0 from 198.51.100.7 on interface outside`,
+	`%ASA-6-605005: Login permitted from 10.0.0.9/51234 to inside:10.0.0.1/ssh for user "admin"`,
+	`%ASA-3-710003: TCP access denied by ACL from 198.51.100.7/4444 to outside:203.0.113.1/22`,
+	`%ASA-7-710005: UDP request discarded from 198.51.100.7/137 to outside:203.0.113.255/137`,
+}
+
+// corpus is asaLines over and over, 100k lines in all.
+var corpus = []byte(strings.Repeat(strings.Join(asaLines, "\n")+"\n", 100000/len(asaLines)))
+
+func lines(data []byte, parse func([]byte) (ASALogEntry, bool)) (int, bool) {
+	var n int
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			i = len(data)
+		}
+		if _, ok := parse(data[:i]); !ok {
+			return 0, false
+		}
+		n++
+		data = data[min(i+1, len(data)):]
+	}
+	return n, true
+}
+
+const (
+	reIP    = `([\da-fA-F.:]+)`
+	reIface = `([\w.-]+)`
+	reProto = `([A-Za-z]+|\d+)`
+	reDir   = `(inbound|outbound|Inbound|Outbound)`
+	reDur   = `(\d+):(\d\d):(\d\d)`
+)
+
+var (
+	reASA = regexp.MustCompile(`^%ASA-([0-7])-(\d{6}): (.*)$`)
+
+	reASABuilt        = regexp.MustCompile(`^(Built) ` + reDir + ` ` + reProto + ` connection (\d+) for ` + reIface + `:` + reIP + `/(\d+) \([\da-fA-F.:]+/\d+\) to ` + reIface + `:` + reIP + `/(\d+) \([\da-fA-F.:]+/\d+\)$`)
+	reASATeardown     = regexp.MustCompile(`^(Teardown) ` + reProto + ` connection (\d+) for ` + reIface + `:` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(\d+) duration ` + reDur + ` bytes (\d+)(?: (.+))?$`)
+	reASAICMP         = regexp.MustCompile(`^(?:(Built) ` + reDir + `|(Teardown)) ` + reProto + ` connection for faddr ` + reIP + `/(\d+) gaddr [\da-fA-F.:]+/\d+ laddr ` + reIP + `/(\d+)(?: type \d+ code \d+)?$`)
+	reASAXlate        = regexp.MustCompile(`^(Built|Teardown) (?:dynamic|static) ` + reProto + ` translation from ` + reIface + `:` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(\d+)(?: duration ` + reDur + `)?$`)
+	reASAConnDenied   = regexp.MustCompile(`^` + reDir + ` ` + reProto + ` connection (denied) from ` + reIP + `/(\d+) to ` + reIP + `/(\d+) flags [A-Z ]+on interface ` + reIface + `$`)
+	reASADenyInbound  = regexp.MustCompile(`^(Deny) ` + reDir + ` ` + reProto + ` from ` + reIP + `/(\d+) to ` + reIP + `/(\d+) (?:on interface ` + reIface + `|due to (.+))$`)
+	reASADenyICMP     = regexp.MustCompile(`^(Deny) ` + reDir + ` ` + reProto + ` src ` + reIface + `:` + reIP + `(?:/(\d+))? dst ` + reIface + `:` + reIP + `(?:/(\d+))? \(type \d+, code \d+\)$`)
+	reASADenyNoConn   = regexp.MustCompile(`^(Deny) ` + reProto + ` \(no connection\) from ` + reIP + `/(\d+) to ` + reIP + `/(\d+) flags [A-Z ]+on interface ` + reIface + `$`)
+	reASADenyACL      = regexp.MustCompile(`^(Deny) ` + reProto + ` src ` + reIface + `:` + reIP + `(?:/(\d+))? dst ` + reIface + `:` + reIP + `(?:/(\d+))?(?: \(type \d+, code \d+\))? by access-group "([^"]+)" \[0x[\da-fA-F]+, 0x[\da-fA-F]+\]$`)
+	reASAACLLog       = regexp.MustCompile(`^access-list (\S+) (permitted|denied|est-allowed) ` + reProto + ` ` + reIface + `/` + reIP + `\((\d+)\) -> ` + reIface + `/` + reIP + `\((\d+)\) hit-cnt \d+ .+$`)
+	reASANoRoute      = regexp.MustCompile(`^Routing failed to locate next hop for ` + reProto + ` from ` + reIface + `:` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(\d+)$`)
+	reASAAAA          = regexp.MustCompile(`^AAA user [a-z]+ (Successful|Rejected)((?: : [^:]+)*)$`)
+	reASAICMPDenied   = regexp.MustCompile(`^(Denied) ` + reProto + ` type=\d+, code=\d+ from ` + reIP + ` on interface ` + reIface + `$`)
+	reASALogin        = regexp.MustCompile(`^Login (permitted|denied) from ` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(?:(\d+)|[A-Za-z]+) for user "([^"]*)"$`)
+	reASAAccessDenied = regexp.MustCompile(`^` + reProto + ` access (denied|permitted) by ACL from ` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(\d+)$`)
+	reASADiscarded    = regexp.MustCompile(`^` + reProto + ` request (discarded) from ` + reIP + `/(\d+) to ` + reIface + `:` + reIP + `/(\d+)$`)
+)
+
+var (
+	srcEP  = []string{"src.if", "src.addr", "src.port"}
+	dstEP  = []string{"dst.if", "dst.addr", "dst.port"}
+	srcIP  = []string{"src.addr", "src.port"}
+	dstIP  = []string{"dst.addr", "dst.port"}
+	parts  = []string{"h", "m", "s"}
+	fields = func(f ...[]string) (all []string) {
+		for _, f := range f {
+			all = append(all, f...)
+		}
+		return all
+	}
+)
+
+// regexASA picks a regular expression for the message with a switch on
+// its ID.  fields names what each capture group holds.
+func regexASA(line []byte) (ASALogEntry, bool) {
+	m := reASA.FindSubmatch(line)
+	if m == nil {
+		return ASALogEntry{}, false
+	}
+	e := ASALogEntry{Text: m[3]}
+	e.Severity, _ = strconv.Atoi(string(m[1]))
+	e.MessageID, _ = strconv.Atoi(string(m[2]))
+
+	var re *regexp.Regexp
+	var names []string
+	switch e.MessageID {
+	case 302013, 302015:
+		re, names = reASABuilt, fields([]string{"verb", "dir", "proto", "conn"}, srcEP, dstEP)
+	case 302014, 302016:
+		re, names = reASATeardown, fields([]string{"verb", "proto", "conn"}, srcEP, dstEP, parts, []string{"bytes", "reason"})
+	case 302020, 302021:
+		re, names = reASAICMP, fields([]string{"verb", "dir", "verb", "proto"}, srcIP, dstIP)
+	case 305011, 305012:
+		re, names = reASAXlate, fields([]string{"verb", "proto"}, srcEP, dstEP, parts)
+	case 106001:
+		re, names = reASAConnDenied, fields([]string{"dir", "proto", "verb"}, srcIP, dstIP, []string{"src.if"})
+	case 106006, 106007:
+		re, names = reASADenyInbound, fields([]string{"verb", "dir", "proto"}, srcIP, dstIP, []string{"src.if", "reason"})
+	case 106014:
+		re, names = reASADenyICMP, fields([]string{"verb", "dir", "proto"}, srcEP, dstEP)
+	case 106015:
+		re, names = reASADenyNoConn, fields([]string{"verb", "proto"}, srcIP, dstIP, []string{"src.if"})
+	case 106023:
+		re, names = reASADenyACL, fields([]string{"verb", "proto"}, srcEP, dstEP, []string{"acl"})
+	case 106100:
+		re, names = reASAACLLog, fields([]string{"acl", "verb", "proto"}, srcEP, dstEP)
+	case 110003:
+		re, names = reASANoRoute, fields([]string{"proto"}, srcEP, dstEP)
+	case 113004, 113005, 113015:
+		re, names = reASAAAA, []string{"verb", "aaa"}
+	case 313001:
+		re, names = reASAICMPDenied, []string{"verb", "proto", "src.addr", "src.if"}
+	case 605005:
+		re, names = reASALogin, fields([]string{"verb"}, srcIP, dstEP, []string{"user"})
+	case 710003:
+		re, names = reASAAccessDenied, fields([]string{"proto", "verb"}, srcIP, dstEP)
+	case 710005:
+		re, names = reASADiscarded, fields([]string{"proto", "verb"}, srcIP, dstEP)
+	default:
+		return e, true
+	}
+
+	m = re.FindSubmatch(e.Text)
+	if m == nil {
+		return ASALogEntry{}, false
+	}
+
+	var hours, mins, secs int
+	for i, name := range names {
+		b := m[i+1]
+		if b == nil {
+			continue
+		}
+		switch name {
+		case "verb":
+			e.Verb = b
+		case "dir":
+			e.Direction = b
+		case "proto":
+			e.Protocol = b
+		case "conn":
+			e.ConnID, _ = strconv.ParseInt(string(b), 10, 64)
+		case "src.if":
+			e.Src.Interface = b
+		case "src.addr":
+			e.Src.Addr = b
+		case "src.port":
+			e.Src.Port, _ = strconv.Atoi(string(b))
+		case "dst.if":
+			e.Dst.Interface = b
+		case "dst.addr":
+			e.Dst.Addr = b
+		case "dst.port":
+			e.Dst.Port, _ = strconv.Atoi(string(b))
+		case "h":
+			hours, _ = strconv.Atoi(string(b))
+		case "m":
+			mins, _ = strconv.Atoi(string(b))
+		case "s":
+			secs, _ = strconv.Atoi(string(b))
+			e.Duration = time.Duration(hours*3600+mins*60+secs) * time.Second
+		case "bytes":
+			e.Bytes, _ = strconv.ParseInt(string(b), 10, 64)
+		case "acl":
+			e.ACL = b
+		case "reason":
+			e.Reason = b
+		case "user":
+			e.User = b
+		case "aaa":
+			for _, f := range bytes.Split(b, []byte(" : "))[1:] {
+				if v, ok := bytes.CutPrefix(f, []byte("reason = ")); ok {
+					e.Reason = v
+				}
+				if v, ok := bytes.CutPrefix(f, []byte("user = ")); ok {
+					e.User = v
+				}
+			}
+		}
+	}
+
+	return e, true
+}
+
+func TestASAAlternatives(t *testing.T) {
+	for _, line := range asaLines {
+		want, ok := ParseCiscoASALog([]byte(line))
+		if !ok || want.Verb == nil && want.Protocol == nil {
+			t.Errorf("ParseCiscoASALog(%q)=%+v, %v", line, want, ok)
+			continue
+		}
+		if got, ok := regexASA([]byte(line)); !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("regexASA(%q)=%+v, want %+v", line, got, want)
+		}
+	}
+
+	if n, ok := lines(corpus, ParseCiscoASALog); !ok || n != 100000 {
+		t.Errorf("ParseCiscoASALog read %d lines of corpus, %v, want 100000", n, ok)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	b.SetBytes(int64(len(corpus)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := lines(corpus, regexASA); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	b.SetBytes(int64(len(corpus)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := lines(corpus, ParseCiscoASALog); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,903 @@
+
+//line base64.rl:1
+package main
+
+// DecodeBase64 decodes standard, padded Base64 from RFC 4648 section 4
+// into dst, returning the number of bytes written.  Like the decoders in
+// encoding/base64, it panics if dst is too small; len(src)*3/4 bytes is
+// always enough.  Line breaks are not allowed.
+func DecodeBase64(src, dst []byte) (n int, ok bool) {
+	n, ok, _ = decodeBase64(src, dst, false)
+	return n, ok
+}
+
+// DecodeBase64At is like DecodeBase64 but also returns the offset in src of
+// the first byte that isn't valid Base64, len(src) if src ends part way
+// through a quantum, or -1 if src decoded.
+func DecodeBase64At(src, dst []byte) (n int, ok bool, errPos int) {
+	return decodeBase64(src, dst, false)
+}
+
+// DecodeBase64URL decodes the URL and filename safe Base64 from RFC 4648
+// section 5, with or without padding, into dst.  dst must be as large as it
+// is for DecodeBase64.
+func DecodeBase64URL(src, dst []byte) (n int, ok bool) {
+	n, ok, _ = decodeBase64(src, dst, true)
+	return n, ok
+}
+
+// DecodeBase64URLAt is like DecodeBase64URL but also returns the offset in
+// src of the first byte it could not decode, or -1.
+func DecodeBase64URLAt(src, dst []byte) (n int, ok bool, errPos int) {
+	return decodeBase64(src, dst, true)
+}
+
+func decodeBase64(data, dst []byte, url bool) (n int, ok bool, errPos int) {
+
+
+//line base64.rl:36
+
+//line base64.go:41
+const base64_start int = 7
+const base64_first_final int = 7
+const base64_error int = 0
+
+const base64_en_main int = 7
+const base64_en_url int = 9
+
+
+//line base64.rl:37
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	var acc uint32
+	var k int
+
+	
+//line base64.go:60
+	{
+	cs = base64_start
+	}
+
+//line base64.rl:75
+
+
+	if url {
+		cs = base64_en_url
+	}
+
+	
+//line base64.go:73
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 7:
+		goto st_case_7
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 8:
+		goto st_case_8
+	case 4:
+		goto st_case_4
+	case 9:
+		goto st_case_9
+	case 5:
+		goto st_case_5
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 6:
+		goto st_case_6
+	}
+	goto st_out
+tr12:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st7
+tr13:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st7
+tr14:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st7
+tr16:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st7
+tr17:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line base64.go:177
+		switch data[p] {
+		case 43:
+			goto tr24
+		case 47:
+			goto tr25
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr26
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr28
+			}
+		default:
+			goto tr27
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr24:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st1
+tr25:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st1
+tr26:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st1
+tr27:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st1
+tr28:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line base64.go:271
+		switch data[p] {
+		case 43:
+			goto tr1
+		case 47:
+			goto tr2
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr3
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr5
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+tr1:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st2
+tr2:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st2
+tr3:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st2
+tr4:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st2
+tr5:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line base64.go:361
+		switch data[p] {
+		case 43:
+			goto tr6
+		case 47:
+			goto tr7
+		case 61:
+			goto st4
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr8
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr11
+			}
+		default:
+			goto tr10
+		}
+		goto st0
+tr6:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st3
+tr7:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st3
+tr8:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st3
+tr10:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st3
+tr11:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line base64.go:453
+		switch data[p] {
+		case 43:
+			goto tr12
+		case 47:
+			goto tr13
+		case 61:
+			goto st8
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr14
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr17
+			}
+		default:
+			goto tr16
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 61 {
+			goto st8
+		}
+		goto st0
+tr40:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st9
+tr41:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st9
+tr42:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st9
+tr43:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st9
+tr44:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line base64.go:560
+		switch data[p] {
+		case 45:
+			goto tr29
+		case 95:
+			goto tr32
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr30
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr33
+			}
+		default:
+			goto tr31
+		}
+		goto st0
+tr29:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st5
+tr30:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st5
+tr31:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st5
+tr32:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st5
+tr33:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line base64.go:650
+		switch data[p] {
+		case 45:
+			goto tr18
+		case 95:
+			goto tr21
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr19
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr22
+			}
+		default:
+			goto tr20
+		}
+		goto st0
+tr18:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st10
+tr19:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st10
+tr20:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st10
+tr21:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st10
+tr22:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line base64.go:740
+		switch data[p] {
+		case 45:
+			goto tr34
+		case 61:
+			goto st6
+		case 95:
+			goto tr38
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr35
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr39
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr34:
+//line base64.rl:51
+ acc = acc<<6 | 62 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st11
+tr35:
+//line base64.rl:50
+ acc = acc<<6 | uint32((data[p])-'0'+52) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st11
+tr37:
+//line base64.rl:48
+ acc = acc<<6 | uint32((data[p])-'A') 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st11
+tr38:
+//line base64.rl:52
+ acc = acc<<6 | 63 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st11
+tr39:
+//line base64.rl:49
+ acc = acc<<6 | uint32((data[p])-'a'+26) 
+//line base64.rl:54
+
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line base64.go:832
+		switch data[p] {
+		case 45:
+			goto tr40
+		case 61:
+			goto st12
+		case 95:
+			goto tr43
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr41
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr44
+			}
+		default:
+			goto tr42
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 61 {
+			goto st12
+		}
+		goto st0
+	st_out:
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line base64.rl:82
+
+	if cs < base64_first_final {
+		return 0, false, p
+	}
+
+	// flush a final, padded, quantum
+	switch k {
+	case 2:
+		dst[n] = byte(acc >> 4)
+		n++
+	case 3:
+		dst[n], dst[n+1] = byte(acc>>10), byte(acc>>2)
+		n += 2
+	}
+
+	return n, true, -1
+}
@@ -0,0 +1,98 @@
+package main
+
+// DecodeBase64 decodes standard, padded Base64 from RFC 4648 section 4
+// into dst, returning the number of bytes written.  Like the decoders in
+// encoding/base64, it panics if dst is too small; len(src)*3/4 bytes is
+// always enough.  Line breaks are not allowed.
+func DecodeBase64(src, dst []byte) (n int, ok bool) {
+	n, ok, _ = decodeBase64(src, dst, false)
+	return n, ok
+}
+
+// DecodeBase64At is like DecodeBase64 but also returns the offset in src of
+// the first byte that isn't valid Base64, len(src) if src ends part way
+// through a quantum, or -1 if src decoded.
+func DecodeBase64At(src, dst []byte) (n int, ok bool, errPos int) {
+	return decodeBase64(src, dst, false)
+}
+
+// DecodeBase64URL decodes the URL and filename safe Base64 from RFC 4648
+// section 5, with or without padding, into dst.  dst must be as large as it
+// is for DecodeBase64.
+func DecodeBase64URL(src, dst []byte) (n int, ok bool) {
+	n, ok, _ = decodeBase64(src, dst, true)
+	return n, ok
+}
+
+// DecodeBase64URLAt is like DecodeBase64URL but also returns the offset in
+// src of the first byte it could not decode, or -1.
+func DecodeBase64URLAt(src, dst []byte) (n int, ok bool, errPos int) {
+	return decodeBase64(src, dst, true)
+}
+
+func decodeBase64(data, dst []byte, url bool) (n int, ok bool, errPos int) {
+
+%% machine base64;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	var acc uint32
+	var k int
+
+	%%{
+	    include base64url "../patterns/base64url.rl";
+
+	    action upper { acc = acc<<6 | uint32(fc-'A') }
+	    action lower { acc = acc<<6 | uint32(fc-'a'+26) }
+	    action digit { acc = acc<<6 | uint32(fc-'0'+52) }
+	    action c62   { acc = acc<<6 | 62 }
+	    action c63   { acc = acc<<6 | 63 }
+
+	    action sextet {
+	        k++
+	        if k == 4 {
+	            dst[n], dst[n+1], dst[n+2] = byte(acc>>16), byte(acc>>8), byte(acc)
+	            n += 3
+	            acc, k = 0, 0
+	        }
+	    }
+
+	    std_char = ( upper $upper | lower $lower | digit $digit | '+' $c62 | '/' $c63 ) $sextet ;
+	    url_char = ( upper $upper | lower $lower | digit $digit | '-' $c62 | '_' $c63 ) $sextet ;
+
+	    main := std_char{4}* ( std_char{2} '==' | std_char{3} '=' )? ;
+
+	    # The unpadded grouping is the one JWT uses; the decoder only adds
+	    # the optional padding.
+	    url_padded = base64url_char{4}* ( base64url_char{2} '==' | base64url_char{3} '=' ) ;
+
+	    url := ( base64url | url_padded ) & ( url_char* '='* ) ;
+
+	    write init;
+	}%%
+
+	if url {
+		cs = base64_en_url
+	}
+
+	%% write exec;
+
+	if cs < base64_first_final {
+		return 0, false, p
+	}
+
+	// flush a final, padded, quantum
+	switch k {
+	case 2:
+		dst[n] = byte(acc >> 4)
+		n++
+	case 3:
+		dst[n], dst[n+1] = byte(acc>>10), byte(acc>>2)
+		n += 2
+	}
+
+	return n, true, -1
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math/rand"
+	"testing"
+)
+
+var payload = func() []byte {
+	b := make([]byte, 1024)
+	rand.New(rand.NewSource(1)).Read(b)
+	return b
+}()
+
+var (
+	data    = []byte(base64.StdEncoding.EncodeToString(payload))
+	urlData = []byte(base64.RawURLEncoding.EncodeToString(payload))
+)
+
+var hits int
+
+func TestDecodeBase64(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{in: "", want: "", ok: true},
+		{in: "Zg==", want: "f", ok: true},
+		{in: "Zm8=", want: "fo", ok: true},
+		{in: "Zm9v", want: "foo", ok: true},
+		{in: "Zm9vYmFy", want: "foobar", ok: true},
+		{in: "+/+/", want: "\xfb\xff\xbf", ok: true},
+
+		{in: "Zg"},
+		{in: "Zg="},
+		{in: "Zm8"},
+		{in: "Z==="},
+		{in: "Zg==Zg=="},
+		{in: "Zm9v\nYmFy"},
+		{in: "-_-_"},
+		{in: "Zm9v!"},
+	}
+
+	for _, tt := range tests {
+		dst := make([]byte, len(tt.in)*3/4)
+		n, ok := DecodeBase64([]byte(tt.in), dst)
+		if ok != tt.ok {
+			t.Errorf("DecodeBase64(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && string(dst[:n]) != tt.want {
+			t.Errorf("DecodeBase64(%q)=%q, want %q", tt.in, dst[:n], tt.want)
+		}
+	}
+}
+
+func TestDecodeBase64At(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{"aGVsbG8=", -1},
+		{"aGVsbG8", 7},
+		{"aGV*bG8=", 3},
+		{"aGVs bG8=", 4},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := DecodeBase64At([]byte(tt.in), make([]byte, len(tt.in)))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("DecodeBase64At(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestDecodeBase64URL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{in: "", want: "", ok: true},
+		{in: "Zg", want: "f", ok: true},
+		{in: "Zg==", want: "f", ok: true},
+		{in: "Zm8", want: "fo", ok: true},
+		{in: "Zm8=", want: "fo", ok: true},
+		{in: "-_-_", want: "\xfb\xff\xbf", ok: true},
+		{in: "eyJhbGciOiJIUzI1NiJ9", want: `{"alg":"HS256"}`, ok: true},
+
+		{in: "Z"},
+		{in: "Zg="},
+		{in: "Zm8=="},
+		{in: "Zg==Zg"},
+		{in: "+/+/"},
+	}
+
+	for _, tt := range tests {
+		dst := make([]byte, len(tt.in)*3/4)
+		n, ok := DecodeBase64URL([]byte(tt.in), dst)
+		if ok != tt.ok {
+			t.Errorf("DecodeBase64URL(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && string(dst[:n]) != tt.want {
+			t.Errorf("DecodeBase64URL(%q)=%q, want %q", tt.in, dst[:n], tt.want)
+		}
+	}
+}
+
+func TestDecodeBase64URLAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{"aGVsbG8", -1},
+		{"aGV/bG8", 3},
+		{"-_+/", 2},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := DecodeBase64URLAt([]byte(tt.in), make([]byte, len(tt.in)))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("DecodeBase64URLAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestBase64Alternatives(t *testing.T) {
+	dst := make([]byte, len(data)*3/4)
+	for i := 0; i <= len(payload); i += 7 {
+		want := payload[:i]
+
+		n, ok := DecodeBase64([]byte(base64.StdEncoding.EncodeToString(want)), dst)
+		if !ok || !bytes.Equal(dst[:n], want) {
+			t.Errorf("DecodeBase64 of %d bytes failed", i)
+		}
+		n, ok = DecodeBase64URL([]byte(base64.RawURLEncoding.EncodeToString(want)), dst)
+		if !ok || !bytes.Equal(dst[:n], want) {
+			t.Errorf("DecodeBase64URL of %d unpadded bytes failed", i)
+		}
+		n, ok = DecodeBase64URL([]byte(base64.URLEncoding.EncodeToString(want)), dst)
+		if !ok || !bytes.Equal(dst[:n], want) {
+			t.Errorf("DecodeBase64URL of %d padded bytes failed", i)
+		}
+	}
+}
+
+func BenchmarkStdlib(b *testing.B) {
+	dst := make([]byte, len(data)*3/4)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, err := base64.StdEncoding.Decode(dst, data); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	dst := make([]byte, len(data)*3/4)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := DecodeBase64(data, dst); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkStdlibURL(b *testing.B) {
+	dst := make([]byte, len(urlData)*3/4)
+	b.SetBytes(int64(len(urlData)))
+	for i := 0; i < b.N; i++ {
+		if _, err := base64.RawURLEncoding.Decode(dst, urlData); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagelURL(b *testing.B) {
+	dst := make([]byte, len(urlData)*3/4)
+	b.SetBytes(int64(len(urlData)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := DecodeBase64URL(urlData, dst); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Command compare runs the benchmarks for the examples and prints a
+// Markdown table of how each Ragel parser does against the alternatives it
+// is benchmarked with, flagging any where Ragel is slower.
+//
+// Usage:
+//
+//	go run ./cmd/compare [-count n] [-o file] [packages]
+//
+// The packages default to ./... .  The raw go test output can be saved
+// with -o and fed to benchstat.
+//
+// A BenchmarkXRagelY is compared with BenchmarkXRegexY if there is one,
+// and otherwise with the fastest other BenchmarkX...Y in the same package
+// that a more specific Ragel benchmark doesn't match: with BenchmarkRagel
+// and BenchmarkRagelJWE, BenchmarkSplitJWE only goes with the latter.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/perf/benchfmt"
+)
+
+func main() {
+	count := flag.Int("count", 1, "run each benchmark `n` times")
+	out := flag.String("o", "", "write the raw benchmark output to `file`")
+	flag.Parse()
+
+	pkgs := flag.Args()
+	if len(pkgs) == 0 {
+		pkgs = []string{"./..."}
+	}
+
+	args := append([]string{"test", "-run=^$", "-bench=.", "-benchmem", "-count=" + strconv.Itoa(*count)}, pkgs...)
+	cmd := exec.Command("go", args...)
+	cmd.Stderr = os.Stderr
+	raw, err := cmd.Output()
+	if *out != "" {
+		if err := os.WriteFile(*out, raw, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err != nil {
+		os.Stderr.Write(raw)
+		log.Fatalf("go test: %v", err)
+	}
+
+	rows, err := compare(bytes.NewReader(raw))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	writeTable(os.Stdout, rows)
+
+	var slower []string
+	for _, r := range rows {
+		if r.regression() {
+			slower = append(slower, r.pkg+" "+r.ragel)
+		}
+	}
+	if len(slower) > 0 {
+		fmt.Printf("\nRagel is slower than the alternative in: %s\n", strings.Join(slower, ", "))
+	}
+}
+
+// a benchmark's mean time over all its runs
+type bench struct {
+	name  string
+	total float64
+	runs  int
+}
+
+func (b *bench) nsPerOp() float64 { return b.total / float64(b.runs) }
+
+// row compares one Ragel benchmark with its alternative, if it has one.
+type row struct {
+	pkg     string
+	ragel   string
+	ragelNs float64
+	alt     string
+	altNs   float64
+}
+
+func (r row) speedup() float64 { return r.altNs / r.ragelNs }
+
+func (r row) regression() bool { return r.alt != "" && r.altNs < r.ragelNs }
+
+// compare reads go test -bench output and pairs up the Ragel benchmarks in
+// each package with their alternatives.  Rows are sorted by package, then
+// benchmark name.
+func compare(r io.Reader) ([]row, error) {
+	benches := map[string]map[string]*bench{}
+
+	br := benchfmt.NewReader(r, "go test")
+	for br.Scan() {
+		res, ok := br.Result().(*benchfmt.Result)
+		if !ok {
+			continue
+		}
+		ns, ok := nsPerOp(res)
+		if !ok {
+			continue
+		}
+		pkg, name := res.GetConfig("pkg"), string(res.Name.Base())
+		if benches[pkg] == nil {
+			benches[pkg] = map[string]*bench{}
+		}
+		b := benches[pkg][name]
+		if b == nil {
+			b = &bench{name: name}
+			benches[pkg][name] = b
+		}
+		b.total += ns
+		b.runs++
+	}
+	if err := br.Err(); err != nil {
+		return nil, err
+	}
+
+	var rows []row
+	for pkg, bs := range benches {
+		for name, b := range bs {
+			i := strings.Index(name, "Ragel")
+			if i < 0 {
+				continue
+			}
+			rw := row{pkg: pkg, ragel: name, ragelNs: b.nsPerOp()}
+			if alt := pickAlternative(bs, name[:i], name[i+len("Ragel"):]); alt != nil {
+				rw.alt, rw.altNs = alt.name, alt.nsPerOp()
+			}
+			rows = append(rows, rw)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].pkg != rows[j].pkg {
+			return rows[i].pkg < rows[j].pkg
+		}
+		return rows[i].ragel < rows[j].ragel
+	})
+
+	return rows, nil
+}
+
+func nsPerOp(res *benchfmt.Result) (float64, bool) {
+	for _, v := range res.Values {
+		if v.OrigUnit == "ns/op" {
+			return v.OrigValue, true
+		}
+	}
+	return 0, false
+}
+
+// pickAlternative finds the benchmark to compare prefix+"Ragel"+suffix
+// with.
+func pickAlternative(bs map[string]*bench, prefix, suffix string) *bench {
+	if b := bs[prefix+"Regex"+suffix]; b != nil {
+		return b
+	}
+
+	var best *bench
+	for name, b := range bs {
+		if strings.Contains(name, "Ragel") || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		if claimed(bs, name, len(prefix)+len(suffix)) {
+			continue
+		}
+		if best == nil || b.nsPerOp() < best.nsPerOp() || b.nsPerOp() == best.nsPerOp() && name < best.name {
+			best = b
+		}
+	}
+	return best
+}
+
+// claimed reports whether name also matches a Ragel benchmark whose prefix
+// and suffix are longer than n bytes together, so that a bare BenchmarkRagel
+// doesn't take BenchmarkSplitJWE away from BenchmarkRagelJWE.
+func claimed(bs map[string]*bench, name string, n int) bool {
+	for other := range bs {
+		i := strings.Index(other, "Ragel")
+		if i < 0 {
+			continue
+		}
+		prefix, suffix := other[:i], other[i+len("Ragel"):]
+		if len(prefix)+len(suffix) > n && strings.HasPrefix(name, prefix) && strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeTable(w io.Writer, rows []row) {
+	fmt.Fprintln(w, "| Example | Benchmark | Alternative | Alternative ns/op | Ragel ns/op | Speedup |")
+	fmt.Fprintln(w, "|---|---|---|---:|---:|---:|")
+	for _, r := range rows {
+		example := r.pkg[strings.LastIndex(r.pkg, "/")+1:]
+		if r.alt == "" {
+			fmt.Fprintf(w, "| %s | %s | - | - | %s | - |\n", example, r.ragel, formatNs(r.ragelNs))
+			continue
+		}
+		speedup := fmt.Sprintf("%.2fx", r.speedup())
+		if r.regression() {
+			speedup = "**" + speedup + " (slower)**"
+		}
+		fmt.Fprintf(w, "| %s | %s | %s | %s | %s | %s |\n", example, r.ragel, r.alt, formatNs(r.altNs), formatNs(r.ragelNs), speedup)
+	}
+}
+
+func formatNs(ns float64) string {
+	if ns >= 100 {
+		return strconv.FormatFloat(ns, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(ns, 'f', 2, 64)
+}
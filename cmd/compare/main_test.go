@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var output = `goos: linux
+goarch: amd64
+pkg: github.com/dgryski/ragel-examples/apache
+BenchmarkRegex-8    	  297796	      4000 ns/op	     800 B/op	       1 allocs/op
+BenchmarkRegex-8    	  297796	      4200 ns/op	     800 B/op	       1 allocs/op
+BenchmarkSscanf-8   	  199614	      6000 ns/op	     600 B/op	      18 allocs/op
+BenchmarkRagel-8    	 5000000	       200 ns/op	       0 B/op	       0 allocs/op
+BenchmarkRagel-8    	 5000000	       300 ns/op	       0 B/op	       0 allocs/op
+PASS
+ok  	github.com/dgryski/ragel-examples/apache	6.000s
+pkg: github.com/dgryski/ragel-examples/base64
+BenchmarkStdlib-8   	  597363	      1741 ns/op	 785.60 MB/s	       0 B/op	       0 allocs/op
+BenchmarkRagel-8    	  200404	      6247 ns/op	 218.97 MB/s	       0 B/op	       0 allocs/op
+PASS
+ok  	github.com/dgryski/ragel-examples/base64	4.000s
+pkg: github.com/dgryski/ragel-examples/ip
+BenchmarkNetParseIPv4-8     	20000000	        60 ns/op
+BenchmarkNetipParseAddrIPv4-8	30000000	        40 ns/op
+BenchmarkNetipCIDR-8        	30000000	        45 ns/op
+BenchmarkRagelIPv4-8        	50000000	        20 ns/op
+BenchmarkRagelCIDR-8        	40000000	        30 ns/op
+PASS
+ok  	github.com/dgryski/ragel-examples/ip	5.000s
+pkg: github.com/dgryski/ragel-examples/http
+BenchmarkRequestLineRegex-8 	 1000000	      1000 ns/op
+BenchmarkRequestLineSplit-8 	 5000000	       150 ns/op
+BenchmarkRequestLineRagel-8 	 8000000	       125 ns/op
+PASS
+ok  	github.com/dgryski/ragel-examples/http	3.000s
+pkg: github.com/dgryski/ragel-examples/jwt
+BenchmarkSplit-8            	 3000000	       300 ns/op
+BenchmarkSplitJWE-8         	20000000	        50 ns/op
+BenchmarkRagel-8            	10000000	       100 ns/op
+BenchmarkRagelJWE-8         	 5000000	       200 ns/op
+BenchmarkRagelJWK-8         	10000000	       150 ns/op
+PASS
+ok  	github.com/dgryski/ragel-examples/jwt	4.000s
+`
+
+func TestCompare(t *testing.T) {
+	rows, err := compare(strings.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []row{
+		{pkg: "github.com/dgryski/ragel-examples/apache", ragel: "Ragel", ragelNs: 250, alt: "Regex", altNs: 4100},
+		{pkg: "github.com/dgryski/ragel-examples/base64", ragel: "Ragel", ragelNs: 6247, alt: "Stdlib", altNs: 1741},
+		{pkg: "github.com/dgryski/ragel-examples/http", ragel: "RequestLineRagel", ragelNs: 125, alt: "RequestLineRegex", altNs: 1000},
+		{pkg: "github.com/dgryski/ragel-examples/ip", ragel: "RagelCIDR", ragelNs: 30, alt: "NetipCIDR", altNs: 45},
+		{pkg: "github.com/dgryski/ragel-examples/ip", ragel: "RagelIPv4", ragelNs: 20, alt: "NetipParseAddrIPv4", altNs: 40},
+		{pkg: "github.com/dgryski/ragel-examples/jwt", ragel: "Ragel", ragelNs: 100, alt: "Split", altNs: 300},
+		{pkg: "github.com/dgryski/ragel-examples/jwt", ragel: "RagelJWE", ragelNs: 200, alt: "SplitJWE", altNs: 50},
+		{pkg: "github.com/dgryski/ragel-examples/jwt", ragel: "RagelJWK", ragelNs: 150},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("compare=%+v\nwant %+v", rows, want)
+	}
+
+	var buf bytes.Buffer
+	writeTable(&buf, rows)
+	table := `| Example | Benchmark | Alternative | Alternative ns/op | Ragel ns/op | Speedup |
+|---|---|---|---:|---:|---:|
+| apache | Ragel | Regex | 4100 | 250 | 16.40x |
+| base64 | Ragel | Stdlib | 1741 | 6247 | **0.28x (slower)** |
+| http | RequestLineRagel | RequestLineRegex | 1000 | 125 | 8.00x |
+| ip | RagelCIDR | NetipCIDR | 45.00 | 30.00 | 1.50x |
+| ip | RagelIPv4 | NetipParseAddrIPv4 | 40.00 | 20.00 | 2.00x |
+| jwt | Ragel | Split | 300 | 100 | 3.00x |
+| jwt | RagelJWE | SplitJWE | 50.00 | 200 | **0.25x (slower)** |
+| jwt | RagelJWK | - | - | 150 | - |
+`
+	if buf.String() != table {
+		t.Errorf("writeTable=\n%s\nwant\n%s", buf.String(), table)
+	}
+}
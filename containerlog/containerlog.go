@@ -0,0 +1,1086 @@
+
+//line containerlog.rl:1
+package main
+
+import "time"
+
+// ContainerLogFormat says which runtime's timestamps a line was written
+// with.
+type ContainerLogFormat int
+
+const (
+	// DockerLog timestamps are in UTC with a 'Z' suffix.
+	DockerLog ContainerLogFormat = iota
+	// CRIOLog timestamps end in a numeric offset such as +00:00.
+	CRIOLog
+)
+
+// ContainerLogEntry is a line from a container log file as kept by the
+// kubelet.  Stream and Message point into the parsed line.
+type ContainerLogEntry struct {
+	Format    ContainerLogFormat
+	Timestamp time.Time
+	Stream    []byte
+	Flags     byte
+	Message   []byte
+}
+
+// zones holds a fixed zone for every quarter-hour offset, which covers all
+// offsets in use, so that ParseContainerLog needn't allocate one per line.
+var zones = func() (z [2*24*4 + 1]*time.Location) {
+	for i := range z {
+		z[i] = time.FixedZone("", (i-24*4)*15*60)
+	}
+	return z
+}()
+
+// ParseContainerLog parses a line, without its trailing newline, such as
+//
+//	2024-01-18T06:41:30.123456789Z stdout F log message here
+//
+// The timestamp is RFC 3339 with up to nine digits of fractional seconds.
+// The stream is stdout or stderr, and Flags is 'F' for a full line or 'P'
+// for one the runtime split because it was too long, with the rest to
+// follow on the next line.
+func ParseContainerLog(data []byte) (ContainerLogEntry, bool) {
+	e, ok, _ := ParseContainerLogAt(data)
+	return e, ok
+}
+
+// ParseContainerLogAt is like ParseContainerLog but also returns the offset
+// of the first byte that is out of place, or -1 if the line parsed.  A date
+// that doesn't exist, such as February 30th, fails at the day.
+func ParseContainerLogAt(data []byte) (ContainerLogEntry, bool, int) {
+
+
+//line containerlog.rl:54
+
+//line containerlog.go:59
+const containerlog_start int = 1
+const containerlog_first_final int = 53
+const containerlog_error int = 0
+
+const containerlog_en_main int = 1
+
+
+//line containerlog.rl:55
+
+	var e ContainerLogEntry
+
+	var year, month, day, hour, minute, sec, nsec int
+	var offset, offHour, offMin int
+
+	scale := 100000000
+	sign := 1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line containerlog.go:82
+	{
+	cs = containerlog_start
+	}
+
+//line containerlog.go:87
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	}
+	goto st_out
+	st_case_1:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line containerlog.rl:70
+ year = year*10 + int((data[p])-'0') 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line containerlog.go:225
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr2
+		}
+		goto st0
+tr2:
+//line containerlog.rl:70
+ year = year*10 + int((data[p])-'0') 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line containerlog.go:239
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr3
+		}
+		goto st0
+tr3:
+//line containerlog.rl:70
+ year = year*10 + int((data[p])-'0') 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line containerlog.go:253
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr4
+		}
+		goto st0
+tr4:
+//line containerlog.rl:70
+ year = year*10 + int((data[p])-'0') 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line containerlog.go:267
+		if data[p] == 45 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 48:
+			goto tr6
+		case 49:
+			goto tr7
+		}
+		goto st0
+tr6:
+//line containerlog.rl:71
+ month = month*10 + int((data[p])-'0') 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line containerlog.go:293
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+tr8:
+//line containerlog.rl:71
+ month = month*10 + int((data[p])-'0') 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line containerlog.go:307
+		if data[p] == 45 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 48:
+			goto tr10
+		case 51:
+			goto tr12
+		}
+		if 49 <= data[p] && data[p] <= 50 {
+			goto tr11
+		}
+		goto st0
+tr10:
+//line containerlog.rl:72
+ day = day*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line containerlog.go:336
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr13
+		}
+		goto st0
+tr13:
+//line containerlog.rl:72
+ day = day*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line containerlog.go:350
+		if data[p] == 84 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 50 {
+			goto tr16
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line containerlog.rl:73
+ hour = hour*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line containerlog.go:376
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr17
+		}
+		goto st0
+tr17:
+//line containerlog.rl:73
+ hour = hour*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line containerlog.go:390
+		if data[p] == 58 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr19
+		}
+		goto st0
+tr19:
+//line containerlog.rl:74
+ minute = minute*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line containerlog.go:413
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line containerlog.rl:74
+ minute = minute*10 + int((data[p])-'0') 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line containerlog.go:427
+		if data[p] == 58 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr22
+		}
+		goto st0
+tr22:
+//line containerlog.rl:75
+ sec = sec*10 + int((data[p])-'0') 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line containerlog.go:450
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr23
+		}
+		goto st0
+tr23:
+//line containerlog.rl:75
+ sec = sec*10 + int((data[p])-'0') 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line containerlog.go:464
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 46:
+			goto st38
+		case 90:
+			goto tr27
+		}
+		goto st0
+tr25:
+//line containerlog.rl:79
+ sign = -1 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line containerlog.go:485
+		if data[p] == 50 {
+			goto tr29
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr28
+		}
+		goto st0
+tr28:
+//line containerlog.rl:77
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line containerlog.go:502
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr30
+		}
+		goto st0
+tr30:
+//line containerlog.rl:77
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line containerlog.go:516
+		if data[p] == 58 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr32
+		}
+		goto st0
+tr32:
+//line containerlog.rl:78
+ offMin = offMin*10 + int((data[p])-'0') 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line containerlog.go:539
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr33
+		}
+		goto st0
+tr33:
+//line containerlog.rl:78
+ offMin = offMin*10 + int((data[p])-'0') 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line containerlog.go:553
+		if data[p] == 32 {
+			goto tr34
+		}
+		goto st0
+tr34:
+//line containerlog.rl:80
+ offset = sign * (offHour*3600 + offMin*60) 
+//line containerlog.rl:82
+ e.Format = CRIOLog 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line containerlog.go:569
+		if data[p] == 115 {
+			goto tr35
+		}
+		goto st0
+tr35:
+//line containerlog.rl:69
+ mark = p 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line containerlog.go:583
+		if data[p] == 116 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 100 {
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 101:
+			goto st31
+		case 111:
+			goto st35
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if data[p] == 114 {
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 114 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 32 {
+			goto tr42
+		}
+		goto st0
+tr42:
+//line containerlog.rl:83
+ e.Stream = data[mark:p] 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line containerlog.go:645
+		switch data[p] {
+		case 70:
+			goto tr43
+		case 80:
+			goto tr43
+		}
+		goto st0
+tr43:
+//line containerlog.rl:84
+ e.Flags = (data[p]) 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line containerlog.go:662
+		if data[p] == 32 {
+			goto st54
+		}
+		goto st0
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		goto tr56
+tr56:
+//line containerlog.rl:69
+ mark = p 
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line containerlog.go:682
+		goto st55
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 117 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 116 {
+			goto st33
+		}
+		goto st0
+tr29:
+//line containerlog.rl:77
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line containerlog.go:711
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr30
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr45
+		}
+		goto st0
+tr45:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line containerlog.go:734
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr46
+		}
+		goto st0
+tr46:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line containerlog.go:756
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr47
+		}
+		goto st0
+tr47:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line containerlog.go:778
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr48
+		}
+		goto st0
+tr48:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line containerlog.go:800
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr49
+		}
+		goto st0
+tr49:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line containerlog.go:822
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr50
+		}
+		goto st0
+tr50:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line containerlog.go:844
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr51
+		}
+		goto st0
+tr51:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line containerlog.go:866
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr52
+		}
+		goto st0
+tr52:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line containerlog.go:888
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr53
+		}
+		goto st0
+tr53:
+//line containerlog.rl:76
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line containerlog.go:910
+		switch data[p] {
+		case 43:
+			goto st21
+		case 45:
+			goto tr25
+		case 90:
+			goto tr27
+		}
+		goto st0
+tr27:
+//line containerlog.rl:81
+ e.Format = DockerLog 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line containerlog.go:929
+		if data[p] == 32 {
+			goto st27
+		}
+		goto st0
+tr16:
+//line containerlog.rl:73
+ hour = hour*10 + int((data[p])-'0') 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line containerlog.go:943
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr17
+		}
+		goto st0
+tr11:
+//line containerlog.rl:72
+ day = day*10 + int((data[p])-'0') 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line containerlog.go:957
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr13
+		}
+		goto st0
+tr12:
+//line containerlog.rl:72
+ day = day*10 + int((data[p])-'0') 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line containerlog.go:971
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr13
+		}
+		goto st0
+tr7:
+//line containerlog.rl:71
+ month = month*10 + int((data[p])-'0') 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line containerlog.go:985
+		if 48 <= data[p] && data[p] <= 50 {
+			goto tr8
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 54:
+//line containerlog.rl:69
+ mark = p 
+//line containerlog.rl:85
+ e.Message = data[mark:p] 
+		case 55:
+//line containerlog.rl:85
+ e.Message = data[mark:p] 
+//line containerlog.go:1057
+		}
+	}
+
+	_out: {}
+	}
+
+//line containerlog.rl:103
+
+
+	if cs < containerlog_first_final {
+		return ContainerLogEntry{}, false, p
+	}
+
+	loc := time.UTC
+	switch {
+	case offset == 0:
+	case offset%(15*60) == 0:
+		loc = zones[offset/(15*60)+24*4]
+	default:
+		loc = time.FixedZone("", offset)
+	}
+
+	e.Timestamp = time.Date(year, time.Month(month), day, hour, minute, sec, nsec, loc)
+	if e.Timestamp.Day() != day {
+		// February 30th and friends
+		return ContainerLogEntry{}, false, len("2006-01-")
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,125 @@
+package main
+
+import "time"
+
+// ContainerLogFormat says which runtime's timestamps a line was written
+// with.
+type ContainerLogFormat int
+
+const (
+	// DockerLog timestamps are in UTC with a 'Z' suffix.
+	DockerLog ContainerLogFormat = iota
+	// CRIOLog timestamps end in a numeric offset such as +00:00.
+	CRIOLog
+)
+
+// ContainerLogEntry is a line from a container log file as kept by the
+// kubelet.  Stream and Message point into the parsed line.
+type ContainerLogEntry struct {
+	Format    ContainerLogFormat
+	Timestamp time.Time
+	Stream    []byte
+	Flags     byte
+	Message   []byte
+}
+
+// zones holds a fixed zone for every quarter-hour offset, which covers all
+// offsets in use, so that ParseContainerLog needn't allocate one per line.
+var zones = func() (z [2*24*4 + 1]*time.Location) {
+	for i := range z {
+		z[i] = time.FixedZone("", (i-24*4)*15*60)
+	}
+	return z
+}()
+
+// ParseContainerLog parses a line, without its trailing newline, such as
+//
+//	2024-01-18T06:41:30.123456789Z stdout F log message here
+//
+// The timestamp is RFC 3339 with up to nine digits of fractional seconds.
+// The stream is stdout or stderr, and Flags is 'F' for a full line or 'P'
+// for one the runtime split because it was too long, with the rest to
+// follow on the next line.
+func ParseContainerLog(data []byte) (ContainerLogEntry, bool) {
+	e, ok, _ := ParseContainerLogAt(data)
+	return e, ok
+}
+
+// ParseContainerLogAt is like ParseContainerLog but also returns the offset
+// of the first byte that is out of place, or -1 if the line parsed.  A date
+// that doesn't exist, such as February 30th, fails at the day.
+func ParseContainerLogAt(data []byte) (ContainerLogEntry, bool, int) {
+
+%% machine containerlog;
+%% write data;
+
+	var e ContainerLogEntry
+
+	var year, month, day, hour, minute, sec, nsec int
+	var offset, offHour, offMin int
+
+	scale := 100000000
+	sign := 1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark     { mark = p }
+	    action year     { year = year*10 + int(fc-'0') }
+	    action month    { month = month*10 + int(fc-'0') }
+	    action day      { day = day*10 + int(fc-'0') }
+	    action hour     { hour = hour*10 + int(fc-'0') }
+	    action minute   { minute = minute*10 + int(fc-'0') }
+	    action sec      { sec = sec*10 + int(fc-'0') }
+	    action nsec     { nsec += int(fc-'0') * scale; scale /= 10 }
+	    action off_hour { offHour = offHour*10 + int(fc-'0') }
+	    action off_min  { offMin = offMin*10 + int(fc-'0') }
+	    action negative { sign = -1 }
+	    action offset   { offset = sign * (offHour*3600 + offMin*60) }
+	    action docker   { e.Format = DockerLog }
+	    action crio     { e.Format = CRIOLog }
+	    action stream   { e.Stream = data[mark:p] }
+	    action flags    { e.Flags = fc }
+	    action message  { e.Message = data[mark:p] }
+
+	    date = digit{4} $year '-' ( '0' '1'..'9' | '1' '0'..'2' ) $month '-' ( '0' '1'..'9' | '1'..'2' digit | '3' '0'..'1' ) $day ;
+
+	    time = ( '0'..'1' digit | '2' '0'..'3' ) $hour ':' ( '0'..'5' digit ) $minute ':' ( '0'..'5' digit ) $sec ( '.' digit{1,9} $nsec )? ;
+
+	    off_hour = ( '0'..'1' digit | '2' '0'..'3' ) $off_hour ;
+	    off_minute = ( '0'..'5' digit ) $off_min ;
+	    numoffset = ( '+' | '-' @negative ) off_hour ':' off_minute ;
+
+	    zone = 'Z' @docker | numoffset %offset %crio ;
+
+	    stream = 'stdout' | 'stderr' ;
+
+	    main := date 'T' time zone ' ' stream >mark %stream ' ' [PF] @flags ( ' ' any* >mark %message )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < containerlog_first_final {
+		return ContainerLogEntry{}, false, p
+	}
+
+	loc := time.UTC
+	switch {
+	case offset == 0:
+	case offset%(15*60) == 0:
+		loc = zones[offset/(15*60)+24*4]
+	default:
+		loc = time.FixedZone("", offset)
+	}
+
+	e.Timestamp = time.Date(year, time.Month(month), day, hour, minute, sec, nsec, loc)
+	if e.Timestamp.Day() != day {
+		// February 30th and friends
+		return ContainerLogEntry{}, false, len("2006-01-")
+	}
+
+	return e, true, -1
+}
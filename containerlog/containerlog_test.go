@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var data = []byte("2024-01-18T06:41:30.123456789Z stdout F log message here")
+
+var hits int
+
+func TestParseContainerLog(t *testing.T) {
+	plus530 := time.FixedZone("", 5*3600+30*60)
+
+	tests := []struct {
+		line string
+		want ContainerLogEntry
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: ContainerLogEntry{
+				Format:    DockerLog,
+				Timestamp: time.Date(2024, 1, 18, 6, 41, 30, 123456789, time.UTC),
+				Stream:    []byte("stdout"),
+				Flags:     'F',
+				Message:   []byte("log message here"),
+			},
+			ok: true,
+		},
+		{
+			line: "2024-01-18T06:41:30.123456789+00:00 stdout F log message",
+			want: ContainerLogEntry{
+				Format:    CRIOLog,
+				Timestamp: time.Date(2024, 1, 18, 6, 41, 30, 123456789, time.UTC),
+				Stream:    []byte("stdout"),
+				Flags:     'F',
+				Message:   []byte("log message"),
+			},
+			ok: true,
+		},
+		{
+			line: "2024-02-29T23:59:59.5+05:30 stderr P first half of a long line ",
+			want: ContainerLogEntry{
+				Format:    CRIOLog,
+				Timestamp: time.Date(2024, 2, 29, 23, 59, 59, 500000000, plus530),
+				Stream:    []byte("stderr"),
+				Flags:     'P',
+				Message:   []byte("first half of a long line "),
+			},
+			ok: true,
+		},
+		{
+			line: "2024-01-18T06:41:30Z stdout F ",
+			want: ContainerLogEntry{
+				Timestamp: time.Date(2024, 1, 18, 6, 41, 30, 0, time.UTC),
+				Stream:    []byte("stdout"),
+				Flags:     'F',
+				Message:   []byte(""),
+			},
+			ok: true,
+		},
+		{
+			line: "2024-01-18T06:41:30Z stdout F",
+			want: ContainerLogEntry{
+				Timestamp: time.Date(2024, 1, 18, 6, 41, 30, 0, time.UTC),
+				Stream:    []byte("stdout"),
+				Flags:     'F',
+			},
+			ok: true,
+		},
+
+		{line: "2024-01-18T06:41:30.123456789Z stdin F message"},
+		{line: "2024-01-18T06:41:30.123456789Z stdout X message"},
+		{line: "2024-01-18T06:41:30.123456789Z stdout F:P message"},
+		{line: "2024-01-18T06:41:30.123456789 stdout F message"},
+		{line: "2024-01-18T06:41:30.1234567890Z stdout F message"},
+		{line: "2024-01-18T06:41:30.Z stdout F message"},
+		{line: "2024-01-18T06:41:30+0000 stdout F message"},
+		{line: "2024-01-18 06:41:30Z stdout F message"},
+		{line: "2023-02-29T06:41:30Z stdout F message"},
+		{line: "2024-01-18T24:41:30Z stdout F message"},
+		{line: "2024-01-18T06:41:30Z  stdout F message"},
+		{line: `{"log":"message\n","stream":"stdout","time":"2024-01-18T06:41:30.123456789Z"}`},
+		{line: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseContainerLog([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseContainerLog(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseContainerLog(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseContainerLogAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"2024-02-30T06:41:30Z stdout F no such day", 8},
+		{"2024-01-18T06:41:30Z stdin F log", 24},
+		{"2024-01-18T06:41:30Z stdout X log", 28},
+		{"2024-01-18T06:41:30Z stdout", 27},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseContainerLogAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseContainerLogAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// corpus is 100k lines alternating between the two timestamp formats and
+// streams, with messages of varying length.
+var corpus = func() []byte {
+	var buf bytes.Buffer
+	start := time.Date(2024, 1, 18, 6, 41, 30, 0, time.UTC)
+	for i := 0; i < 100000; i++ {
+		ts := start.Add(time.Duration(i) * 1234567 * time.Nanosecond)
+		stream, flags := "stdout", 'F'
+		if i%7 == 0 {
+			stream = "stderr"
+		}
+		if i%11 == 0 {
+			flags = 'P'
+		}
+		msg := fmt.Sprintf("GET /api/v1/items/%d 200 %dms", i, i%500)
+		if i%2 == 0 {
+			fmt.Fprintf(&buf, "%s %s %c %s\n", ts.Format(time.RFC3339Nano), stream, flags, msg)
+		} else {
+			fmt.Fprintf(&buf, "%s %s %c %s\n", ts.Format("2006-01-02T15:04:05.999999999-07:00"), stream, flags, msg)
+		}
+	}
+	return buf.Bytes()
+}()
+
+func lines(data []byte, parse func([]byte) (ContainerLogEntry, bool)) (int, bool) {
+	var n int
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			i = len(data)
+		}
+		if _, ok := parse(data[:i]); !ok {
+			return 0, false
+		}
+		n++
+		data = data[min(i+1, len(data)):]
+	}
+	return n, true
+}
+
+// splitContainerLog is how this tends to be written by hand.
+func splitContainerLog(line []byte) (ContainerLogEntry, bool) {
+	f := bytes.SplitN(line, []byte(" "), 4)
+	if len(f) < 3 {
+		return ContainerLogEntry{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, string(f[0]))
+	if err != nil {
+		return ContainerLogEntry{}, false
+	}
+	if !bytes.Equal(f[1], []byte("stdout")) && !bytes.Equal(f[1], []byte("stderr")) {
+		return ContainerLogEntry{}, false
+	}
+	if len(f[2]) != 1 || (f[2][0] != 'F' && f[2][0] != 'P') {
+		return ContainerLogEntry{}, false
+	}
+	e := ContainerLogEntry{Timestamp: ts, Stream: f[1], Flags: f[2][0]}
+	if f[0][len(f[0])-1] != 'Z' {
+		e.Format = CRIOLog
+	}
+	if len(f) == 4 {
+		e.Message = f[3]
+	}
+	return e, true
+}
+
+func TestContainerLogAlternatives(t *testing.T) {
+	for i, line := range bytes.SplitN(corpus, []byte("\n"), 1001)[:1000] {
+		want, ok := ParseContainerLog(line)
+		if !ok || want.Format != ContainerLogFormat(i%2) {
+			t.Fatalf("ParseContainerLog(%q)=%+v, %v", line, want, ok)
+		}
+		got, ok := splitContainerLog(line)
+		if !ok || !got.Timestamp.Equal(want.Timestamp) {
+			t.Fatalf("splitContainerLog(%q)=%+v, want %+v", line, got, want)
+		}
+		got.Timestamp = want.Timestamp
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("splitContainerLog(%q)=%+v, want %+v", line, got, want)
+		}
+	}
+
+	if n, ok := lines(corpus, ParseContainerLog); !ok || n != 100000 {
+		t.Errorf("ParseContainerLog read %d lines of corpus, %v, want 100000", n, ok)
+	}
+}
+
+func BenchmarkSplit(b *testing.B) {
+	b.SetBytes(int64(len(corpus)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := lines(corpus, splitContainerLog); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	b.SetBytes(int64(len(corpus)))
+	for i := 0; i < b.N; i++ {
+		if _, ok := lines(corpus, ParseContainerLog); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,412 @@
+
+//line cookie.rl:1
+package main
+
+// ParseCookieHeader calls fn with the name and value of each cookie in the
+// value of a Cookie request header, in order.  Cookie names are tokens and
+// values are made of the cookie-octets from RFC 6265 section 4.1.1, which
+// allow '=' but not spaces, commas, or semicolons.  Values may be wrapped in
+// double quotes, which are removed.  Whitespace is allowed around the
+// semicolons and a trailing semicolon is ignored.  Both name and value
+// point into data.
+//
+// ParseCookieHeader reports whether the whole header was well-formed; fn
+// will already have seen the cookies before any error.
+func ParseCookieHeader(data []byte, fn func(name, value []byte)) bool {
+	ok, _ := ParseCookieHeaderAt(data, fn)
+	return ok
+}
+
+// ParseCookieHeaderAt is like ParseCookieHeader but also returns the offset
+// of the first malformed byte, or -1 if the header was well-formed.
+func ParseCookieHeaderAt(data []byte, fn func(name, value []byte)) (ok bool, errPos int) {
+
+
+//line cookie.rl:23
+
+//line cookie.go:28
+const cookie_start int = 4
+const cookie_first_final int = 4
+const cookie_error int = 0
+
+const cookie_en_main int = 4
+
+
+//line cookie.rl:24
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name, value []byte
+
+	
+//line cookie.go:44
+	{
+	cs = cookie_start
+	}
+
+//line cookie.go:49
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 4:
+		goto st_case_4
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 8:
+		goto st_case_8
+	}
+	goto st_out
+tr12:
+//line cookie.rl:31
+ mark = p 
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+	goto st4
+tr16:
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+	goto st4
+tr18:
+//line cookie.rl:34
+ fn(name, value) 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line cookie.go:98
+		switch data[p] {
+		case 9:
+			goto st4
+		case 32:
+			goto st4
+		case 33:
+			goto tr8
+		case 124:
+			goto tr8
+		case 126:
+			goto tr8
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr8
+				}
+			case data[p] >= 35:
+				goto tr8
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr8
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr8
+				}
+			default:
+				goto tr8
+			}
+		default:
+			goto tr8
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr8:
+//line cookie.rl:31
+ mark = p 
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line cookie.go:151
+		switch data[p] {
+		case 33:
+			goto st1
+		case 61:
+			goto tr2
+		case 124:
+			goto st1
+		case 126:
+			goto st1
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st1
+				}
+			case data[p] >= 35:
+				goto st1
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st1
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st1
+				}
+			default:
+				goto st1
+			}
+		default:
+			goto st1
+		}
+		goto st0
+tr2:
+//line cookie.rl:32
+ name = data[mark:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line cookie.go:198
+		switch data[p] {
+		case 9:
+			goto tr9
+		case 32:
+			goto tr9
+		case 34:
+			goto st2
+		case 59:
+			goto tr12
+		}
+		switch {
+		case data[p] < 45:
+			if 33 <= data[p] && data[p] <= 43 {
+				goto tr10
+			}
+		case data[p] > 91:
+			if 93 <= data[p] && data[p] <= 126 {
+				goto tr10
+			}
+		default:
+			goto tr10
+		}
+		goto st0
+tr9:
+//line cookie.rl:31
+ mark = p 
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+	goto st6
+tr14:
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+	goto st6
+tr17:
+//line cookie.rl:34
+ fn(name, value) 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line cookie.go:245
+		switch data[p] {
+		case 9:
+			goto st6
+		case 32:
+			goto st6
+		case 59:
+			goto st4
+		}
+		goto st0
+tr10:
+//line cookie.rl:31
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line cookie.go:264
+		switch data[p] {
+		case 9:
+			goto tr14
+		case 32:
+			goto tr14
+		case 33:
+			goto st7
+		case 59:
+			goto tr16
+		}
+		switch {
+		case data[p] < 45:
+			if 35 <= data[p] && data[p] <= 43 {
+				goto st7
+			}
+		case data[p] > 91:
+			if 93 <= data[p] && data[p] <= 126 {
+				goto st7
+			}
+		default:
+			goto st7
+		}
+		goto st0
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 34 {
+			goto tr4
+		}
+		switch {
+		case data[p] < 45:
+			if 33 <= data[p] && data[p] <= 43 {
+				goto tr3
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 93 <= data[p] && data[p] <= 126 {
+					goto tr3
+				}
+			case data[p] >= 60:
+				goto tr3
+			}
+		default:
+			goto tr3
+		}
+		goto st0
+tr3:
+//line cookie.rl:31
+ mark = p 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line cookie.go:323
+		if data[p] == 34 {
+			goto tr6
+		}
+		switch {
+		case data[p] < 45:
+			if 33 <= data[p] && data[p] <= 43 {
+				goto st3
+			}
+		case data[p] > 58:
+			switch {
+			case data[p] > 91:
+				if 93 <= data[p] && data[p] <= 126 {
+					goto st3
+				}
+			case data[p] >= 60:
+				goto st3
+			}
+		default:
+			goto st3
+		}
+		goto st0
+tr4:
+//line cookie.rl:31
+ mark = p 
+//line cookie.rl:33
+ value = data[mark:p] 
+	goto st8
+tr6:
+//line cookie.rl:33
+ value = data[mark:p] 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line cookie.go:360
+		switch data[p] {
+		case 9:
+			goto tr17
+		case 32:
+			goto tr17
+		case 59:
+			goto tr18
+		}
+		goto st0
+	st_out:
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 5:
+//line cookie.rl:31
+ mark = p 
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+		case 7:
+//line cookie.rl:33
+ value = data[mark:p] 
+//line cookie.rl:34
+ fn(name, value) 
+		case 8:
+//line cookie.rl:34
+ fn(name, value) 
+//line cookie.go:398
+		}
+	}
+
+	_out: {}
+	}
+
+//line cookie.rl:52
+
+
+	if cs < cookie_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
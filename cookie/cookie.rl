@@ -0,0 +1,59 @@
+package main
+
+// ParseCookieHeader calls fn with the name and value of each cookie in the
+// value of a Cookie request header, in order.  Cookie names are tokens and
+// values are made of the cookie-octets from RFC 6265 section 4.1.1, which
+// allow '=' but not spaces, commas, or semicolons.  Values may be wrapped in
+// double quotes, which are removed.  Whitespace is allowed around the
+// semicolons and a trailing semicolon is ignored.  Both name and value
+// point into data.
+//
+// ParseCookieHeader reports whether the whole header was well-formed; fn
+// will already have seen the cookies before any error.
+func ParseCookieHeader(data []byte, fn func(name, value []byte)) bool {
+	ok, _ := ParseCookieHeaderAt(data, fn)
+	return ok
+}
+
+// ParseCookieHeaderAt is like ParseCookieHeader but also returns the offset
+// of the first malformed byte, or -1 if the header was well-formed.
+func ParseCookieHeaderAt(data []byte, fn func(name, value []byte)) (ok bool, errPos int) {
+
+%% machine cookie;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name, value []byte
+
+	%%{
+	    action mark  { mark = p }
+	    action name  { name = data[mark:p] }
+	    action value { value = data[mark:p] }
+	    action pair  { fn(name, value) }
+
+	    tchar = alnum | [!#$%&'*+\-.\^_`|~] ;
+	    token = tchar+ ;
+
+	    cookie_octet = 0x21 | 0x23..0x2b | 0x2d..0x3a | 0x3c..0x5b | 0x5d..0x7e ;
+
+	    value = cookie_octet* >mark %value
+	          | '"' cookie_octet* >mark %value '"' ;
+
+	    pair = token >mark %name '=' value ;
+
+	    ows = [ \t]* ;
+
+	    main := ows ( pair %pair ( ows ';' ows pair %pair )* ( ows ';' )? )? ows ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < cookie_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
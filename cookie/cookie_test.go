@@ -0,0 +1,118 @@
+package main
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+var data = []byte(`_ga=GA1.2.1234567890.1700000000; session=eyJ1c2VyIjoxfQ==; theme="dark"; consent=; lang=en-US`)
+
+var hits int
+
+type cookie struct {
+	name, value string
+}
+
+func collect(header []byte) ([]cookie, bool) {
+	var cookies []cookie
+	ok := ParseCookieHeader(header, func(name, value []byte) {
+		cookies = append(cookies, cookie{string(name), string(value)})
+	})
+	return cookies, ok
+}
+
+func TestParseCookieHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []cookie
+		ok     bool
+	}{
+		{
+			header: string(data),
+			want: []cookie{
+				{"_ga", "GA1.2.1234567890.1700000000"},
+				{"session", "eyJ1c2VyIjoxfQ=="},
+				{"theme", "dark"},
+				{"consent", ""},
+				{"lang", "en-US"},
+			},
+			ok: true,
+		},
+		{header: "a=1", want: []cookie{{"a", "1"}}, ok: true},
+		{header: `a=""`, want: []cookie{{"a", ""}}, ok: true},
+		{header: "a=b=c;d==", want: []cookie{{"a", "b=c"}, {"d", "="}}, ok: true},
+		{header: "  a=1 ;b=2\t;  c=3 ;  ", want: []cookie{{"a", "1"}, {"b", "2"}, {"c", "3"}}, ok: true},
+		{header: "", ok: true},
+
+		{header: "a=1; b=has space", want: []cookie{{"a", "1"}, {"b", "has"}}},
+		{header: "a=1,b=2"},
+		{header: `a="unterminated`},
+		{header: `a=x"y"`},
+		{header: `a=\x`},
+		{header: "noequals"},
+		{header: "=value"},
+		{header: "na(me)=1"},
+		{header: "a=1;; b=2", want: []cookie{{"a", "1"}}},
+	}
+
+	for _, tt := range tests {
+		got, ok := collect([]byte(tt.header))
+		if ok != tt.ok {
+			t.Errorf("ParseCookieHeader(%q) ok=%v, want %v", tt.header, ok, tt.ok)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCookieHeader(%q)=%q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestParseCookieHeaderAt(t *testing.T) {
+	tests := []struct {
+		header string
+		errPos int
+	}{
+		{string(data), -1},
+		{"a=1; =2", 5},
+		{`a="1`, 4},
+		{"a b=1", 1},
+	}
+
+	for _, tt := range tests {
+		ok, errPos := ParseCookieHeaderAt([]byte(tt.header), func(name, value []byte) {})
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseCookieHeaderAt(%q)=%v, %d, want errPos %d", tt.header, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func cookieRequest(header []byte) *http.Request {
+	return &http.Request{Header: http.Header{"Cookie": {string(header)}}}
+}
+
+func TestCookieAlternatives(t *testing.T) {
+	want, _ := collect(data)
+
+	var got []cookie
+	for _, c := range cookieRequest(data).Cookies() {
+		got = append(got, cookie{c.Name, c.Value})
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("http.Request.Cookies=%q, want %q", got, want)
+	}
+}
+
+func BenchmarkStdlib(b *testing.B) {
+	req := cookieRequest(data)
+	for i := 0; i < b.N; i++ {
+		hits += len(req.Cookies())
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	fn := func(name, value []byte) { hits++ }
+	for i := 0; i < b.N; i++ {
+		ParseCookieHeader(data, fn)
+	}
+}
@@ -0,0 +1,2808 @@
+
+//line cron.rl:1
+package main
+
+// CronExpr is a parsed cron schedule.  Each field is a bit set with bit i
+// set if the field matches the value i, so Month uses bits 1 to 12.  A
+// day-of-week of 7 is folded into 0, both meaning Sunday.
+type CronExpr struct {
+	Minute     uint64
+	Hour       uint64
+	DayOfMonth uint64
+	Month      uint64
+	DayOfWeek  uint64
+}
+
+// the values a '*' stands for in each field
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// ParseCron parses a standard five-field cron expression such as
+// "*/5 0 * * 1-5".  Each field is a comma-separated list of "*", a value,
+// or a range "a-b", any of which may be followed by a step "/n"; a value
+// with a step runs to the end of the field's range, as in "5/15".  The
+// @yearly, @annually, @monthly, @weekly, @daily, @midnight, and @hourly
+// aliases are also accepted.  Month and day names are not.
+func ParseCron(data []byte) (CronExpr, bool) {
+	c, ok, _ := ParseCronAt(data)
+	return c, ok
+}
+
+// ParseCronAt is like ParseCron but also returns the offset of the first
+// byte that doesn't fit, or -1 if data parsed.  A range that runs
+// backwards, such as "5-1", fails at its upper end.
+func ParseCronAt(data []byte) (CronExpr, bool, int) {
+
+
+//line cron.rl:34
+
+//line cron.go:39
+const cron_start int = 1
+const cron_first_final int = 96
+const cron_error int = 0
+
+const cron_en_main int = 1
+
+
+//line cron.rl:35
+
+	var fields [5]uint64
+	var bits uint64
+	f := 0
+	var v, lo, hi, step int
+	bad, badAt := false, 0
+	num, hiAt := 0, 0
+	alias := ""
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	
+//line cron.go:60
+	{
+	cs = cron_start
+	}
+
+//line cron.go:65
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 10:
+		goto st_case_10
+	case 98:
+		goto st_case_98
+	case 11:
+		goto st_case_11
+	case 99:
+		goto st_case_99
+	case 12:
+		goto st_case_12
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 9:
+			goto st1
+		case 32:
+			goto st1
+		case 42:
+			goto tr2
+		case 64:
+			goto st57
+		}
+		switch {
+		case data[p] > 53:
+			if data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] >= 48:
+			goto tr3
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr2:
+//line cron.rl:51
+ lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line cron.go:314
+		switch data[p] {
+		case 9:
+			goto tr6
+		case 32:
+			goto tr6
+		case 44:
+			goto tr7
+		case 47:
+			goto st52
+		}
+		goto st0
+tr6:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st3
+tr88:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st3
+tr95:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st3
+tr100:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line cron.go:389
+		switch data[p] {
+		case 9:
+			goto st3
+		case 32:
+			goto st3
+		case 42:
+			goto tr10
+		case 50:
+			goto tr12
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr13
+			}
+		case data[p] >= 48:
+			goto tr11
+		}
+		goto st0
+tr10:
+//line cron.rl:51
+ lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line cron.go:418
+		switch data[p] {
+		case 9:
+			goto tr14
+		case 32:
+			goto tr14
+		case 44:
+			goto tr15
+		case 47:
+			goto st41
+		}
+		goto st0
+tr14:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st5
+tr72:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st5
+tr80:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st5
+tr85:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line cron.go:493
+		switch data[p] {
+		case 9:
+			goto st5
+		case 32:
+			goto st5
+		case 42:
+			goto tr18
+		case 48:
+			goto tr19
+		case 51:
+			goto tr21
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr22
+			}
+		case data[p] >= 49:
+			goto tr20
+		}
+		goto st0
+tr18:
+//line cron.rl:51
+ lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line cron.go:524
+		switch data[p] {
+		case 9:
+			goto tr23
+		case 32:
+			goto tr23
+		case 44:
+			goto tr24
+		case 47:
+			goto st30
+		}
+		goto st0
+tr23:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st7
+tr56:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st7
+tr65:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st7
+tr69:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line cron.go:599
+		switch data[p] {
+		case 9:
+			goto st7
+		case 32:
+			goto st7
+		case 42:
+			goto tr27
+		case 48:
+			goto tr28
+		case 49:
+			goto tr29
+		}
+		if 50 <= data[p] && data[p] <= 57 {
+			goto tr30
+		}
+		goto st0
+tr27:
+//line cron.rl:51
+ lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line cron.go:625
+		switch data[p] {
+		case 9:
+			goto tr31
+		case 32:
+			goto tr31
+		case 44:
+			goto tr32
+		case 47:
+			goto st19
+		}
+		goto st0
+tr31:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st9
+tr40:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st9
+tr48:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st9
+tr52:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line cron.go:700
+		switch data[p] {
+		case 9:
+			goto st9
+		case 32:
+			goto st9
+		case 42:
+			goto tr35
+		}
+		if 48 <= data[p] && data[p] <= 55 {
+			goto tr36
+		}
+		goto st0
+tr35:
+//line cron.rl:51
+ lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 
+	goto st96
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+//line cron.go:722
+		switch data[p] {
+		case 9:
+			goto tr148
+		case 32:
+			goto tr148
+		case 44:
+			goto tr149
+		case 47:
+			goto st12
+		}
+		goto st0
+tr115:
+//line cron.rl:89
+ alias = "0 0 1 1 *" 
+	goto st97
+tr119:
+//line cron.rl:92
+ alias = "0 0 * * *" 
+	goto st97
+tr124:
+//line cron.rl:94
+ alias = "0 * * * *" 
+	goto st97
+tr132:
+//line cron.rl:93
+ alias = "0 0 * * *" 
+	goto st97
+tr137:
+//line cron.rl:90
+ alias = "0 0 1 * *" 
+	goto st97
+tr142:
+//line cron.rl:91
+ alias = "0 0 * * 0" 
+	goto st97
+tr147:
+//line cron.rl:88
+ alias = "0 0 1 1 *" 
+	goto st97
+tr148:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st97
+tr152:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st97
+tr156:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st97
+tr159:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+	goto st97
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+//line cron.go:825
+		switch data[p] {
+		case 9:
+			goto st97
+		case 32:
+			goto st97
+		}
+		goto st0
+tr149:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st10
+tr153:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st10
+tr157:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st10
+tr160:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line cron.go:888
+		if data[p] == 42 {
+			goto tr35
+		}
+		if 48 <= data[p] && data[p] <= 55 {
+			goto tr36
+		}
+		goto st0
+tr36:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st98
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+//line cron.go:907
+		switch data[p] {
+		case 9:
+			goto tr152
+		case 32:
+			goto tr152
+		case 44:
+			goto tr153
+		case 45:
+			goto tr154
+		case 47:
+			goto tr155
+		}
+		goto st0
+tr154:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line cron.go:930
+		if 48 <= data[p] && data[p] <= 55 {
+			goto tr37
+		}
+		goto st0
+tr37:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st99
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+//line cron.go:946
+		switch data[p] {
+		case 9:
+			goto tr156
+		case 32:
+			goto tr156
+		case 44:
+			goto tr157
+		case 47:
+			goto tr158
+		}
+		goto st0
+tr155:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:52
+ hi = cronBounds[f][1] 
+	goto st12
+tr158:
+//line cron.rl:50
+ hi = v; hiAt = num 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line cron.go:973
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr38
+		}
+		goto st0
+tr38:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st100
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+//line cron.go:989
+		switch data[p] {
+		case 9:
+			goto tr159
+		case 32:
+			goto tr159
+		case 44:
+			goto tr160
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr161
+		}
+		goto st0
+tr161:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st101
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+//line cron.go:1011
+		switch data[p] {
+		case 9:
+			goto tr159
+		case 32:
+			goto tr159
+		case 44:
+			goto tr160
+		}
+		goto st0
+tr32:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st13
+tr41:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st13
+tr49:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st13
+tr53:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line cron.go:1076
+		switch data[p] {
+		case 42:
+			goto tr27
+		case 48:
+			goto tr28
+		case 49:
+			goto tr29
+		}
+		if 50 <= data[p] && data[p] <= 57 {
+			goto tr30
+		}
+		goto st0
+tr28:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line cron.go:1100
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr39
+		}
+		goto st0
+tr30:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st15
+tr39:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line cron.go:1120
+		switch data[p] {
+		case 9:
+			goto tr40
+		case 32:
+			goto tr40
+		case 44:
+			goto tr41
+		case 45:
+			goto tr42
+		case 47:
+			goto tr43
+		}
+		goto st0
+tr42:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line cron.go:1143
+		switch data[p] {
+		case 48:
+			goto tr44
+		case 49:
+			goto tr45
+		}
+		if 50 <= data[p] && data[p] <= 57 {
+			goto tr46
+		}
+		goto st0
+tr44:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line cron.go:1165
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr47
+		}
+		goto st0
+tr46:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st18
+tr47:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line cron.go:1185
+		switch data[p] {
+		case 9:
+			goto tr48
+		case 32:
+			goto tr48
+		case 44:
+			goto tr49
+		case 47:
+			goto tr50
+		}
+		goto st0
+tr43:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:52
+ hi = cronBounds[f][1] 
+	goto st19
+tr50:
+//line cron.rl:50
+ hi = v; hiAt = num 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line cron.go:1212
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr51
+		}
+		goto st0
+tr51:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line cron.go:1228
+		switch data[p] {
+		case 9:
+			goto tr52
+		case 32:
+			goto tr52
+		case 44:
+			goto tr53
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr54
+		}
+		goto st0
+tr54:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line cron.go:1250
+		switch data[p] {
+		case 9:
+			goto tr52
+		case 32:
+			goto tr52
+		case 44:
+			goto tr53
+		}
+		goto st0
+tr45:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line cron.go:1271
+		switch data[p] {
+		case 9:
+			goto tr48
+		case 32:
+			goto tr48
+		case 44:
+			goto tr49
+		case 47:
+			goto tr50
+		}
+		if 48 <= data[p] && data[p] <= 50 {
+			goto tr47
+		}
+		goto st0
+tr29:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line cron.go:1297
+		switch data[p] {
+		case 9:
+			goto tr40
+		case 32:
+			goto tr40
+		case 44:
+			goto tr41
+		case 45:
+			goto tr42
+		case 47:
+			goto tr43
+		}
+		if 48 <= data[p] && data[p] <= 50 {
+			goto tr39
+		}
+		goto st0
+tr24:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st24
+tr57:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st24
+tr66:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st24
+tr70:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line cron.go:1369
+		switch data[p] {
+		case 42:
+			goto tr18
+		case 48:
+			goto tr19
+		case 51:
+			goto tr21
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr22
+			}
+		case data[p] >= 49:
+			goto tr20
+		}
+		goto st0
+tr19:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line cron.go:1398
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr55
+		}
+		goto st0
+tr22:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st26
+tr55:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line cron.go:1418
+		switch data[p] {
+		case 9:
+			goto tr56
+		case 32:
+			goto tr56
+		case 44:
+			goto tr57
+		case 45:
+			goto tr58
+		case 47:
+			goto tr59
+		}
+		goto st0
+tr58:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line cron.go:1441
+		switch data[p] {
+		case 48:
+			goto tr60
+		case 51:
+			goto tr62
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr63
+			}
+		case data[p] >= 49:
+			goto tr61
+		}
+		goto st0
+tr60:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line cron.go:1468
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr64
+		}
+		goto st0
+tr63:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st29
+tr64:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line cron.go:1488
+		switch data[p] {
+		case 9:
+			goto tr65
+		case 32:
+			goto tr65
+		case 44:
+			goto tr66
+		case 47:
+			goto tr67
+		}
+		goto st0
+tr59:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:52
+ hi = cronBounds[f][1] 
+	goto st30
+tr67:
+//line cron.rl:50
+ hi = v; hiAt = num 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line cron.go:1515
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr68
+		}
+		goto st0
+tr68:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line cron.go:1531
+		switch data[p] {
+		case 9:
+			goto tr69
+		case 32:
+			goto tr69
+		case 44:
+			goto tr70
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr71
+		}
+		goto st0
+tr71:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line cron.go:1553
+		switch data[p] {
+		case 9:
+			goto tr69
+		case 32:
+			goto tr69
+		case 44:
+			goto tr70
+		}
+		goto st0
+tr61:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line cron.go:1574
+		switch data[p] {
+		case 9:
+			goto tr65
+		case 32:
+			goto tr65
+		case 44:
+			goto tr66
+		case 47:
+			goto tr67
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr64
+		}
+		goto st0
+tr62:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line cron.go:1600
+		switch data[p] {
+		case 9:
+			goto tr65
+		case 32:
+			goto tr65
+		case 44:
+			goto tr66
+		case 47:
+			goto tr67
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr64
+		}
+		goto st0
+tr20:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line cron.go:1626
+		switch data[p] {
+		case 9:
+			goto tr56
+		case 32:
+			goto tr56
+		case 44:
+			goto tr57
+		case 45:
+			goto tr58
+		case 47:
+			goto tr59
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr55
+		}
+		goto st0
+tr21:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line cron.go:1654
+		switch data[p] {
+		case 9:
+			goto tr56
+		case 32:
+			goto tr56
+		case 44:
+			goto tr57
+		case 45:
+			goto tr58
+		case 47:
+			goto tr59
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr55
+		}
+		goto st0
+tr15:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st37
+tr73:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st37
+tr81:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st37
+tr86:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line cron.go:1726
+		switch data[p] {
+		case 42:
+			goto tr10
+		case 50:
+			goto tr12
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr13
+			}
+		case data[p] >= 48:
+			goto tr11
+		}
+		goto st0
+tr11:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line cron.go:1753
+		switch data[p] {
+		case 9:
+			goto tr72
+		case 32:
+			goto tr72
+		case 44:
+			goto tr73
+		case 45:
+			goto tr74
+		case 47:
+			goto tr75
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr76
+		}
+		goto st0
+tr74:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line cron.go:1779
+		if data[p] == 50 {
+			goto tr78
+		}
+		switch {
+		case data[p] > 49:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr79
+			}
+		case data[p] >= 48:
+			goto tr77
+		}
+		goto st0
+tr77:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line cron.go:1803
+		switch data[p] {
+		case 9:
+			goto tr80
+		case 32:
+			goto tr80
+		case 44:
+			goto tr81
+		case 47:
+			goto tr82
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr83
+		}
+		goto st0
+tr75:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:52
+ hi = cronBounds[f][1] 
+	goto st41
+tr82:
+//line cron.rl:50
+ hi = v; hiAt = num 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line cron.go:1833
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr84
+		}
+		goto st0
+tr84:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line cron.go:1849
+		switch data[p] {
+		case 9:
+			goto tr85
+		case 32:
+			goto tr85
+		case 44:
+			goto tr86
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr87
+		}
+		goto st0
+tr87:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line cron.go:1871
+		switch data[p] {
+		case 9:
+			goto tr85
+		case 32:
+			goto tr85
+		case 44:
+			goto tr86
+		}
+		goto st0
+tr79:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st44
+tr83:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line cron.go:1896
+		switch data[p] {
+		case 9:
+			goto tr80
+		case 32:
+			goto tr80
+		case 44:
+			goto tr81
+		case 47:
+			goto tr82
+		}
+		goto st0
+tr78:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line cron.go:1919
+		switch data[p] {
+		case 9:
+			goto tr80
+		case 32:
+			goto tr80
+		case 44:
+			goto tr81
+		case 47:
+			goto tr82
+		}
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr83
+		}
+		goto st0
+tr13:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st46
+tr76:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line cron.go:1949
+		switch data[p] {
+		case 9:
+			goto tr72
+		case 32:
+			goto tr72
+		case 44:
+			goto tr73
+		case 45:
+			goto tr74
+		case 47:
+			goto tr75
+		}
+		goto st0
+tr12:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line cron.go:1974
+		switch data[p] {
+		case 9:
+			goto tr72
+		case 32:
+			goto tr72
+		case 44:
+			goto tr73
+		case 45:
+			goto tr74
+		case 47:
+			goto tr75
+		}
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr76
+		}
+		goto st0
+tr7:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st48
+tr89:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st48
+tr96:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st48
+tr101:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line cron.go:2046
+		if data[p] == 42 {
+			goto tr2
+		}
+		switch {
+		case data[p] > 53:
+			if data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] >= 48:
+			goto tr3
+		}
+		goto st0
+tr3:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line cron.go:2070
+		switch data[p] {
+		case 9:
+			goto tr88
+		case 32:
+			goto tr88
+		case 44:
+			goto tr89
+		case 45:
+			goto tr90
+		case 47:
+			goto tr91
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr92
+		}
+		goto st0
+tr90:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line cron.go:2096
+		switch {
+		case data[p] > 53:
+			if data[p] <= 57 {
+				goto tr94
+			}
+		case data[p] >= 48:
+			goto tr93
+		}
+		goto st0
+tr93:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line cron.go:2117
+		switch data[p] {
+		case 9:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 47:
+			goto tr97
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr98
+		}
+		goto st0
+tr91:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:52
+ hi = cronBounds[f][1] 
+	goto st52
+tr97:
+//line cron.rl:50
+ hi = v; hiAt = num 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line cron.go:2147
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr99
+		}
+		goto st0
+tr99:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line cron.go:2163
+		switch data[p] {
+		case 9:
+			goto tr100
+		case 32:
+			goto tr100
+		case 44:
+			goto tr101
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr102
+		}
+		goto st0
+tr102:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line cron.go:2185
+		switch data[p] {
+		case 9:
+			goto tr100
+		case 32:
+			goto tr100
+		case 44:
+			goto tr101
+		}
+		goto st0
+tr94:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st55
+tr98:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line cron.go:2210
+		switch data[p] {
+		case 9:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 47:
+			goto tr97
+		}
+		goto st0
+tr4:
+//line cron.rl:47
+ v = 0; num = p 
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st56
+tr92:
+//line cron.rl:48
+ v = v*10 + int((data[p])-'0') 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line cron.go:2237
+		switch data[p] {
+		case 9:
+			goto tr88
+		case 32:
+			goto tr88
+		case 44:
+			goto tr89
+		case 45:
+			goto tr90
+		case 47:
+			goto tr91
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 97:
+			goto st58
+		case 100:
+			goto st65
+		case 104:
+			goto st69
+		case 109:
+			goto st74
+		case 119:
+			goto st86
+		case 121:
+			goto st91
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 110 {
+			goto st59
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 110 {
+			goto st60
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 117 {
+			goto st61
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 97 {
+			goto st62
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 108 {
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 108 {
+			goto st64
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 121 {
+			goto tr115
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 97 {
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 105 {
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 108 {
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 121 {
+			goto tr119
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 111 {
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 117 {
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 114 {
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 108 {
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 121 {
+			goto tr124
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 105:
+			goto st75
+		case 111:
+			goto st81
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 100 {
+			goto st76
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 110 {
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 105 {
+			goto st78
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 103 {
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 104 {
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 116 {
+			goto tr132
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 110 {
+			goto st82
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 116 {
+			goto st83
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 104 {
+			goto st84
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 108 {
+			goto st85
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		if data[p] == 121 {
+			goto tr137
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 101 {
+			goto st87
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 101 {
+			goto st88
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 107 {
+			goto st89
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		if data[p] == 108 {
+			goto st90
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		if data[p] == 121 {
+			goto tr142
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 101 {
+			goto st92
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 97 {
+			goto st93
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		if data[p] == 114 {
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		if data[p] == 108 {
+			goto st95
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		if data[p] == 121 {
+			goto tr147
+		}
+		goto st0
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 96:
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+		case 98:
+//line cron.rl:49
+ lo, hi, step = v, v, 1 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+		case 99:
+//line cron.rl:50
+ hi = v; hiAt = num 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+		case 100, 101:
+//line cron.rl:53
+ step = v 
+//line cron.rl:55
+
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    
+//line cron.rl:64
+ fields[f] = bits; bits = 0; f++ 
+//line cron.go:2776
+		}
+	}
+
+	_out: {}
+	}
+
+//line cron.rl:100
+
+
+	if cs < cron_first_final {
+		return CronExpr{}, false, p
+	}
+
+	if bad {
+		return CronExpr{}, false, badAt
+	}
+
+	if alias != "" {
+		return ParseCronAt([]byte(alias))
+	}
+
+	if fields[4]&(1<<7) != 0 {
+		fields[4] = fields[4]&^(1<<7) | 1
+	}
+
+	return CronExpr{
+		Minute:     fields[0],
+		Hour:       fields[1],
+		DayOfMonth: fields[2],
+		Month:      fields[3],
+		DayOfWeek:  fields[4],
+	}, true, -1
+}
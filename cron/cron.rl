@@ -0,0 +1,125 @@
+package main
+
+// CronExpr is a parsed cron schedule.  Each field is a bit set with bit i
+// set if the field matches the value i, so Month uses bits 1 to 12.  A
+// day-of-week of 7 is folded into 0, both meaning Sunday.
+type CronExpr struct {
+	Minute     uint64
+	Hour       uint64
+	DayOfMonth uint64
+	Month      uint64
+	DayOfWeek  uint64
+}
+
+// the values a '*' stands for in each field
+var cronBounds = [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// ParseCron parses a standard five-field cron expression such as
+// "*/5 0 * * 1-5".  Each field is a comma-separated list of "*", a value,
+// or a range "a-b", any of which may be followed by a step "/n"; a value
+// with a step runs to the end of the field's range, as in "5/15".  The
+// @yearly, @annually, @monthly, @weekly, @daily, @midnight, and @hourly
+// aliases are also accepted.  Month and day names are not.
+func ParseCron(data []byte) (CronExpr, bool) {
+	c, ok, _ := ParseCronAt(data)
+	return c, ok
+}
+
+// ParseCronAt is like ParseCron but also returns the offset of the first
+// byte that doesn't fit, or -1 if data parsed.  A range that runs
+// backwards, such as "5-1", fails at its upper end.
+func ParseCronAt(data []byte) (CronExpr, bool, int) {
+
+%% machine cron;
+%% write data;
+
+	var fields [5]uint64
+	var bits uint64
+	f := 0
+	var v, lo, hi, step int
+	bad, badAt := false, 0
+	num, hiAt := 0, 0
+	alias := ""
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	%%{
+	    action start   { v = 0; num = p }
+	    action digit   { v = v*10 + int(fc-'0') }
+	    action lo      { lo, hi, step = v, v, 1 }
+	    action hi      { hi = v; hiAt = num }
+	    action star    { lo, hi, step = cronBounds[f][0], cronBounds[f][1], 1 }
+	    action to_last { hi = cronBounds[f][1] }
+	    action step    { step = v }
+
+	    action term {
+	        if lo > hi && !bad {
+	            bad, badAt = true, hiAt
+	        }
+	        for i := lo; i <= hi; i += step {
+	            bits |= 1 << uint(i)
+	        }
+	    }
+
+	    action field { fields[f] = bits; bits = 0; f++ }
+
+	    minute = ( digit | [0-5] digit ) >start $digit ;
+	    hour = ( digit | [01] digit | '2' [0-3] ) >start $digit ;
+	    dom = ( [1-9] | '0' [1-9] | [12] digit | '3' [01] ) >start $digit ;
+	    month = ( [1-9] | '0' [1-9] | '1' [0-2] ) >start $digit ;
+	    dow = [0-7] >start $digit ;
+
+	    step = '/' ( [1-9] digit? ) >start $digit %step ;
+
+	    minute_term = ( '*' @star | minute %lo '-' minute %hi ) step? | minute %lo ( step >to_last )? ;
+	    hour_term = ( '*' @star | hour %lo '-' hour %hi ) step? | hour %lo ( step >to_last )? ;
+	    dom_term = ( '*' @star | dom %lo '-' dom %hi ) step? | dom %lo ( step >to_last )? ;
+	    month_term = ( '*' @star | month %lo '-' month %hi ) step? | month %lo ( step >to_last )? ;
+	    dow_term = ( '*' @star | dow %lo '-' dow %hi ) step? | dow %lo ( step >to_last )? ;
+
+	    ws = [ \t]+ ;
+
+	    fields = ( minute_term %term ( ',' minute_term %term )* ) %field ws
+	             ( hour_term %term ( ',' hour_term %term )* ) %field ws
+	             ( dom_term %term ( ',' dom_term %term )* ) %field ws
+	             ( month_term %term ( ',' month_term %term )* ) %field ws
+	             ( dow_term %term ( ',' dow_term %term )* ) %field ;
+
+	    alias = '@yearly' @{ alias = "0 0 1 1 *" }
+	          | '@annually' @{ alias = "0 0 1 1 *" }
+	          | '@monthly' @{ alias = "0 0 1 * *" }
+	          | '@weekly' @{ alias = "0 0 * * 0" }
+	          | '@daily' @{ alias = "0 0 * * *" }
+	          | '@midnight' @{ alias = "0 0 * * *" }
+	          | '@hourly' @{ alias = "0 * * * *" } ;
+
+	    main := [ \t]* ( fields | alias ) [ \t]* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < cron_first_final {
+		return CronExpr{}, false, p
+	}
+
+	if bad {
+		return CronExpr{}, false, badAt
+	}
+
+	if alias != "" {
+		return ParseCronAt([]byte(alias))
+	}
+
+	if fields[4]&(1<<7) != 0 {
+		fields[4] = fields[4]&^(1<<7) | 1
+	}
+
+	return CronExpr{
+		Minute:     fields[0],
+		Hour:       fields[1],
+		DayOfMonth: fields[2],
+		Month:      fields[3],
+		DayOfWeek:  fields[4],
+	}, true, -1
+}
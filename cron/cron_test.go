@@ -0,0 +1,146 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/robfig/cron/v3"
+)
+
+var data = []byte("*/5 0 * * 1-5")
+
+var hits int
+
+// span returns the bits from lo to hi with the given step.
+func span(lo, hi, step int) uint64 {
+	var b uint64
+	for i := lo; i <= hi; i += step {
+		b |= 1 << uint(i)
+	}
+	return b
+}
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expr string
+		want CronExpr
+		ok   bool
+	}{
+		{
+			expr: string(data),
+			want: CronExpr{Minute: span(0, 59, 5), Hour: 1, DayOfMonth: span(1, 31, 1), Month: span(1, 12, 1), DayOfWeek: span(1, 5, 1)},
+			ok:   true,
+		},
+		{
+			expr: "0,15,30,45 9-17/2 1,15 */3 *",
+			want: CronExpr{Minute: span(0, 45, 15), Hour: span(9, 17, 2), DayOfMonth: 1<<1 | 1<<15, Month: span(1, 12, 3), DayOfWeek: span(0, 6, 1)},
+			ok:   true,
+		},
+		{
+			expr: "5/15 23 31 12 7",
+			want: CronExpr{Minute: span(5, 59, 15), Hour: 1 << 23, DayOfMonth: 1 << 31, Month: 1 << 12, DayOfWeek: 1},
+			ok:   true,
+		},
+		{
+			expr: "  05 08 09 01 0-7\t",
+			want: CronExpr{Minute: 1 << 5, Hour: 1 << 8, DayOfMonth: 1 << 9, Month: 1 << 1, DayOfWeek: span(0, 6, 1)},
+			ok:   true,
+		},
+		{expr: "@yearly", want: CronExpr{Minute: 1, Hour: 1, DayOfMonth: 1 << 1, Month: 1 << 1, DayOfWeek: span(0, 6, 1)}, ok: true},
+		{expr: "@weekly", want: CronExpr{Minute: 1, Hour: 1, DayOfMonth: span(1, 31, 1), Month: span(1, 12, 1), DayOfWeek: 1}, ok: true},
+		{expr: "@hourly", want: CronExpr{Minute: 1, Hour: span(0, 23, 1), DayOfMonth: span(1, 31, 1), Month: span(1, 12, 1), DayOfWeek: span(0, 6, 1)}, ok: true},
+
+		{expr: "60 * * * *"},
+		{expr: "* 24 * * *"},
+		{expr: "* * 0 * *"},
+		{expr: "* * 32 * *"},
+		{expr: "* * * 13 *"},
+		{expr: "* * * * 8"},
+		{expr: "*/0 * * * *"},
+		{expr: "30-10 * * * *"},
+		{expr: "1-2-3 * * * *"},
+		{expr: "1, * * * *"},
+		{expr: "* * * *"},
+		{expr: "* * * * * *"},
+		{expr: "* * * JAN MON"},
+		{expr: "@reboot"},
+		{expr: "@daily *"},
+		{expr: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseCron([]byte(tt.expr))
+		if ok != tt.ok {
+			t.Errorf("ParseCron(%q) ok=%v, want %v", tt.expr, ok, tt.ok)
+			continue
+		}
+		if ok && got != tt.want {
+			t.Errorf("ParseCron(%q)=%+v, want %+v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseCronAt(t *testing.T) {
+	tests := []struct {
+		expr   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"@monthly", -1},
+		{"60 * * * *", 1},
+		// a backwards range is reported at its upper bound
+		{"* * * * 1-5,5-1", 14},
+		{"@weekdays", 5},
+		{"* * * *", 7},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseCronAt([]byte(tt.expr))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseCronAt(%q)=%v, %d, want errPos %d", tt.expr, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func robfigCron(expr string) (CronExpr, bool) {
+	sched, err := cron.ParseStandard(expr)
+	if err != nil {
+		return CronExpr{}, false
+	}
+	s := sched.(*cron.SpecSchedule)
+
+	// robfig/cron marks fields given as "*" with the top bit
+	const star = 1 << 63
+	return CronExpr{
+		Minute:     s.Minute &^ star,
+		Hour:       s.Hour &^ star,
+		DayOfMonth: s.Dom &^ star,
+		Month:      s.Month &^ star,
+		DayOfWeek:  s.Dow &^ star,
+	}, true
+}
+
+func TestCronAlternatives(t *testing.T) {
+	for _, expr := range []string{string(data), "0,15,30,45 9-17/2 1,15 */3 *", "5/15 23 31 12 6", "@monthly"} {
+		want, _ := ParseCron([]byte(expr))
+		if got, ok := robfigCron(expr); !ok || got != want {
+			t.Errorf("robfigCron(%q)=%+v, want %+v", expr, got, want)
+		}
+	}
+}
+
+func BenchmarkRobfigCron(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, err := cron.ParseStandard(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseCron(data); ok {
+			hits++
+		}
+	}
+}
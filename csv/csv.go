@@ -0,0 +1,941 @@
+
+//line csv.rl:1
+package main
+
+
+//line csv.rl:31
+
+
+// unquote replaces each "" in a quoted field with ".
+func unquote(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == '"' {
+			i++
+		}
+	}
+	return out
+}
+
+// scanCSV scans the first record in data, returning the number of fields
+// found and the offset just past the record's line ending.  If the record
+// is malformed, next is instead the offset where it went wrong: the first
+// unexpected byte, or the separator before the first field that did not
+// fit in fields.
+func scanCSV(data []byte, fields [][]byte) (n, next int, ok bool) {
+
+
+//line csv.rl:53
+
+//line csv.go:32
+const csv_start int = 4
+const csv_first_final int = 4
+const csv_error int = 0
+
+const csv_en_main int = 4
+
+
+//line csv.rl:54
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	start, end := 0, 0
+	escaped := false
+	full := 0
+
+	
+//line csv.go:49
+	{
+	cs = csv_start
+	}
+
+//line csv.go:54
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 7:
+		goto st_case_7
+	}
+	goto st_out
+tr10:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+tr14:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+tr18:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line csv.go:139
+		switch data[p] {
+		case 10:
+			goto tr7
+		case 13:
+			goto tr8
+		case 34:
+			goto tr9
+		case 44:
+			goto tr10
+		}
+		goto tr6
+tr6:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line csv.go:162
+		switch data[p] {
+		case 10:
+			goto tr12
+		case 13:
+			goto tr13
+		case 34:
+			goto st0
+		case 44:
+			goto tr14
+		}
+		goto st5
+tr1:
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr7:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr12:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr15:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line csv.go:249
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr8:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+tr13:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+tr16:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line csv.go:316
+		if data[p] == 10 {
+			goto tr1
+		}
+		goto st0
+tr9:
+//line csv.rl:6
+ escaped = false 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line csv.go:330
+		if data[p] == 34 {
+			goto tr3
+		}
+		goto tr2
+tr2:
+//line csv.rl:7
+ start = p 
+	goto st3
+tr17:
+//line csv.rl:9
+ escaped = true 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line csv.go:348
+		if data[p] == 34 {
+			goto tr5
+		}
+		goto st3
+tr3:
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+	goto st7
+tr5:
+//line csv.rl:8
+ end = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line csv.go:368
+		switch data[p] {
+		case 10:
+			goto tr15
+		case 13:
+			goto tr16
+		case 34:
+			goto tr17
+		case 44:
+			goto tr18
+		}
+		goto st0
+	st_out:
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 4:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+		case 5:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+		case 7:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.go:445
+		}
+	}
+
+	_out: {}
+	}
+
+//line csv.rl:71
+
+
+	if cs < csv_first_final {
+		return 0, p, false
+	}
+
+	if n > len(fields) {
+		return 0, full, false
+	}
+
+	return n, p, true
+}
+
+// scanTSV is scanCSV with tabs separating fields.
+func scanTSV(data []byte, fields [][]byte) (n, next int, ok bool) {
+
+
+//line csv.rl:88
+
+//line csv.go:472
+const tsv_start int = 4
+const tsv_first_final int = 4
+const tsv_error int = 0
+
+const tsv_en_main int = 4
+
+
+//line csv.rl:89
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	start, end := 0, 0
+	escaped := false
+	full := 0
+
+	
+//line csv.go:489
+	{
+	cs = tsv_start
+	}
+
+//line csv.go:494
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 7:
+		goto st_case_7
+	}
+	goto st_out
+tr7:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+tr12:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+tr15:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line csv.go:579
+		switch data[p] {
+		case 9:
+			goto tr7
+		case 10:
+			goto tr8
+		case 13:
+			goto tr9
+		case 34:
+			goto tr10
+		}
+		goto tr6
+tr6:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line csv.go:602
+		switch data[p] {
+		case 9:
+			goto tr12
+		case 10:
+			goto tr13
+		case 13:
+			goto tr14
+		case 34:
+			goto st0
+		}
+		goto st5
+tr1:
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr8:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr13:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+tr16:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.rl:10
+ p++; cs = 6; goto _out
+ 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line csv.go:689
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr9:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+tr14:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+tr17:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line csv.go:756
+		if data[p] == 10 {
+			goto tr1
+		}
+		goto st0
+tr10:
+//line csv.rl:6
+ escaped = false 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line csv.go:770
+		if data[p] == 34 {
+			goto tr3
+		}
+		goto tr2
+tr2:
+//line csv.rl:7
+ start = p 
+	goto st3
+tr18:
+//line csv.rl:9
+ escaped = true 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line csv.go:788
+		if data[p] == 34 {
+			goto tr5
+		}
+		goto st3
+tr3:
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+	goto st7
+tr5:
+//line csv.rl:8
+ end = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line csv.go:808
+		switch data[p] {
+		case 9:
+			goto tr15
+		case 10:
+			goto tr16
+		case 13:
+			goto tr17
+		case 34:
+			goto tr18
+		}
+		goto st0
+	st_out:
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 4:
+//line csv.rl:6
+ escaped = false 
+//line csv.rl:7
+ start = p 
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+		case 5:
+//line csv.rl:8
+ end = p 
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+		case 7:
+//line csv.rl:12
+
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    
+//line csv.go:885
+		}
+	}
+
+	_out: {}
+	}
+
+//line csv.rl:106
+
+
+	if cs < tsv_first_final {
+		return 0, p, false
+	}
+
+	if n > len(fields) {
+		return 0, full, false
+	}
+
+	return n, p, true
+}
+
+// ScanCSVRow splits a single RFC 4180 record, with or without its trailing
+// line ending, into fields.  Quoted fields may contain commas, line breaks,
+// and doubled quotes.  Fields point into b except for quoted fields
+// containing "", which are unescaped into a copy.  It returns the number
+// of fields found; ok is false if the record is malformed, if anything
+// follows the line ending, or if there are more than len(fields) fields.
+func ScanCSVRow(b []byte, fields [][]byte) (n int, ok bool) {
+	n, ok, _ = ScanCSVRowAt(b, fields)
+	return n, ok
+}
+
+// ScanCSVRowAt is like ScanCSVRow but also returns where the record went
+// wrong: the offset of the first malformed byte or of anything after the
+// line ending, or of the separator before the first field that didn't fit.
+// It is -1 if the record was scanned.
+func ScanCSVRowAt(b []byte, fields [][]byte) (n int, ok bool, errPos int) {
+	n, next, ok := scanCSV(b, fields)
+	if !ok || next != len(b) {
+		return 0, false, next
+	}
+	return n, true, -1
+}
+
+// ScanTSVRow is like ScanCSVRow but with fields separated by tabs.
+func ScanTSVRow(b []byte, fields [][]byte) (n int, ok bool) {
+	n, ok, _ = ScanTSVRowAt(b, fields)
+	return n, ok
+}
+
+// ScanTSVRowAt is like ScanCSVRowAt but with fields separated by tabs.
+func ScanTSVRowAt(b []byte, fields [][]byte) (n int, ok bool, errPos int) {
+	n, next, ok := scanTSV(b, fields)
+	if !ok || next != len(b) {
+		return 0, false, next
+	}
+	return n, true, -1
+}
@@ -0,0 +1,155 @@
+package main
+
+%%{
+    machine csv_common;
+
+    action reset   { escaped = false }
+    action start   { start = p }
+    action end     { end = p }
+    action escaped { escaped = true }
+    action done    { fbreak; }
+
+    action commit {
+        if n < len(fields) {
+            if escaped {
+                fields[n] = unquote(data[start:end])
+            } else {
+                fields[n] = data[start:end]
+            }
+        }
+        n++
+        if n == len(fields) {
+            full = p
+        }
+    }
+
+    # a closing quote is only known to be one once we see what follows, so
+    # end may be set more than once
+    quoted_field = '"' ( ( any - '"' ) | '""' @escaped )* >start %end '"' ;
+
+    eol = '\r'? '\n' @done ;
+}%%
+
+// unquote replaces each "" in a quoted field with ".
+func unquote(b []byte) []byte {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		out = append(out, b[i])
+		if b[i] == '"' {
+			i++
+		}
+	}
+	return out
+}
+
+// scanCSV scans the first record in data, returning the number of fields
+// found and the offset just past the record's line ending.  If the record
+// is malformed, next is instead the offset where it went wrong: the first
+// unexpected byte, or the separator before the first field that did not
+// fit in fields.
+func scanCSV(data []byte, fields [][]byte) (n, next int, ok bool) {
+
+%% machine csv;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	start, end := 0, 0
+	escaped := false
+	full := 0
+
+	%%{
+	    include csv_common;
+
+	    plain_field = ( any - [,"\r\n] )* >start %end ;
+	    field = ( plain_field | quoted_field ) >reset %commit ;
+
+	    main := field ( ',' field )* eol? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < csv_first_final {
+		return 0, p, false
+	}
+
+	if n > len(fields) {
+		return 0, full, false
+	}
+
+	return n, p, true
+}
+
+// scanTSV is scanCSV with tabs separating fields.
+func scanTSV(data []byte, fields [][]byte) (n, next int, ok bool) {
+
+%% machine tsv;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	start, end := 0, 0
+	escaped := false
+	full := 0
+
+	%%{
+	    include csv_common;
+
+	    plain_field = ( any - [\t"\r\n] )* >start %end ;
+	    field = ( plain_field | quoted_field ) >reset %commit ;
+
+	    main := field ( '\t' field )* eol? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < tsv_first_final {
+		return 0, p, false
+	}
+
+	if n > len(fields) {
+		return 0, full, false
+	}
+
+	return n, p, true
+}
+
+// ScanCSVRow splits a single RFC 4180 record, with or without its trailing
+// line ending, into fields.  Quoted fields may contain commas, line breaks,
+// and doubled quotes.  Fields point into b except for quoted fields
+// containing "", which are unescaped into a copy.  It returns the number
+// of fields found; ok is false if the record is malformed, if anything
+// follows the line ending, or if there are more than len(fields) fields.
+func ScanCSVRow(b []byte, fields [][]byte) (n int, ok bool) {
+	n, ok, _ = ScanCSVRowAt(b, fields)
+	return n, ok
+}
+
+// ScanCSVRowAt is like ScanCSVRow but also returns where the record went
+// wrong: the offset of the first malformed byte or of anything after the
+// line ending, or of the separator before the first field that didn't fit.
+// It is -1 if the record was scanned.
+func ScanCSVRowAt(b []byte, fields [][]byte) (n int, ok bool, errPos int) {
+	n, next, ok := scanCSV(b, fields)
+	if !ok || next != len(b) {
+		return 0, false, next
+	}
+	return n, true, -1
+}
+
+// ScanTSVRow is like ScanCSVRow but with fields separated by tabs.
+func ScanTSVRow(b []byte, fields [][]byte) (n int, ok bool) {
+	n, ok, _ = ScanTSVRowAt(b, fields)
+	return n, ok
+}
+
+// ScanTSVRowAt is like ScanCSVRowAt but with fields separated by tabs.
+func ScanTSVRowAt(b []byte, fields [][]byte) (n int, ok bool, errPos int) {
+	n, next, ok := scanTSV(b, fields)
+	if !ok || next != len(b) {
+		return 0, false, next
+	}
+	return n, true, -1
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+)
+
+var data = []byte("1997,Ford,E350,\"ac, abs, moon\",3000.00\r\n")
+
+var hits int
+
+func TestScanCSVRow(t *testing.T) {
+	tests := []struct {
+		row  string
+		want []string
+		ok   bool
+	}{
+		{string(data), []string{"1997", "Ford", "E350", "ac, abs, moon", "3000.00"}, true},
+		{"a,b,c", []string{"a", "b", "c"}, true},
+		{"a,b,c\n", []string{"a", "b", "c"}, true},
+		{"single", []string{"single"}, true},
+		{",,", []string{"", "", ""}, true},
+		{"", []string{""}, true},
+		{`"",""`, []string{"", ""}, true},
+		{`1999,Chevy,"Venture ""Extended Edition""",,4900.00`, []string{"1999", "Chevy", `Venture "Extended Edition"`, "", "4900.00"}, true},
+		{"\"multi\r\nline\",x\r\n", []string{"multi\r\nline", "x"}, true},
+		{`"""quoted"""`, []string{`"quoted"`}, true},
+		{"a b, c ", []string{"a b", " c "}, true},
+		{"tab\there,x", []string{"tab\there", "x"}, true},
+
+		// quotes only around whole fields
+		{row: `a"b,c`},
+		{row: `"a"b,c`},
+		{row: `"unterminated,c`},
+		// bare CR
+		{row: "a\rb"},
+		// more than one record
+		{row: "a,b\nc,d\n"},
+		// more fields than we have room for
+		{row: "1,2,3,4,5,6,7,8,9"},
+	}
+
+	fields := make([][]byte, 8)
+	for _, tt := range tests {
+		n, ok := ScanCSVRow([]byte(tt.row), fields)
+		if ok != tt.ok {
+			t.Errorf("ScanCSVRow(%q) ok=%v, want %v", tt.row, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var got []string
+		for _, f := range fields[:n] {
+			got = append(got, string(f))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ScanCSVRow(%q)=%q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestScanCSVRowAt(t *testing.T) {
+	tests := []struct {
+		row    string
+		errPos int
+	}{
+		{string(data), -1},
+		{"a,\"b\"c\r\n", 5},
+		{"a,\"b", 4},
+		{"1,2,3,4,5,6,7,8,9\r\n", 15},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ScanCSVRowAt([]byte(tt.row), make([][]byte, 8))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanCSVRowAt(%q)=%v, %d, want errPos %d", tt.row, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestScanTSVRow(t *testing.T) {
+	tests := []struct {
+		row  string
+		want []string
+		ok   bool
+	}{
+		{"a\tb\tc\n", []string{"a", "b", "c"}, true},
+		{"commas, are\tfine", []string{"commas, are", "fine"}, true},
+		{"\"tab\tquoted\"\t\"\"\"\"\r\n", []string{"tab\tquoted", `"`}, true},
+		{"\t", []string{"", ""}, true},
+		{row: "a\t\"b"},
+	}
+
+	fields := make([][]byte, 8)
+	for _, tt := range tests {
+		n, ok := ScanTSVRow([]byte(tt.row), fields)
+		if ok != tt.ok {
+			t.Errorf("ScanTSVRow(%q) ok=%v, want %v", tt.row, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var got []string
+		for _, f := range fields[:n] {
+			got = append(got, string(f))
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ScanTSVRow(%q)=%q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestScanTSVRowAt(t *testing.T) {
+	tests := []struct {
+		row    string
+		errPos int
+	}{
+		{"a\tb\n", -1},
+		{"a\tb\"c\n", 3},
+		{"1\t2\t3\t4\t5\t6\t7\t8\t9\n", 15},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ScanTSVRowAt([]byte(tt.row), make([][]byte, 8))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanTSVRowAt(%q)=%v, %d, want errPos %d", tt.row, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// payload is 1000 rows in the style of a typical data export, with the
+// occasional quoted field.
+var payload = func() []byte {
+	var buf bytes.Buffer
+	for i := 0; i < 1000; i++ {
+		switch i % 4 {
+		case 0:
+			fmt.Fprintf(&buf, "%d,Ford,E350,\"ac, abs, moon\",%d.00\r\n", 1990+i%30, 3000+i)
+		case 1:
+			fmt.Fprintf(&buf, "%d,Chevy,\"Venture \"\"Extended Edition\"\"\",,%d.00\r\n", 1990+i%30, 4900+i)
+		case 2:
+			fmt.Fprintf(&buf, "%d,Jeep,Grand Cherokee,\"MUST SELL!\nair, moon roof, loaded\",%d.00\r\n", 1990+i%30, 4799+i)
+		default:
+			fmt.Fprintf(&buf, "%d,Honda,Civic,plain,%d.00\r\n", 1990+i%30, 1200+i)
+		}
+	}
+	return buf.Bytes()
+}()
+
+func TestCSVAlternatives(t *testing.T) {
+	r := csv.NewReader(bytes.NewReader(payload))
+	fields := make([][]byte, 8)
+	b := payload
+	for {
+		want, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		n, next, ok := scanCSV(b, fields)
+		if !ok {
+			t.Fatalf("scanCSV(%q) failed", b[:20])
+		}
+		var got []string
+		for _, f := range fields[:n] {
+			got = append(got, string(f))
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("scanCSV=%q, want %q", got, want)
+		}
+		b = b[next:]
+	}
+	if len(b) != 0 {
+		t.Errorf("%d bytes left over", len(b))
+	}
+}
+
+func BenchmarkEncodingCSV(b *testing.B) {
+	b.ReportAllocs()
+	rd := bytes.NewReader(payload)
+	for i := 0; i < b.N; i++ {
+		rd.Reset(payload)
+		r := csv.NewReader(rd)
+		r.ReuseRecord = true
+		for {
+			_, err := r.Read()
+			if err != nil {
+				break
+			}
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	b.ReportAllocs()
+	fields := make([][]byte, 8)
+	for i := 0; i < b.N; i++ {
+		for p := payload; len(p) > 0; {
+			_, next, ok := scanCSV(p, fields)
+			if !ok {
+				break
+			}
+			hits++
+			p = p[next:]
+		}
+	}
+}
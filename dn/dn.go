@@ -0,0 +1,984 @@
+
+//line dn.rl:1
+package main
+
+// DNAttribute is one attribute type and value from a distinguished name.
+// Attributes sharing an RDN, joined by '+', have the same RDN index.
+type DNAttribute struct {
+	Type  []byte
+	Value []byte
+	RDN   int
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// unescapeDN removes the backslash escapes from a string or quoted value,
+// decoding \XX hex pairs into single bytes.
+func unescapeDN(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			out = append(out, v[i])
+			continue
+		}
+		i++
+		if i+1 < len(v) && isHex(v[i]) && isHex(v[i+1]) {
+			out = append(out, unhex(v[i])<<4|unhex(v[i+1]))
+			i++
+			continue
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseDN parses a distinguished name in the string form from RFC 4514,
+// such as "CN=John Doe,OU=Engineering,O=Acme Corp,C=US".  As in the older
+// RFC 2253 and RFC 1779 forms, ';' also separates RDNs, values may be
+// enclosed in double quotes, and spaces are allowed around the separators.
+//
+// Attribute types must be keywords or dotted-decimal OIDs.  Values are
+// unescaped, except for those written as "#" and hex-encoded BER, which are
+// returned as they are, '#' included.  Types, and values without escapes,
+// point into data.
+func ParseDN(data []byte) ([]DNAttribute, bool) {
+	attrs, ok, _ := ParseDNAt(data)
+	return attrs, ok
+}
+
+// ParseDNAt is like ParseDN but also returns the offset of the first byte
+// that is out of place, or -1 if data parsed.
+func ParseDNAt(data []byte) ([]DNAttribute, bool, int) {
+
+
+//line dn.rl:64
+
+//line dn.go:69
+const dn_start int = 23
+const dn_first_final int = 23
+const dn_error int = 0
+
+const dn_en_main int = 23
+
+
+//line dn.rl:65
+
+	var attrs []DNAttribute
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var typ, value []byte
+	rdn := 0
+	escaped := false
+
+	
+//line dn.go:89
+	{
+	cs = dn_start
+	}
+
+//line dn.go:94
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 23:
+		goto st_case_23
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 27:
+		goto st_case_27
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 28:
+		goto st_case_28
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	}
+	goto st_out
+	st_case_23:
+		switch data[p] {
+		case 32:
+			goto st1
+		case 48:
+			goto tr2
+		}
+		switch {
+		case data[p] < 65:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto tr3
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr32:
+//line dn.rl:76
+ mark = p 
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+	goto st1
+tr33:
+//line dn.rl:76
+ mark = p 
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+//line dn.rl:81
+ rdn++ 
+	goto st1
+tr43:
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+	goto st1
+tr44:
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+//line dn.rl:81
+ rdn++ 
+	goto st1
+tr52:
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+	goto st1
+tr53:
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+//line dn.rl:81
+ rdn++ 
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line dn.go:261
+		switch data[p] {
+		case 32:
+			goto st1
+		case 48:
+			goto tr2
+		}
+		switch {
+		case data[p] < 65:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto tr3
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+tr2:
+//line dn.rl:76
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line dn.go:290
+		if data[p] == 46 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 48 {
+			goto st4
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 32:
+			goto tr8
+		case 46:
+			goto st3
+		case 61:
+			goto tr9
+		}
+		goto st0
+tr8:
+//line dn.rl:77
+ typ = data[mark:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line dn.go:330
+		switch data[p] {
+		case 32:
+			goto st5
+		case 61:
+			goto tr11
+		}
+		goto st0
+tr9:
+//line dn.rl:77
+ typ = data[mark:p] 
+//line dn.rl:78
+ escaped = false 
+	goto st24
+tr11:
+//line dn.rl:78
+ escaped = false 
+	goto st24
+tr29:
+//line dn.rl:76
+ mark = p 
+//line dn.rl:80
+ value = data[mark:p] 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line dn.go:359
+		switch data[p] {
+		case 0:
+			goto st0
+		case 32:
+			goto tr29
+		case 34:
+			goto st15
+		case 35:
+			goto tr31
+		case 43:
+			goto tr32
+		case 44:
+			goto tr33
+		case 59:
+			goto tr33
+		case 60:
+			goto st0
+		case 62:
+			goto st0
+		case 92:
+			goto tr34
+		case 224:
+			goto tr36
+		case 237:
+			goto tr38
+		case 240:
+			goto tr39
+		case 244:
+			goto tr41
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto tr35
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto tr40
+			}
+		default:
+			goto tr37
+		}
+		goto tr28
+tr12:
+//line dn.rl:79
+ escaped = true 
+	goto st25
+tr28:
+//line dn.rl:76
+ mark = p 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line dn.go:426
+		switch data[p] {
+		case 0:
+			goto st0
+		case 32:
+			goto tr42
+		case 34:
+			goto st0
+		case 43:
+			goto tr43
+		case 44:
+			goto tr44
+		case 59:
+			goto tr44
+		case 60:
+			goto st0
+		case 62:
+			goto st0
+		case 92:
+			goto st6
+		case 224:
+			goto st9
+		case 237:
+			goto st11
+		case 240:
+			goto st12
+		case 244:
+			goto st14
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto st8
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto st13
+			}
+		default:
+			goto st10
+		}
+		goto st25
+tr42:
+//line dn.rl:80
+ value = data[mark:p] 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line dn.go:487
+		switch data[p] {
+		case 0:
+			goto st0
+		case 32:
+			goto st26
+		case 34:
+			goto st0
+		case 43:
+			goto tr52
+		case 44:
+			goto tr53
+		case 59:
+			goto tr53
+		case 60:
+			goto st0
+		case 62:
+			goto st0
+		case 92:
+			goto st6
+		case 224:
+			goto st9
+		case 237:
+			goto st11
+		case 240:
+			goto st12
+		case 244:
+			goto st14
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto st8
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto st13
+			}
+		default:
+			goto st10
+		}
+		goto st25
+tr34:
+//line dn.rl:76
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line dn.go:548
+		switch data[p] {
+		case 32:
+			goto tr12
+		case 92:
+			goto tr12
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 35:
+				if 43 <= data[p] && data[p] <= 44 {
+					goto tr12
+				}
+			case data[p] >= 34:
+				goto tr12
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] < 65:
+				if 59 <= data[p] && data[p] <= 62 {
+					goto tr12
+				}
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr13
+				}
+			default:
+				goto tr13
+			}
+		default:
+			goto tr13
+		}
+		goto st0
+tr13:
+//line dn.rl:79
+ escaped = true 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line dn.go:591
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+tr35:
+//line dn.rl:76
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line dn.go:614
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st25
+		}
+		goto st0
+tr36:
+//line dn.rl:76
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line dn.go:628
+		if 160 <= data[p] && data[p] <= 191 {
+			goto st8
+		}
+		goto st0
+tr37:
+//line dn.rl:76
+ mark = p 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line dn.go:642
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st8
+		}
+		goto st0
+tr38:
+//line dn.rl:76
+ mark = p 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line dn.go:656
+		if 128 <= data[p] && data[p] <= 159 {
+			goto st8
+		}
+		goto st0
+tr39:
+//line dn.rl:76
+ mark = p 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line dn.go:670
+		if 144 <= data[p] && data[p] <= 191 {
+			goto st10
+		}
+		goto st0
+tr40:
+//line dn.rl:76
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line dn.go:684
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st10
+		}
+		goto st0
+tr41:
+//line dn.rl:76
+ mark = p 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line dn.go:698
+		if 128 <= data[p] && data[p] <= 143 {
+			goto st10
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 34:
+			goto tr18
+		case 92:
+			goto tr19
+		}
+		goto tr17
+tr17:
+//line dn.rl:76
+ mark = p 
+	goto st16
+tr23:
+//line dn.rl:79
+ escaped = true 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line dn.go:728
+		switch data[p] {
+		case 34:
+			goto tr21
+		case 92:
+			goto st17
+		}
+		goto st16
+tr18:
+//line dn.rl:76
+ mark = p 
+//line dn.rl:80
+ value = data[mark:p] 
+	goto st27
+tr21:
+//line dn.rl:80
+ value = data[mark:p] 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line dn.go:751
+		switch data[p] {
+		case 32:
+			goto st27
+		case 43:
+			goto tr52
+		case 44:
+			goto tr53
+		case 59:
+			goto tr53
+		}
+		goto st0
+tr19:
+//line dn.rl:76
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line dn.go:772
+		goto tr23
+tr31:
+//line dn.rl:76
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line dn.go:783
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st19
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st19
+			}
+		default:
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st28
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st28
+			}
+		default:
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 32:
+			goto tr21
+		case 43:
+			goto tr43
+		case 44:
+			goto tr44
+		case 59:
+			goto tr44
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st19
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st19
+			}
+		default:
+			goto st19
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 32:
+			goto tr8
+		case 46:
+			goto st3
+		case 61:
+			goto tr9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+tr3:
+//line dn.rl:76
+ mark = p 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line dn.go:869
+		if data[p] == 46 {
+			goto st3
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st21
+		}
+		goto st0
+tr4:
+//line dn.rl:76
+ mark = p 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line dn.go:886
+		switch data[p] {
+		case 32:
+			goto tr8
+		case 45:
+			goto st22
+		case 61:
+			goto tr9
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st22
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st22
+			}
+		default:
+			goto st22
+		}
+		goto st0
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 24:
+//line dn.rl:76
+ mark = p 
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+		case 25, 28:
+//line dn.rl:80
+ value = data[mark:p] 
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+		case 26, 27:
+//line dn.rl:83
+
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    
+//line dn.go:970
+		}
+	}
+
+	_out: {}
+	}
+
+//line dn.rl:127
+
+
+	if cs < dn_first_final {
+		return nil, false, p
+	}
+
+	return attrs, true, -1
+}
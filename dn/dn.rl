@@ -0,0 +1,134 @@
+package main
+
+// DNAttribute is one attribute type and value from a distinguished name.
+// Attributes sharing an RDN, joined by '+', have the same RDN index.
+type DNAttribute struct {
+	Type  []byte
+	Value []byte
+	RDN   int
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+// unescapeDN removes the backslash escapes from a string or quoted value,
+// decoding \XX hex pairs into single bytes.
+func unescapeDN(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			out = append(out, v[i])
+			continue
+		}
+		i++
+		if i+1 < len(v) && isHex(v[i]) && isHex(v[i+1]) {
+			out = append(out, unhex(v[i])<<4|unhex(v[i+1]))
+			i++
+			continue
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseDN parses a distinguished name in the string form from RFC 4514,
+// such as "CN=John Doe,OU=Engineering,O=Acme Corp,C=US".  As in the older
+// RFC 2253 and RFC 1779 forms, ';' also separates RDNs, values may be
+// enclosed in double quotes, and spaces are allowed around the separators.
+//
+// Attribute types must be keywords or dotted-decimal OIDs.  Values are
+// unescaped, except for those written as "#" and hex-encoded BER, which are
+// returned as they are, '#' included.  Types, and values without escapes,
+// point into data.
+func ParseDN(data []byte) ([]DNAttribute, bool) {
+	attrs, ok, _ := ParseDNAt(data)
+	return attrs, ok
+}
+
+// ParseDNAt is like ParseDN but also returns the offset of the first byte
+// that is out of place, or -1 if data parsed.
+func ParseDNAt(data []byte) ([]DNAttribute, bool, int) {
+
+%% machine dn;
+%% write data;
+
+	var attrs []DNAttribute
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var typ, value []byte
+	rdn := 0
+	escaped := false
+
+	%%{
+	    action mark   { mark = p }
+	    action type   { typ = data[mark:p] }
+	    action reset  { escaped = false }
+	    action escape { escaped = true }
+	    action value  { value = data[mark:p] }
+	    action rdn    { rdn++ }
+
+	    action attr {
+	        if escaped {
+	            value = unescapeDN(value)
+	        }
+	        attrs = append(attrs, DNAttribute{Type: typ, Value: value, RDN: rdn})
+	    }
+
+	    # RFC 4512 section 1.4
+	    descr = alpha ( alnum | '-' )* ;
+	    number = digit | [1-9] digit+ ;
+	    numericoid = number ( '.' number )+ ;
+	    attribute_type = descr | numericoid ;
+
+	    utfmb = 0xc2..0xdf 0x80..0xbf
+	          | 0xe0 0xa0..0xbf 0x80..0xbf
+	          | ( 0xe1..0xec | 0xee..0xef ) 0x80..0xbf 0x80..0xbf
+	          | 0xed 0x80..0x9f 0x80..0xbf
+	          | 0xf0 0x90..0xbf 0x80..0xbf 0x80..0xbf
+	          | 0xf1..0xf3 0x80..0xbf 0x80..0xbf 0x80..0xbf
+	          | 0xf4 0x80..0x8f 0x80..0xbf 0x80..0xbf ;
+
+	    # RFC 4514 section 3; a string value can't start with '#' or a
+	    # space, or end with a space, unless they are escaped
+	    lutf1 = 0x01..0x1f | 0x21 | 0x24..0x2a | 0x2d..0x3a | 0x3d | 0x3f..0x5b | 0x5d..0x7f ;
+	    tutf1 = 0x01..0x1f | 0x21 | 0x23..0x2a | 0x2d..0x3a | 0x3d | 0x3f..0x5b | 0x5d..0x7f ;
+	    sutf1 = 0x01..0x21 | 0x23..0x2a | 0x2d..0x3a | 0x3d | 0x3f..0x5b | 0x5d..0x7f ;
+	    pair = '\\' ( [\\"+,;<> #=] | xdigit xdigit ) >escape ;
+
+	    string = ( ( lutf1 | utfmb | pair ) ( ( sutf1 | utfmb | pair )* ( tutf1 | utfmb | pair ) )? )? ;
+	    quoted = '"' ( ( any - ["\\] ) | '\\' any >escape )* >mark %value '"' ;
+	    hexstring = '#' ( xdigit xdigit )+ ;
+
+	    attribute_value = string >mark %value | quoted | hexstring >mark %value ;
+
+	    ows = ' '* ;
+
+	    atv = attribute_type >mark %type ows '=' @reset ows attribute_value ows ;
+
+	    rdn = ows atv %attr ( '+' ows atv %attr )* ;
+
+	    main := ( rdn ( [,;] @rdn rdn )* )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < dn_first_final {
+		return nil, false, p
+	}
+
+	return attrs, true, -1
+}
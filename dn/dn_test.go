@@ -0,0 +1,177 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+var data = []byte(`CN=John Doe,OU=Engineering,O=Acme Corp,L=San Francisco,ST=California,C=US`)
+
+var hits int
+
+type attr struct {
+	typ, value string
+	rdn        int
+}
+
+func TestParseDN(t *testing.T) {
+	tests := []struct {
+		dn   string
+		want []attr
+		ok   bool
+	}{
+		{
+			dn: string(data),
+			want: []attr{
+				{"CN", "John Doe", 0}, {"OU", "Engineering", 1}, {"O", "Acme Corp", 2},
+				{"L", "San Francisco", 3}, {"ST", "California", 4}, {"C", "US", 5},
+			},
+			ok: true,
+		},
+		{
+			// RFC 4514 section 4
+			dn:   `UID=jsmith,DC=example,DC=net`,
+			want: []attr{{"UID", "jsmith", 0}, {"DC", "example", 1}, {"DC", "net", 2}},
+			ok:   true,
+		},
+		{
+			dn:   `OU=Sales+CN=J.  Smith,DC=example,DC=net`,
+			want: []attr{{"OU", "Sales", 0}, {"CN", "J.  Smith", 0}, {"DC", "example", 1}, {"DC", "net", 2}},
+			ok:   true,
+		},
+		{
+			dn:   `CN=James \"Jim\" Smith\, III,DC=example,DC=net`,
+			want: []attr{{"CN", `James "Jim" Smith, III`, 0}, {"DC", "example", 1}, {"DC", "net", 2}},
+			ok:   true,
+		},
+		{
+			dn:   `CN=Before\0dAfter,DC=example,DC=net`,
+			want: []attr{{"CN", "Before\rAfter", 0}, {"DC", "example", 1}, {"DC", "net", 2}},
+			ok:   true,
+		},
+		{
+			dn:   `1.3.6.1.4.1.1466.0=#04024869`,
+			want: []attr{{"1.3.6.1.4.1.1466.0", "#04024869", 0}},
+			ok:   true,
+		},
+		{
+			dn:   `CN=Lu\C4\8Di\C4\87`,
+			want: []attr{{"CN", "Lučić", 0}},
+			ok:   true,
+		},
+		{
+			dn:   `CN=Jürgen Müller; O="Acme, Inc." ; C = DE`,
+			want: []attr{{"CN", "Jürgen Müller", 0}, {"O", "Acme, Inc.", 1}, {"C", "DE", 2}},
+			ok:   true,
+		},
+		{
+			dn:   `CN=\ leading and trailing\ ,O=\#hash,OU=a\=b\+c\<d\>e\;f\\g,SN=`,
+			want: []attr{{"CN", " leading and trailing ", 0}, {"O", "#hash", 1}, {"OU", `a=b+c<d>e;f\g`, 2}, {"SN", "", 3}},
+			ok:   true,
+		},
+		{dn: ``, ok: true},
+
+		{dn: `CN=a,b`},
+		{dn: `CN=a+b`},
+		{dn: `CN=#hash`},
+		{dn: `CN=#0`},
+		{dn: `CN="unterminated`},
+		{dn: `CN=a"b`},
+		{dn: `CN=a<b`},
+		{dn: `CN=trailing\`},
+		{dn: `CN=\q`},
+		{dn: `CN=bad` + "\xff" + `utf8`},
+		{dn: `1CN=x`},
+		{dn: `1.03=x`},
+		{dn: `1.=x`},
+		{dn: `C_N=x`},
+		{dn: `=x`},
+		{dn: `CN=a,`},
+		{dn: `,CN=a`},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseDN([]byte(tt.dn))
+		if ok != tt.ok {
+			t.Errorf("ParseDN(%q) ok=%v, want %v", tt.dn, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var attrs []attr
+		for _, a := range got {
+			attrs = append(attrs, attr{string(a.Type), string(a.Value), a.RDN})
+		}
+		if !reflect.DeepEqual(attrs, tt.want) {
+			t.Errorf("ParseDN(%q)=%+v, want %+v", tt.dn, attrs, tt.want)
+		}
+	}
+}
+
+func TestParseDNAt(t *testing.T) {
+	tests := []struct {
+		dn     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"CN=John Doe,", 12},
+		{"CN John Doe", 3},
+		{`CN=John "Doe"`, 8},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseDNAt([]byte(tt.dn))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseDNAt(%q)=%v, %d, want errPos %d", tt.dn, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// crypto/x509 only parses DNs in their DER form, so the comparison is with
+// the string DN parser from go-ldap.
+func ldapDN(dn string) ([]attr, bool) {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return nil, false
+	}
+	var attrs []attr
+	for i, rdn := range parsed.RDNs {
+		for _, a := range rdn.Attributes {
+			attrs = append(attrs, attr{a.Type, a.Value, i})
+		}
+	}
+	return attrs, true
+}
+
+func TestDNAlternatives(t *testing.T) {
+	for _, dn := range []string{string(data), `OU=Sales+CN=J.  Smith,DC=example,DC=net`, `CN=James \"Jim\" Smith\, III,DC=example,DC=net`} {
+		parsed, _ := ParseDN([]byte(dn))
+		var want []attr
+		for _, a := range parsed {
+			want = append(want, attr{string(a.Type), string(a.Value), a.RDN})
+		}
+		if got, ok := ldapDN(dn); !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("ldapDN(%q)=%+v, want %+v", dn, got, want)
+		}
+	}
+}
+
+func BenchmarkLDAP(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, err := ldap.ParseDN(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseDN(data); ok {
+			hits++
+		}
+	}
+}
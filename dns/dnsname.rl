@@ -0,0 +1,71 @@
+package main
+
+import "bytes"
+
+// ParseDNSName splits a domain name in presentation format into its
+// labels, which point into data.  Each label must follow the LDH rule from
+// RFC 1123: letters, digits, and hyphens, 1 to 63 of them, and not starting
+// or ending with a hyphen.  Hyphens in the third and fourth positions are
+// only allowed in IDN A-labels ("xn--").  The leftmost label may instead be
+// the wildcard "*".  A trailing dot is optional, and the root name "." has
+// no labels.  The name must fit in the 255 octets allowed on the wire.
+//
+// Backslash escapes are not supported.
+func ParseDNSName(data []byte) (labels [][]byte, ok bool) {
+	labels, ok, _ = ParseDNSNameAt(data)
+	return labels, ok
+}
+
+// ParseDNSNameAt is like ParseDNSName but also returns the offset of the
+// first byte that is not allowed where it is, or -1 if data is a valid
+// name.  A name too long for the wire fails at the first label that
+// doesn't fit.
+func ParseDNSNameAt(data []byte) (labels [][]byte, ok bool, errPos int) {
+
+%% machine dnsname;
+%% write data;
+
+	labels = make([][]byte, 0, bytes.Count(data, []byte{'.'})+1)
+
+	// the root label
+	wire := 1
+	long := -1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark { mark = p }
+
+	    action label {
+	        labels = append(labels, data[mark:p])
+	        wire += 1 + p - mark
+	        if wire > 255 && long < 0 {
+	            long = mark
+	        }
+	    }
+
+	    ldh_label = alnum ( ( alnum | '-' ){0,61} alnum )? ;
+
+	    # RFC 5891 section 4.2.3.1
+	    label = ldh_label - ( ( any{2} - 'xn'i ) '--' any* ) ;
+
+	    name = ( '*' >mark %label '.' )? label >mark %label ( '.' label >mark %label )* '.'? ;
+
+	    main := '.' | name ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < dnsname_first_final {
+		return nil, false, p
+	}
+
+	if long >= 0 {
+		return nil, false, long
+	}
+
+	return labels, true, -1
+}
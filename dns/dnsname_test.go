@@ -0,0 +1,125 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+var data = []byte("www.example.com.")
+
+var hits int
+
+func TestParseDNSName(t *testing.T) {
+	label63 := strings.Repeat("a", 63)
+
+	// 3 labels of 63 plus one of 61 is 4*64 - 2 + 1 = 255 octets on the wire
+	longest := label63 + "." + label63 + "." + label63 + "." + strings.Repeat("b", 61) + "."
+
+	tests := []struct {
+		name string
+		want []string
+		ok   bool
+	}{
+		{name: string(data), want: []string{"www", "example", "com"}, ok: true},
+		{name: "www.example.com", want: []string{"www", "example", "com"}, ok: true},
+		{name: ".", want: []string{}, ok: true},
+		{name: "localhost", want: []string{"localhost"}, ok: true},
+		{name: "*.example.com", want: []string{"*", "example", "com"}, ok: true},
+		{name: "xn--bcher-kva.example.", want: []string{"xn--bcher-kva", "example"}, ok: true},
+		{name: "XN--80AKHBYKNJ4F.com", want: []string{"XN--80AKHBYKNJ4F", "com"}, ok: true},
+		{name: "a-b.c--d.9x.1", want: []string{"a-b", "c--d", "9x", "1"}, ok: true},
+		{name: label63 + ".com", want: []string{label63, "com"}, ok: true},
+		{name: longest, want: []string{label63, label63, label63, strings.Repeat("b", 61)}, ok: true},
+
+		{name: longest[:len(longest)-1] + "bb."},
+		{name: strings.Repeat("a", 64) + ".com"},
+		{name: "ab--cd.example"},
+		{name: "-www.example.com"},
+		{name: "www-.example.com"},
+		{name: "www..example.com"},
+		{name: ".example.com"},
+		{name: "www.example.com.."},
+		{name: "www.*.example.com"},
+		{name: "*"},
+		{name: "*.*.example.com"},
+		{name: "_dmarc.example.com"},
+		{name: `www\.example.com`},
+		{name: "www.exam ple.com"},
+		{name: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseDNSName([]byte(tt.name))
+		if ok != tt.ok {
+			t.Errorf("ParseDNSName(%q) ok=%v, want %v", tt.name, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		labels := []string{}
+		for _, l := range got {
+			labels = append(labels, string(l))
+		}
+		if !reflect.DeepEqual(labels, tt.want) {
+			t.Errorf("ParseDNSName(%q)=%q, want %q", tt.name, labels, tt.want)
+		}
+	}
+}
+
+func TestParseDNSNameAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"www..example.com", 4},
+		{"-www.example.com", 0},
+		{strings.Repeat("a", 64) + ".com", 63},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseDNSNameAt([]byte(tt.name))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseDNSNameAt(%q)=%v, %d, want errPos %d", tt.name, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestDNSNameAlternatives(t *testing.T) {
+	labels, _ := ParseDNSName(data)
+	if n, ok := dns.IsDomainName(string(data)); !ok || n != len(labels) {
+		t.Errorf("dns.IsDomainName=%d %v, want %d", n, ok, len(labels))
+	}
+}
+
+// dns.IsFqdn only looks for the trailing dot; IsDomainName is the closer
+// match but is more lenient about what a label may hold.
+func BenchmarkIsFqdn(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if dns.IsFqdn(s) {
+			hits++
+		}
+	}
+}
+
+func BenchmarkIsDomainName(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := dns.IsDomainName(s); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseDNSName(data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,1315 @@
+
+//line dockerfile.rl:1
+package main
+
+// DockerInstruction is a single Dockerfile instruction.  The byte slices
+// point into the parsed line.
+type DockerInstruction struct {
+	Keyword []byte
+	Flags   []DockerFlag
+	Args    []byte
+}
+
+// DockerFlag is a --name=value flag given before an instruction's
+// arguments.  Value is nil for a flag written as just --name.
+type DockerFlag struct {
+	Name  []byte
+	Value []byte
+}
+
+// ParseDockerfileLine parses a Dockerfile instruction such as
+//
+//	RUN --mount=type=cache,target=/go/pkg/mod go build ./...
+//
+// Any words starting with "--" directly after the keyword are flags, and
+// the rest of the line, less surrounding whitespace, is the arguments.
+// Unlike docker build, which ignores case, the keyword must be uppercase.
+// Flag values may not be quoted, and lines continued with a backslash need
+// to be joined before being passed in.
+func ParseDockerfileLine(data []byte) (DockerInstruction, bool) {
+	inst, ok, _ := ParseDockerfileLineAt(data)
+	return inst, ok
+}
+
+// ParseDockerfileLineAt is like ParseDockerfileLine, and also returns where
+// the line went wrong, or -1 if it didn't.
+func ParseDockerfileLineAt(data []byte) (DockerInstruction, bool, int) {
+
+
+//line dockerfile.rl:37
+
+//line dockerfile.go:42
+const dockerfile_start int = 1
+const dockerfile_first_final int = 81
+const dockerfile_error int = 0
+
+const dockerfile_en_main int = 1
+
+
+//line dockerfile.rl:38
+
+	var inst DockerInstruction
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line dockerfile.go:59
+	{
+	cs = dockerfile_start
+	}
+
+//line dockerfile.go:64
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 6:
+		goto st_case_6
+	case 83:
+		goto st_case_83
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 9:
+			goto st1
+		case 32:
+			goto st1
+		case 65:
+			goto tr2
+		case 67:
+			goto tr3
+		case 69:
+			goto tr4
+		case 70:
+			goto tr5
+		case 72:
+			goto tr6
+		case 76:
+			goto tr7
+		case 77:
+			goto tr8
+		case 79:
+			goto tr9
+		case 82:
+			goto tr10
+		case 83:
+			goto tr11
+		case 85:
+			goto tr12
+		case 86:
+			goto tr13
+		case 87:
+			goto tr14
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr2:
+//line dockerfile.rl:46
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line dockerfile.go:291
+		switch data[p] {
+		case 68:
+			goto st3
+		case 82:
+			goto st11
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 68 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 9:
+			goto tr18
+		case 32:
+			goto tr18
+		}
+		goto st0
+tr18:
+//line dockerfile.rl:47
+ inst.Keyword = data[mark:p] 
+	goto st5
+tr25:
+//line dockerfile.rl:49
+ inst.Flags = append(inst.Flags, DockerFlag{Name: data[mark:p]}) 
+	goto st5
+tr29:
+//line dockerfile.rl:46
+ mark = p 
+//line dockerfile.rl:50
+ inst.Flags[len(inst.Flags)-1].Value = data[mark:p] 
+	goto st5
+tr31:
+//line dockerfile.rl:50
+ inst.Flags[len(inst.Flags)-1].Value = data[mark:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line dockerfile.go:343
+		switch data[p] {
+		case 9:
+			goto st5
+		case 32:
+			goto st5
+		case 45:
+			goto tr21
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr19
+tr19:
+//line dockerfile.rl:46
+ mark = p 
+	goto st81
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+//line dockerfile.go:365
+		switch data[p] {
+		case 9:
+			goto tr89
+		case 32:
+			goto tr89
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st6
+		}
+		goto st81
+tr89:
+//line dockerfile.rl:54
+ inst.Args = data[mark:p] 
+	goto st82
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+//line dockerfile.go:385
+		switch data[p] {
+		case 9:
+			goto st82
+		case 32:
+			goto st82
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st6
+		}
+		goto st81
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 32 {
+			goto st6
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st6
+		}
+		goto st81
+tr21:
+//line dockerfile.rl:46
+ mark = p 
+	goto st83
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+//line dockerfile.go:417
+		switch data[p] {
+		case 9:
+			goto tr89
+		case 32:
+			goto tr89
+		case 45:
+			goto st7
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st6
+		}
+		goto st81
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if 97 <= data[p] && data[p] <= 122 {
+			goto tr24
+		}
+		goto st0
+tr24:
+//line dockerfile.rl:46
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line dockerfile.go:448
+		switch data[p] {
+		case 9:
+			goto tr25
+		case 32:
+			goto tr25
+		case 45:
+			goto st8
+		case 61:
+			goto tr27
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st8
+			}
+		case data[p] >= 48:
+			goto st8
+		}
+		goto st0
+tr27:
+//line dockerfile.rl:49
+ inst.Flags = append(inst.Flags, DockerFlag{Name: data[mark:p]}) 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line dockerfile.go:477
+		switch data[p] {
+		case 9:
+			goto tr29
+		case 32:
+			goto tr29
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr28
+tr28:
+//line dockerfile.rl:46
+ mark = p 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line dockerfile.go:497
+		switch data[p] {
+		case 9:
+			goto tr31
+		case 32:
+			goto tr31
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st10
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 71 {
+			goto st4
+		}
+		goto st0
+tr3:
+//line dockerfile.rl:46
+ mark = p 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line dockerfile.go:526
+		switch data[p] {
+		case 77:
+			goto st3
+		case 79:
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 80 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 89 {
+			goto st4
+		}
+		goto st0
+tr4:
+//line dockerfile.rl:46
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line dockerfile.go:561
+		switch data[p] {
+		case 78:
+			goto st16
+		case 88:
+			goto st24
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 84:
+			goto st17
+		case 86:
+			goto st4
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 82 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 89 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 80 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 79 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 73 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 78 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 84 {
+			goto st4
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 80 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 79 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 83 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 69 {
+			goto st4
+		}
+		goto st0
+tr5:
+//line dockerfile.rl:46
+ mark = p 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line dockerfile.go:689
+		if data[p] == 82 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 79 {
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 77 {
+			goto st4
+		}
+		goto st0
+tr6:
+//line dockerfile.rl:46
+ mark = p 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line dockerfile.go:721
+		if data[p] == 69 {
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 65 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 76 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 84 {
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 72 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 67 {
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 72 {
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 69 {
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 67 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 75 {
+			goto st4
+		}
+		goto st0
+tr7:
+//line dockerfile.rl:46
+ mark = p 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line dockerfile.go:816
+		if data[p] == 65 {
+			goto st42
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		if data[p] == 66 {
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if data[p] == 69 {
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 76 {
+			goto st4
+		}
+		goto st0
+tr8:
+//line dockerfile.rl:46
+ mark = p 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line dockerfile.go:857
+		if data[p] == 65 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 73 {
+			goto st47
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		if data[p] == 78 {
+			goto st48
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 84 {
+			goto st49
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 65 {
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 73 {
+			goto st51
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 78 {
+			goto st52
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		if data[p] == 69 {
+			goto st53
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 82 {
+			goto st4
+		}
+		goto st0
+tr9:
+//line dockerfile.rl:46
+ mark = p 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line dockerfile.go:943
+		if data[p] == 78 {
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 66 {
+			goto st56
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		if data[p] == 85 {
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 73 {
+			goto st58
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 76 {
+			goto st3
+		}
+		goto st0
+tr10:
+//line dockerfile.rl:46
+ mark = p 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line dockerfile.go:993
+		if data[p] == 85 {
+			goto st60
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 78 {
+			goto st4
+		}
+		goto st0
+tr11:
+//line dockerfile.rl:46
+ mark = p 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line dockerfile.go:1016
+		switch data[p] {
+		case 72:
+			goto st62
+		case 84:
+			goto st64
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 69 {
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 76 {
+			goto st44
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 79 {
+			goto st65
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 80 {
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 83 {
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 73 {
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 71 {
+			goto st69
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 78 {
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 65 {
+			goto st44
+		}
+		goto st0
+tr12:
+//line dockerfile.rl:46
+ mark = p 
+	goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+//line dockerfile.go:1114
+		if data[p] == 83 {
+			goto st52
+		}
+		goto st0
+tr13:
+//line dockerfile.rl:46
+ mark = p 
+	goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+//line dockerfile.go:1128
+		if data[p] == 79 {
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 76 {
+			goto st74
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 85 {
+			goto st75
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 77 {
+			goto st27
+		}
+		goto st0
+tr14:
+//line dockerfile.rl:46
+ mark = p 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line dockerfile.go:1169
+		if data[p] == 79 {
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 82 {
+			goto st78
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 75 {
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 68 {
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 73 {
+			goto st53
+		}
+		goto st0
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 81, 83:
+//line dockerfile.rl:54
+ inst.Args = data[mark:p] 
+//line dockerfile.go:1301
+		}
+	}
+
+	_out: {}
+	}
+
+//line dockerfile.rl:74
+
+
+	if cs < dockerfile_first_final {
+		return DockerInstruction{}, false, p
+	}
+
+	return inst, true, -1
+}
@@ -0,0 +1,81 @@
+package main
+
+// DockerInstruction is a single Dockerfile instruction.  The byte slices
+// point into the parsed line.
+type DockerInstruction struct {
+	Keyword []byte
+	Flags   []DockerFlag
+	Args    []byte
+}
+
+// DockerFlag is a --name=value flag given before an instruction's
+// arguments.  Value is nil for a flag written as just --name.
+type DockerFlag struct {
+	Name  []byte
+	Value []byte
+}
+
+// ParseDockerfileLine parses a Dockerfile instruction such as
+//
+//	RUN --mount=type=cache,target=/go/pkg/mod go build ./...
+//
+// Any words starting with "--" directly after the keyword are flags, and
+// the rest of the line, less surrounding whitespace, is the arguments.
+// Unlike docker build, which ignores case, the keyword must be uppercase.
+// Flag values may not be quoted, and lines continued with a backslash need
+// to be joined before being passed in.
+func ParseDockerfileLine(data []byte) (DockerInstruction, bool) {
+	inst, ok, _ := ParseDockerfileLineAt(data)
+	return inst, ok
+}
+
+// ParseDockerfileLineAt is like ParseDockerfileLine, and also returns where
+// the line went wrong, or -1 if it didn't.
+func ParseDockerfileLineAt(data []byte) (DockerInstruction, bool, int) {
+
+%% machine dockerfile;
+%% write data;
+
+	var inst DockerInstruction
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark    { mark = p }
+	    action keyword { inst.Keyword = data[mark:p] }
+
+	    action flag_name { inst.Flags = append(inst.Flags, DockerFlag{Name: data[mark:p]}) }
+	    action flag_value { inst.Flags[len(inst.Flags)-1].Value = data[mark:p] }
+
+	    # trailing whitespace can only be told apart from the arguments once
+	    # the line ends, so args may be set more than once
+	    action args { inst.Args = data[mark:p] }
+
+	    ws = [ \t] ;
+
+	    keyword = 'ADD' | 'ARG' | 'CMD' | 'COPY' | 'ENTRYPOINT' | 'ENV' |
+	              'EXPOSE' | 'FROM' | 'HEALTHCHECK' | 'LABEL' | 'MAINTAINER' |
+	              'ONBUILD' | 'RUN' | 'SHELL' | 'STOPSIGNAL' | 'USER' |
+	              'VOLUME' | 'WORKDIR' ;
+
+	    flag_name = lower ( lower | digit | '-' )* ;
+	    flag_value = ( any - space )* ;
+	    flag = '--' flag_name >mark %flag_name ( '=' flag_value >mark %flag_value )? ;
+
+	    nonws = any - space ;
+	    args = ( ( nonws ( any* nonws )? ) - ( '--' any* ) ) >mark %args ;
+
+	    main := ws* keyword >mark %keyword ( ws+ flag )* ws+ args ws* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < dockerfile_first_final {
+		return DockerInstruction{}, false, p
+	}
+
+	return inst, true, -1
+}
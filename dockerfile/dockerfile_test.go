@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+)
+
+var data = []byte(`RUN --mount=type=cache,target=/go/pkg/mod --network=none go build -o /out/app ./...`)
+
+var hits int
+
+func TestParseDockerfileLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want DockerInstruction
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: DockerInstruction{
+				Keyword: []byte("RUN"),
+				Flags: []DockerFlag{
+					{Name: []byte("mount"), Value: []byte("type=cache,target=/go/pkg/mod")},
+					{Name: []byte("network"), Value: []byte("none")},
+				},
+				Args: []byte("go build -o /out/app ./..."),
+			},
+			ok: true,
+		},
+		{
+			line: `FROM golang:1.22-alpine AS builder`,
+			want: DockerInstruction{Keyword: []byte("FROM"), Args: []byte("golang:1.22-alpine AS builder")},
+			ok:   true,
+		},
+		{
+			line: `COPY --chown=user:group src dest`,
+			want: DockerInstruction{
+				Keyword: []byte("COPY"),
+				Flags:   []DockerFlag{{Name: []byte("chown"), Value: []byte("user:group")}},
+				Args:    []byte("src dest"),
+			},
+			ok: true,
+		},
+		{
+			line: "  COPY --link --from=builder\t/out/app /usr/local/bin/ \t",
+			want: DockerInstruction{
+				Keyword: []byte("COPY"),
+				Flags: []DockerFlag{
+					{Name: []byte("link")},
+					{Name: []byte("from"), Value: []byte("builder")},
+				},
+				Args: []byte("/out/app /usr/local/bin/"),
+			},
+			ok: true,
+		},
+		{
+			line: `FROM --platform= alpine`,
+			want: DockerInstruction{
+				Keyword: []byte("FROM"),
+				Flags:   []DockerFlag{{Name: []byte("platform"), Value: []byte("")}},
+				Args:    []byte("alpine"),
+			},
+			ok: true,
+		},
+		{
+			line: `CMD ["/bin/sh", "-c", "echo --not-a-flag"]`,
+			want: DockerInstruction{Keyword: []byte("CMD"), Args: []byte(`["/bin/sh", "-c", "echo --not-a-flag"]`)},
+			ok:   true,
+		},
+		{
+			line: `ENTRYPOINT -v`,
+			want: DockerInstruction{Keyword: []byte("ENTRYPOINT"), Args: []byte("-v")},
+			ok:   true,
+		},
+		{
+			line: `HEALTHCHECK --interval=5m --timeout=3s CMD curl -f http://localhost/ || exit 1`,
+			want: DockerInstruction{
+				Keyword: []byte("HEALTHCHECK"),
+				Flags: []DockerFlag{
+					{Name: []byte("interval"), Value: []byte("5m")},
+					{Name: []byte("timeout"), Value: []byte("3s")},
+				},
+				Args: []byte("CMD curl -f http://localhost/ || exit 1"),
+			},
+			ok: true,
+		},
+		{
+			line: `ONBUILD RUN make`,
+			want: DockerInstruction{Keyword: []byte("ONBUILD"), Args: []byte("RUN make")},
+			ok:   true,
+		},
+		{
+			line: `ENV A=1 B="two words"`,
+			want: DockerInstruction{Keyword: []byte("ENV"), Args: []byte(`A=1 B="two words"`)},
+			ok:   true,
+		},
+
+		// keywords must be uppercase
+		{line: `from alpine`},
+		{line: `From alpine`},
+		{line: `FETCH alpine`},
+		{line: `FROMalpine`},
+		// arguments are required
+		{line: `RUN`},
+		{line: `RUN `},
+		{line: `RUN --network=none`},
+		{line: `RUN --network=none `},
+		// flags are lowercase
+		{line: `RUN --Network=none true`},
+		{line: `RUN -- true`},
+		{line: `# syntax=docker/dockerfile:1`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseDockerfileLine([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseDockerfileLine(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseDockerfileLine(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseDockerfileLineAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"RUNS echo hi", 3},
+		{"COPY --chown=", 13},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseDockerfileLineAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseDockerfileLineAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// buildkitDockerfile runs the parser used by docker build.  It has to deal
+// with whole files, so it also handles continuation lines, comments,
+// parser directives, and heredocs, and splits the arguments of most
+// instructions into words.
+func buildkitDockerfile(line []byte) (keyword string, flags []string, ok bool) {
+	res, err := parser.Parse(bytes.NewReader(line))
+	if err != nil || len(res.AST.Children) != 1 {
+		return "", nil, false
+	}
+	n := res.AST.Children[0]
+	return n.Value, n.Flags, true
+}
+
+func TestDockerfileAlternatives(t *testing.T) {
+	want, _ := ParseDockerfileLine(data)
+	keyword, flags, ok := buildkitDockerfile(data)
+	if !ok || !strings.EqualFold(keyword, string(want.Keyword)) || len(flags) != len(want.Flags) {
+		t.Fatalf("buildkitDockerfile=%q, %q, %v, want %s with %d flags", keyword, flags, ok, want.Keyword, len(want.Flags))
+	}
+	for i, f := range want.Flags {
+		if s := "--" + string(f.Name) + "=" + string(f.Value); flags[i] != s {
+			t.Errorf("buildkitDockerfile flag %d=%q, want %q", i, flags[i], s)
+		}
+	}
+}
+
+func BenchmarkBuildkit(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := buildkitDockerfile(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseDockerfileLine(data); ok {
+			hits++
+		}
+	}
+}
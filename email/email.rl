@@ -0,0 +1,95 @@
+package main
+
+// matchEmail reports whether data is a syntactically valid RFC 5321 mailbox.
+// The local part may be at most 64 octets and the whole address at most 254,
+// the longest that fits in a forward-path.
+func matchEmail(data []byte) bool {
+	ok, _ := matchEmailAt(data)
+	return ok
+}
+
+// matchEmailAt is like matchEmail but also returns the offset of the first
+// byte that makes data invalid, or -1 if it is valid.  An address that is
+// too long fails at the first byte past the limit.
+func matchEmailAt(data []byte) (ok bool, errPos int) {
+
+%% machine email;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	at := 0
+
+	%%{
+	    action at { at = p }
+
+	    # local-part = Dot-string / Quoted-string
+	    atext = alnum | [!#$%&'*+\-/=?\^_`{|}~] ;
+	    atom = atext+ ;
+	    dot_string = atom ( '.' atom )* ;
+
+	    qtext = 32..33 | 35..91 | 93..126 ;
+	    quoted_pair = '\\' 32..126 ;
+	    quoted_string = '"' ( qtext | quoted_pair )* '"' ;
+
+	    local_part = dot_string | quoted_string ;
+
+	    # labels are limited to 63 octets and can't start or end with a hyphen
+	    domain_label = alnum ( ( alnum | '-' ){0,61} alnum )? ;
+	    domain = domain_label ( '.' domain_label )* ;
+
+	    dec_octet = digit
+	              | '1'..'9' digit
+	              | '1' digit{2}
+	              | '2' '0'..'4' digit
+	              | '25' '0'..'5' ;
+	    ipv4 = dec_octet '.' dec_octet '.' dec_octet '.' dec_octet ;
+
+	    h16 = xdigit{1,4} ;
+	    ipv6_full = h16 ( ':' h16 ){7} ;
+	    ipv6v4_full = h16 ( ':' h16 ){5} ':' ipv4 ;
+
+	    # at most 6 groups besides the "::", split any way between its two
+	    # sides, or 4 in front of an IPv4 address
+	    ipv6_comp = '::' ( h16 ( ':' h16 ){,5} )?
+	              | h16 '::' ( h16 ( ':' h16 ){,4} )?
+	              | h16 ( ':' h16 ){1} '::' ( h16 ( ':' h16 ){,3} )?
+	              | h16 ( ':' h16 ){2} '::' ( h16 ( ':' h16 ){,2} )?
+	              | h16 ( ':' h16 ){3} '::' ( h16 ( ':' h16 )? )?
+	              | h16 ( ':' h16 ){4} '::' h16?
+	              | h16 ( ':' h16 ){5} '::' ;
+	    ipv6v4_comp = ( '::' ( h16 ':' ){,4}
+	                  | h16 '::' ( h16 ':' ){,3}
+	                  | h16 ( ':' h16 ){1} '::' ( h16 ':' ){,2}
+	                  | h16 ( ':' h16 ){2} '::' ( h16 ':' )?
+	                  | h16 ( ':' h16 ){3} '::' ) ipv4 ;
+	    ipv6 = ipv6_full | ipv6_comp | ipv6v4_full | ipv6v4_comp ;
+
+	    dcontent = 33..90 | 94..126 ;
+	    standardized_tag = ( alnum | '-' )* alnum ;
+	    general = ( standardized_tag - 'IPv6' ) ':' dcontent+ ;
+
+	    address_literal = '[' ( ipv4 | 'IPv6:' ipv6 | general ) ']' ;
+
+	    main := local_part '@' @at ( domain | address_literal ) ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < email_first_final {
+		return false, p
+	}
+
+	// RFC 5321 4.5.3.1.3's limit on the path includes the angle brackets
+	switch {
+	case at > 64:
+		return false, 64
+	case len(data) > 254:
+		return false, 254
+	}
+
+	return true, -1
+}
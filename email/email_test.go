@@ -0,0 +1,170 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var addresses = [][]byte{
+	[]byte("john.doe@example.com"),
+	[]byte("jane+newsletter@mail.example.co.uk"),
+	[]byte("x@example.org"),
+	[]byte("first_last-123@sub-domain.example.net"),
+	[]byte(`"john..doe"@example.com`),
+	[]byte("postmaster@[192.0.2.1]"),
+	[]byte("not an address"),
+	[]byte("missing-at.example.com"),
+}
+
+var hits int
+
+func TestMatchEmail(t *testing.T) {
+	tests := []struct {
+		addr string
+		ok   bool
+	}{
+		{"simple@example.com", true},
+		{"very.common@example.com", true},
+		{"disposable.style.email.with+symbol@example.com", true},
+		{"other.email-with-hyphen@example.com", true},
+		{"x@example.com", true},
+		{"user@localhost", true},
+		{"!#$%&'*+-/=?^_`{|}~@example.org", true},
+		{"example-indeed@strange-example.com", true},
+		{"admin@mailserver1", true},
+		{"user@[192.168.2.1]", true},
+		{"user@[IPv6:2001:db8::1]", true},
+		{"user@[IPv6:::ffff:192.0.2.1]", true},
+		{"user@[IPv6:1:2:3:4:5:6:7:8]", true},
+		{"user@[IPv6:1:2:3::4:5:6]", true},
+		{"user@[IPv6:::1:2:3:4:5:6]", true},
+		{"user@[IPv6:1:2:3:4:5:6::]", true},
+		{"user@[IPv6:1:2::3:4:1.2.3.4]", true},
+		{"user@[x-tag:opaque.data]", true},
+		{`" "@example.org`, true},
+		{`"john..doe"@example.org`, true},
+		{`"very.(),:;<>[]\".VERY.\"very@\\ \"very\".unusual"@strange.example.com`, true},
+		{`"escaped\@at"@example.com`, true},
+		{strings.Repeat("a", 64) + "@example.com", true},
+		{"a@" + strings.Repeat("b", 63) + ".com", true},
+
+		{"Abc.example.com", false},
+		{"A@b@c@example.com", false},
+		{`a"b(c)d,e:f;g<h>i[j\k]l@example.com`, false},
+		{`just"not"right@example.com`, false},
+		{`this is"not\allowed@example.com`, false},
+		{"john..doe@example.com", false},
+		{".john@example.com", false},
+		{"john.@example.com", false},
+		{"john@example..com", false},
+		{"john@-example.com", false},
+		{"john@example-.com", false},
+		{"john@.example.com", false},
+		{"john@example.com.", false},
+		{"john@", false},
+		{"@example.com", false},
+		{`"unterminated@example.com`, false},
+		{"\"tab\tinside\"@example.com", false},
+		{"user@[256.1.1.1]", false},
+		{"user@[192.168.2]", false},
+		{"user@[IPv6:2001:db8::zz]", false},
+		{"user@[IPv6:1::2::3]", false},
+		{"a@[IPv6:1:2:3:4:5:6::7:8:9:10:11:12]", false},
+		{"a@[IPv6:1:2:3::4:5:6:7]", false},
+		{"a@[IPv6:1:2:3:4::5:6:7:8:1.2.3.4]", false},
+		{"a@[IPv6:1:2::3:4:5:1.2.3.4]", false},
+		{"user@192.168.2.1]", false},
+		{strings.Repeat("a", 65) + "@example.com", false},
+		{"a@" + strings.Repeat("b", 64) + ".com", false},
+		{"a@" + strings.Repeat("b.", 126) + "com", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchEmail([]byte(tt.addr)); got != tt.ok {
+			t.Errorf("matchEmail(%q)=%v, want %v", tt.addr, got, tt.ok)
+		}
+	}
+}
+
+func TestMatchEmailAt(t *testing.T) {
+	tests := []struct {
+		addr   string
+		errPos int
+	}{
+		{"simple@example.com", -1},
+		{"Abc.example.com", 15},
+		{"A@b@c@example.com", 3},
+		{"john..doe@example.com", 5},
+		{"john@example-.com", 13},
+		{"user@[256.1.1.1]", 9}, // 256 could still be a tag, as in [x-tag:data]
+		{`"unterminated@example.com`, 25},
+		// too long for a label, and for a local part
+		{"a@" + strings.Repeat("b", 64) + ".com", 65},
+		{strings.Repeat("a", 65) + "@example.com", 64},
+	}
+
+	for _, tt := range tests {
+		ok, errPos := matchEmailAt([]byte(tt.addr))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("matchEmailAt(%q)=%v, %d, want errPos %d", tt.addr, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// A selection of the regular expressions people tend to paste into their
+// code, from least to most thorough.
+var (
+	// the one everyone writes first
+	reSimple = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+	// the "good enough" one from countless blog posts
+	reCommon = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
+
+	// the WHATWG HTML input type=email pattern
+	reHTML5 = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+	// the "RFC 5322 official standard" one from emailregex.com
+	reRFC5322 = regexp.MustCompile("(?i)^(?:[a-z0-9!#$%&'*+/=?^_`{|}~-]+(?:\\.[a-z0-9!#$%&'*+/=?^_`{|}~-]+)*|\"(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x21\\x23-\\x5b\\x5d-\\x7f]|\\\\[\\x01-\\x09\\x0b\\x0c\\x0e-\\x7f])*\")@(?:(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\\.)+[a-z0-9](?:[a-z0-9-]*[a-z0-9])?|\\[(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?|[a-z0-9-]*[a-z0-9]:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x21-\\x5a\\x53-\\x7f]|\\\\[\\x01-\\x09\\x0b\\x0c\\x0e-\\x7f])+)\\])$")
+)
+
+func TestEmailAlternatives(t *testing.T) {
+	// the regexps disagree with each other on the harder cases, but all
+	// of them should agree on plain dotted addresses
+	for _, addr := range addresses[:4] {
+		for _, re := range []*regexp.Regexp{reSimple, reCommon, reHTML5, reRFC5322} {
+			if !re.Match(addr) {
+				t.Errorf("%v doesn't match %q", re, addr)
+			}
+		}
+		if !matchEmail(addr) {
+			t.Errorf("matchEmail(%q)=false", addr)
+		}
+	}
+}
+
+func benchmarkRegex(b *testing.B, re *regexp.Regexp) {
+	for i := 0; i < b.N; i++ {
+		for _, addr := range addresses {
+			if re.Match(addr) {
+				hits++
+			}
+		}
+	}
+}
+
+func BenchmarkRegexSimple(b *testing.B)  { benchmarkRegex(b, reSimple) }
+func BenchmarkRegexCommon(b *testing.B)  { benchmarkRegex(b, reCommon) }
+func BenchmarkRegexHTML5(b *testing.B)   { benchmarkRegex(b, reHTML5) }
+func BenchmarkRegexRFC5322(b *testing.B) { benchmarkRegex(b, reRFC5322) }
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, addr := range addresses {
+			if matchEmail(addr) {
+				hits++
+			}
+		}
+	}
+}
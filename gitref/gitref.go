@@ -0,0 +1,3808 @@
+
+//line gitref.rl:1
+package main
+
+// GitRefType says what kind of revision a GitRef names.
+type GitRefType int
+
+const (
+	// GitRefSHA is a full or abbreviated object name.
+	GitRefSHA GitRefType = iota + 1
+
+	// GitRefSymbolic is a ref name such as HEAD or refs/heads/main.
+	GitRefSymbolic
+
+	// GitRefRelative is a SHA or ref name followed by ~ and ^ steps back
+	// through history, such as HEAD~3^2.
+	GitRefRelative
+)
+
+// GitRef is a parsed revision.  For relative revisions, Base is the SHA or
+// ref name the steps start from and Path holds the steps.  Both point into
+// the parsed input.
+type GitRef struct {
+	Type GitRefType
+	Base []byte
+	Path []byte
+}
+
+// ParseGitRef parses a revision naming an object by SHA, by ref, or
+// relative to either.  An object name is 4 to 64 hex digits, covering
+// abbreviations as well as full SHA-1 and SHA-256 names; like git, the
+// parser can't tell a branch made of hex digits from an abbreviated SHA,
+// and reports it as a SHA.  Ref names follow the rules of git
+// check-ref-format --allow-onelevel.
+func ParseGitRef(data []byte) (GitRef, bool) {
+	r, ok, _ := ParseGitRefAt(data)
+	return r, ok
+}
+
+// ParseGitRefAt is like ParseGitRef but also returns the offset of the
+// first byte that makes data an invalid ref name, or -1 if it is valid.
+func ParseGitRefAt(data []byte) (GitRef, bool, int) {
+
+
+//line gitref.rl:43
+
+//line gitref.go:48
+const gitref_start int = 1
+const gitref_first_final int = 6
+const gitref_error int = 0
+
+const gitref_en_main int = 1
+
+
+//line gitref.rl:44
+
+	var r GitRef
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line gitref.go:65
+	{
+	cs = gitref_start
+	}
+
+//line gitref.go:70
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 6:
+		goto st_case_6
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 4:
+		goto st_case_4
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 5:
+		goto st_case_5
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto tr3
+		case 94:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] < 46:
+				if data[p] <= 32 {
+					goto st0
+				}
+			case data[p] > 47:
+				if data[p] <= 57 {
+					goto tr2
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] < 97:
+				if 91 <= data[p] && data[p] <= 92 {
+					goto st0
+				}
+			case data[p] > 102:
+				if 126 <= data[p] && data[p] <= 127 {
+					goto st0
+				}
+			default:
+				goto tr2
+			}
+		default:
+			goto tr2
+		}
+		goto tr1
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line gitref.rl:52
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line gitref.go:287
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st0
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto st0
+		case 108:
+			goto st9
+		}
+		switch {
+		case data[p] < 91:
+			if data[p] <= 32 {
+				goto st0
+			}
+		case data[p] > 92:
+			if 126 <= data[p] && data[p] <= 127 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto st0
+		}
+		switch {
+		case data[p] < 46:
+			if data[p] <= 32 {
+				goto st0
+			}
+		case data[p] > 47:
+			switch {
+			case data[p] > 92:
+				if 126 <= data[p] && data[p] <= 127 {
+					goto st0
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 123:
+			goto st0
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+tr9:
+//line gitref.rl:54
+ r.Type = GitRefSymbolic 
+//line gitref.rl:55
+ r.Base = data[mark:p] 
+//line gitref.rl:52
+ mark = p 
+	goto st8
+tr18:
+//line gitref.rl:53
+ r.Type = GitRefSHA 
+//line gitref.rl:55
+ r.Base = data[mark:p] 
+//line gitref.rl:52
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line gitref.go:445
+		switch data[p] {
+		case 94:
+			goto st8
+		case 126:
+			goto st8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 111:
+			goto st10
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 99:
+			goto st11
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 107:
+			goto st4
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st0
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto st0
+		}
+		switch {
+		case data[p] < 91:
+			if data[p] <= 32 {
+				goto st0
+			}
+		case data[p] > 92:
+			if 126 <= data[p] && data[p] <= 127 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+tr2:
+//line gitref.rl:52
+ mark = p 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line gitref.go:607
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st13
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st13
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st13
+		}
+		goto st6
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st14
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st14
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st14
+		}
+		goto st6
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr9
+		case 126:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st15
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st15
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st15
+		}
+		goto st6
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st16
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st16
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st16
+		}
+		goto st6
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st17
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st17
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st17
+		}
+		goto st6
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st18
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st18
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st18
+		}
+		goto st6
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st19
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st19
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st19
+		}
+		goto st6
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st20
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st20
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st20
+		}
+		goto st6
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st21
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st21
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st21
+		}
+		goto st6
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st22
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st22
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st22
+		}
+		goto st6
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st23
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st23
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st23
+		}
+		goto st6
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st24
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st24
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st24
+		}
+		goto st6
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st25
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st25
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st25
+		}
+		goto st6
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st26
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st26
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st26
+		}
+		goto st6
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st27
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st27
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st27
+		}
+		goto st6
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st28
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st28
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st28
+		}
+		goto st6
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st29
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st29
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st29
+		}
+		goto st6
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st30
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st30
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st30
+		}
+		goto st6
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st31
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st31
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st31
+		}
+		goto st6
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st32
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st32
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st32
+		}
+		goto st6
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st33
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st33
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st33
+		}
+		goto st6
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st34
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st34
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st34
+		}
+		goto st6
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st35
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st35
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st35
+		}
+		goto st6
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st36
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st36
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st36
+		}
+		goto st6
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st37
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st37
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st37
+		}
+		goto st6
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st38
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st38
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st38
+		}
+		goto st6
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st39
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st39
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st39
+		}
+		goto st6
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st40
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st40
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st40
+		}
+		goto st6
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st41
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st41
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st41
+		}
+		goto st6
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st42
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st42
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st42
+		}
+		goto st6
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st43
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st43
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st43
+		}
+		goto st6
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st44
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st44
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st44
+		}
+		goto st6
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st45
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st45
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st45
+		}
+		goto st6
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st46
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st46
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st46
+		}
+		goto st6
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st47
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st47
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st47
+		}
+		goto st6
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st48
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st48
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st48
+		}
+		goto st6
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st49
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st49
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st49
+		}
+		goto st6
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st50
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st50
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st50
+		}
+		goto st6
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st51
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st51
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st51
+		}
+		goto st6
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st52
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st52
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st52
+		}
+		goto st6
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st53
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st53
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st53
+		}
+		goto st6
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st54
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st54
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st54
+		}
+		goto st6
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st55
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st55
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st55
+		}
+		goto st6
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st56
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st56
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st56
+		}
+		goto st6
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st57
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st57
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st57
+		}
+		goto st6
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st58
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st58
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st58
+		}
+		goto st6
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st59
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st59
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st59
+		}
+		goto st6
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st60
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st60
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st60
+		}
+		goto st6
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st61
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st61
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st61
+		}
+		goto st6
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st62
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st62
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st62
+		}
+		goto st6
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st63
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st63
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st63
+		}
+		goto st6
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st64
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st64
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st64
+		}
+		goto st6
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st65
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st65
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st65
+		}
+		goto st6
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st66
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st66
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st66
+		}
+		goto st6
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st67
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st67
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st67
+		}
+		goto st6
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st68
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st68
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st68
+		}
+		goto st6
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st69
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st69
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st69
+		}
+		goto st6
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st70
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st70
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st70
+		}
+		goto st6
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st71
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st71
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st71
+		}
+		goto st6
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st72
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st72
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st72
+		}
+		goto st6
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st73
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st73
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st73
+		}
+		goto st6
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st74
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st74
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st74
+		}
+		goto st6
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 32:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st75
+				}
+			default:
+				goto st0
+			}
+		case data[p] > 70:
+			switch {
+			case data[p] > 92:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st75
+				}
+			case data[p] >= 91:
+				goto st0
+			}
+		default:
+			goto st75
+		}
+		goto st6
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto tr18
+		case 126:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 32:
+			if 91 <= data[p] && data[p] <= 92 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+tr3:
+//line gitref.rl:52
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line gitref.go:3670
+		switch data[p] {
+		case 42:
+			goto st0
+		case 46:
+			goto st2
+		case 47:
+			goto st3
+		case 58:
+			goto st0
+		case 63:
+			goto st0
+		case 64:
+			goto st7
+		case 94:
+			goto st0
+		case 123:
+			goto st0
+		}
+		switch {
+		case data[p] < 91:
+			if data[p] <= 32 {
+				goto st0
+			}
+		case data[p] > 92:
+			if 126 <= data[p] && data[p] <= 127 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st6
+	st_out:
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 6, 7, 9, 10, 11, 12, 13, 14:
+//line gitref.rl:54
+ r.Type = GitRefSymbolic 
+//line gitref.rl:55
+ r.Base = data[mark:p] 
+		case 8:
+//line gitref.rl:56
+ r.Type = GitRefRelative; r.Path = data[mark:p] 
+		case 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75:
+//line gitref.rl:53
+ r.Type = GitRefSHA 
+//line gitref.rl:55
+ r.Base = data[mark:p] 
+//line gitref.go:3794
+		}
+	}
+
+	_out: {}
+	}
+
+//line gitref.rl:79
+
+
+	if cs < gitref_first_final {
+		return GitRef{}, false, p
+	}
+
+	return r, true, -1
+}
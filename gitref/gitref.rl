@@ -0,0 +1,86 @@
+package main
+
+// GitRefType says what kind of revision a GitRef names.
+type GitRefType int
+
+const (
+	// GitRefSHA is a full or abbreviated object name.
+	GitRefSHA GitRefType = iota + 1
+
+	// GitRefSymbolic is a ref name such as HEAD or refs/heads/main.
+	GitRefSymbolic
+
+	// GitRefRelative is a SHA or ref name followed by ~ and ^ steps back
+	// through history, such as HEAD~3^2.
+	GitRefRelative
+)
+
+// GitRef is a parsed revision.  For relative revisions, Base is the SHA or
+// ref name the steps start from and Path holds the steps.  Both point into
+// the parsed input.
+type GitRef struct {
+	Type GitRefType
+	Base []byte
+	Path []byte
+}
+
+// ParseGitRef parses a revision naming an object by SHA, by ref, or
+// relative to either.  An object name is 4 to 64 hex digits, covering
+// abbreviations as well as full SHA-1 and SHA-256 names; like git, the
+// parser can't tell a branch made of hex digits from an abbreviated SHA,
+// and reports it as a SHA.  Ref names follow the rules of git
+// check-ref-format --allow-onelevel.
+func ParseGitRef(data []byte) (GitRef, bool) {
+	r, ok, _ := ParseGitRefAt(data)
+	return r, ok
+}
+
+// ParseGitRefAt is like ParseGitRef but also returns the offset of the
+// first byte that makes data an invalid ref name, or -1 if it is valid.
+func ParseGitRefAt(data []byte) (GitRef, bool, int) {
+
+%% machine gitref;
+%% write data;
+
+	var r GitRef
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark     { mark = p }
+	    action sha      { r.Type = GitRefSHA }
+	    action symbolic { r.Type = GitRefSymbolic }
+	    action base     { r.Base = data[mark:p] }
+	    action path     { r.Type = GitRefRelative; r.Path = data[mark:p] }
+
+	    sha = xdigit{4,64} ;
+
+	    # no control characters, space, ~ ^ : ? * [ or \ anywhere
+	    refchar = any - ( cntrl | 0x7f | [ ~\^:?*\[\\] ) ;
+
+	    # no component may start with a '.' or end with ".lock"
+	    component = ( refchar - '/' )+ - ( '.' any* ) - ( any* '.lock' ) ;
+
+	    refname = ( component ( '/' component )* )
+	              -- '..' -- '@{'
+	              - ( any* '.' )
+	              - '@' ;
+
+	    symbolic = refname - sha ;
+
+	    path = ( '~' digit* | '^' digit* )+ ;
+
+	    main := ( sha %sha | symbolic %symbolic ) >mark %base ( path >mark %path )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < gitref_first_final {
+		return GitRef{}, false, p
+	}
+
+	return r, true, -1
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+var data = []byte("refs/heads/feature/parse-refs~3^2")
+
+var hits int
+
+func TestParseGitRef(t *testing.T) {
+	sha1 := "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	sha256 := "6ef19b41225c5369f1c104d45d8d85efa9b057b53b14b4b9b939dd74decc5321"
+
+	tests := []struct {
+		ref  string
+		want GitRef
+		ok   bool
+	}{
+		{ref: sha1, want: GitRef{Type: GitRefSHA, Base: []byte(sha1)}, ok: true},
+		{ref: sha256, want: GitRef{Type: GitRefSHA, Base: []byte(sha256)}, ok: true},
+		{ref: "4B825DC", want: GitRef{Type: GitRefSHA, Base: []byte("4B825DC")}, ok: true},
+		{ref: "beef", want: GitRef{Type: GitRefSHA, Base: []byte("beef")}, ok: true},
+		{ref: "HEAD", want: GitRef{Type: GitRefSymbolic, Base: []byte("HEAD")}, ok: true},
+		{ref: "refs/heads/main", want: GitRef{Type: GitRefSymbolic, Base: []byte("refs/heads/main")}, ok: true},
+		{ref: "origin/release-1.2", want: GitRef{Type: GitRefSymbolic, Base: []byte("origin/release-1.2")}, ok: true},
+		{ref: "refs/tags/v1.0.0-rc.1", want: GitRef{Type: GitRefSymbolic, Base: []byte("refs/tags/v1.0.0-rc.1")}, ok: true},
+		{ref: "abc", want: GitRef{Type: GitRefSymbolic, Base: []byte("abc")}, ok: true},
+		{ref: "user@host", want: GitRef{Type: GitRefSymbolic, Base: []byte("user@host")}, ok: true},
+		{ref: sha1 + "0", want: GitRef{Type: GitRefSHA, Base: []byte(sha1 + "0")}, ok: true},
+		{ref: sha256 + "0", want: GitRef{Type: GitRefSymbolic, Base: []byte(sha256 + "0")}, ok: true},
+		{ref: "HEAD~3^2", want: GitRef{Type: GitRefRelative, Base: []byte("HEAD"), Path: []byte("~3^2")}, ok: true},
+		{ref: "main^", want: GitRef{Type: GitRefRelative, Base: []byte("main"), Path: []byte("^")}, ok: true},
+		{ref: "4b825dc~~", want: GitRef{Type: GitRefRelative, Base: []byte("4b825dc"), Path: []byte("~~")}, ok: true},
+		{
+			ref:  string(data),
+			want: GitRef{Type: GitRefRelative, Base: []byte("refs/heads/feature/parse-refs"), Path: []byte("~3^2")},
+			ok:   true,
+		},
+
+		{ref: "refs/heads/a..b"},
+		{ref: "refs/heads/main.lock"},
+		{ref: "refs/heads/.hidden"},
+		{ref: "refs/heads/main."},
+		{ref: "refs/heads/main/"},
+		{ref: "/refs/heads/main"},
+		{ref: "refs//heads"},
+		{ref: "main@{upstream}"},
+		{ref: "@"},
+		{ref: `refs\heads`},
+		{ref: "refs/heads/with space"},
+		{ref: "refs/heads/col:on"},
+		{ref: "refs/heads/qu?"},
+		{ref: "refs/heads/st*r"},
+		{ref: "refs/heads/br[acket"},
+		{ref: "refs/heads/tab\t"},
+		{ref: "HEAD~3x"},
+		{ref: "~3"},
+		{ref: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseGitRef([]byte(tt.ref))
+		if ok != tt.ok {
+			t.Errorf("ParseGitRef(%q) ok=%v, want %v", tt.ref, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseGitRef(%q)=%+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestParseGitRefAt(t *testing.T) {
+	tests := []struct {
+		ref    string
+		errPos int
+	}{
+		{string(data), -1},
+		{"refs/heads/a..b", 13},
+		{"refs/heads/feature~x", 19},
+		{"refs/heads/a b", 12},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseGitRefAt([]byte(tt.ref))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseGitRefAt(%q)=%v, %d, want errPos %d", tt.ref, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// RE2 has no lookahead, so the rules about what a ref may not contain are
+// checked separately.
+var (
+	reGitRef  = regexp.MustCompile(`^([^\x00-\x20\x7f~^:?*\[\\]+?)((?:[~^][0-9]*)*)$`)
+	reGitSHA  = regexp.MustCompile(`^[0-9a-fA-F]{4,64}$`)
+	reBadPart = regexp.MustCompile(`(?:^|/)\.|\.lock(?:/|$)|//|^/|/$|\.\.|@\{|\.$`)
+)
+
+func regexGitRef(ref []byte) (GitRef, bool) {
+	m := reGitRef.FindSubmatch(ref)
+	if m == nil || reBadPart.Match(m[1]) || bytes.Equal(m[1], []byte("@")) {
+		return GitRef{}, false
+	}
+	r := GitRef{Type: GitRefSymbolic, Base: m[1]}
+	if reGitSHA.Match(m[1]) {
+		r.Type = GitRefSHA
+	}
+	if len(m[2]) > 0 {
+		r.Type, r.Path = GitRefRelative, m[2]
+	}
+	return r, true
+}
+
+func TestGitRefAlternatives(t *testing.T) {
+	for _, ref := range []string{string(data), "HEAD", "4b825dc", "refs/heads/a..b", "x.lock/y"} {
+		want, wantOK := ParseGitRef([]byte(ref))
+		if got, ok := regexGitRef([]byte(ref)); ok != wantOK || !reflect.DeepEqual(got, want) {
+			t.Errorf("regexGitRef(%q)=%+v %v, want %+v %v", ref, got, ok, want, wantOK)
+		}
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexGitRef(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseGitRef(data); ok {
+			hits++
+		}
+	}
+}
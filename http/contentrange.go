@@ -0,0 +1,1264 @@
+
+//line contentrange.rl:1
+package main
+
+// ContentRange is the value of a Content-Range header.  First and Last are
+// -1 for an unsatisfied range, "*/length", and Length is -1 when the
+// complete length is given as "*".
+type ContentRange struct {
+	First  int64
+	Last   int64
+	Length int64
+}
+
+// ParseContentRange parses the value of a Content-Range header as defined
+// by RFC 7233 section 4.2, such as "bytes 0-499/1234".  Only byte ranges
+// are accepted, and the positions and length are limited to 18 digits.
+// The range must not be backwards and must lie within the complete length
+// if one is given.
+func ParseContentRange(data []byte) (ContentRange, bool) {
+	cr, ok, _ := ParseContentRangeAt(data)
+	return cr, ok
+}
+
+// ParseContentRangeAt is like ParseContentRange but also returns the offset
+// of the first byte that is wrong, or -1 if there is none.  A backwards
+// range fails at its last position, and one running past the end fails at
+// the length.
+func ParseContentRangeAt(data []byte) (ContentRange, bool, int) {
+
+
+//line contentrange.rl:29
+
+//line contentrange.go:34
+const contentrange_start int = 1
+const contentrange_first_final int = 48
+const contentrange_error int = 0
+
+const contentrange_en_main int = 1
+
+
+//line contentrange.rl:30
+
+	cr := ContentRange{First: -1, Last: -1, Length: -1}
+
+	var lastAt, lengthAt int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	
+//line contentrange.go:53
+	{
+	cs = contentrange_start
+	}
+
+//line contentrange.go:58
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 66:
+			goto st2
+		case 98:
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 89:
+			goto st3
+		case 121:
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 84:
+			goto st4
+		case 116:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 69:
+			goto st5
+		case 101:
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 83:
+			goto st6
+		case 115:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 32 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 42 {
+			goto st8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if data[p] == 47 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr10
+		}
+		goto st0
+tr10:
+//line contentrange.rl:44
+ cr.Length = 0; lengthAt = p 
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line contentrange.go:308
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr49
+		}
+		goto st0
+tr49:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line contentrange.go:322
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr50
+		}
+		goto st0
+tr50:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line contentrange.go:336
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr51
+		}
+		goto st0
+tr51:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line contentrange.go:350
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr52
+		}
+		goto st0
+tr52:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line contentrange.go:364
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr53
+		}
+		goto st0
+tr53:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line contentrange.go:378
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr54
+		}
+		goto st0
+tr54:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line contentrange.go:392
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr55
+		}
+		goto st0
+tr55:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line contentrange.go:406
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr56
+		}
+		goto st0
+tr56:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line contentrange.go:420
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr57
+		}
+		goto st0
+tr57:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+//line contentrange.go:434
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr58
+		}
+		goto st0
+tr58:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line contentrange.go:448
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr59
+		}
+		goto st0
+tr59:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line contentrange.go:462
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr60
+		}
+		goto st0
+tr60:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+//line contentrange.go:476
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr61
+		}
+		goto st0
+tr61:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line contentrange.go:490
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr62
+		}
+		goto st0
+tr62:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+//line contentrange.go:504
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr63
+		}
+		goto st0
+tr63:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line contentrange.go:518
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr64
+		}
+		goto st0
+tr64:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+//line contentrange.go:532
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr65
+		}
+		goto st0
+tr65:
+//line contentrange.rl:45
+ cr.Length = cr.Length*10 + int64((data[p])-'0') 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line contentrange.go:546
+		goto st0
+tr8:
+//line contentrange.rl:40
+ cr.First = 0 
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line contentrange.go:559
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr12
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr13
+		}
+		goto st0
+tr13:
+//line contentrange.rl:42
+ cr.Last = 0; lastAt = p 
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line contentrange.go:587
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr15
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 42 {
+			goto st65
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr10
+		}
+		goto st0
+tr15:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line contentrange.go:616
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr17
+		}
+		goto st0
+tr17:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line contentrange.go:633
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr18
+		}
+		goto st0
+tr18:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line contentrange.go:650
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr19
+		}
+		goto st0
+tr19:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line contentrange.go:667
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line contentrange.go:684
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr21
+		}
+		goto st0
+tr21:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line contentrange.go:701
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr22
+		}
+		goto st0
+tr22:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line contentrange.go:718
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr23
+		}
+		goto st0
+tr23:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line contentrange.go:735
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr24:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line contentrange.go:752
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr25
+		}
+		goto st0
+tr25:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line contentrange.go:769
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr26
+		}
+		goto st0
+tr26:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line contentrange.go:786
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr27
+		}
+		goto st0
+tr27:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line contentrange.go:803
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr28
+		}
+		goto st0
+tr28:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line contentrange.go:820
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+tr29:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line contentrange.go:837
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr30
+		}
+		goto st0
+tr30:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line contentrange.go:854
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr31
+		}
+		goto st0
+tr31:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line contentrange.go:871
+		if data[p] == 47 {
+			goto st13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr32
+		}
+		goto st0
+tr32:
+//line contentrange.rl:43
+ cr.Last = cr.Last*10 + int64((data[p])-'0') 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line contentrange.go:888
+		if data[p] == 47 {
+			goto st13
+		}
+		goto st0
+tr12:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line contentrange.go:902
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr33
+		}
+		goto st0
+tr33:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line contentrange.go:919
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr34
+		}
+		goto st0
+tr34:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line contentrange.go:936
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr35
+		}
+		goto st0
+tr35:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line contentrange.go:953
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr36
+		}
+		goto st0
+tr36:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line contentrange.go:970
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr37
+		}
+		goto st0
+tr37:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line contentrange.go:987
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr38
+		}
+		goto st0
+tr38:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line contentrange.go:1004
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr39
+		}
+		goto st0
+tr39:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line contentrange.go:1021
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr40
+		}
+		goto st0
+tr40:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line contentrange.go:1038
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr41
+		}
+		goto st0
+tr41:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line contentrange.go:1055
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr42
+		}
+		goto st0
+tr42:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line contentrange.go:1072
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr43
+		}
+		goto st0
+tr43:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line contentrange.go:1089
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr44
+		}
+		goto st0
+tr44:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line contentrange.go:1106
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr45
+		}
+		goto st0
+tr45:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line contentrange.go:1123
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr46
+		}
+		goto st0
+tr46:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line contentrange.go:1140
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr47
+		}
+		goto st0
+tr47:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line contentrange.go:1157
+		if data[p] == 45 {
+			goto st11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr48
+		}
+		goto st0
+tr48:
+//line contentrange.rl:41
+ cr.First = cr.First*10 + int64((data[p])-'0') 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line contentrange.go:1174
+		if data[p] == 45 {
+			goto st11
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line contentrange.rl:59
+
+
+	if cs < contentrange_first_final {
+		return ContentRange{}, false, p
+	}
+
+	if cr.First > cr.Last {
+		return ContentRange{}, false, lastAt
+	}
+
+	if cr.Length >= 0 && cr.Last >= cr.Length {
+		return ContentRange{}, false, lengthAt
+	}
+
+	return cr, true, -1
+}
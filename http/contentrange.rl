@@ -0,0 +1,74 @@
+package main
+
+// ContentRange is the value of a Content-Range header.  First and Last are
+// -1 for an unsatisfied range, "*/length", and Length is -1 when the
+// complete length is given as "*".
+type ContentRange struct {
+	First  int64
+	Last   int64
+	Length int64
+}
+
+// ParseContentRange parses the value of a Content-Range header as defined
+// by RFC 7233 section 4.2, such as "bytes 0-499/1234".  Only byte ranges
+// are accepted, and the positions and length are limited to 18 digits.
+// The range must not be backwards and must lie within the complete length
+// if one is given.
+func ParseContentRange(data []byte) (ContentRange, bool) {
+	cr, ok, _ := ParseContentRangeAt(data)
+	return cr, ok
+}
+
+// ParseContentRangeAt is like ParseContentRange but also returns the offset
+// of the first byte that is wrong, or -1 if there is none.  A backwards
+// range fails at its last position, and one running past the end fails at
+// the length.
+func ParseContentRangeAt(data []byte) (ContentRange, bool, int) {
+
+%% machine contentrange;
+%% write data;
+
+	cr := ContentRange{First: -1, Last: -1, Length: -1}
+
+	var lastAt, lengthAt int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	%%{
+	    action first_start  { cr.First = 0 }
+	    action first        { cr.First = cr.First*10 + int64(fc-'0') }
+	    action last_start   { cr.Last = 0; lastAt = p }
+	    action last         { cr.Last = cr.Last*10 + int64(fc-'0') }
+	    action length_start { cr.Length = 0; lengthAt = p }
+	    action length       { cr.Length = cr.Length*10 + int64(fc-'0') }
+
+	    pos = digit{1,18} ;
+
+	    byte_range = pos >first_start $first '-' pos >last_start $last ;
+	    complete_length = pos >length_start $length ;
+
+	    resp_range = byte_range '/' ( complete_length | '*' ) ;
+	    unsatisfied_range = '*/' complete_length ;
+
+	    main := 'bytes'i ' ' ( resp_range | unsatisfied_range ) ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < contentrange_first_final {
+		return ContentRange{}, false, p
+	}
+
+	if cr.First > cr.Last {
+		return ContentRange{}, false, lastAt
+	}
+
+	if cr.Length >= 0 && cr.Last >= cr.Length {
+		return ContentRange{}, false, lengthAt
+	}
+
+	return cr, true, -1
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var contentRange = []byte("bytes 21010-47021/47022")
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ContentRange
+		ok   bool
+	}{
+		{"bytes 0-499/1234", ContentRange{0, 499, 1234}, true},
+		{"bytes 21010-47021/47022", ContentRange{21010, 47021, 47022}, true},
+		{"bytes 0-0/1", ContentRange{0, 0, 1}, true},
+		{"bytes 42-42/*", ContentRange{42, 42, -1}, true},
+		{"bytes 0-499/*", ContentRange{0, 499, -1}, true},
+		{"bytes */1234", ContentRange{-1, -1, 1234}, true},
+		{"bytes */0", ContentRange{-1, -1, 0}, true},
+		{"Bytes 0-499/1234", ContentRange{0, 499, 1234}, true},
+		{"bytes 0-999999999999999998/999999999999999999", ContentRange{0, 999999999999999998, 999999999999999999}, true},
+
+		// backwards, or past the end
+		{in: "bytes 500-499/1234"},
+		{in: "bytes 0-1234/1234"},
+		{in: "bytes 0-0/0"},
+		// malformed
+		{in: "bytes */*"},
+		{in: "bytes 0-/1234"},
+		{in: "bytes -499/1234"},
+		{in: "bytes 0-499"},
+		{in: "bytes 0-499/"},
+		{in: "bytes  0-499/1234"},
+		{in: "bytes 0 - 499/1234"},
+		{in: "bytes=0-499/1234"},
+		{in: "bytes -1-499/1234"},
+		{in: "bytes 0-499/1234 "},
+		{in: "bytes 0-9999999999999999999/*"},
+		// only byte ranges
+		{in: "items 0-9/100"},
+		{in: "0-499/1234"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseContentRange([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseContentRange(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && got != tt.want {
+			t.Errorf("ParseContentRange(%q)=%+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseContentRangeAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(contentRange), -1},
+		// the last byte comes before the first, which is reported at the last
+		{"bytes 500-499/1234", 10},
+		// the range runs past the end
+		{"bytes 0-1234/1234", 13},
+		{"items 0-9/100", 0},
+		{"bytes 0-9", 9},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseContentRangeAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseContentRangeAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// cutContentRange follows the shape of net/http's unexported parseRange for
+// the Range request header, which cuts the value apart with the strings
+// package and converts each number with strconv.
+func cutContentRange(s string) (ContentRange, bool) {
+	const b = "bytes "
+	if len(s) < len(b) || !strings.EqualFold(s[:len(b)], b) {
+		return ContentRange{}, false
+	}
+	rng, length, ok := strings.Cut(s[len(b):], "/")
+	if !ok {
+		return ContentRange{}, false
+	}
+	cr := ContentRange{First: -1, Last: -1, Length: -1}
+	if length != "*" {
+		n, err := strconv.ParseInt(length, 10, 64)
+		if err != nil || n < 0 || length[0] == '+' {
+			return ContentRange{}, false
+		}
+		cr.Length = n
+	}
+	if rng == "*" {
+		if cr.Length < 0 {
+			return ContentRange{}, false
+		}
+		return cr, true
+	}
+	first, last, ok := strings.Cut(rng, "-")
+	if !ok {
+		return ContentRange{}, false
+	}
+	var err error
+	if cr.First, err = strconv.ParseInt(first, 10, 64); err != nil || cr.First < 0 || first[0] == '+' {
+		return ContentRange{}, false
+	}
+	if cr.Last, err = strconv.ParseInt(last, 10, 64); err != nil || cr.Last < 0 || last[0] == '+' {
+		return ContentRange{}, false
+	}
+	if cr.First > cr.Last || (cr.Length >= 0 && cr.Last >= cr.Length) {
+		return ContentRange{}, false
+	}
+	return cr, true
+}
+
+func TestContentRangeAlternatives(t *testing.T) {
+	for _, s := range []string{string(contentRange), "bytes 0-499/*", "bytes */1234", "bytes 500-499/1234", "bytes */*", "items 0-9/100"} {
+		want, wantOK := ParseContentRange([]byte(s))
+		if got, ok := cutContentRange(s); ok != wantOK || got != want {
+			t.Errorf("cutContentRange(%q)=%+v, %v, want %+v, %v", s, got, ok, want, wantOK)
+		}
+	}
+}
+
+func BenchmarkContentRangeCut(b *testing.B) {
+	s := string(contentRange)
+	for i := 0; i < b.N; i++ {
+		if _, ok := cutContentRange(s); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkContentRangeRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseContentRange(contentRange); ok {
+			hits++
+		}
+	}
+}
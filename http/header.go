@@ -0,0 +1,287 @@
+
+//line header.rl:1
+package main
+
+// unfold replaces each run of whitespace containing an obs-fold with a
+// single space.
+func unfold(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); {
+		if v[i] != ' ' && v[i] != '\t' && v[i] != '\r' {
+			out = append(out, v[i])
+			i++
+			continue
+		}
+		j, fold := i, false
+		for j < len(v) && (v[j] == ' ' || v[j] == '\t' || v[j] == '\r' || v[j] == '\n') {
+			fold = fold || v[j] == '\r'
+			j++
+		}
+		if fold {
+			out = append(out, ' ')
+		} else {
+			out = append(out, v[i:j]...)
+		}
+		i = j
+	}
+	return out
+}
+
+// ParseHTTPHeaderField parses a single header field as defined by RFC 7230
+// section 3.2, optionally followed by CRLF.  Leading and trailing OWS is
+// removed from the value.  The name and value point into data unless the
+// value contains an obs-fold, in which case the fold and the whitespace
+// around it are collapsed to a single space in a copy.
+func ParseHTTPHeaderField(data []byte) (name, value []byte, ok bool) {
+	name, value, ok, _ = ParseHTTPHeaderFieldAt(data)
+	return name, value, ok
+}
+
+// ParseHTTPHeaderFieldAt is like ParseHTTPHeaderField but also returns the
+// offset of the first byte not allowed in a header field, or -1 if data is
+// one.
+func ParseHTTPHeaderFieldAt(data []byte) (name, value []byte, ok bool, errPos int) {
+
+
+//line header.rl:44
+
+//line header.go:49
+const header_start int = 1
+const header_first_final int = 4
+const header_error int = 0
+
+const header_en_main int = 1
+
+
+//line header.rl:45
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	start, end := -1, -1
+	folded := false
+
+	
+//line header.go:68
+	{
+	cs = header_start
+	}
+
+//line header.go:73
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 4:
+		goto st_case_4
+	case 3:
+		goto st_case_3
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 33:
+			goto tr1
+		case 124:
+			goto tr1
+		case 126:
+			goto tr1
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr1
+				}
+			case data[p] >= 35:
+				goto tr1
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr1
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr1
+				}
+			default:
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line header.rl:55
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line header.go:144
+		switch data[p] {
+		case 33:
+			goto st2
+		case 58:
+			goto tr3
+		case 124:
+			goto st2
+		case 126:
+			goto st2
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st2
+				}
+			case data[p] >= 35:
+				goto st2
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st2
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st2
+				}
+			default:
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+tr3:
+//line header.rl:56
+ name = data[mark:p] 
+	goto st4
+tr7:
+//line header.rl:59
+
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line header.go:200
+		switch data[p] {
+		case 9:
+			goto st4
+		case 13:
+			goto st3
+		case 32:
+			goto st4
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr7
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 10 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 9:
+			goto tr8
+		case 32:
+			goto tr8
+		}
+		goto st0
+tr8:
+//line header.rl:57
+ folded = true 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line header.go:245
+		switch data[p] {
+		case 9:
+			goto tr8
+		case 13:
+			goto st3
+		case 32:
+			goto tr8
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr7
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line header.rl:79
+
+
+	if cs < header_first_final {
+		return nil, nil, false, p
+	}
+
+	switch {
+	case start < 0:
+		value = data[p:p]
+	case folded:
+		value = unfold(data[start:end])
+	default:
+		value = data[start:end]
+	}
+
+	return name, value, true, -1
+}
@@ -0,0 +1,95 @@
+package main
+
+// unfold replaces each run of whitespace containing an obs-fold with a
+// single space.
+func unfold(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); {
+		if v[i] != ' ' && v[i] != '\t' && v[i] != '\r' {
+			out = append(out, v[i])
+			i++
+			continue
+		}
+		j, fold := i, false
+		for j < len(v) && (v[j] == ' ' || v[j] == '\t' || v[j] == '\r' || v[j] == '\n') {
+			fold = fold || v[j] == '\r'
+			j++
+		}
+		if fold {
+			out = append(out, ' ')
+		} else {
+			out = append(out, v[i:j]...)
+		}
+		i = j
+	}
+	return out
+}
+
+// ParseHTTPHeaderField parses a single header field as defined by RFC 7230
+// section 3.2, optionally followed by CRLF.  Leading and trailing OWS is
+// removed from the value.  The name and value point into data unless the
+// value contains an obs-fold, in which case the fold and the whitespace
+// around it are collapsed to a single space in a copy.
+func ParseHTTPHeaderField(data []byte) (name, value []byte, ok bool) {
+	name, value, ok, _ = ParseHTTPHeaderFieldAt(data)
+	return name, value, ok
+}
+
+// ParseHTTPHeaderFieldAt is like ParseHTTPHeaderField but also returns the
+// offset of the first byte not allowed in a header field, or -1 if data is
+// one.
+func ParseHTTPHeaderFieldAt(data []byte) (name, value []byte, ok bool, errPos int) {
+
+%% machine header;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	start, end := -1, -1
+	folded := false
+
+	%%{
+	    action mark   { mark = p }
+	    action name   { name = data[mark:p] }
+	    action folded { folded = true }
+
+	    action vchar {
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    }
+
+	    tchar = alnum | [!#$%&'*+\-.^_`|~] ;
+	    token = tchar+ ;
+
+	    ws = [ \t] ;
+	    field_vchar = 0x21..0x7e | 0x80..0xff ;
+	    obs_fold = '\r\n' ws+ @folded ;
+
+	    field_value = ( field_vchar $vchar | ws | obs_fold )* ;
+
+	    main := token >mark %name ':' field_value ( '\r\n' )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < header_first_final {
+		return nil, nil, false, p
+	}
+
+	switch {
+	case start < 0:
+		value = data[p:p]
+	case folded:
+		value = unfold(data[start:end])
+	default:
+		value = data[start:end]
+	}
+
+	return name, value, true, -1
+}
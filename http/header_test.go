@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+var headerField = []byte("Content-Type: text/html; charset=utf-8\r\n")
+
+func TestParseHTTPHeaderField(t *testing.T) {
+	tests := []struct {
+		line        string
+		name, value string
+		ok          bool
+	}{
+		{"Content-Type: text/html; charset=utf-8\r\n", "Content-Type", "text/html; charset=utf-8", true},
+		{"Host:www.example.com", "Host", "www.example.com", true},
+		{"X-Padded: \t  spaced  out \t ", "X-Padded", "spaced  out", true},
+		{"X-Empty:", "X-Empty", "", true},
+		{"X-Blank:   \r\n", "X-Blank", "", true},
+		{"X-Obs-Text: caf\xc3\xa9", "X-Obs-Text", "caf\xc3\xa9", true},
+		{"!#$%&'*+-.^_`|~09AZaz: token chars", "!#$%&'*+-.^_`|~09AZaz", "token chars", true},
+		// obs-fold
+		{"X-Folded: first\r\n second\r\n", "X-Folded", "first second", true},
+		{"X-Folded: first  \r\n\t\t second \r\n  third", "X-Folded", "first second third", true},
+		{"X-Folded:\r\n leading", "X-Folded", "leading", true},
+		{"X-Folded: trailing\r\n \r\n", "X-Folded", "trailing", true},
+
+		// no whitespace allowed between the name and colon
+		{line: "Host : www.example.com"},
+		{line: " Host: www.example.com"},
+		{line: "Ho(st: x"},
+		{line: ": no name"},
+		{line: "No-Colon"},
+		// CR and LF only as part of an obs-fold or the line ending
+		{line: "X: a\rb"},
+		{line: "X: a\nb"},
+		{line: "X: a\r\nb"},
+		{line: "X: a\r\n\r\n"},
+		{line: "X: \x00"},
+		{line: ""},
+	}
+
+	for _, tt := range tests {
+		name, value, ok := ParseHTTPHeaderField([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseHTTPHeaderField(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && (string(name) != tt.name || string(value) != tt.value) {
+			t.Errorf("ParseHTTPHeaderField(%q)=(%q,%q), want (%q,%q)", tt.line, name, value, tt.name, tt.value)
+		}
+	}
+}
+
+func TestParseHTTPHeaderFieldAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(headerField), -1},
+		{"Content Type: text/html\r\n", 7},
+		{"Content-Type text/html\r\n", 12},
+	}
+
+	for _, tt := range tests {
+		_, _, ok, errPos := ParseHTTPHeaderFieldAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseHTTPHeaderFieldAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// textprotoHeaderField doesn't validate the name or handle obs-fold; that
+// would need ReadContinuedLine, which is slower still.
+func textprotoHeaderField(r *textproto.Reader) (name, value string, ok bool) {
+	line, err := r.ReadLine()
+	if err != nil {
+		return "", "", false
+	}
+	f := strings.SplitN(line, ":", 2)
+	if len(f) != 2 {
+		return "", "", false
+	}
+	return f[0], strings.Trim(f[1], " \t"), true
+}
+
+func TestHeaderFieldAlternatives(t *testing.T) {
+	wn, wv, _ := ParseHTTPHeaderField(headerField)
+	r := textproto.NewReader(bufio.NewReader(bytes.NewReader(headerField)))
+	if n, v, ok := textprotoHeaderField(r); !ok || n != string(wn) || v != string(wv) {
+		t.Errorf("textprotoHeaderField=(%q,%q), want (%q,%q)", n, v, wn, wv)
+	}
+}
+
+func BenchmarkHeaderFieldTextproto(b *testing.B) {
+	r := bytes.NewReader(headerField)
+	br := bufio.NewReader(r)
+	tr := textproto.NewReader(br)
+	for i := 0; i < b.N; i++ {
+		r.Reset(headerField)
+		br.Reset(r)
+		if _, _, ok := textprotoHeaderField(tr); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkHeaderFieldRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := ParseHTTPHeaderField(headerField); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,1264 @@
+
+//line requestline.rl:1
+package main
+
+
+//line requestline.rl:8
+
+
+// ParseHTTPRequestLine parses an HTTP/1.x request line as defined by RFC 7230
+// section 3.1.1.  A trailing CRLF is allowed but not required.  The returned
+// slices point into data.
+func ParseHTTPRequestLine(data []byte) (method, requestURI, version []byte, ok bool) {
+	method, requestURI, version, ok, _ = ParseHTTPRequestLineAt(data)
+	return method, requestURI, version, ok
+}
+
+// ParseHTTPRequestLineAt is like ParseHTTPRequestLine but also returns the
+// offset of the first byte that breaks the request line, len(data) if it
+// is cut short, or -1 if it parsed.
+func ParseHTTPRequestLineAt(data []byte) (method, requestURI, version []byte, ok bool, errPos int) {
+
+
+//line requestline.rl:24
+
+//line requestline.go:26
+const requestline_start int = 1
+const requestline_first_final int = 43
+const requestline_error int = 0
+
+const requestline_en_main int = 1
+
+
+//line requestline.rl:25
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line requestline.go:41
+	{
+	cs = requestline_start
+	}
+
+//line requestline.go:46
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 43:
+		goto st_case_43
+	case 16:
+		goto st_case_16
+	case 44:
+		goto st_case_44
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	}
+	goto st_out
+	st_case_1:
+		if 65 <= data[p] && data[p] <= 90 {
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line requestline.rl:33
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line requestline.go:162
+		if data[p] == 32 {
+			goto tr2
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st2
+		}
+		goto st0
+tr2:
+//line requestline.rl:34
+ method = data[mark:p] 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line requestline.go:179
+		switch data[p] {
+		case 33:
+			goto tr4
+		case 37:
+			goto tr5
+		case 42:
+			goto tr6
+		case 47:
+			goto tr7
+		case 59:
+			goto tr4
+		case 61:
+			goto tr4
+		case 91:
+			goto tr9
+		case 95:
+			goto tr4
+		case 126:
+			goto tr4
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr8
+			}
+		default:
+			goto tr8
+		}
+		goto st0
+tr4:
+//line requestline.rl:33
+ mark = p 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line requestline.go:222
+		switch data[p] {
+		case 33:
+			goto st4
+		case 37:
+			goto st5
+		case 58:
+			goto st7
+		case 61:
+			goto st4
+		case 95:
+			goto st4
+		case 126:
+			goto st4
+		}
+		switch {
+		case data[p] < 48:
+			if 36 <= data[p] && data[p] <= 46 {
+				goto st4
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st4
+				}
+			case data[p] >= 65:
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+tr5:
+//line requestline.rl:33
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line requestline.go:264
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st7
+		}
+		goto st0
+tr14:
+//line requestline.rl:35
+ requestURI = data[mark:p] 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line requestline.go:317
+		if data[p] == 72 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line requestline.rl:33
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line requestline.go:331
+		if data[p] == 84 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 84 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 80 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 47 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 49 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 46 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if data[p] == 13 {
+			goto tr48
+		}
+		goto st0
+tr48:
+//line requestline.rl:36
+ version = data[mark:p] 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line requestline.go:408
+		if data[p] == 10 {
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		goto st0
+tr6:
+//line requestline.rl:33
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line requestline.go:428
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st4
+		case 37:
+			goto st5
+		case 58:
+			goto st7
+		case 61:
+			goto st4
+		case 95:
+			goto st4
+		case 126:
+			goto st4
+		}
+		switch {
+		case data[p] < 48:
+			if 36 <= data[p] && data[p] <= 46 {
+				goto st4
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st4
+				}
+			case data[p] >= 65:
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+tr7:
+//line requestline.rl:33
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line requestline.go:472
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st18
+		case 37:
+			goto st19
+		case 61:
+			goto st18
+		case 95:
+			goto st18
+		case 126:
+			goto st18
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st18
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st20
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st20
+			}
+		default:
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st18
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+tr8:
+//line requestline.rl:33
+ mark = p 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line requestline.go:545
+		switch data[p] {
+		case 33:
+			goto st4
+		case 37:
+			goto st5
+		case 43:
+			goto st21
+		case 58:
+			goto st22
+		case 59:
+			goto st4
+		case 61:
+			goto st4
+		case 95:
+			goto st4
+		case 126:
+			goto st4
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 44:
+				if data[p] <= 46 {
+					goto st21
+				}
+			case data[p] >= 36:
+				goto st4
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st21
+				}
+			case data[p] >= 65:
+				goto st21
+			}
+		default:
+			goto st21
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st18
+		case 37:
+			goto st19
+		case 47:
+			goto st23
+		case 61:
+			goto st18
+		case 95:
+			goto st18
+		case 126:
+			goto st18
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st18
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st18
+		case 37:
+			goto st19
+		case 47:
+			goto st24
+		case 61:
+			goto st18
+		case 95:
+			goto st18
+		case 126:
+			goto st18
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st18
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st25
+		case 37:
+			goto st26
+		case 47:
+			goto st18
+		case 58:
+			goto st28
+		case 61:
+			goto st25
+		case 63:
+			goto st18
+		case 64:
+			goto st32
+		case 91:
+			goto st37
+		case 95:
+			goto st25
+		case 126:
+			goto st25
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st25
+		case 37:
+			goto st26
+		case 47:
+			goto st18
+		case 58:
+			goto st28
+		case 61:
+			goto st25
+		case 63:
+			goto st18
+		case 64:
+			goto st32
+		case 95:
+			goto st25
+		case 126:
+			goto st25
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st29
+		case 37:
+			goto st30
+		case 47:
+			goto st18
+		case 61:
+			goto st29
+		case 63:
+			goto st18
+		case 64:
+			goto st32
+		case 95:
+			goto st29
+		case 126:
+			goto st29
+		}
+		switch {
+		case data[p] < 58:
+			switch {
+			case data[p] > 46:
+				if 48 <= data[p] {
+					goto st28
+				}
+			case data[p] >= 36:
+				goto st29
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st29
+				}
+			case data[p] >= 65:
+				goto st29
+			}
+		default:
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 33:
+			goto st29
+		case 37:
+			goto st30
+		case 61:
+			goto st29
+		case 64:
+			goto st32
+		case 95:
+			goto st29
+		case 126:
+			goto st29
+		}
+		switch {
+		case data[p] < 48:
+			if 36 <= data[p] && data[p] <= 46 {
+				goto st29
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st29
+				}
+			case data[p] >= 65:
+				goto st29
+			}
+		default:
+			goto st29
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st31
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st31
+			}
+		default:
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st29
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st29
+			}
+		default:
+			goto st29
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st33
+		case 37:
+			goto st34
+		case 47:
+			goto st18
+		case 58:
+			goto st36
+		case 61:
+			goto st33
+		case 63:
+			goto st18
+		case 91:
+			goto st37
+		case 95:
+			goto st33
+		case 126:
+			goto st33
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st33
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st33
+			}
+		default:
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 33:
+			goto st33
+		case 37:
+			goto st34
+		case 47:
+			goto st18
+		case 58:
+			goto st36
+		case 61:
+			goto st33
+		case 63:
+			goto st18
+		case 95:
+			goto st33
+		case 126:
+			goto st33
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st33
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st33
+			}
+		default:
+			goto st33
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st35
+			}
+		default:
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st33
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st33
+			}
+		default:
+			goto st33
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 47:
+			goto st18
+		case 63:
+			goto st18
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st36
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 33:
+			goto st38
+		case 36:
+			goto st38
+		case 61:
+			goto st38
+		case 95:
+			goto st38
+		case 126:
+			goto st38
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st38
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st38
+				}
+			case data[p] >= 65:
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 33:
+			goto st38
+		case 36:
+			goto st38
+		case 61:
+			goto st38
+		case 93:
+			goto st39
+		case 95:
+			goto st38
+		case 126:
+			goto st38
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st38
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st38
+				}
+			case data[p] >= 65:
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 32:
+			goto tr14
+		case 47:
+			goto st18
+		case 58:
+			goto st36
+		case 63:
+			goto st18
+		}
+		goto st0
+tr9:
+//line requestline.rl:33
+ mark = p 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line requestline.go:1122
+		switch data[p] {
+		case 33:
+			goto st41
+		case 36:
+			goto st41
+		case 61:
+			goto st41
+		case 95:
+			goto st41
+		case 126:
+			goto st41
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st41
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st41
+				}
+			case data[p] >= 65:
+				goto st41
+			}
+		default:
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 33:
+			goto st41
+		case 36:
+			goto st41
+		case 61:
+			goto st41
+		case 93:
+			goto st42
+		case 95:
+			goto st41
+		case 126:
+			goto st41
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st41
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st41
+				}
+			case data[p] >= 65:
+				goto st41
+			}
+		default:
+			goto st41
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		if data[p] == 58 {
+			goto st7
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 43:
+//line requestline.rl:36
+ version = data[mark:p] 
+//line requestline.go:1250
+		}
+	}
+
+	_out: {}
+	}
+
+//line requestline.rl:82
+
+
+	if cs < requestline_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	return method, requestURI, version, true, -1
+}
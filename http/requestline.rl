@@ -0,0 +1,89 @@
+package main
+
+%%{
+    machine http_common;
+
+    # RFC 7230 section 2.6; only the versions that use this syntax
+    http_version = 'HTTP/1.' [01] ;
+}%%
+
+// ParseHTTPRequestLine parses an HTTP/1.x request line as defined by RFC 7230
+// section 3.1.1.  A trailing CRLF is allowed but not required.  The returned
+// slices point into data.
+func ParseHTTPRequestLine(data []byte) (method, requestURI, version []byte, ok bool) {
+	method, requestURI, version, ok, _ = ParseHTTPRequestLineAt(data)
+	return method, requestURI, version, ok
+}
+
+// ParseHTTPRequestLineAt is like ParseHTTPRequestLine but also returns the
+// offset of the first byte that breaks the request line, len(data) if it
+// is cut short, or -1 if it parsed.
+func ParseHTTPRequestLineAt(data []byte) (method, requestURI, version []byte, ok bool, errPos int) {
+
+%% machine requestline;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    include http_common;
+
+	    action mark        { mark = p }
+	    action method      { method = data[mark:p] }
+	    action request_uri { requestURI = data[mark:p] }
+	    action version     { version = data[mark:p] }
+
+	    # RFC 3986
+	    unreserved = alnum | [\-._~] ;
+	    pct_encoded = '%' xdigit xdigit ;
+	    sub_delims = [!$&'()*+,;=] ;
+	    pchar = unreserved | pct_encoded | sub_delims | [:@] ;
+
+	    segment = pchar* ;
+	    query = ( pchar | [/?] )* ;
+
+	    # close enough for an IPv6 or IPvFuture literal
+	    ip_literal = '[' ( xdigit | [:.] | unreserved | sub_delims )+ ']' ;
+	    reg_name = ( unreserved | pct_encoded | sub_delims )* ;
+	    host = ip_literal | reg_name ;
+	    port = digit* ;
+	    userinfo = ( unreserved | pct_encoded | sub_delims | ':' )* ;
+	    authority = ( userinfo '@' )? host ( ':' port )? ;
+
+	    scheme = alpha ( alpha | digit | [+\-.] )* ;
+
+	    path_abempty = ( '/' segment )* ;
+	    path_absolute = '/' ( pchar+ ( '/' segment )* )? ;
+	    path_rootless = pchar+ ( '/' segment )* ;
+	    hier_part = '//' authority path_abempty
+	              | path_absolute
+	              | path_rootless
+	              | zlen ;
+
+	    # RFC 7230 section 5.3
+	    origin_form = ( '/' segment )+ ( '?' query )? ;
+	    absolute_form = scheme ':' hier_part ( '?' query )? ;
+	    authority_form = ( ip_literal | ( reg_name - zlen ) ) ':' port ;
+	    asterisk_form = '*' ;
+
+	    request_target = origin_form | absolute_form | authority_form | asterisk_form ;
+
+	    method = upper+ ;
+
+	    main := method >mark %method
+	            ' ' request_target >mark %request_uri
+	            ' ' http_version >mark %version
+	            ( '\r\n' )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < requestline_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	return method, requestURI, version, true, -1
+}
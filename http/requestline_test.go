@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var requestLine = []byte("GET /where?q=now&lang=en HTTP/1.1\r\n")
+
+var hits int
+
+func TestParseHTTPRequestLine(t *testing.T) {
+	tests := []struct {
+		line                 string
+		method, uri, version string
+		ok                   bool
+	}{
+		{"GET /where?q=now&lang=en HTTP/1.1\r\n", "GET", "/where?q=now&lang=en", "HTTP/1.1", true},
+		{"GET / HTTP/1.0", "GET", "/", "HTTP/1.0", true},
+		{"POST /a/b/%7Euser/ HTTP/1.1", "POST", "/a/b/%7Euser/", "HTTP/1.1", true},
+		{"GET http://www.example.org/pub/WWW/TheProject.html HTTP/1.1", "GET", "http://www.example.org/pub/WWW/TheProject.html", "HTTP/1.1", true},
+		{"GET http://user:pw@[::1]:8080/?x HTTP/1.1", "GET", "http://user:pw@[::1]:8080/?x", "HTTP/1.1", true},
+		{"CONNECT www.example.com:80 HTTP/1.1", "CONNECT", "www.example.com:80", "HTTP/1.1", true},
+		{"CONNECT [2001:db8::1]:443 HTTP/1.1", "CONNECT", "[2001:db8::1]:443", "HTTP/1.1", true},
+		{"OPTIONS * HTTP/1.1", "OPTIONS", "*", "HTTP/1.1", true},
+
+		// missing SP separators
+		{line: "GET /HTTP/1.1"},
+		{line: "GET/ HTTP/1.1"},
+		{line: "GET  / HTTP/1.1"},
+		{line: "GET / "},
+		// invalid method characters
+		{line: "get / HTTP/1.1"},
+		{line: "GE-T / HTTP/1.1"},
+		{line: " / HTTP/1.1"},
+		// unsupported versions
+		{line: "GET / HTTP/2.0"},
+		{line: "GET / HTTP/1.10"},
+		{line: "GET / http/1.1"},
+		// bad request targets
+		{line: "GET /a b HTTP/1.1"},
+		{line: "GET /%zz HTTP/1.1"},
+		{line: "GET /\x7f HTTP/1.1"},
+		{line: "CONNECT :80 HTTP/1.1"},
+		// bare LF and stray CR
+		{line: "GET / HTTP/1.1\n"},
+		{line: "GET / HTTP/1.1\r"},
+		{line: ""},
+	}
+
+	for _, tt := range tests {
+		method, uri, version, ok := ParseHTTPRequestLine([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseHTTPRequestLine(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && (string(method) != tt.method || string(uri) != tt.uri || string(version) != tt.version) {
+			t.Errorf("ParseHTTPRequestLine(%q)=(%q,%q,%q), want (%q,%q,%q)", tt.line, method, uri, version, tt.method, tt.uri, tt.version)
+		}
+	}
+}
+
+func TestParseHTTPRequestLineAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(requestLine), -1},
+		{"GET /where HTTP/2.0\r\n", 16},
+		{"GET  /where HTTP/1.1\r\n", 4},
+	}
+
+	for _, tt := range tests {
+		_, _, _, ok, errPos := ParseHTTPRequestLineAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseHTTPRequestLineAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var reRequestLine = regexp.MustCompile(`^([A-Z]+) (\S+) (HTTP/1\.[01])\r?\n?$`)
+
+func regexRequestLine(line []byte) (method, requestURI, version []byte, ok bool) {
+	m := reRequestLine.FindSubmatch(line)
+	if m == nil {
+		return nil, nil, nil, false
+	}
+	return m[1], m[2], m[3], true
+}
+
+// splitRequestLine is how net/http does it: read a line and split on the
+// first two spaces, leaving most of the validation to later stages.
+func splitRequestLine(r *bufio.Reader) (method, requestURI, version string, ok bool) {
+	line, _, err := r.ReadLine()
+	if err != nil {
+		return "", "", "", false
+	}
+	f := strings.SplitN(string(line), " ", 3)
+	if len(f) != 3 {
+		return "", "", "", false
+	}
+	return f[0], f[1], f[2], true
+}
+
+func TestRequestLineAlternatives(t *testing.T) {
+	wm, wu, wv, _ := ParseHTTPRequestLine(requestLine)
+	if m, u, v, ok := regexRequestLine(requestLine); !ok || !bytes.Equal(m, wm) || !bytes.Equal(u, wu) || !bytes.Equal(v, wv) {
+		t.Errorf("regexRequestLine=(%q,%q,%q), want (%q,%q,%q)", m, u, v, wm, wu, wv)
+	}
+	if m, u, v, ok := splitRequestLine(bufio.NewReader(bytes.NewReader(requestLine))); !ok || m != string(wm) || u != string(wu) || v != string(wv) {
+		t.Errorf("splitRequestLine=(%q,%q,%q), want (%q,%q,%q)", m, u, v, wm, wu, wv)
+	}
+}
+
+func BenchmarkRequestLineRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := regexRequestLine(requestLine); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRequestLineSplit(b *testing.B) {
+	r := bytes.NewReader(requestLine)
+	br := bufio.NewReader(r)
+	for i := 0; i < b.N; i++ {
+		r.Reset(requestLine)
+		br.Reset(r)
+		if _, _, _, ok := splitRequestLine(br); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRequestLineRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := ParseHTTPRequestLine(requestLine); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,342 @@
+
+//line statusline.rl:1
+package main
+
+// ParseHTTPStatusLine parses an HTTP/1.x status line as defined by RFC 7230
+// section 3.1.2.  The status code must be in the range 100-599.  The reason
+// phrase may be empty and runs up to an optional trailing CRLF.  The
+// returned slices point into data.
+func ParseHTTPStatusLine(data []byte) (version []byte, statusCode int, reasonPhrase []byte, ok bool) {
+	version, statusCode, reasonPhrase, ok, _ = ParseHTTPStatusLineAt(data)
+	return version, statusCode, reasonPhrase, ok
+}
+
+// ParseHTTPStatusLineAt is like ParseHTTPStatusLine but also returns the
+// offset of the first unexpected byte, or -1 if the status line parsed.
+func ParseHTTPStatusLineAt(data []byte) (version []byte, statusCode int, reasonPhrase []byte, ok bool, errPos int) {
+
+
+//line statusline.rl:17
+
+//line statusline.go:22
+const statusline_start int = 1
+const statusline_first_final int = 15
+const statusline_error int = 0
+
+const statusline_en_main int = 1
+
+
+//line statusline.rl:18
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line statusline.go:37
+	{
+	cs = statusline_start
+	}
+
+//line statusline.go:42
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 14:
+		goto st_case_14
+	case 17:
+		goto st_case_17
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 72 {
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line statusline.rl:26
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line statusline.go:104
+		if data[p] == 84 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 84 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 80 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 47 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 49 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 46 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if data[p] == 32 {
+			goto tr9
+		}
+		goto st0
+tr9:
+//line statusline.rl:27
+ version = data[mark:p] 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line statusline.go:181
+		if 49 <= data[p] && data[p] <= 53 {
+			goto tr10
+		}
+		goto st0
+tr10:
+//line statusline.rl:28
+ statusCode = statusCode*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line statusline.go:195
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr11
+		}
+		goto st0
+tr11:
+//line statusline.rl:28
+ statusCode = statusCode*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line statusline.go:209
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr12
+		}
+		goto st0
+tr12:
+//line statusline.rl:28
+ statusCode = statusCode*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line statusline.go:223
+		if data[p] == 32 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 13:
+			goto tr16
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto tr15
+tr15:
+//line statusline.rl:26
+ mark = p 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line statusline.go:257
+		switch data[p] {
+		case 13:
+			goto tr18
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st16
+tr16:
+//line statusline.rl:26
+ mark = p 
+//line statusline.rl:29
+ reasonPhrase = data[mark:p] 
+	goto st14
+tr18:
+//line statusline.rl:29
+ reasonPhrase = data[mark:p] 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line statusline.go:288
+		if data[p] == 10 {
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 15:
+//line statusline.rl:26
+ mark = p 
+//line statusline.rl:29
+ reasonPhrase = data[mark:p] 
+		case 16:
+//line statusline.rl:29
+ reasonPhrase = data[mark:p] 
+//line statusline.go:328
+		}
+	}
+
+	_out: {}
+	}
+
+//line statusline.rl:41
+
+
+	if cs < statusline_first_final {
+		return nil, 0, nil, false, p
+	}
+
+	return version, statusCode, reasonPhrase, true, -1
+}
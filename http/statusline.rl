@@ -0,0 +1,48 @@
+package main
+
+// ParseHTTPStatusLine parses an HTTP/1.x status line as defined by RFC 7230
+// section 3.1.2.  The status code must be in the range 100-599.  The reason
+// phrase may be empty and runs up to an optional trailing CRLF.  The
+// returned slices point into data.
+func ParseHTTPStatusLine(data []byte) (version []byte, statusCode int, reasonPhrase []byte, ok bool) {
+	version, statusCode, reasonPhrase, ok, _ = ParseHTTPStatusLineAt(data)
+	return version, statusCode, reasonPhrase, ok
+}
+
+// ParseHTTPStatusLineAt is like ParseHTTPStatusLine but also returns the
+// offset of the first unexpected byte, or -1 if the status line parsed.
+func ParseHTTPStatusLineAt(data []byte) (version []byte, statusCode int, reasonPhrase []byte, ok bool, errPos int) {
+
+%% machine statusline;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    include http_common "requestline.rl";
+
+	    action mark    { mark = p }
+	    action version { version = data[mark:p] }
+	    action status  { statusCode = statusCode*10 + int(fc-'0') }
+	    action reason  { reasonPhrase = data[mark:p] }
+
+	    status_code = '1'..'5' digit digit ;
+	    reason_phrase = ( [\t ] | 0x21..0x7e | 0x80..0xff )* ;
+
+	    main := http_version >mark %version
+	            ' ' status_code $status
+	            ' ' reason_phrase >mark %reason
+	            ( '\r\n' )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < statusline_first_final {
+		return nil, 0, nil, false, p
+	}
+
+	return version, statusCode, reasonPhrase, true, -1
+}
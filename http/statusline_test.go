@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+var statusLine = []byte("HTTP/1.1 404 Not Found\r\n")
+
+func TestParseHTTPStatusLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		version string
+		code    int
+		reason  string
+		ok      bool
+	}{
+		{"HTTP/1.1 404 Not Found\r\n", "HTTP/1.1", 404, "Not Found", true},
+		{"HTTP/1.0 200 OK", "HTTP/1.0", 200, "OK", true},
+		{"HTTP/1.1 100 Continue\r\n", "HTTP/1.1", 100, "Continue", true},
+		{"HTTP/1.1 599 \r\n", "HTTP/1.1", 599, "", true},
+		{"HTTP/1.1 418 I'm a\tteapot", "HTTP/1.1", 418, "I'm a\tteapot", true},
+		{"HTTP/1.1 200 caf\xc3\xa9", "HTTP/1.1", 200, "caf\xc3\xa9", true},
+
+		// the space before an empty reason phrase is required
+		{line: "HTTP/1.1 204"},
+		{line: "HTTP/1.1 099 Low"},
+		{line: "HTTP/1.1 600 High"},
+		{line: "HTTP/1.1 20 Short"},
+		{line: "HTTP/1.1 2000 Long"},
+		{line: "HTTP/2 200 OK"},
+		{line: "HTTP/1.1  200 OK"},
+		{line: "http/1.1 200 OK"},
+		{line: "HTTP/1.1 200 OK\r\nX"},
+		{line: "HTTP/1.1 200 O\nK"},
+		{line: ""},
+	}
+
+	for _, tt := range tests {
+		version, code, reason, ok := ParseHTTPStatusLine([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseHTTPStatusLine(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && (string(version) != tt.version || code != tt.code || string(reason) != tt.reason) {
+			t.Errorf("ParseHTTPStatusLine(%q)=(%q,%d,%q), want (%q,%d,%q)", tt.line, version, code, reason, tt.version, tt.code, tt.reason)
+		}
+	}
+}
+
+func TestParseHTTPStatusLineAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(statusLine), -1},
+		{"HTTP/1.1 40 Not Found\r\n", 11},
+		{"HTTP/1.1", 8},
+	}
+
+	for _, tt := range tests {
+		_, _, _, ok, errPos := ParseHTTPStatusLineAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseHTTPStatusLineAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// sscanfStatusLine is the common naive approach; %s stops at the first
+// space, so it only gets the first word of the reason phrase.
+func sscanfStatusLine(b []byte) (version string, code int, reason string, ok bool) {
+	if _, err := fmt.Sscanf(string(b), "HTTP/%s %d %s", &version, &code, &reason); err != nil {
+		return "", 0, "", false
+	}
+	return "HTTP/" + version, code, reason, true
+}
+
+func TestStatusLineAlternatives(t *testing.T) {
+	wv, wc, wr, _ := ParseHTTPStatusLine(statusLine)
+	v, c, r, ok := sscanfStatusLine(statusLine)
+	if !ok || v != string(wv) || c != wc || !bytes.HasPrefix(wr, []byte(r)) {
+		t.Errorf("sscanfStatusLine=(%q,%d,%q), want (%q,%d,%q)", v, c, r, wv, wc, wr)
+	}
+}
+
+func BenchmarkStatusLineSscanf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := sscanfStatusLine(statusLine); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkStatusLineRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := ParseHTTPStatusLine(statusLine); ok {
+			hits++
+		}
+	}
+}
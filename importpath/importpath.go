@@ -0,0 +1,2573 @@
+
+//line importpath.rl:1
+package main
+
+// ModulePath is a Go module path split the way module.SplitPathVersion
+// does.  Major is the major version suffix, such as "/v2", or ".v3" for
+// gopkg.in, and Prefix is everything before it.  Host is the first
+// element.  The byte slices point into the parsed path.
+type ModulePath struct {
+	Host   []byte
+	Prefix []byte
+	Major  []byte
+}
+
+// ValidateImportPath reports whether data is a valid module path, as
+// checked by module.CheckPath in golang.org/x/mod.  This is the path an
+// import has to start with for the go command to find the module that
+// provides it.
+func ValidateImportPath(data []byte) bool {
+	_, ok, _ := ParseModulePathAt(data)
+	return ok
+}
+
+// ParseModulePath parses a module path such as "github.com/dgryski/go-tsz/v2".
+// The path is made of elements separated by slashes.  Each element is made
+// of ASCII letters, digits, and the punctuation - . _ ~, and can't start or
+// end with a dot.  Names Windows reserves for devices, like "aux" or
+// "com1.go", and ones that look like Windows short names, such as
+// "progra~1", aren't allowed either.
+//
+// The first element must be a lower case domain name with at least one dot
+// in it, and can't start with a dash.  A final element of the form vN must
+// be a major version of 2 or more with no leading zero, while a gopkg.in
+// path has to end in a version like ".v3" instead, where v0 and v1 are
+// fine too.
+//
+// As only ASCII is allowed, there is no question of Unicode normalization:
+// "café" is rejected whether the é is a single code point or an e followed
+// by a combining accent, and so is a Cyrillic letter that looks just like a
+// Latin one.
+func ParseModulePath(data []byte) (ModulePath, bool) {
+	m, ok, _ := ParseModulePathAt(data)
+	return m, ok
+}
+
+// ParseModulePathAt is like ParseModulePath but also returns the offset of
+// the first byte the path can't continue with, len(data) if it ends where
+// it can't, or -1.
+func ParseModulePathAt(data []byte) (ModulePath, bool, int) {
+
+
+//line importpath.rl:50
+
+//line importpath.go:55
+const modpath_start int = 1
+const modpath_first_final int = 57
+const modpath_error int = 0
+
+const modpath_en_main int = 1
+
+
+//line importpath.rl:51
+
+	var m ModulePath
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	slash, major := 0, len(data)
+
+	
+//line importpath.go:72
+	{
+	cs = modpath_start
+	}
+
+//line importpath.go:77
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 57:
+		goto st_case_57
+	case 4:
+		goto st_case_4
+	case 58:
+		goto st_case_58
+	case 5:
+		goto st_case_5
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 6:
+		goto st_case_6
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 7:
+		goto st_case_7
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 73:
+		goto st_case_73
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 76:
+		goto st_case_76
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 97:
+			goto st12
+		case 99:
+			goto st15
+		case 103:
+			goto st18
+		case 108:
+			goto st51
+		case 110:
+			goto st53
+		case 112:
+			goto st55
+		}
+		switch {
+		case data[p] > 57:
+			if 98 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 45:
+			goto st57
+		case 46:
+			goto st3
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		case data[p] >= 48:
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 46:
+			goto st3
+		case 47:
+			goto tr68
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		case data[p] >= 45:
+			goto st57
+		}
+		goto st0
+tr23:
+//line importpath.rl:60
+ slash = p 
+	goto st4
+tr68:
+//line importpath.rl:59
+ m.Host = data[:p] 
+//line importpath.rl:60
+ slash = p 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line importpath.go:346
+		switch data[p] {
+		case 45:
+			goto st58
+		case 65:
+			goto st61
+		case 67:
+			goto st63
+		case 76:
+			goto st66
+		case 78:
+			goto st68
+		case 80:
+			goto st70
+		case 95:
+			goto st58
+		case 97:
+			goto st61
+		case 99:
+			goto st63
+		case 108:
+			goto st66
+		case 110:
+			goto st68
+		case 112:
+			goto st70
+		case 118:
+			goto st72
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 66:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 98 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 45:
+			goto st59
+		case 46:
+			goto st5
+		case 95:
+			goto st59
+		case 126:
+			goto st59
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st59
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st59
+			}
+		default:
+			goto st59
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 95:
+			goto st59
+		case 126:
+			goto st59
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st59
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st59
+			}
+		default:
+			goto st59
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 85:
+			goto st62
+		case 95:
+			goto st58
+		case 117:
+			goto st62
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 88:
+			goto st7
+		case 95:
+			goto st58
+		case 120:
+			goto st7
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 79:
+			goto st64
+		case 95:
+			goto st58
+		case 111:
+			goto st64
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 77:
+			goto st65
+		case 78:
+			goto st7
+		case 95:
+			goto st58
+		case 109:
+			goto st65
+		case 110:
+			goto st7
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 49:
+			if 45 <= data[p] {
+				goto st58
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st58
+				}
+			case data[p] >= 65:
+				goto st58
+			}
+		default:
+			goto st7
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 80:
+			goto st67
+		case 95:
+			goto st58
+		case 112:
+			goto st67
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 84:
+			goto st65
+		case 95:
+			goto st58
+		case 116:
+			goto st65
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 85:
+			goto st69
+		case 95:
+			goto st58
+		case 117:
+			goto st69
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 76:
+			goto st7
+		case 95:
+			goto st58
+		case 108:
+			goto st7
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 82:
+			goto st71
+		case 95:
+			goto st58
+		case 114:
+			goto st71
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch data[p] {
+		case 46:
+			goto st5
+		case 47:
+			goto tr23
+		case 78:
+			goto st7
+		case 95:
+			goto st58
+		case 110:
+			goto st7
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st58
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 46:
+			goto st8
+		case 47:
+			goto tr23
+		case 48:
+			goto st10
+		case 49:
+			goto st11
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 50 <= data[p] && data[p] <= 57 {
+				goto st73
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 45:
+			goto st59
+		case 46:
+			goto st8
+		case 95:
+			goto st59
+		case 126:
+			goto st59
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st9
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st59
+			}
+		default:
+			goto st59
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 45:
+			goto st59
+		case 46:
+			goto st8
+		case 47:
+			goto tr23
+		case 95:
+			goto st59
+		case 126:
+			goto st59
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st9
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st59
+			}
+		default:
+			goto st59
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 46:
+			goto st8
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 46:
+			goto st8
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st73
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 45:
+			goto st58
+		case 46:
+			goto st8
+		case 47:
+			goto tr23
+		case 95:
+			goto st58
+		case 126:
+			goto st60
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st73
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st58
+			}
+		default:
+			goto st58
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 117:
+			goto st13
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 120:
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 45 {
+			goto st2
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 111:
+			goto st16
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 109:
+			goto st17
+		case 110:
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 48:
+			goto st2
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 49:
+			goto st14
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 111:
+			goto st19
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 112:
+			goto st20
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 107:
+			goto st21
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 103:
+			goto st22
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st23
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 45:
+			goto st57
+		case 46:
+			goto st3
+		case 105:
+			goto st74
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		case data[p] >= 48:
+			goto st57
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 46:
+			goto st3
+		case 47:
+			goto tr68
+		case 110:
+			goto st75
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		case data[p] >= 45:
+			goto st57
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 46:
+			goto st3
+		case 47:
+			goto tr78
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st57
+			}
+		case data[p] >= 45:
+			goto st57
+		}
+		goto st0
+tr78:
+//line importpath.rl:59
+ m.Host = data[:p] 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line importpath.go:1405
+		switch data[p] {
+		case 45:
+			goto st25
+		case 65:
+			goto st39
+		case 67:
+			goto st42
+		case 76:
+			goto st45
+		case 78:
+			goto st47
+		case 80:
+			goto st49
+		case 95:
+			goto st25
+		case 97:
+			goto st39
+		case 99:
+			goto st42
+		case 108:
+			goto st45
+		case 110:
+			goto st47
+		case 112:
+			goto st49
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 66:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 98 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st25
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+tr43:
+//line importpath.rl:62
+ major = p 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line importpath.go:1484
+		switch data[p] {
+		case 45:
+			goto st27
+		case 46:
+			goto tr43
+		case 95:
+			goto st27
+		case 118:
+			goto st28
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 45:
+			goto st27
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 48:
+			goto st76
+		case 95:
+			goto st27
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st78
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 45:
+			goto st29
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 117:
+			goto st30
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 110:
+			goto st31
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 115:
+			goto st32
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 116:
+			goto st33
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 97:
+			goto st34
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 98 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 98:
+			goto st35
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 108:
+			goto st36
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 101:
+			goto st77
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 45:
+			goto st29
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st27
+		case 126:
+			goto st27
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st78
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 45:
+			goto st25
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st25
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 45:
+			goto st25
+		case 95:
+			goto st25
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 85:
+			goto st40
+		case 95:
+			goto st25
+		case 117:
+			goto st40
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 88:
+			goto st41
+		case 95:
+			goto st25
+		case 120:
+			goto st41
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 45:
+			goto st25
+		case 95:
+			goto st25
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 79:
+			goto st43
+		case 95:
+			goto st25
+		case 111:
+			goto st43
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 77:
+			goto st44
+		case 78:
+			goto st41
+		case 95:
+			goto st25
+		case 109:
+			goto st44
+		case 110:
+			goto st41
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 95:
+			goto st25
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 49:
+			if 45 <= data[p] {
+				goto st25
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st25
+				}
+			case data[p] >= 65:
+				goto st25
+			}
+		default:
+			goto st41
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 80:
+			goto st46
+		case 95:
+			goto st25
+		case 112:
+			goto st46
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 84:
+			goto st44
+		case 95:
+			goto st25
+		case 116:
+			goto st44
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 85:
+			goto st48
+		case 95:
+			goto st25
+		case 117:
+			goto st48
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 76:
+			goto st41
+		case 95:
+			goto st25
+		case 108:
+			goto st41
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 82:
+			goto st50
+		case 95:
+			goto st25
+		case 114:
+			goto st50
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 46:
+			goto tr43
+		case 47:
+			goto st24
+		case 78:
+			goto st41
+		case 95:
+			goto st25
+		case 110:
+			goto st41
+		case 126:
+			goto st37
+		}
+		switch {
+		case data[p] < 65:
+			if 45 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 112:
+			goto st52
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 116:
+			goto st17
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 117:
+			goto st54
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 108:
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 114:
+			goto st56
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st3
+		case 110:
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st2
+			}
+		case data[p] >= 48:
+			goto st2
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 57, 74, 75:
+//line importpath.rl:59
+ m.Host = data[:p] 
+		case 73:
+//line importpath.rl:61
+ major = slash 
+//line importpath.go:2557
+		}
+	}
+
+	_out: {}
+	}
+
+//line importpath.rl:93
+
+
+	if cs < modpath_first_final {
+		return ModulePath{}, false, p
+	}
+
+	m.Prefix, m.Major = data[:major], data[major:]
+
+	return m, true, -1
+}
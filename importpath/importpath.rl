@@ -0,0 +1,102 @@
+package main
+
+// ModulePath is a Go module path split the way module.SplitPathVersion
+// does.  Major is the major version suffix, such as "/v2", or ".v3" for
+// gopkg.in, and Prefix is everything before it.  Host is the first
+// element.  The byte slices point into the parsed path.
+type ModulePath struct {
+	Host   []byte
+	Prefix []byte
+	Major  []byte
+}
+
+// ValidateImportPath reports whether data is a valid module path, as
+// checked by module.CheckPath in golang.org/x/mod.  This is the path an
+// import has to start with for the go command to find the module that
+// provides it.
+func ValidateImportPath(data []byte) bool {
+	_, ok, _ := ParseModulePathAt(data)
+	return ok
+}
+
+// ParseModulePath parses a module path such as "github.com/dgryski/go-tsz/v2".
+// The path is made of elements separated by slashes.  Each element is made
+// of ASCII letters, digits, and the punctuation - . _ ~, and can't start or
+// end with a dot.  Names Windows reserves for devices, like "aux" or
+// "com1.go", and ones that look like Windows short names, such as
+// "progra~1", aren't allowed either.
+//
+// The first element must be a lower case domain name with at least one dot
+// in it, and can't start with a dash.  A final element of the form vN must
+// be a major version of 2 or more with no leading zero, while a gopkg.in
+// path has to end in a version like ".v3" instead, where v0 and v1 are
+// fine too.
+//
+// As only ASCII is allowed, there is no question of Unicode normalization:
+// "café" is rejected whether the é is a single code point or an e followed
+// by a combining accent, and so is a Cyrillic letter that looks just like a
+// Latin one.
+func ParseModulePath(data []byte) (ModulePath, bool) {
+	m, ok, _ := ParseModulePathAt(data)
+	return m, ok
+}
+
+// ParseModulePathAt is like ParseModulePath but also returns the offset of
+// the first byte the path can't continue with, len(data) if it ends where
+// it can't, or -1.
+func ParseModulePathAt(data []byte) (ModulePath, bool, int) {
+
+%% machine modpath;
+%% write data;
+
+	var m ModulePath
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	slash, major := 0, len(data)
+
+	%%{
+	    action host  { m.Host = data[:p] }
+	    action slash { slash = p }
+	    action major { major = slash }
+	    action gopkg { major = p }
+
+	    mod_char = alnum | [\-._~] ;
+	    plain = mod_char - '.' ;
+	    word = plain ( mod_char* plain )? ;
+
+	    # Only the part before the first dot counts for both of these.
+	    reserved = ( 'con'i | 'prn'i | 'aux'i | 'nul'i | ( 'com'i | 'lpt'i ) [1-9] ) ( '.' any* )? ;
+	    short_name = [^.]* '~' digit+ ( '.' any* )? ;
+
+	    elem = word - reserved - short_name ;
+
+	    host = ( elem & ( lower | digit | [\-.] )+ & ( any* '.' any* ) ) - ( '-' any* ) ;
+
+	    major = 'v' ( [1-9] digit* - '1' ) ;
+	    not_major = 'v' [0-9.]+ ;
+
+	    last = elem - not_major | major %major ;
+
+	    path = ( host - 'gopkg.in' ) %host ( ( '/' elem )* '/' @slash last )? ;
+
+	    # gopkg.in/yaml.v3 and gopkg.in/user/pkg.v1-unstable
+	    gopkg_major = '.v' ( '0' | [1-9] digit* ) '-unstable'? ;
+	    gopkg_last = elem & ( any* gopkg_major >gopkg ) ;
+
+	    gopkg = 'gopkg.in' %host ( ( '/' elem )* '/' gopkg_last )? ;
+
+	    main := path | gopkg ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < modpath_first_final {
+		return ModulePath{}, false, p
+	}
+
+	m.Prefix, m.Major = data[:major], data[major:]
+
+	return m, true, -1
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"testing"
+
+	"golang.org/x/mod/module"
+)
+
+var data = []byte("github.com/dgryski/go-tsz/v2")
+
+var hits int
+
+func TestParseModulePath(t *testing.T) {
+	tests := []struct {
+		path                string
+		host, prefix, major string
+	}{
+		{string(data), "github.com", "github.com/dgryski/go-tsz", "/v2"},
+		{"golang.org/x/mod", "golang.org", "golang.org/x/mod", ""},
+		{"example.com", "example.com", "example.com", ""},
+		{"example.com/v10", "example.com", "example.com", "/v10"},
+		// only a final element counts as a version
+		{"example.com/v2/pkg", "example.com", "example.com/v2/pkg", ""},
+		{"example.com/pkg/v2x", "example.com", "example.com/pkg/v2x", ""},
+		{"gopkg.in/yaml.v3", "gopkg.in", "gopkg.in/yaml", ".v3"},
+		{"gopkg.in/user/pkg.v0", "gopkg.in", "gopkg.in/user/pkg", ".v0"},
+		{"gopkg.in/pkg.v2.v1-unstable", "gopkg.in", "gopkg.in/pkg.v2", ".v1-unstable"},
+		{"gopkg.in", "gopkg.in", "gopkg.in", ""},
+	}
+
+	for _, tt := range tests {
+		m, ok := ParseModulePath([]byte(tt.path))
+		if !ok || string(m.Host) != tt.host || string(m.Prefix) != tt.prefix || string(m.Major) != tt.major {
+			t.Errorf("ParseModulePath(%q)=(%q,%q,%q), %v, want (%q,%q,%q)", tt.path, m.Host, m.Prefix, m.Major, ok, tt.host, tt.prefix, tt.major)
+		}
+	}
+}
+
+func TestValidateImportPath(t *testing.T) {
+	tests := []struct {
+		path string
+		ok   bool
+	}{
+		{string(data), true},
+		{"github.com/Azure/azure-sdk-for-go", true},
+		{"example.com/a_b/c~d/e-f.g", true},
+		{"1.2.3.4/x", true},
+		{"example.com/comma.go", true},
+		{"example.com/com10", true},
+		{"example.com/x~1y", true},
+		{"example.com/v1.x", true},
+
+		{"", false},
+		{"fmt", false},
+		{"localhost/x", false},
+		{"GitHub.com/x", false},
+		{"-example.com/x", false},
+		{"example_test.com/x", false},
+		{".example.com/x", false},
+		{"example.com/.x", false},
+		{"example.com/x.", false},
+		{"example.com/..", false},
+		{"example.com//x", false},
+		{"example.com/x/", false},
+		{"/example.com", false},
+		{"example.com/x+y", false},
+		{"example.com/x y", false},
+		{"example.com/x%20y", false},
+		{"example.com/AUX", false},
+		{"example.com/nul.txt", false},
+		{"example.com/Com1", false},
+		{"example.com/progra~1", false},
+		{"example.com/~1.go", false},
+		{"example.com/v1", false},
+		{"example.com/v0", false},
+		{"example.com/v02", false},
+		{"example.com/v2.0", false},
+		{"gopkg.in/yaml", false},
+		{"gopkg.in/yaml.v03", false},
+		{"gopkg.in/yaml.v3-beta", false},
+
+		// Unicode letters aren't allowed in any form
+		{"example.com/caf\u00e9", false},
+		{"example.com/cafe\u0301", false},
+		{"example.com/\uff58", false},
+		{"\u0435xample.com/x", false},
+		{"example.com/\xff", false},
+	}
+
+	for _, tt := range tests {
+		if ok := ValidateImportPath([]byte(tt.path)); ok != tt.ok {
+			t.Errorf("ValidateImportPath(%q)=%v, want %v", tt.path, ok, tt.ok)
+		}
+	}
+}
+
+func TestParseModulePathAt(t *testing.T) {
+	tests := []struct {
+		path   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"GitHub.com/x", 0},
+		{"example.com/x+y", 13},
+		{"example.com/caf\u00e9", 15},
+		{"example.com/aux/x", 15},
+		{"localhost/x", 9},
+		{"example.com/v1", 14},
+		{"gopkg.in/yaml", 13},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseModulePathAt([]byte(tt.path))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseModulePathAt(%q)=%v, %d, want errPos %d", tt.path, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestImportPathAlternatives(t *testing.T) {
+	paths := []string{
+		string(data), "gopkg.in/yaml.v3", "gopkg.in/yaml.v1-unstable", "gopkg.in/yaml.v01",
+		"example.com/v2", "example.com/v1", "example.com/v2.0", "example.com/v",
+		"example.com/lpt9.x", "example.com/lpt0", "example.com/x~", "example.com/x~12",
+		"example.com/caf\u00e9", "example.com/cafe\u0301", "Example.com/x", "example/x",
+	}
+	// and every pair of a few awkward elements after a host
+	elems := []string{"x", "v2", "v1", "v0", "v2.0", "aux", "aux.go", "com1x", "x~1", "x~", ".x", "x.", "-x", "X", "yaml.v3", "x.v01", "a+b"}
+	for _, a := range elems {
+		paths = append(paths, "example.com/"+a, "gopkg.in/"+a)
+		for _, b := range elems {
+			paths = append(paths, "example.com/"+a+"/"+b, "gopkg.in/"+a+"/"+b)
+		}
+	}
+
+	for _, p := range paths {
+		want := module.CheckPath(p) == nil
+		if got := ValidateImportPath([]byte(p)); got != want {
+			t.Errorf("ValidateImportPath(%q)=%v, but CheckPath says %v", p, got, want)
+		}
+		m, _ := ParseModulePath([]byte(p))
+		if prefix, major, ok := module.SplitPathVersion(p); want && ok && (prefix != string(m.Prefix) || major != string(m.Major)) {
+			t.Errorf("ParseModulePath(%q)=(%q,%q), but SplitPathVersion says (%q,%q)", p, m.Prefix, m.Major, prefix, major)
+		}
+	}
+}
+
+func BenchmarkCheckPath(b *testing.B) {
+	p := string(data)
+	for i := 0; i < b.N; i++ {
+		if module.CheckPath(p) == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if ValidateImportPath(data) {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,482 @@
+
+//line ini.rl:1
+package main
+
+// INILineKind says what sort of line ParseINILine found.
+type INILineKind int
+
+const (
+	INIBlank INILineKind = iota
+	INIComment
+	INISection
+	INIKeyValue
+)
+
+// INILine is a single line of an INI file.  Name is the section name for
+// INISection and the key for INIKeyValue, and Value is the value for
+// INIKeyValue.  Both are nil for comments and blank lines.  The byte
+// slices point into the parsed line except for quoted values containing
+// escapes.
+type INILine struct {
+	Kind  INILineKind
+	Name  []byte
+	Value []byte
+}
+
+// unquoteINI removes the backslashes from a quoted value, turning \n and
+// \t into a newline and a tab.
+func unquoteINI(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' {
+			i++
+			switch v[i] {
+			case 'n':
+				out = append(out, '\n')
+				continue
+			case 't':
+				out = append(out, '\t')
+				continue
+			}
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseINILine parses a single line, without its trailing newline, of an
+// INI file.  Comments start with '#' or ';', either on a line of their own
+// or after a section header or value.  A value runs from the first '=' on
+// the line, so it may contain more of them, to the start of any comment.
+// Whitespace around section names, keys, and values is removed.  A value
+// in double quotes may contain '#' and ';' and backslash escapes.
+func ParseINILine(data []byte) (INILine, bool) {
+	l, ok, _ := ParseINILineAt(data)
+	return l, ok
+}
+
+// ParseINILineAt is like ParseINILine but also returns the offset of the
+// first unexpected byte, or -1 if the line parsed.
+func ParseINILineAt(data []byte) (INILine, bool, int) {
+
+
+//line ini.rl:61
+
+//line ini.go:66
+const ini_start int = 9
+const ini_first_final int = 9
+const ini_error int = 0
+
+const ini_en_main int = 9
+
+
+//line ini.rl:62
+
+	var l INILine
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+
+	
+//line ini.go:84
+	{
+	cs = ini_start
+	}
+
+//line ini.go:89
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 9:
+		goto st_case_9
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 0:
+		goto st_case_0
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 14:
+		goto st_case_14
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	}
+	goto st_out
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 9:
+			goto st9
+		case 10:
+			goto st0
+		case 32:
+			goto st9
+		case 35:
+			goto tr22
+		case 59:
+			goto tr22
+		case 61:
+			goto st0
+		case 91:
+			goto tr23
+		}
+		goto tr20
+tr20:
+//line ini.rl:74
+ l.Kind = INIKeyValue 
+//line ini.rl:71
+ mark = p 
+	goto st1
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+//line ini.go:160
+		switch data[p] {
+		case 9:
+			goto tr1
+		case 10:
+			goto st0
+		case 32:
+			goto tr1
+		case 61:
+			goto tr3
+		}
+		goto st1
+tr1:
+//line ini.rl:78
+ l.Name = data[mark:p] 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line ini.go:181
+		switch data[p] {
+		case 9:
+			goto st2
+		case 10:
+			goto st0
+		case 32:
+			goto st2
+		case 61:
+			goto tr5
+		}
+		goto st1
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr3:
+//line ini.rl:78
+ l.Name = data[mark:p] 
+//line ini.rl:81
+ l.Value = data[p+1:p+1] 
+	goto st10
+tr5:
+//line ini.rl:81
+ l.Value = data[p+1:p+1] 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line ini.go:212
+		switch data[p] {
+		case 9:
+			goto st10
+		case 10:
+			goto st0
+		case 32:
+			goto st10
+		case 34:
+			goto st3
+		case 35:
+			goto st13
+		case 59:
+			goto st13
+		}
+		goto tr24
+tr24:
+//line ini.rl:71
+ mark = p 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line ini.go:237
+		switch data[p] {
+		case 9:
+			goto tr29
+		case 10:
+			goto st0
+		case 32:
+			goto tr29
+		case 35:
+			goto tr30
+		case 59:
+			goto tr30
+		}
+		goto st11
+tr29:
+//line ini.rl:79
+ l.Value = data[mark:p] 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line ini.go:260
+		switch data[p] {
+		case 9:
+			goto st12
+		case 10:
+			goto st0
+		case 32:
+			goto st12
+		case 35:
+			goto st13
+		case 59:
+			goto st13
+		}
+		goto st11
+tr22:
+//line ini.rl:72
+ l.Kind = INIComment 
+	goto st13
+tr30:
+//line ini.rl:79
+ l.Value = data[mark:p] 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line ini.go:287
+		goto st13
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto tr7
+		case 92:
+			goto tr8
+		}
+		goto tr6
+tr6:
+//line ini.rl:71
+ mark = p 
+	goto st4
+tr12:
+//line ini.rl:82
+ escaped = true 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line ini.go:316
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto tr10
+		case 92:
+			goto st5
+		}
+		goto st4
+tr7:
+//line ini.rl:71
+ mark = p 
+//line ini.rl:84
+
+	        if escaped {
+	            l.Value = unquoteINI(data[mark:p])
+	        } else {
+	            l.Value = data[mark:p]
+	        }
+	    
+	goto st14
+tr10:
+//line ini.rl:84
+
+	        if escaped {
+	            l.Value = unquoteINI(data[mark:p])
+	        } else {
+	            l.Value = data[mark:p]
+	        }
+	    
+	goto st14
+tr17:
+//line ini.rl:78
+ l.Name = data[mark:p] 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line ini.go:357
+		switch data[p] {
+		case 9:
+			goto st14
+		case 32:
+			goto st14
+		case 35:
+			goto st13
+		case 59:
+			goto st13
+		}
+		goto st0
+tr8:
+//line ini.rl:71
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line ini.go:378
+		if data[p] == 10 {
+			goto st0
+		}
+		goto tr12
+tr23:
+//line ini.rl:73
+ l.Kind = INISection 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line ini.go:392
+		switch data[p] {
+		case 9:
+			goto st6
+		case 10:
+			goto st0
+		case 32:
+			goto st6
+		case 93:
+			goto st0
+		}
+		goto tr13
+tr13:
+//line ini.rl:71
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line ini.go:413
+		switch data[p] {
+		case 9:
+			goto tr16
+		case 10:
+			goto st0
+		case 32:
+			goto tr16
+		case 93:
+			goto tr17
+		}
+		goto st7
+tr16:
+//line ini.rl:78
+ l.Name = data[mark:p] 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line ini.go:434
+		switch data[p] {
+		case 9:
+			goto st8
+		case 10:
+			goto st0
+		case 32:
+			goto st8
+		case 93:
+			goto st14
+		}
+		goto st7
+	st_out:
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 11:
+//line ini.rl:79
+ l.Value = data[mark:p] 
+//line ini.go:468
+		}
+	}
+
+	_out: {}
+	}
+
+//line ini.rl:113
+
+
+	if cs < ini_first_final {
+		return INILine{}, false, p
+	}
+
+	return l, true, -1
+}
@@ -0,0 +1,120 @@
+package main
+
+// INILineKind says what sort of line ParseINILine found.
+type INILineKind int
+
+const (
+	INIBlank INILineKind = iota
+	INIComment
+	INISection
+	INIKeyValue
+)
+
+// INILine is a single line of an INI file.  Name is the section name for
+// INISection and the key for INIKeyValue, and Value is the value for
+// INIKeyValue.  Both are nil for comments and blank lines.  The byte
+// slices point into the parsed line except for quoted values containing
+// escapes.
+type INILine struct {
+	Kind  INILineKind
+	Name  []byte
+	Value []byte
+}
+
+// unquoteINI removes the backslashes from a quoted value, turning \n and
+// \t into a newline and a tab.
+func unquoteINI(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' {
+			i++
+			switch v[i] {
+			case 'n':
+				out = append(out, '\n')
+				continue
+			case 't':
+				out = append(out, '\t')
+				continue
+			}
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseINILine parses a single line, without its trailing newline, of an
+// INI file.  Comments start with '#' or ';', either on a line of their own
+// or after a section header or value.  A value runs from the first '=' on
+// the line, so it may contain more of them, to the start of any comment.
+// Whitespace around section names, keys, and values is removed.  A value
+// in double quotes may contain '#' and ';' and backslash escapes.
+func ParseINILine(data []byte) (INILine, bool) {
+	l, ok, _ := ParseINILineAt(data)
+	return l, ok
+}
+
+// ParseINILineAt is like ParseINILine but also returns the offset of the
+// first unexpected byte, or -1 if the line parsed.
+func ParseINILineAt(data []byte) (INILine, bool, int) {
+
+%% machine ini;
+%% write data;
+
+	var l INILine
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+
+	%%{
+	    action mark    { mark = p }
+	    action comment { l.Kind = INIComment }
+	    action section { l.Kind = INISection }
+	    action keyval  { l.Kind = INIKeyValue }
+
+	    # trailing whitespace can only be told apart from a name or value
+	    # once something else follows, so these may be run more than once
+	    action name  { l.Name = data[mark:p] }
+	    action value { l.Value = data[mark:p] }
+
+	    action empty   { l.Value = data[p+1:p+1] }
+	    action escaped { escaped = true }
+
+	    action quoted {
+	        if escaped {
+	            l.Value = unquoteINI(data[mark:p])
+	        } else {
+	            l.Value = data[mark:p]
+	        }
+	    }
+
+	    ws = [ \t] ;
+
+	    comment = [#;] any* ;
+
+	    section_char = any - ( ']' | '\n' ) ;
+	    section_name = ( section_char - ws ) ( section_char* ( section_char - ws ) )? ;
+	    section = '[' ws* section_name >mark %name ws* ']' ws* comment? ;
+
+	    key_char = any - ( '=' | '\n' ) ;
+	    key = ( key_char - ( ws | [#;\[] ) ) ( key_char* ( key_char - ws ) )? ;
+
+	    value_char = any - [#;\n] ;
+	    unquoted = ( value_char - ( ws | '"' ) ) ( value_char* ( value_char - ws ) )? ;
+	    quoted = '"' ( [^"\\\n] | '\\' [^\n] @escaped )* >mark %quoted '"' ;
+
+	    keyval = key >mark %name ws* '=' @empty ws* ( unquoted >mark %value | quoted )? ws* comment? ;
+
+	    main := ws* ( comment >comment | section >section | keyval >keyval )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < ini_first_final {
+		return INILine{}, false, p
+	}
+
+	return l, true, -1
+}
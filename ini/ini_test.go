@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"gopkg.in/ini.v1"
+)
+
+var data = []byte(`; last modified 1 April 2001 by John Doe
+[owner]
+name = John Doe
+organization = Acme Widgets Inc.
+
+[database]
+# use IP address in case network name resolution is not working
+server = 192.0.2.62
+port = 143
+file = "payroll.dat" ; quoted
+options = sslmode=require connect_timeout=10
+`)
+
+var hits int
+
+func TestParseINILine(t *testing.T) {
+	tests := []struct {
+		line string
+		want INILine
+		ok   bool
+	}{
+		{line: "", want: INILine{Kind: INIBlank}, ok: true},
+		{line: " \t ", want: INILine{Kind: INIBlank}, ok: true},
+		{line: "; comment", want: INILine{Kind: INIComment}, ok: true},
+		{line: "  # comment = not a value", want: INILine{Kind: INIComment}, ok: true},
+		{line: "[owner]", want: INILine{Kind: INISection, Name: []byte("owner")}, ok: true},
+		{line: " [ two words ] ; comment", want: INILine{Kind: INISection, Name: []byte("two words")}, ok: true},
+		{line: "[a.b]#c", want: INILine{Kind: INISection, Name: []byte("a.b")}, ok: true},
+		{line: "key=value", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("value")}, ok: true},
+		{line: "key = value", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("value")}, ok: true},
+		{line: "  some key\t=\tsome value  ", want: INILine{Kind: INIKeyValue, Name: []byte("some key"), Value: []byte("some value")}, ok: true},
+		{line: "options = a=b c=d", want: INILine{Kind: INIKeyValue, Name: []byte("options"), Value: []byte("a=b c=d")}, ok: true},
+		{line: "key = value # comment", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("value")}, ok: true},
+		{line: "key = value;comment", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("value")}, ok: true},
+		{line: "key =", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("")}, ok: true},
+		{line: "key = ; comment", want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("")}, ok: true},
+		{line: `key = ""`, want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("")}, ok: true},
+		{line: `key = "a # b ; c"`, want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("a # b ; c")}, ok: true},
+		{line: `key = "say \"hi\"\n\\" # comment`, want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte("say \"hi\"\n\\")}, ok: true},
+		{line: `key = a "b" c`, want: INILine{Kind: INIKeyValue, Name: []byte("key"), Value: []byte(`a "b" c`)}, ok: true},
+
+		{line: "[owner"},
+		{line: "[]"},
+		{line: "[ ]"},
+		{line: "[owner] extra"},
+		{line: "key"},
+		{line: "= value"},
+		{line: `key = "unterminated`},
+		{line: `key = "quoted" extra`},
+		{line: `key = "bad \"`},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseINILine([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseINILine(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseINILine(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseINILineAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{"name = John Doe", -1},
+		{"[owner", 6},
+		{"= value", 0},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseINILineAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseINILineAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+type iniKey struct {
+	section, key, value string
+}
+
+func ragelINI(data []byte) ([]iniKey, bool) {
+	var keys []iniKey
+	var section string
+	for len(data) > 0 {
+		line := data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			data = nil
+		}
+		l, ok := ParseINILine(line)
+		if !ok {
+			return nil, false
+		}
+		switch l.Kind {
+		case INISection:
+			section = string(l.Name)
+		case INIKeyValue:
+			keys = append(keys, iniKey{section, string(l.Name), string(l.Value)})
+		}
+	}
+	return keys, true
+}
+
+func iniv1INI(data []byte) ([]iniKey, bool) {
+	f, err := ini.Load(data)
+	if err != nil {
+		return nil, false
+	}
+	var keys []iniKey
+	for _, s := range f.Sections() {
+		for _, k := range s.Keys() {
+			keys = append(keys, iniKey{s.Name(), k.Name(), k.Value()})
+		}
+	}
+	return keys, true
+}
+
+func TestINIAlternatives(t *testing.T) {
+	want, ok := ragelINI(data)
+	if !ok || len(want) != 6 {
+		t.Fatalf("ragelINI=%q, %v, want 6 keys", want, ok)
+	}
+	if got, ok := iniv1INI(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("iniv1INI=%q, want %q", got, want)
+	}
+}
+
+func BenchmarkIniV1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := iniv1INI(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ragelINI(data); ok {
+			hits++
+		}
+	}
+}
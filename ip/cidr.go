@@ -0,0 +1,2449 @@
+
+//line cidr.rl:1
+package main
+
+import "net/netip"
+
+// ParseCIDR parses an IPv4 or IPv6 prefix in CIDR notation such as
+// 192.168.1.0/24 or 2001:db8::/32.  Unlike net.ParseCIDR, any bits set
+// below the mask are an error rather than silently cleared, since in a
+// config file they are usually a typo.
+func ParseCIDR(data []byte) (netip.Prefix, bool) {
+	pfx, ok, _ := ParseCIDRAt(data)
+	return pfx, ok
+}
+
+// ParseCIDRAt is like ParseCIDR but also returns the offset of the first
+// byte that is wrong, or -1 if data parsed.  An IPv6 address with too many
+// or too few groups fails at the '/', and a prefix length that is too long
+// for the address, or too short for the bits set in it, fails at the
+// length.
+func ParseCIDRAt(data []byte) (netip.Prefix, bool, int) {
+
+
+//line cidr.rl:22
+
+//line cidr.go:27
+const cidr_start int = 1
+const cidr_first_final int = 75
+const cidr_error int = 0
+
+const cidr_en_main int = 1
+
+
+//line cidr.rl:23
+
+	var ip [16]byte
+	var n int
+	ellipsis := -1
+	var hex, dec int
+	var bits, slash int
+	is4 := false
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	
+//line cidr.go:49
+	{
+	cs = cidr_start
+	}
+
+//line cidr.go:54
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 48:
+			goto tr1
+		case 49:
+			goto tr2
+		case 50:
+			goto tr3
+		case 58:
+			goto st74
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr6
+			}
+		default:
+			goto tr6
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line cidr.go:261
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr7:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line cidr.go:297
+		switch data[p] {
+		case 48:
+			goto tr11
+		case 49:
+			goto tr12
+		case 50:
+			goto tr13
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+tr11:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st4
+tr35:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line cidr.go:325
+		if data[p] == 46 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line cidr.go:344
+		switch data[p] {
+		case 48:
+			goto tr16
+		case 49:
+			goto tr17
+		case 50:
+			goto tr18
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr19
+		}
+		goto st0
+tr16:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st6
+tr32:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line cidr.go:372
+		if data[p] == 46 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line cidr.go:391
+		switch data[p] {
+		case 48:
+			goto tr21
+		case 49:
+			goto tr22
+		case 50:
+			goto tr23
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr21:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st8
+tr29:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line cidr.go:419
+		if data[p] == 47 {
+			goto tr25
+		}
+		goto st0
+tr8:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+//line cidr.rl:40
+ slash = p 
+	goto st9
+tr25:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+//line cidr.rl:38
+ is4 = true 
+//line cidr.rl:40
+ slash = p 
+	goto st9
+tr59:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+//line cidr.rl:40
+ slash = p 
+	goto st9
+tr73:
+//line cidr.rl:40
+ slash = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line cidr.go:468
+		if data[p] == 48 {
+			goto tr26
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr27
+		}
+		goto st0
+tr26:
+//line cidr.rl:39
+ bits = bits*10 + int((data[p])-'0') 
+	goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+//line cidr.go:485
+		goto st0
+tr27:
+//line cidr.rl:39
+ bits = bits*10 + int((data[p])-'0') 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line cidr.go:496
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr91
+		}
+		goto st0
+tr91:
+//line cidr.rl:39
+ bits = bits*10 + int((data[p])-'0') 
+	goto st77
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+//line cidr.go:510
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr26
+		}
+		goto st0
+tr22:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line cidr.go:526
+		if data[p] == 47 {
+			goto tr25
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr28
+		}
+		goto st0
+tr24:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st11
+tr28:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line cidr.go:549
+		if data[p] == 47 {
+			goto tr25
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+tr23:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line cidr.go:568
+		switch data[p] {
+		case 47:
+			goto tr25
+		case 53:
+			goto tr30
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr29
+			}
+		case data[p] >= 48:
+			goto tr28
+		}
+		goto st0
+tr30:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line cidr.go:593
+		if data[p] == 47 {
+			goto tr25
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr29
+		}
+		goto st0
+tr17:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line cidr.go:612
+		if data[p] == 46 {
+			goto tr20
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr31
+		}
+		goto st0
+tr19:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st15
+tr31:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line cidr.go:635
+		if data[p] == 46 {
+			goto tr20
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr32
+		}
+		goto st0
+tr18:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line cidr.go:654
+		switch data[p] {
+		case 46:
+			goto tr20
+		case 53:
+			goto tr33
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr32
+			}
+		case data[p] >= 48:
+			goto tr31
+		}
+		goto st0
+tr33:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line cidr.go:679
+		if data[p] == 46 {
+			goto tr20
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr32
+		}
+		goto st0
+tr12:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line cidr.go:698
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr34
+		}
+		goto st0
+tr14:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st19
+tr34:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line cidr.go:721
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr35
+		}
+		goto st0
+tr13:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line cidr.go:740
+		switch data[p] {
+		case 46:
+			goto tr15
+		case 53:
+			goto tr36
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr35
+			}
+		case data[p] >= 48:
+			goto tr34
+		}
+		goto st0
+tr36:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line cidr.go:765
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr35
+		}
+		goto st0
+tr9:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line cidr.go:782
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr37:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line cidr.go:811
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr38:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line cidr.go:840
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		goto st0
+tr10:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line cidr.go:862
+		switch data[p] {
+		case 48:
+			goto tr39
+		case 49:
+			goto tr40
+		case 50:
+			goto tr41
+		case 58:
+			goto tr43
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr42
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr6
+			}
+		default:
+			goto tr6
+		}
+		goto st0
+tr39:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line cidr.go:901
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr44:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line cidr.go:937
+		switch data[p] {
+		case 48:
+			goto tr45
+		case 49:
+			goto tr46
+		case 50:
+			goto tr47
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr48
+		}
+		goto st0
+tr45:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st28
+tr67:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line cidr.go:965
+		if data[p] == 46 {
+			goto tr49
+		}
+		goto st0
+tr49:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line cidr.go:984
+		switch data[p] {
+		case 48:
+			goto tr50
+		case 49:
+			goto tr51
+		case 50:
+			goto tr52
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr53
+		}
+		goto st0
+tr50:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st30
+tr64:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line cidr.go:1012
+		if data[p] == 46 {
+			goto tr54
+		}
+		goto st0
+tr54:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line cidr.go:1031
+		switch data[p] {
+		case 48:
+			goto tr55
+		case 49:
+			goto tr56
+		case 50:
+			goto tr57
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr58
+		}
+		goto st0
+tr55:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st32
+tr61:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line cidr.go:1059
+		if data[p] == 47 {
+			goto tr59
+		}
+		goto st0
+tr56:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line cidr.go:1075
+		if data[p] == 47 {
+			goto tr59
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr60
+		}
+		goto st0
+tr58:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st34
+tr60:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line cidr.go:1098
+		if data[p] == 47 {
+			goto tr59
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr61
+		}
+		goto st0
+tr57:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line cidr.go:1117
+		switch data[p] {
+		case 47:
+			goto tr59
+		case 53:
+			goto tr62
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr61
+			}
+		case data[p] >= 48:
+			goto tr60
+		}
+		goto st0
+tr62:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line cidr.go:1142
+		if data[p] == 47 {
+			goto tr59
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr61
+		}
+		goto st0
+tr51:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line cidr.go:1161
+		if data[p] == 46 {
+			goto tr54
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr63
+		}
+		goto st0
+tr53:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st38
+tr63:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line cidr.go:1184
+		if data[p] == 46 {
+			goto tr54
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr64
+		}
+		goto st0
+tr52:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line cidr.go:1203
+		switch data[p] {
+		case 46:
+			goto tr54
+		case 53:
+			goto tr65
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr64
+			}
+		case data[p] >= 48:
+			goto tr63
+		}
+		goto st0
+tr65:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line cidr.go:1228
+		if data[p] == 46 {
+			goto tr54
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr64
+		}
+		goto st0
+tr46:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line cidr.go:1247
+		if data[p] == 46 {
+			goto tr49
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr66
+		}
+		goto st0
+tr48:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st42
+tr66:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line cidr.go:1270
+		if data[p] == 46 {
+			goto tr49
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr67
+		}
+		goto st0
+tr47:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line cidr.go:1289
+		switch data[p] {
+		case 46:
+			goto tr49
+		case 53:
+			goto tr68
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr67
+			}
+		case data[p] >= 48:
+			goto tr66
+		}
+		goto st0
+tr68:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line cidr.go:1314
+		if data[p] == 46 {
+			goto tr49
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr67
+		}
+		goto st0
+tr40:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line cidr.go:1337
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr69
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr69:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line cidr.go:1370
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr70
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr70:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line cidr.go:1403
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr41:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line cidr.go:1440
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 53:
+			goto tr71
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto tr69
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr9
+				}
+			case data[p] >= 65:
+				goto tr9
+			}
+		default:
+			goto tr72
+		}
+		goto st0
+tr71:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line cidr.go:1480
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto tr70
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr37
+				}
+			case data[p] >= 65:
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr72:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line cidr.go:1518
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr42:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line cidr.go:1555
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr72
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr43:
+//line ip.rl:68
+ ellipsis = n 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line cidr.go:1586
+		switch data[p] {
+		case 47:
+			goto tr73
+		case 48:
+			goto tr74
+		case 49:
+			goto tr75
+		case 50:
+			goto tr76
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr77
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr78
+			}
+		default:
+			goto tr78
+		}
+		goto st0
+tr74:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line cidr.go:1625
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr79
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr79
+			}
+		default:
+			goto tr79
+		}
+		goto st0
+tr79:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line cidr.go:1656
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr81
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr81
+			}
+		default:
+			goto tr81
+		}
+		goto st0
+tr81:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line cidr.go:1685
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr82
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr82
+			}
+		default:
+			goto tr82
+		}
+		goto st0
+tr82:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line cidr.go:1714
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		goto st0
+tr80:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+	goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+//line cidr.go:1736
+		switch data[p] {
+		case 48:
+			goto tr74
+		case 49:
+			goto tr75
+		case 50:
+			goto tr76
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr77
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr78
+			}
+		default:
+			goto tr78
+		}
+		goto st0
+tr75:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line cidr.go:1773
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr83
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr79
+			}
+		default:
+			goto tr79
+		}
+		goto st0
+tr83:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line cidr.go:1806
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr84
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr81
+			}
+		default:
+			goto tr81
+		}
+		goto st0
+tr84:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+//line cidr.go:1839
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr82
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr82
+			}
+		default:
+			goto tr82
+		}
+		goto st0
+tr76:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line cidr.go:1876
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 53:
+			goto tr85
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto tr83
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr79
+				}
+			case data[p] >= 65:
+				goto tr79
+			}
+		default:
+			goto tr86
+		}
+		goto st0
+tr85:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+//line cidr.go:1916
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto tr84
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr81
+				}
+			case data[p] >= 65:
+				goto tr81
+			}
+		default:
+			goto tr81
+		}
+		goto st0
+tr86:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line cidr.go:1954
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr81
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr81
+			}
+		default:
+			goto tr81
+		}
+		goto st0
+tr77:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+//line cidr.go:1991
+		switch data[p] {
+		case 46:
+			goto tr44
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr86
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr79
+			}
+		default:
+			goto tr79
+		}
+		goto st0
+tr78:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line cidr.go:2024
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr80
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr79
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr79
+			}
+		default:
+			goto tr79
+		}
+		goto st0
+tr6:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st66
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+//line cidr.go:2055
+		switch data[p] {
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr2:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st67
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+//line cidr.go:2090
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr87
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr87:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st68
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+//line cidr.go:2123
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr88
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr88:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st69
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+//line cidr.go:2156
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr3:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st70
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+//line cidr.go:2193
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 53:
+			goto tr89
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto tr87
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr9
+				}
+			case data[p] >= 65:
+				goto tr9
+			}
+		default:
+			goto tr90
+		}
+		goto st0
+tr89:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+//line cidr.go:2233
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto tr88
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr37
+				}
+			case data[p] >= 65:
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr90:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+//line cidr.go:2271
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr4:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st73
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+//line cidr.go:2308
+		switch data[p] {
+		case 46:
+			goto tr7
+		case 47:
+			goto tr8
+		case 58:
+			goto tr10
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr90
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 58 {
+			goto tr43
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line cidr.rl:48
+
+
+	if cs < cidr_first_final {
+		return netip.Prefix{}, false, p
+	}
+
+	var addr netip.Addr
+	if is4 {
+		if bits > 32 {
+			return netip.Prefix{}, false, slash + 1
+		}
+		addr = netip.AddrFrom4([4]byte(ip[:4]))
+	} else {
+		if !expandIPv6(&ip, n, ellipsis) {
+			return netip.Prefix{}, false, slash
+		}
+		if bits > 128 {
+			return netip.Prefix{}, false, slash + 1
+		}
+		addr = netip.AddrFrom16(ip)
+	}
+
+	pfx := netip.PrefixFrom(addr, bits)
+	if pfx.Masked() != pfx {
+		return netip.Prefix{}, false, slash + 1
+	}
+
+	return pfx, true, -1
+}
@@ -0,0 +1,76 @@
+package main
+
+import "net/netip"
+
+// ParseCIDR parses an IPv4 or IPv6 prefix in CIDR notation such as
+// 192.168.1.0/24 or 2001:db8::/32.  Unlike net.ParseCIDR, any bits set
+// below the mask are an error rather than silently cleared, since in a
+// config file they are usually a typo.
+func ParseCIDR(data []byte) (netip.Prefix, bool) {
+	pfx, ok, _ := ParseCIDRAt(data)
+	return pfx, ok
+}
+
+// ParseCIDRAt is like ParseCIDR but also returns the offset of the first
+// byte that is wrong, or -1 if data parsed.  An IPv6 address with too many
+// or too few groups fails at the '/', and a prefix length that is too long
+// for the address, or too short for the bits set in it, fails at the
+// length.
+func ParseCIDRAt(data []byte) (netip.Prefix, bool, int) {
+
+%% machine cidr;
+%% write data;
+
+	var ip [16]byte
+	var n int
+	ellipsis := -1
+	var hex, dec int
+	var bits, slash int
+	is4 := false
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	%%{
+	    include ipv6_common "ip.rl";
+
+	    action is4  { is4 = true }
+	    action bits { bits = bits*10 + int(fc-'0') }
+	    action slash { slash = p }
+
+	    prefix_len = ( '0' | '1'..'9' digit{0,2} ) $bits ;
+
+	    main := ( ipv4 %is4 | ipv6 ) '/' @slash prefix_len ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < cidr_first_final {
+		return netip.Prefix{}, false, p
+	}
+
+	var addr netip.Addr
+	if is4 {
+		if bits > 32 {
+			return netip.Prefix{}, false, slash + 1
+		}
+		addr = netip.AddrFrom4([4]byte(ip[:4]))
+	} else {
+		if !expandIPv6(&ip, n, ellipsis) {
+			return netip.Prefix{}, false, slash
+		}
+		if bits > 128 {
+			return netip.Prefix{}, false, slash + 1
+		}
+		addr = netip.AddrFrom16(ip)
+	}
+
+	pfx := netip.PrefixFrom(addr, bits)
+	if pfx.Masked() != pfx {
+		return netip.Prefix{}, false, slash + 1
+	}
+
+	return pfx, true, -1
+}
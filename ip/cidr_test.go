@@ -0,0 +1,115 @@
+package main
+
+import (
+	"net/netip"
+	"testing"
+)
+
+var cidrs = [][]byte{
+	[]byte("192.168.1.0/24"),
+	[]byte("10.0.0.0/8"),
+	[]byte("2001:db8::/32"),
+	[]byte("fe80::/10"),
+}
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		ok   bool
+	}{
+		{"192.168.1.0/24", "192.168.1.0/24", true},
+		{"0.0.0.0/0", "0.0.0.0/0", true},
+		{"10.1.2.3/32", "10.1.2.3/32", true},
+		{"172.16.0.0/12", "172.16.0.0/12", true},
+		{"2001:db8::/32", "2001:db8::/32", true},
+		{"::/0", "::/0", true},
+		{"::1/128", "::1/128", true},
+		{"2001:DB8:0:0:0:0:0:0/64", "2001:db8::/64", true},
+		{"::ffff:192.0.2.0/120", "::ffff:192.0.2.0/120", true},
+		{"fe80::/10", "fe80::/10", true},
+
+		// prefix too long
+		{in: "192.168.1.0/33"},
+		{in: "2001:db8::/129"},
+		// host bits set
+		{in: "192.168.1.1/24"},
+		{in: "2001:db8::1/32"},
+		{in: "11.0.0.0/7"},
+		// malformed
+		{in: "192.168.1.0/024"},
+		{in: "192.168.1.0/"},
+		{in: "192.168.1.0"},
+		{in: "192.168.1/24"},
+		{in: "256.0.0.0/8"},
+		{in: "2001:db8:::/32"},
+		{in: "1:2:3:4:5:6:7/112"},
+		{in: "fe80::1%eth0/64"},
+		{in: "/8"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseCIDR([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseCIDR(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && got.String() != tt.want {
+			t.Errorf("ParseCIDR(%q)=%v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCIDRAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{"10.0.0.0/8", -1},
+		{"10.0.0.0/33", 9},
+		{"10.0.0.1/8", 9},
+		{"10.0.0.0", 8},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseCIDRAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseCIDRAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestCIDRAlternatives(t *testing.T) {
+	for _, c := range cidrs {
+		want, _ := ParseCIDR(c)
+		if got, err := netip.ParsePrefix(string(c)); err != nil || got != want {
+			t.Errorf("netip.ParsePrefix(%q)=%v, want %v", c, got, want)
+		}
+	}
+}
+
+func BenchmarkNetipCIDR(b *testing.B) {
+	var s []string
+	for _, c := range cidrs {
+		s = append(s, string(c))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, c := range s {
+			if _, err := netip.ParsePrefix(c); err == nil {
+				hits++
+			}
+		}
+	}
+}
+
+func BenchmarkRagelCIDR(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, c := range cidrs {
+			if _, ok := ParseCIDR(c); ok {
+				hits++
+			}
+		}
+	}
+}
@@ -0,0 +1,2302 @@
+
+//line ip.rl:1
+package main
+
+
+//line ip.rl:12
+
+
+// ParseIPv4 parses a dotted-quad IPv4 address.  Octets with leading zeros
+// are rejected rather than guessed at as octal.
+func ParseIPv4(data []byte) (a, b, c, d uint8, ok bool) {
+	a, b, c, d, ok, _ = ParseIPv4At(data)
+	return a, b, c, d, ok
+}
+
+// ParseIPv4At is like ParseIPv4 but also returns the offset of the first
+// byte that doesn't belong in the address, or -1 if data is one.
+func ParseIPv4At(data []byte) (a, b, c, d uint8, ok bool, errPos int) {
+
+
+//line ip.rl:26
+
+//line ip.go:24
+const ipv4_start int = 1
+const ipv4_first_final int = 20
+const ipv4_error int = 0
+
+const ipv4_en_main int = 1
+
+
+//line ip.rl:27
+
+	var ip [4]byte
+	var n int
+	var dec int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	
+//line ip.go:41
+	{
+	cs = ipv4_start
+	}
+
+//line ip.go:46
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 48:
+			goto tr1
+		case 49:
+			goto tr2
+		case 50:
+			goto tr3
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr4
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st2
+tr27:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line ip.go:136
+		if data[p] == 46 {
+			goto tr5
+		}
+		goto st0
+tr5:
+//line ip.rl:39
+ ip[n] = byte(dec); n++ 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line ip.go:150
+		switch data[p] {
+		case 48:
+			goto tr6
+		case 49:
+			goto tr7
+		case 50:
+			goto tr8
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr9
+		}
+		goto st0
+tr6:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st4
+tr24:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line ip.go:178
+		if data[p] == 46 {
+			goto tr10
+		}
+		goto st0
+tr10:
+//line ip.rl:39
+ ip[n] = byte(dec); n++ 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line ip.go:192
+		switch data[p] {
+		case 48:
+			goto tr11
+		case 49:
+			goto tr12
+		case 50:
+			goto tr13
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+tr11:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st6
+tr21:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line ip.go:220
+		if data[p] == 46 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line ip.rl:39
+ ip[n] = byte(dec); n++ 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line ip.go:234
+		switch data[p] {
+		case 48:
+			goto tr16
+		case 49:
+			goto tr17
+		case 50:
+			goto tr18
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr19
+		}
+		goto st0
+tr16:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st20
+tr30:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line ip.go:262
+		goto st0
+tr17:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line ip.go:275
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+tr19:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st22
+tr29:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line ip.go:295
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr30
+		}
+		goto st0
+tr18:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line ip.go:311
+		if data[p] == 53 {
+			goto tr31
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr30
+			}
+		case data[p] >= 48:
+			goto tr29
+		}
+		goto st0
+tr31:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line ip.go:333
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr30
+		}
+		goto st0
+tr12:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line ip.go:349
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr20
+		}
+		goto st0
+tr14:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st9
+tr20:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line ip.go:372
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr21
+		}
+		goto st0
+tr13:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line ip.go:391
+		switch data[p] {
+		case 46:
+			goto tr15
+		case 53:
+			goto tr22
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr21
+			}
+		case data[p] >= 48:
+			goto tr20
+		}
+		goto st0
+tr22:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line ip.go:416
+		if data[p] == 46 {
+			goto tr15
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr21
+		}
+		goto st0
+tr7:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line ip.go:435
+		if data[p] == 46 {
+			goto tr10
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr23
+		}
+		goto st0
+tr9:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st13
+tr23:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line ip.go:458
+		if data[p] == 46 {
+			goto tr10
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr8:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line ip.go:477
+		switch data[p] {
+		case 46:
+			goto tr10
+		case 53:
+			goto tr25
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr24
+			}
+		case data[p] >= 48:
+			goto tr23
+		}
+		goto st0
+tr25:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line ip.go:502
+		if data[p] == 46 {
+			goto tr10
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr24
+		}
+		goto st0
+tr2:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line ip.go:521
+		if data[p] == 46 {
+			goto tr5
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr26
+		}
+		goto st0
+tr4:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st17
+tr26:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line ip.go:544
+		if data[p] == 46 {
+			goto tr5
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr27
+		}
+		goto st0
+tr3:
+//line ip.rl:37
+ dec = 0 
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line ip.go:563
+		switch data[p] {
+		case 46:
+			goto tr5
+		case 53:
+			goto tr28
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr27
+			}
+		case data[p] >= 48:
+			goto tr26
+		}
+		goto st0
+tr28:
+//line ip.rl:38
+ dec = dec*10 + int((data[p])-'0') 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line ip.go:588
+		if data[p] == 46 {
+			goto tr5
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr27
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 20, 21, 22, 23, 24:
+//line ip.rl:39
+ ip[n] = byte(dec); n++ 
+//line ip.go:627
+		}
+	}
+
+	_out: {}
+	}
+
+//line ip.rl:47
+
+
+	if cs < ipv4_first_final {
+		return 0, 0, 0, 0, false, p
+	}
+
+	return ip[0], ip[1], ip[2], ip[3], true, -1
+}
+
+
+//line ip.rl:95
+
+
+func unhex(c byte) int {
+	switch {
+	case c <= '9':
+		return int(c - '0')
+	case c <= 'F':
+		return int(c - 'A' + 10)
+	}
+	return int(c - 'a' + 10)
+}
+
+// expandIPv6 checks that the n bytes of ip parsed by ipv6_common make up a
+// full address and fills in the zeros for a "::" found at ellipsis.
+func expandIPv6(ip *[16]byte, n, ellipsis int) bool {
+	if ellipsis < 0 {
+		return n == 16
+	}
+
+	// "::" stands for at least one group of zeros
+	if n > 14 {
+		return false
+	}
+
+	copy(ip[16-(n-ellipsis):], ip[ellipsis:n])
+	for i := ellipsis; i < 16-(n-ellipsis); i++ {
+		ip[i] = 0
+	}
+
+	return true
+}
+
+// ParseIPv6 parses an IPv6 address in any of the text forms from RFC 4291
+// section 2.2, including "::" compression and a trailing dotted-quad.  A
+// zone ID is accepted and discarded; use ParseIPv6Zone to retrieve it.
+func ParseIPv6(data []byte) ([16]byte, bool) {
+	ip, _, ok := ParseIPv6Zone(data)
+	return ip, ok
+}
+
+// ParseIPv6At is like ParseIPv6 but also returns the offset of the first
+// byte that doesn't fit, or -1 if data parsed.  See ParseIPv6ZoneAt.
+func ParseIPv6At(data []byte) ([16]byte, bool, int) {
+	ip, _, ok, errPos := ParseIPv6ZoneAt(data)
+	return ip, ok, errPos
+}
+
+// ParseIPv6Zone is like ParseIPv6 but also returns the zone ID following
+// a '%', if any.  The zone points into data.
+func ParseIPv6Zone(data []byte) (ip [16]byte, zone []byte, ok bool) {
+	ip, zone, ok, _ = ParseIPv6ZoneAt(data)
+	return ip, zone, ok
+}
+
+// ParseIPv6ZoneAt is like ParseIPv6Zone but also returns the offset of the
+// first byte that doesn't fit, or -1 if data parsed.  An address with too
+// many or too few groups fails where the address ends.
+func ParseIPv6ZoneAt(data []byte) (ip [16]byte, zone []byte, ok bool, errPos int) {
+
+
+//line ip.rl:155
+
+//line ip.go:708
+const ipv6_start int = 1
+const ipv6_first_final int = 19
+const ipv6_error int = 0
+
+const ipv6_en_main int = 1
+
+
+//line ip.rl:156
+
+	var n int
+	ellipsis := -1
+	var hex, dec int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line ip.go:727
+	{
+	cs = ipv6_start
+	}
+
+//line ip.go:732
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 19:
+		goto st_case_19
+	case 2:
+		goto st_case_2
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 3:
+		goto st_case_3
+	case 24:
+		goto st_case_24
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 17:
+		goto st_case_17
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 18:
+		goto st_case_18
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 58 {
+			goto st18
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line ip.go:872
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr35
+			}
+		default:
+			goto tr35
+		}
+		goto st0
+tr34:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+	goto st2
+tr41:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line ip.go:915
+		goto tr3
+tr3:
+//line ip.rl:168
+ mark = p 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line ip.go:926
+		goto st20
+tr35:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line ip.go:937
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr38:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line ip.go:966
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr39
+			}
+		default:
+			goto tr39
+		}
+		goto st0
+tr39:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line ip.go:995
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr36
+		}
+		goto st0
+tr36:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line ip.go:1017
+		switch data[p] {
+		case 48:
+			goto tr4
+		case 49:
+			goto tr5
+		case 50:
+			goto tr6
+		case 58:
+			goto tr8
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr7
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+tr4:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line ip.go:1056
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr35
+			}
+		default:
+			goto tr35
+		}
+		goto st0
+tr40:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line ip.go:1092
+		switch data[p] {
+		case 48:
+			goto tr9
+		case 49:
+			goto tr10
+		case 50:
+			goto tr11
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr12
+		}
+		goto st0
+tr9:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st5
+tr27:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line ip.go:1120
+		if data[p] == 46 {
+			goto tr13
+		}
+		goto st0
+tr13:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line ip.go:1139
+		switch data[p] {
+		case 48:
+			goto tr14
+		case 49:
+			goto tr15
+		case 50:
+			goto tr16
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr17
+		}
+		goto st0
+tr14:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st7
+tr24:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line ip.go:1167
+		if data[p] == 46 {
+			goto tr18
+		}
+		goto st0
+tr18:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line ip.go:1186
+		switch data[p] {
+		case 48:
+			goto tr19
+		case 49:
+			goto tr20
+		case 50:
+			goto tr21
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto tr22
+		}
+		goto st0
+tr19:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st25
+tr43:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line ip.go:1214
+		if data[p] == 37 {
+			goto tr41
+		}
+		goto st0
+tr20:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line ip.go:1230
+		if data[p] == 37 {
+			goto tr41
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr42
+		}
+		goto st0
+tr22:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st27
+tr42:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line ip.go:1253
+		if data[p] == 37 {
+			goto tr41
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr43
+		}
+		goto st0
+tr21:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line ip.go:1272
+		switch data[p] {
+		case 37:
+			goto tr41
+		case 53:
+			goto tr44
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr43
+			}
+		case data[p] >= 48:
+			goto tr42
+		}
+		goto st0
+tr44:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line ip.go:1297
+		if data[p] == 37 {
+			goto tr41
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr43
+		}
+		goto st0
+tr15:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line ip.go:1316
+		if data[p] == 46 {
+			goto tr18
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr23
+		}
+		goto st0
+tr17:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st10
+tr23:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line ip.go:1339
+		if data[p] == 46 {
+			goto tr18
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr16:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line ip.go:1358
+		switch data[p] {
+		case 46:
+			goto tr18
+		case 53:
+			goto tr25
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr24
+			}
+		case data[p] >= 48:
+			goto tr23
+		}
+		goto st0
+tr25:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line ip.go:1383
+		if data[p] == 46 {
+			goto tr18
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr24
+		}
+		goto st0
+tr10:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line ip.go:1402
+		if data[p] == 46 {
+			goto tr13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr26
+		}
+		goto st0
+tr12:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st14
+tr26:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line ip.go:1425
+		if data[p] == 46 {
+			goto tr13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr27
+		}
+		goto st0
+tr11:
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line ip.go:1444
+		switch data[p] {
+		case 46:
+			goto tr13
+		case 53:
+			goto tr28
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr27
+			}
+		case data[p] >= 48:
+			goto tr26
+		}
+		goto st0
+tr28:
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line ip.go:1469
+		if data[p] == 46 {
+			goto tr13
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr27
+		}
+		goto st0
+tr5:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line ip.go:1492
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr45
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr35
+			}
+		default:
+			goto tr35
+		}
+		goto st0
+tr45:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line ip.go:1525
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr46
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr46:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line ip.go:1558
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr39
+			}
+		default:
+			goto tr39
+		}
+		goto st0
+tr6:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line ip.go:1595
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 53:
+			goto tr47
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto tr45
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr35
+				}
+			case data[p] >= 65:
+				goto tr35
+			}
+		default:
+			goto tr48
+		}
+		goto st0
+tr47:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line ip.go:1635
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto tr46
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr38
+				}
+			case data[p] >= 65:
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr48:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line ip.go:1673
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr7:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line ip.go:1710
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr48
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr35
+			}
+		default:
+			goto tr35
+		}
+		goto st0
+tr8:
+//line ip.rl:68
+ ellipsis = n 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line ip.go:1741
+		switch data[p] {
+		case 37:
+			goto st2
+		case 48:
+			goto tr29
+		case 49:
+			goto tr30
+		case 50:
+			goto tr31
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr32
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr33
+			}
+		default:
+			goto tr33
+		}
+		goto st0
+tr29:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line ip.go:1780
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr50
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr50
+			}
+		default:
+			goto tr50
+		}
+		goto st0
+tr50:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line ip.go:1811
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr52
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr52:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line ip.go:1840
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr53
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr53
+			}
+		default:
+			goto tr53
+		}
+		goto st0
+tr53:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line ip.go:1869
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr51
+		}
+		goto st0
+tr51:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line ip.go:1891
+		switch data[p] {
+		case 48:
+			goto tr29
+		case 49:
+			goto tr30
+		case 50:
+			goto tr31
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto tr32
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr33
+			}
+		default:
+			goto tr33
+		}
+		goto st0
+tr30:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line ip.go:1928
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr54
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr50
+			}
+		default:
+			goto tr50
+		}
+		goto st0
+tr54:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line ip.go:1961
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr55
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr55:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line ip.go:1994
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr53
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr53
+			}
+		default:
+			goto tr53
+		}
+		goto st0
+tr31:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line ip.go:2031
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 53:
+			goto tr56
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto tr54
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr50
+				}
+			case data[p] >= 65:
+				goto tr50
+			}
+		default:
+			goto tr57
+		}
+		goto st0
+tr56:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line ip.go:2071
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto tr55
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto tr52
+				}
+			case data[p] >= 65:
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr57:
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line ip.go:2109
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr52
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr32:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+//line ip.rl:66
+ dec = 0 
+//line ip.rl:67
+ dec = dec*10 + int((data[p])-'0') 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line ip.go:2146
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 46:
+			goto tr40
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr57
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr50
+			}
+		default:
+			goto tr50
+		}
+		goto st0
+tr33:
+//line ip.rl:64
+ hex = 0 
+//line ip.rl:65
+ hex = hex<<4 | unhex((data[p])) 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line ip.go:2179
+		switch data[p] {
+		case 37:
+			goto tr34
+		case 58:
+			goto tr51
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr50
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr50
+			}
+		default:
+			goto tr50
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 58 {
+			goto tr8
+		}
+		goto st0
+	st_out:
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 19, 21, 22, 23, 24, 30, 31, 32, 33, 34, 35, 36, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49:
+//line ip.rl:70
+
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    
+		case 20:
+//line ip.rl:169
+ zone = data[mark:p] 
+		case 25, 26, 27, 28, 29:
+//line ip.rl:77
+
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    
+//line ip.go:2280
+		}
+	}
+
+	_out: {}
+	}
+
+//line ip.rl:175
+
+
+	if cs < ipv6_first_final {
+		return [16]byte{}, nil, false, p
+	}
+
+	if !expandIPv6(&ip, n, ellipsis) {
+		end := len(data)
+		if zone != nil {
+			end -= 1 + len(zone)
+		}
+		return [16]byte{}, nil, false, end
+	}
+
+	return ip, zone, true, -1
+}
@@ -0,0 +1,190 @@
+package main
+
+%%{
+    machine ip_common;
+
+    # 0-255 without leading zeros
+    dec_octet = digit
+              | '1'..'9' digit
+              | '1' digit{2}
+              | '2' '0'..'4' digit
+              | '25' '0'..'5' ;
+}%%
+
+// ParseIPv4 parses a dotted-quad IPv4 address.  Octets with leading zeros
+// are rejected rather than guessed at as octal.
+func ParseIPv4(data []byte) (a, b, c, d uint8, ok bool) {
+	a, b, c, d, ok, _ = ParseIPv4At(data)
+	return a, b, c, d, ok
+}
+
+// ParseIPv4At is like ParseIPv4 but also returns the offset of the first
+// byte that doesn't belong in the address, or -1 if data is one.
+func ParseIPv4At(data []byte) (a, b, c, d uint8, ok bool, errPos int) {
+
+%% machine ipv4;
+%% write data;
+
+	var ip [4]byte
+	var n int
+	var dec int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	%%{
+	    include ip_common;
+
+	    action dec_start { dec = 0 }
+	    action dec_digit { dec = dec*10 + int(fc-'0') }
+	    action octet     { ip[n] = byte(dec); n++ }
+
+	    octet = dec_octet >dec_start $dec_digit %octet ;
+
+	    main := octet '.' octet '.' octet '.' octet ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < ipv4_first_final {
+		return 0, 0, 0, 0, false, p
+	}
+
+	return ip[0], ip[1], ip[2], ip[3], true, -1
+}
+
+%%{
+    machine ipv6_common;
+
+    include ip_common;
+
+    # These expect ip [16]byte, n, ellipsis, hex, and dec to be in scope.
+    # n counts bytes seen, which may run past the end of ip on bad input.
+
+    action hex_start { hex = 0 }
+    action hex_digit { hex = hex<<4 | unhex(fc) }
+    action dec_start { dec = 0 }
+    action dec_digit { dec = dec*10 + int(fc-'0') }
+    action ellipsis  { ellipsis = n }
+
+    action group {
+        if n < 16 {
+            ip[n], ip[n+1] = byte(hex>>8), byte(hex)
+        }
+        n += 2
+    }
+
+    action octet {
+        if n < 16 {
+            ip[n] = byte(dec)
+        }
+        n++
+    }
+
+    h16 = xdigit{1,4} >hex_start $hex_digit %group ;
+    octet = dec_octet >dec_start $dec_digit %octet ;
+    ipv4 = octet '.' octet '.' octet '.' octet ;
+
+    # The grammar only fixes the shape of the address; how many groups
+    # appear on each side of the "::" is checked by expandIPv6.
+    groups = h16 ( ':' h16 )* ;
+    tail = groups ( ':' ipv4 )? | ipv4 ;
+    ellipsis = '::' @ellipsis tail? ;
+
+    ipv6 = groups ( ':' ipv4 | ellipsis )? | ellipsis ;
+}%%
+
+func unhex(c byte) int {
+	switch {
+	case c <= '9':
+		return int(c - '0')
+	case c <= 'F':
+		return int(c - 'A' + 10)
+	}
+	return int(c - 'a' + 10)
+}
+
+// expandIPv6 checks that the n bytes of ip parsed by ipv6_common make up a
+// full address and fills in the zeros for a "::" found at ellipsis.
+func expandIPv6(ip *[16]byte, n, ellipsis int) bool {
+	if ellipsis < 0 {
+		return n == 16
+	}
+
+	// "::" stands for at least one group of zeros
+	if n > 14 {
+		return false
+	}
+
+	copy(ip[16-(n-ellipsis):], ip[ellipsis:n])
+	for i := ellipsis; i < 16-(n-ellipsis); i++ {
+		ip[i] = 0
+	}
+
+	return true
+}
+
+// ParseIPv6 parses an IPv6 address in any of the text forms from RFC 4291
+// section 2.2, including "::" compression and a trailing dotted-quad.  A
+// zone ID is accepted and discarded; use ParseIPv6Zone to retrieve it.
+func ParseIPv6(data []byte) ([16]byte, bool) {
+	ip, _, ok := ParseIPv6Zone(data)
+	return ip, ok
+}
+
+// ParseIPv6At is like ParseIPv6 but also returns the offset of the first
+// byte that doesn't fit, or -1 if data parsed.  See ParseIPv6ZoneAt.
+func ParseIPv6At(data []byte) ([16]byte, bool, int) {
+	ip, _, ok, errPos := ParseIPv6ZoneAt(data)
+	return ip, ok, errPos
+}
+
+// ParseIPv6Zone is like ParseIPv6 but also returns the zone ID following
+// a '%', if any.  The zone points into data.
+func ParseIPv6Zone(data []byte) (ip [16]byte, zone []byte, ok bool) {
+	ip, zone, ok, _ = ParseIPv6ZoneAt(data)
+	return ip, zone, ok
+}
+
+// ParseIPv6ZoneAt is like ParseIPv6Zone but also returns the offset of the
+// first byte that doesn't fit, or -1 if data parsed.  An address with too
+// many or too few groups fails where the address ends.
+func ParseIPv6ZoneAt(data []byte) (ip [16]byte, zone []byte, ok bool, errPos int) {
+
+%% machine ipv6;
+%% write data;
+
+	var n int
+	ellipsis := -1
+	var hex, dec int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    include ipv6_common;
+
+	    action mark { mark = p }
+	    action zone { zone = data[mark:p] }
+
+	    main := ipv6 ( '%' any+ >mark %zone )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < ipv6_first_final {
+		return [16]byte{}, nil, false, p
+	}
+
+	if !expandIPv6(&ip, n, ellipsis) {
+		end := len(data)
+		if zone != nil {
+			end -= 1 + len(zone)
+		}
+		return [16]byte{}, nil, false, end
+	}
+
+	return ip, zone, true, -1
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+)
+
+var v4data = []byte("192.168.100.254")
+
+var v6data = []byte("2001:db8:85a3::8a2e:370:7334")
+
+var hits int
+
+func TestParseIPv4(t *testing.T) {
+	tests := []struct {
+		in         string
+		a, b, c, d uint8
+		ok         bool
+	}{
+		{"192.168.100.254", 192, 168, 100, 254, true},
+		{"0.0.0.0", 0, 0, 0, 0, true},
+		{"255.255.255.255", 255, 255, 255, 255, true},
+		{"10.9.99.199", 10, 9, 99, 199, true},
+		{"249.250.251.252", 249, 250, 251, 252, true},
+		{"256.0.0.0", 0, 0, 0, 0, false},
+		{"1.2.3.300", 0, 0, 0, 0, false},
+		{"01.2.3.4", 0, 0, 0, 0, false},
+		{"1.2.3", 0, 0, 0, 0, false},
+		{"1.2.3.4.5", 0, 0, 0, 0, false},
+		{"1.2.3.", 0, 0, 0, 0, false},
+		{"1..3.4", 0, 0, 0, 0, false},
+		{"1.2.3.4 ", 0, 0, 0, 0, false},
+		{"a.b.c.d", 0, 0, 0, 0, false},
+		{"", 0, 0, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		a, b, c, d, ok := ParseIPv4([]byte(tt.in))
+		if ok != tt.ok || a != tt.a || b != tt.b || c != tt.c || d != tt.d {
+			t.Errorf("ParseIPv4(%q)=(%d,%d,%d,%d,%v), want (%d,%d,%d,%d,%v)", tt.in, a, b, c, d, ok, tt.a, tt.b, tt.c, tt.d, tt.ok)
+		}
+	}
+}
+
+func TestParseIPv4At(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(v4data), -1},
+		{"192.168.100.256", 14},
+		{"192.168.1", 9},
+		{"192.168.01.1", 9},
+	}
+
+	for _, tt := range tests {
+		_, _, _, _, ok, errPos := ParseIPv4At([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseIPv4At(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestParseIPv6(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		zone string
+		ok   bool
+	}{
+		{in: "::", want: "0000:0000:0000:0000:0000:0000:0000:0000", ok: true},
+		{in: "::1", want: "0000:0000:0000:0000:0000:0000:0000:0001", ok: true},
+		{in: "1::", want: "0001:0000:0000:0000:0000:0000:0000:0000", ok: true},
+		{in: "2001:DB8:0:0:8:800:200C:417A", want: "2001:0db8:0000:0000:0008:0800:200c:417a", ok: true},
+		{in: "2001:db8::8:800:200c:417a", want: "2001:0db8:0000:0000:0008:0800:200c:417a", ok: true},
+		{in: "ff01::101", want: "ff01:0000:0000:0000:0000:0000:0000:0101", ok: true},
+		{in: "abcd:ef01:2345:6789:abcd:ef01:2345:6789", want: "abcd:ef01:2345:6789:abcd:ef01:2345:6789", ok: true},
+		{in: "1:2:3:4:5:6:7::", want: "0001:0002:0003:0004:0005:0006:0007:0000", ok: true},
+		{in: "::2:3:4:5:6:7:8", want: "0000:0002:0003:0004:0005:0006:0007:0008", ok: true},
+		{in: "1:2:3::6:7:8", want: "0001:0002:0003:0000:0000:0006:0007:0008", ok: true},
+		{in: "0:0:0:0:0:0:13.1.68.3", want: "0000:0000:0000:0000:0000:0000:0d01:4403", ok: true},
+		{in: "::13.1.68.3", want: "0000:0000:0000:0000:0000:0000:0d01:4403", ok: true},
+		{in: "::ffff:192.0.2.1", want: "0000:0000:0000:0000:0000:ffff:c000:0201", ok: true},
+		{in: "0:0:0:0:0:FFFF:129.144.52.38", want: "0000:0000:0000:0000:0000:ffff:8190:3426", ok: true},
+		{in: "64:ff9b::1:192.0.2.33", want: "0064:ff9b:0000:0000:0000:0001:c000:0221", ok: true},
+		{in: "fe80::1%eth0", want: "fe80:0000:0000:0000:0000:0000:0000:0001", zone: "eth0", ok: true},
+		{in: "fe80::a:b%25", want: "fe80:0000:0000:0000:0000:0000:000a:000b", zone: "25", ok: true},
+
+		// nine groups
+		{in: "1:2:3:4:5:6:7:8:9"},
+		// seven groups without "::"
+		{in: "1:2:3:4:5:6:7"},
+		// "::" must replace at least one group
+		{in: "1:2:3:4::5:6:7:8"},
+		// only one "::" is allowed
+		{in: "1::2::3"},
+		{in: ":::"},
+		{in: ":1::"},
+		{in: "1:"},
+		{in: "12345::"},
+		{in: "g::"},
+		// dotted quad must come last and fill exactly two groups
+		{in: "::1.2.3.4:5"},
+		{in: "1:2:3:4:5:6:7:1.2.3.4"},
+		{in: "::256.1.1.1"},
+		{in: "::1.2.3"},
+		// empty zone
+		{in: "fe80::1%"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		ip, zone, ok := ParseIPv6Zone([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseIPv6Zone(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := netip.AddrFrom16(ip).StringExpanded(); got != tt.want || string(zone) != tt.zone {
+			t.Errorf("ParseIPv6Zone(%q)=(%s,%q), want (%s,%q)", tt.in, got, zone, tt.want, tt.zone)
+		}
+		if ip2, ok := ParseIPv6([]byte(tt.in)); !ok || ip2 != ip {
+			t.Errorf("ParseIPv6(%q)=(%v,%v), want (%v,true)", tt.in, ip2, ok, ip)
+		}
+	}
+}
+
+func TestParseIPv6ZoneAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(v6data), -1},
+		{"fe80::1%eth0", -1},
+		{"2001:db8:::1", 10},
+		// too many groups is only noticed once the address is expanded
+		{"1:2:3:4:5:6:7:8:9", 17},
+		{"fe80::1%", 8},
+	}
+
+	for _, tt := range tests {
+		_, _, ok, errPos := ParseIPv6ZoneAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseIPv6ZoneAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestIPAlternatives(t *testing.T) {
+	for _, s := range []string{"::", "::1", "1:2:3::6:7:8", "::ffff:192.0.2.1", "fe80::1%eth0", string(v6data)} {
+		want, _ := ParseIPv6([]byte(s))
+		if got, err := netip.ParseAddr(s); err != nil || got.WithZone("").As16() != want {
+			t.Errorf("netip.ParseAddr(%q)=%v, want %v", s, got, want)
+		}
+	}
+	a, b, c, d, _ := ParseIPv4(v4data)
+	if got := net.ParseIP(string(v4data)); !got.Equal(net.IPv4(a, b, c, d)) {
+		t.Errorf("net.ParseIP(%q)=%v, want %d.%d.%d.%d", v4data, got, a, b, c, d)
+	}
+}
+
+func BenchmarkNetParseIPv4(b *testing.B) {
+	s := string(v4data)
+	for i := 0; i < b.N; i++ {
+		if net.ParseIP(s) != nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkNetipParseAddrIPv4(b *testing.B) {
+	s := string(v4data)
+	for i := 0; i < b.N; i++ {
+		if _, err := netip.ParseAddr(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagelIPv4(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, _, ok := ParseIPv4(v4data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkNetParseIPv6(b *testing.B) {
+	s := string(v6data)
+	for i := 0; i < b.N; i++ {
+		if net.ParseIP(s) != nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkNetipParseAddrIPv6(b *testing.B) {
+	s := string(v6data)
+	for i := 0; i < b.N; i++ {
+		if _, err := netip.ParseAddr(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagelIPv6(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseIPv6(v6data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,2258 @@
+
+//line iptables.rl:1
+package main
+
+import "bytes"
+
+// IPTablesEntry holds the interesting fields of a packet logged by the
+// iptables LOG target, as it appears in syslog.  The byte slices point into
+// the parsed line; fields that weren't logged are nil or zero.
+type IPTablesEntry struct {
+	Timestamp []byte
+	Hostname  []byte
+	Prefix    []byte
+	In        []byte
+	Out       []byte
+	MAC       []byte
+	Src       []byte
+	Dst       []byte
+	Len       int
+	TTL       int
+	Proto     []byte
+	SrcPort   int
+	DstPort   int
+}
+
+// ParseIPTablesLog parses a kernel log line written by the iptables LOG
+// target, such as
+//
+//	Feb  5 13:29:14 gw kernel: [5504.112233] [UFW BLOCK] IN=eth0 OUT= MAC=... SRC=192.0.2.1 DST=198.51.100.2 LEN=60 ... PROTO=TCP SPT=443 DPT=51234 ...
+//
+// Prefix is the --log-prefix text, which is everything between the kernel
+// tag and IN= less any trailing spaces.  After IN= the KEY=VALUE pairs may
+// come in any order, and unknown keys and bare flags such as DF or SYN are
+// skipped.  Len is the IP packet length.
+func ParseIPTablesLog(data []byte) (IPTablesEntry, bool) {
+	e, ok, _ := ParseIPTablesLogAt(data)
+	return e, ok
+}
+
+// ParseIPTablesLogAt is like ParseIPTablesLog but also returns the offset
+// where the line stopped making sense, len(data) if it ended too soon, and
+// -1 if it parsed.
+func ParseIPTablesLogAt(data []byte) (IPTablesEntry, bool, int) {
+
+
+//line iptables.rl:44
+
+//line iptables.go:49
+const iptables_start int = 1
+const iptables_first_final int = 69
+const iptables_error int = 0
+
+const iptables_en_main int = 1
+
+
+//line iptables.rl:45
+
+	var e IPTablesEntry
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	num := 0
+	seenLen := false
+
+	
+//line iptables.go:68
+	{
+	cs = iptables_start
+	}
+
+//line iptables.go:73
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 33:
+		goto st_case_33
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 34:
+		goto st_case_34
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 37:
+		goto st_case_37
+	case 87:
+		goto st_case_87
+	case 38:
+		goto st_case_38
+	case 88:
+		goto st_case_88
+	case 39:
+		goto st_case_39
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 40:
+		goto st_case_40
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 41:
+		goto st_case_41
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 44:
+		goto st_case_44
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 107:
+		goto st_case_107
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 65:
+			goto tr1
+		case 68:
+			goto tr2
+		case 70:
+			goto tr3
+		case 74:
+			goto tr4
+		case 77:
+			goto tr5
+		case 78:
+			goto tr6
+		case 79:
+			goto tr7
+		case 83:
+			goto tr8
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line iptables.rl:55
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line iptables.go:330
+		switch data[p] {
+		case 112:
+			goto st3
+		case 117:
+			goto st53
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 114 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 32 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 32 {
+			goto st6
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 32 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 58 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 58 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 32 {
+			goto tr24
+		}
+		goto st0
+tr24:
+//line iptables.rl:56
+ e.Timestamp = data[mark:p] 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line iptables.go:476
+		if data[p] == 32 {
+			goto st0
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr25
+tr25:
+//line iptables.rl:55
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line iptables.go:493
+		if data[p] == 32 {
+			goto tr27
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st18
+tr27:
+//line iptables.rl:57
+ e.Hostname = data[mark:p] 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line iptables.go:510
+		if data[p] == 107 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 101 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 114 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 110 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 101 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 108 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 58 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 32 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 73:
+			goto tr37
+		case 91:
+			goto tr38
+		}
+		goto tr36
+tr36:
+//line iptables.rl:55
+ mark = p 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line iptables.go:599
+		if data[p] == 73 {
+			goto tr40
+		}
+		goto st28
+tr37:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:58
+ e.Prefix = bytes.TrimRight(data[mark:p], " ") 
+	goto st29
+tr40:
+//line iptables.rl:58
+ e.Prefix = bytes.TrimRight(data[mark:p], " ") 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line iptables.go:619
+		switch data[p] {
+		case 73:
+			goto tr40
+		case 78:
+			goto st30
+		}
+		goto st28
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 61:
+			goto st69
+		case 73:
+			goto tr40
+		}
+		goto st28
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 32 {
+			goto tr74
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr73
+tr73:
+//line iptables.rl:55
+ mark = p 
+	goto st70
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+//line iptables.go:660
+		if data[p] == 32 {
+			goto tr76
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st70
+tr74:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:59
+ e.In = data[mark:p] 
+	goto st71
+tr76:
+//line iptables.rl:59
+ e.In = data[mark:p] 
+	goto st71
+tr91:
+//line iptables.rl:69
+ e.DstPort = num 
+	goto st71
+tr95:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:63
+ e.Dst = data[mark:p] 
+	goto st71
+tr97:
+//line iptables.rl:63
+ e.Dst = data[mark:p] 
+	goto st71
+tr101:
+//line iptables.rl:72
+
+	        if !seenLen {
+	            e.Len = num
+	            seenLen = true
+	        }
+	    
+	goto st71
+tr105:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:61
+ e.MAC = data[mark:p] 
+	goto st71
+tr107:
+//line iptables.rl:61
+ e.MAC = data[mark:p] 
+	goto st71
+tr112:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:60
+ e.Out = data[mark:p] 
+	goto st71
+tr114:
+//line iptables.rl:60
+ e.Out = data[mark:p] 
+	goto st71
+tr120:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:64
+ e.Proto = data[mark:p] 
+	goto st71
+tr122:
+//line iptables.rl:64
+ e.Proto = data[mark:p] 
+	goto st71
+tr126:
+//line iptables.rl:68
+ e.SrcPort = num 
+	goto st71
+tr130:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:62
+ e.Src = data[mark:p] 
+	goto st71
+tr132:
+//line iptables.rl:62
+ e.Src = data[mark:p] 
+	goto st71
+tr135:
+//line iptables.rl:67
+ e.TTL = num 
+	goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+//line iptables.go:754
+		switch data[p] {
+		case 32:
+			goto st72
+		case 68:
+			goto st75
+		case 73:
+			goto st81
+		case 76:
+			goto st82
+		case 77:
+			goto st85
+		case 79:
+			goto st89
+		case 80:
+			goto st93
+		case 83:
+			goto st99
+		case 84:
+			goto st105
+		}
+		if 65 <= data[p] && data[p] <= 90 {
+			goto st73
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 32 {
+			goto st72
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 32 {
+			goto st71
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st74
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 80:
+			goto st76
+		case 83:
+			goto st78
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st31
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 61:
+			goto st32
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr45
+		}
+		goto st0
+tr45:
+//line iptables.rl:65
+ num = 0 
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st77
+tr92:
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st77
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+//line iptables.go:916
+		if data[p] == 32 {
+			goto tr91
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr92
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st33
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 61:
+			goto st79
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 32 {
+			goto tr95
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr94
+tr94:
+//line iptables.rl:55
+ mark = p 
+	goto st80
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+//line iptables.go:989
+		if data[p] == 32 {
+			goto tr97
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st80
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 78:
+			goto st34
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 95 {
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 69:
+			goto st83
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 78:
+			goto st35
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 61:
+			goto st36
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr48
+		}
+		goto st0
+tr48:
+//line iptables.rl:65
+ num = 0 
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st84
+tr102:
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st84
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+//line iptables.go:1130
+		if data[p] == 32 {
+			goto tr101
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr102
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 65:
+			goto st86
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 66 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 67:
+			goto st37
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 61:
+			goto st87
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 32 {
+			goto tr105
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr106
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr106
+			}
+		default:
+			goto tr106
+		}
+		goto st0
+tr106:
+//line iptables.rl:55
+ mark = p 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line iptables.go:1236
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st88
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st88
+			}
+		default:
+			goto st88
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch data[p] {
+		case 32:
+			goto tr107
+		case 58:
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 85:
+			goto st90
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st40
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 61:
+			goto st91
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 32 {
+			goto tr112
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr111
+tr111:
+//line iptables.rl:55
+ mark = p 
+	goto st92
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+//line iptables.go:1369
+		if data[p] == 32 {
+			goto tr114
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st92
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 82:
+			goto st94
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 79:
+			goto st95
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st96
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 79:
+			goto st41
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 61:
+			goto st97
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 32 {
+			goto tr120
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr119
+tr119:
+//line iptables.rl:55
+ mark = p 
+	goto st98
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+//line iptables.go:1514
+		if data[p] == 32 {
+			goto tr122
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st98
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 80:
+			goto st100
+		case 82:
+			goto st102
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st42
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 61:
+			goto st43
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr55
+		}
+		goto st0
+tr55:
+//line iptables.rl:65
+ num = 0 
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st101
+tr127:
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st101
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+//line iptables.go:1616
+		if data[p] == 32 {
+			goto tr126
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr127
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 67:
+			goto st44
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 61:
+			goto st103
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 32 {
+			goto tr130
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr129
+tr129:
+//line iptables.rl:55
+ mark = p 
+	goto st104
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+//line iptables.go:1689
+		if data[p] == 32 {
+			goto tr132
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto st104
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 84:
+			goto st106
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		switch data[p] {
+		case 32:
+			goto st71
+		case 61:
+			goto st74
+		case 76:
+			goto st45
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch data[p] {
+		case 61:
+			goto st46
+		case 95:
+			goto st73
+		}
+		switch {
+		case data[p] > 57:
+			if 65 <= data[p] && data[p] <= 90 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr58
+		}
+		goto st0
+tr58:
+//line iptables.rl:65
+ num = 0 
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st107
+tr136:
+//line iptables.rl:66
+ num = num*10 + int((data[p])-'0') 
+	goto st107
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+//line iptables.go:1789
+		if data[p] == 32 {
+			goto tr135
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr136
+		}
+		goto st0
+tr38:
+//line iptables.rl:55
+ mark = p 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line iptables.go:1806
+		switch data[p] {
+		case 32:
+			goto st47
+		case 73:
+			goto tr40
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st48
+		}
+		goto st28
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 46:
+			goto st49
+		case 73:
+			goto tr40
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st48
+		}
+		goto st28
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 73 {
+			goto tr40
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st50
+		}
+		goto st28
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 73:
+			goto tr40
+		case 93:
+			goto st51
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st50
+		}
+		goto st28
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 32:
+			goto st52
+		case 73:
+			goto tr40
+		}
+		goto st28
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		if data[p] == 73 {
+			goto tr37
+		}
+		goto tr36
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 103 {
+			goto st4
+		}
+		goto st0
+tr2:
+//line iptables.rl:55
+ mark = p 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line iptables.go:1898
+		if data[p] == 101 {
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 99 {
+			goto st4
+		}
+		goto st0
+tr3:
+//line iptables.rl:55
+ mark = p 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line iptables.go:1921
+		if data[p] == 101 {
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 98 {
+			goto st4
+		}
+		goto st0
+tr4:
+//line iptables.rl:55
+ mark = p 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line iptables.go:1944
+		switch data[p] {
+		case 97:
+			goto st59
+		case 117:
+			goto st60
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 110 {
+			goto st4
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 108:
+			goto st4
+		case 110:
+			goto st4
+		}
+		goto st0
+tr5:
+//line iptables.rl:55
+ mark = p 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line iptables.go:1982
+		if data[p] == 97 {
+			goto st62
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 114:
+			goto st4
+		case 121:
+			goto st4
+		}
+		goto st0
+tr6:
+//line iptables.rl:55
+ mark = p 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line iptables.go:2008
+		if data[p] == 111 {
+			goto st64
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 118 {
+			goto st4
+		}
+		goto st0
+tr7:
+//line iptables.rl:55
+ mark = p 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line iptables.go:2031
+		if data[p] == 99 {
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 116 {
+			goto st4
+		}
+		goto st0
+tr8:
+//line iptables.rl:55
+ mark = p 
+	goto st67
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+//line iptables.go:2054
+		if data[p] == 101 {
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 112 {
+			goto st4
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 69:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:59
+ e.In = data[mark:p] 
+		case 70:
+//line iptables.rl:59
+ e.In = data[mark:p] 
+		case 77:
+//line iptables.rl:69
+ e.DstPort = num 
+		case 79:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:63
+ e.Dst = data[mark:p] 
+		case 80:
+//line iptables.rl:63
+ e.Dst = data[mark:p] 
+		case 84:
+//line iptables.rl:72
+
+	        if !seenLen {
+	            e.Len = num
+	            seenLen = true
+	        }
+	    
+		case 87:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:61
+ e.MAC = data[mark:p] 
+		case 88:
+//line iptables.rl:61
+ e.MAC = data[mark:p] 
+		case 91:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:60
+ e.Out = data[mark:p] 
+		case 92:
+//line iptables.rl:60
+ e.Out = data[mark:p] 
+		case 97:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:64
+ e.Proto = data[mark:p] 
+		case 98:
+//line iptables.rl:64
+ e.Proto = data[mark:p] 
+		case 101:
+//line iptables.rl:68
+ e.SrcPort = num 
+		case 103:
+//line iptables.rl:55
+ mark = p 
+//line iptables.rl:62
+ e.Src = data[mark:p] 
+		case 104:
+//line iptables.rl:62
+ e.Src = data[mark:p] 
+		case 107:
+//line iptables.rl:67
+ e.TTL = num 
+//line iptables.go:2244
+		}
+	}
+
+	_out: {}
+	}
+
+//line iptables.rl:115
+
+
+	if cs < iptables_first_final {
+		return IPTablesEntry{}, false, p
+	}
+
+	return e, true, -1
+}
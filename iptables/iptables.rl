@@ -0,0 +1,122 @@
+package main
+
+import "bytes"
+
+// IPTablesEntry holds the interesting fields of a packet logged by the
+// iptables LOG target, as it appears in syslog.  The byte slices point into
+// the parsed line; fields that weren't logged are nil or zero.
+type IPTablesEntry struct {
+	Timestamp []byte
+	Hostname  []byte
+	Prefix    []byte
+	In        []byte
+	Out       []byte
+	MAC       []byte
+	Src       []byte
+	Dst       []byte
+	Len       int
+	TTL       int
+	Proto     []byte
+	SrcPort   int
+	DstPort   int
+}
+
+// ParseIPTablesLog parses a kernel log line written by the iptables LOG
+// target, such as
+//
+//	Feb  5 13:29:14 gw kernel: [5504.112233] [UFW BLOCK] IN=eth0 OUT= MAC=... SRC=192.0.2.1 DST=198.51.100.2 LEN=60 ... PROTO=TCP SPT=443 DPT=51234 ...
+//
+// Prefix is the --log-prefix text, which is everything between the kernel
+// tag and IN= less any trailing spaces.  After IN= the KEY=VALUE pairs may
+// come in any order, and unknown keys and bare flags such as DF or SYN are
+// skipped.  Len is the IP packet length.
+func ParseIPTablesLog(data []byte) (IPTablesEntry, bool) {
+	e, ok, _ := ParseIPTablesLogAt(data)
+	return e, ok
+}
+
+// ParseIPTablesLogAt is like ParseIPTablesLog but also returns the offset
+// where the line stopped making sense, len(data) if it ended too soon, and
+// -1 if it parsed.
+func ParseIPTablesLogAt(data []byte) (IPTablesEntry, bool, int) {
+
+%% machine iptables;
+%% write data;
+
+	var e IPTablesEntry
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	num := 0
+	seenLen := false
+
+	%%{
+	    action mark      { mark = p }
+	    action timestamp { e.Timestamp = data[mark:p] }
+	    action hostname  { e.Hostname = data[mark:p] }
+	    action prefix    { e.Prefix = bytes.TrimRight(data[mark:p], " ") }
+	    action in        { e.In = data[mark:p] }
+	    action out       { e.Out = data[mark:p] }
+	    action mac       { e.MAC = data[mark:p] }
+	    action src       { e.Src = data[mark:p] }
+	    action dst       { e.Dst = data[mark:p] }
+	    action proto     { e.Proto = data[mark:p] }
+	    action num_start { num = 0 }
+	    action num       { num = num*10 + int(fc-'0') }
+	    action ttl       { e.TTL = num }
+	    action spt       { e.SrcPort = num }
+	    action dpt       { e.DstPort = num }
+
+	    # UDP packets log a second LEN= for the datagram length
+	    action len {
+	        if !seenLen {
+	            e.Len = num
+	            seenLen = true
+	        }
+	    }
+
+	    month = 'Jan' | 'Feb' | 'Mar' | 'Apr' | 'May' | 'Jun' |
+	            'Jul' | 'Aug' | 'Sep' | 'Oct' | 'Nov' | 'Dec' ;
+
+	    # Feb  5 13:29:14
+	    timestamp = month ' ' ( ' ' digit | digit{2} ) ' ' digit{2} ':' digit{2} ':' digit{2} ;
+
+	    uptime = '[' ' '* digit+ '.' digit+ '] ' ;
+
+	    prefix = ( any* - ( any* 'IN=' any* ) ) ;
+
+	    value = ( any - space )* ;
+	    number = digit+ >num_start $num ;
+	    mac = ( xdigit{2} ( ':' xdigit{2} )* )? ;
+
+	    known = 'IN' | 'OUT' | 'MAC' | 'SRC' | 'DST' | 'LEN' | 'TTL' | 'PROTO' | 'SPT' | 'DPT' ;
+
+	    field = 'OUT=' value >mark %out
+	          | 'MAC=' mac >mark %mac
+	          | 'SRC=' value >mark %src
+	          | 'DST=' value >mark %dst
+	          | 'LEN=' number %len
+	          | 'TTL=' number %ttl
+	          | 'PROTO=' value >mark %proto
+	          | 'SPT=' number %spt
+	          | 'DPT=' number %dpt
+	          | ( upper ( upper | digit | '_' )* - known ) ( '=' value )? ;
+
+	    main := timestamp >mark %timestamp
+	            ' ' ( any - space )+ >mark %hostname
+	            ' kernel: ' uptime?
+	            prefix >mark %prefix
+	            'IN=' value >mark %in
+	            ( ' ' field )* ' '* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < iptables_first_final {
+		return IPTablesEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+var data = []byte("Feb  5 13:29:14 gw kernel: [5504.112233] [UFW BLOCK] IN=eth0 OUT= MAC=00:11:22:33:44:55:66:77:88:99:aa:bb:08:00 SRC=192.0.2.1 DST=198.51.100.2 LEN=60 TOS=0x00 PREC=0x00 TTL=52 ID=0 DF PROTO=TCP SPT=443 DPT=51234 WINDOW=0 RES=0x00 RST URGP=0 ")
+
+var hits int
+
+func TestParseIPTablesLog(t *testing.T) {
+	tests := []struct {
+		line string
+		want IPTablesEntry
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: IPTablesEntry{
+				Timestamp: []byte("Feb  5 13:29:14"),
+				Hostname:  []byte("gw"),
+				Prefix:    []byte("[UFW BLOCK]"),
+				In:        []byte("eth0"),
+				Out:       []byte(""),
+				MAC:       []byte("00:11:22:33:44:55:66:77:88:99:aa:bb:08:00"),
+				Src:       []byte("192.0.2.1"),
+				Dst:       []byte("198.51.100.2"),
+				Len:       60,
+				TTL:       52,
+				Proto:     []byte("TCP"),
+				SrcPort:   443,
+				DstPort:   51234,
+			},
+			ok: true,
+		},
+		{
+			// no uptime or prefix, fields shuffled, UDP
+			line: "Dec 31 23:59:59 fw01 kernel: IN=ppp0 PROTO=UDP DPT=53 SPT=5353 DST=10.0.0.1 SRC=2001:db8::1 OUT=eth1 LEN=72 TTL=64",
+			want: IPTablesEntry{
+				Timestamp: []byte("Dec 31 23:59:59"),
+				Hostname:  []byte("fw01"),
+				Prefix:    []byte(""),
+				In:        []byte("ppp0"),
+				Out:       []byte("eth1"),
+				Src:       []byte("2001:db8::1"),
+				Dst:       []byte("10.0.0.1"),
+				Len:       72,
+				TTL:       64,
+				Proto:     []byte("UDP"),
+				SrcPort:   5353,
+				DstPort:   53,
+			},
+			ok: true,
+		},
+		{
+			// prefix without a trailing space, ICMP has no ports
+			line: "Jan  1 00:00:00 host kernel: [    1.000000] DROP:IN= OUT=eth0 SRC=192.0.2.9 DST=192.0.2.10 LEN=84 TOS=0x00 PREC=0x00 TTL=64 ID=4242 PROTO=ICMP TYPE=8 CODE=0 ID=1 SEQ=1",
+			want: IPTablesEntry{
+				Timestamp: []byte("Jan  1 00:00:00"),
+				Hostname:  []byte("host"),
+				Prefix:    []byte("DROP:"),
+				In:        []byte(""),
+				Out:       []byte("eth0"),
+				Src:       []byte("192.0.2.9"),
+				Dst:       []byte("192.0.2.10"),
+				Len:       84,
+				TTL:       64,
+				Proto:     []byte("ICMP"),
+			},
+			ok: true,
+		},
+		// no IN=
+		{line: "Feb  5 13:29:14 gw kernel: [UFW BLOCK] OUT=eth0 SRC=192.0.2.1"},
+		// not from the kernel
+		{line: "Feb  5 13:29:14 gw sshd[123]: IN=eth0 OUT="},
+		// lowercase key
+		{line: "Feb  5 13:29:14 gw kernel: IN=eth0 out=eth1"},
+		// bad MAC
+		{line: "Feb  5 13:29:14 gw kernel: IN=eth0 MAC=00-11-22"},
+		// non-numeric port
+		{line: "Feb  5 13:29:14 gw kernel: IN=eth0 SPT=http"},
+		{line: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseIPTablesLog([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseIPTablesLog(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseIPTablesLog(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseIPTablesLogAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"Feb  5 13:29:14 gw kernel: [5504.112233] [UFW BLOCK] IN=eth0 OUT= SPT=x", 70},
+		{"Feb 5 13:29:14 gw kernel: [UFW BLOCK] IN=eth0", 5},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseIPTablesLogAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseIPTablesLogAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var (
+	reIPTablesHeader = regexp.MustCompile(`^([A-Z][a-z]{2} [ \d]\d \d{2}:\d{2}:\d{2}) (\S+) kernel: (?:\[ *\d+\.\d+\] )?(.*?) *IN=`)
+	reIPTablesFields = regexp.MustCompile(`([A-Z][A-Z0-9_]*)=(\S*)`)
+)
+
+func regexIPTables(line []byte) (IPTablesEntry, bool) {
+	h := reIPTablesHeader.FindSubmatch(line)
+	if h == nil {
+		return IPTablesEntry{}, false
+	}
+	e := IPTablesEntry{Timestamp: h[1], Hostname: h[2], Prefix: h[3]}
+	for _, m := range reIPTablesFields.FindAllSubmatch(line[len(h[0])-len("IN="):], -1) {
+		switch string(m[1]) {
+		case "IN":
+			e.In = m[2]
+		case "OUT":
+			e.Out = m[2]
+		case "MAC":
+			e.MAC = m[2]
+		case "SRC":
+			e.Src = m[2]
+		case "DST":
+			e.Dst = m[2]
+		case "LEN":
+			if e.Len == 0 {
+				e.Len, _ = strconv.Atoi(string(m[2]))
+			}
+		case "TTL":
+			e.TTL, _ = strconv.Atoi(string(m[2]))
+		case "PROTO":
+			e.Proto = m[2]
+		case "SPT":
+			e.SrcPort, _ = strconv.Atoi(string(m[2]))
+		case "DPT":
+			e.DstPort, _ = strconv.Atoi(string(m[2]))
+		}
+	}
+	return e, true
+}
+
+// corpus is 1000 lines of the shapes above with varying addresses and ports.
+var corpus = func() [][]byte {
+	var lines [][]byte
+	for i := 0; i < 1000; i++ {
+		var b bytes.Buffer
+		switch i % 3 {
+		case 0:
+			fmt.Fprintf(&b, "Feb  5 13:%02d:%02d gw kernel: [%d.112233] [UFW BLOCK] IN=eth0 OUT= MAC=00:11:22:33:44:55:66:77:88:99:aa:bb:08:00 SRC=192.0.2.%d DST=198.51.100.2 LEN=60 TOS=0x00 PREC=0x00 TTL=52 ID=%d DF PROTO=TCP SPT=%d DPT=443 WINDOW=29200 RES=0x00 SYN URGP=0 ", i/60%60, i%60, 5504+i, i%256, i, 30000+i)
+		case 1:
+			fmt.Fprintf(&b, "Feb  5 13:%02d:%02d gw kernel: [%d.000001] IPTABLES-DROP: IN=ppp0 OUT=eth1 SRC=203.0.113.%d DST=10.0.0.1 LEN=72 TOS=0x00 PREC=0x00 TTL=64 ID=%d PROTO=UDP SPT=53 DPT=%d LEN=52 ", i/60%60, i%60, 5504+i, i%256, i, 40000+i)
+		default:
+			fmt.Fprintf(&b, "Feb  5 13:%02d:%02d gw kernel: [%d.5] IN= OUT=eth0 SRC=192.0.2.9 DST=192.0.2.%d LEN=84 TOS=0x00 PREC=0x00 TTL=64 ID=%d PROTO=ICMP TYPE=8 CODE=0 ID=1 SEQ=%d ", i/60%60, i%60, 5504+i, i%256, i, i)
+		}
+		lines = append(lines, b.Bytes())
+	}
+	return lines
+}()
+
+func TestIPTablesAlternatives(t *testing.T) {
+	for _, line := range [][]byte{data, corpus[0], corpus[1], corpus[2]} {
+		want, _ := ParseIPTablesLog(line)
+		if got, ok := regexIPTables(line); !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("regexIPTables(%q)=%+v, want %+v", line, got, want)
+		}
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, line := range corpus {
+			if _, ok := regexIPTables(line); ok {
+				hits++
+			}
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, line := range corpus {
+			if _, ok := ParseIPTablesLog(line); ok {
+				hits++
+			}
+		}
+	}
+}
@@ -0,0 +1,190 @@
+
+//line ndjson.rl:1
+package main
+
+// ScanNDJSONRecord finds the first record in a stream of newline-delimited
+// JSON, returning it and the data following its newline.  A newline only
+// ends a record outside of any object, array, or string, so a record may
+// be pretty-printed across several lines.  Blank lines are skipped, and
+// whitespace around the record is removed.  The last record in data needn't
+// end in a newline.
+//
+// ok is false if data holds no complete record, either because more data
+// is needed or because it has a closing bracket without an opening one.
+// Nothing else about the JSON is checked.
+func ScanNDJSONRecord(data []byte) (record, rest []byte, ok bool) {
+	record, rest, ok, _ = ScanNDJSONRecordAt(data)
+	return record, rest, ok
+}
+
+// ScanNDJSONRecordAt is like ScanNDJSONRecord but also returns why there
+// was no record: the offset of an unmatched closing bracket, or len(data)
+// if the record isn't finished yet.  It is -1 when a record is found.
+func ScanNDJSONRecordAt(data []byte) (record, rest []byte, ok bool, errPos int) {
+
+
+//line ndjson.rl:24
+
+//line ndjson.go:29
+const ndjson_start int = 2
+const ndjson_first_final int = 2
+const ndjson_error int = -1
+
+const ndjson_en_main int = 2
+
+
+//line ndjson.rl:25
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	depth := 0
+	start, end, nl := -1, 0, -1
+
+	
+//line ndjson.go:47
+	{
+	cs = ndjson_start
+	}
+
+//line ndjson.go:52
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 2:
+		goto st_case_2
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	}
+	goto st_out
+tr1:
+//line ndjson.rl:34
+
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    
+	goto st2
+tr4:
+//line ndjson.rl:50
+
+	        if depth == 0 && start >= 0 {
+	            nl = p
+	            p++; cs = 2; goto _out
+
+	        }
+	    
+	goto st2
+tr6:
+//line ndjson.rl:34
+
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    
+//line ndjson.rl:41
+ depth++ 
+	goto st2
+tr7:
+//line ndjson.rl:34
+
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    
+//line ndjson.rl:43
+
+	        depth--
+	        if depth < 0 {
+	            p++; cs = 2; goto _out
+
+	        }
+	    
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line ndjson.go:118
+		switch data[p] {
+		case 9:
+			goto st2
+		case 10:
+			goto tr4
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto tr5
+		case 91:
+			goto tr6
+		case 93:
+			goto tr7
+		case 123:
+			goto tr6
+		case 125:
+			goto tr7
+		}
+		goto tr1
+tr5:
+//line ndjson.rl:34
+
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    
+	goto st0
+	st0:
+		if p++; p == pe {
+			goto _test_eof0
+		}
+	st_case_0:
+//line ndjson.go:154
+		switch data[p] {
+		case 34:
+			goto tr1
+		case 92:
+			goto st1
+		}
+		goto st0
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof0: cs = 0; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line ndjson.rl:68
+
+
+	switch {
+	case depth < 0:
+		// fbreak has already stepped past the bracket
+		return nil, data, false, p - 1
+	case nl >= 0:
+		return data[start:end], data[nl+1:], true, -1
+	case cs >= ndjson_first_final && depth == 0 && start >= 0:
+		return data[start:end], data[pe:], true, -1
+	}
+
+	return nil, data, false, len(data)
+}
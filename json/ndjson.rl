@@ -0,0 +1,81 @@
+package main
+
+// ScanNDJSONRecord finds the first record in a stream of newline-delimited
+// JSON, returning it and the data following its newline.  A newline only
+// ends a record outside of any object, array, or string, so a record may
+// be pretty-printed across several lines.  Blank lines are skipped, and
+// whitespace around the record is removed.  The last record in data needn't
+// end in a newline.
+//
+// ok is false if data holds no complete record, either because more data
+// is needed or because it has a closing bracket without an opening one.
+// Nothing else about the JSON is checked.
+func ScanNDJSONRecord(data []byte) (record, rest []byte, ok bool) {
+	record, rest, ok, _ = ScanNDJSONRecordAt(data)
+	return record, rest, ok
+}
+
+// ScanNDJSONRecordAt is like ScanNDJSONRecord but also returns why there
+// was no record: the offset of an unmatched closing bracket, or len(data)
+// if the record isn't finished yet.  It is -1 when a record is found.
+func ScanNDJSONRecordAt(data []byte) (record, rest []byte, ok bool, errPos int) {
+
+%% machine ndjson;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	depth := 0
+	start, end, nl := -1, 0, -1
+
+	%%{
+	    action char {
+	        if start < 0 {
+	            start = p
+	        }
+	        end = p + 1
+	    }
+
+	    action open { depth++ }
+
+	    action close {
+	        depth--
+	        if depth < 0 {
+	            fbreak;
+	        }
+	    }
+
+	    action newline {
+	        if depth == 0 && start >= 0 {
+	            nl = p
+	            fbreak;
+	        }
+	    }
+
+	    ws = [ \t\r] ;
+
+	    str = '"' @char ( [^"\\] | '\\' any )* '"' @char ;
+	    open = [{[] @char @open ;
+	    close = [}\]] @char @close ;
+	    other = ( any - ( ws | ["{}[\]\n] ) ) @char ;
+
+	    main := ( ws | str | open | close | other | '\n' @newline )* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	switch {
+	case depth < 0:
+		// fbreak has already stepped past the bracket
+		return nil, data, false, p - 1
+	case nl >= 0:
+		return data[start:end], data[nl+1:], true, -1
+	case cs >= ndjson_first_final && depth == 0 && start >= 0:
+		return data[start:end], data[pe:], true, -1
+	}
+
+	return nil, data, false, len(data)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var ndjson = []byte(`{"id":1,"user":{"name":"alice","tags":["admin","ops"]},"msg":"logged in"}
+{"id":2,"user":{"name":"bob","tags":[]},"msg":"said \"hi\" {not a brace}"}
+{"id":3,"user":null,"msg":"line one\nline two"}
+[1,2,[3,[4]]]
+"just a string"
+42
+`)
+
+func TestScanNDJSONRecord(t *testing.T) {
+	tests := []struct {
+		in      string
+		records []string
+		rest    string
+	}{
+		{in: "{}\n[]\n", records: []string{"{}", "[]"}},
+		{in: "{}", records: []string{"{}"}},
+		{in: `{"a":1}` + "\r\n" + `{"b":2}` + "\r\n", records: []string{`{"a":1}`, `{"b":2}`}},
+		{in: "\n\n  {}  \n\n \t\n[]", records: []string{"{}", "[]"}},
+		// pretty-printed records span lines
+		{in: "{\n  \"a\": [\n    1,\n    2\n  ]\n}\n{\"b\":2}\n", records: []string{"{\n  \"a\": [\n    1,\n    2\n  ]\n}", `{"b":2}`}},
+		// brackets and newlines inside strings don't count
+		{in: "{\"a\":\"}\\\"]\"}\n", records: []string{`{"a":"}\"]"}`}},
+		{in: "{\"a\":\"x\ny\"}\n", records: []string{"{\"a\":\"x\ny\"}"}},
+		{in: "\"{\"\n\"\\\\\"\n", records: []string{`"{"`, `"\\"`}},
+		{in: "true\nnull\n-1.5e3\n", records: []string{"true", "null", "-1.5e3"}},
+		// a backslash outside a string is just another byte
+		{in: "a\\b\n{}\n", records: []string{`a\b`, "{}"}},
+
+		// incomplete, so the rest is kept to be scanned once there's more
+		{in: "{}\n{\"a\":", records: []string{"{}"}, rest: "{\"a\":"},
+		{in: "{}\n{\"a\":\"b", records: []string{"{}"}, rest: "{\"a\":\"b"},
+		{in: "{}\n[[]\n", records: []string{"{}"}, rest: "[[]\n"},
+		{in: "{}\n\"a\\", records: []string{"{}"}, rest: "\"a\\"},
+		{in: "\n \n", rest: "\n \n"},
+		{in: ""},
+		// unbalanced
+		{in: "}\n", rest: "}\n"},
+		{in: "{}}\n", rest: "{}}\n"},
+	}
+
+	for _, tt := range tests {
+		var records []string
+		rest := []byte(tt.in)
+		for {
+			record, r, ok := ScanNDJSONRecord(rest)
+			if !ok {
+				if !bytes.Equal(r, rest) {
+					t.Errorf("ScanNDJSONRecord(%q) rest=%q when not ok", rest, r)
+				}
+				break
+			}
+			records = append(records, string(record))
+			rest = r
+		}
+		if strings.Join(records, "|") != strings.Join(tt.records, "|") || string(rest) != tt.rest {
+			t.Errorf("ScanNDJSONRecord(%q) records=%q rest=%q, want %q rest=%q", tt.in, records, rest, tt.records, tt.rest)
+		}
+	}
+}
+
+func TestScanNDJSONRecordAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{"{\"a\":[1]}\n", -1},
+		{"{\"a\":1}}\n", 7},
+		{"{\"a\":1", 6},
+		{"a\\b\n{}\n", -1},
+	}
+
+	for _, tt := range tests {
+		_, _, ok, errPos := ScanNDJSONRecordAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanNDJSONRecordAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// indexByteNDJSON splits on every newline.  That works for the common case
+// of one compact record per line, but breaks pretty-printed records apart.
+func indexByteNDJSON(data []byte) int {
+	var n int
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			i = len(data)
+		}
+		if len(bytes.TrimSpace(data[:i])) > 0 {
+			n++
+		}
+		data = data[min(i+1, len(data)):]
+	}
+	return n
+}
+
+func ragelNDJSON(data []byte) int {
+	var n int
+	for {
+		_, rest, ok := ScanNDJSONRecord(data)
+		if !ok {
+			return n
+		}
+		n++
+		data = rest
+	}
+}
+
+func TestNDJSONAlternatives(t *testing.T) {
+	if got, want := indexByteNDJSON(ndjson), ragelNDJSON(ndjson); got != want || want != 6 {
+		t.Errorf("indexByteNDJSON=%d, ragelNDJSON=%d, want 6", got, want)
+	}
+
+	rest := ndjson
+	for {
+		record, r, ok := ScanNDJSONRecord(rest)
+		if !ok {
+			break
+		}
+		if !json.Valid(record) {
+			t.Errorf("ScanNDJSONRecord returned invalid JSON %q", record)
+		}
+		rest = r
+	}
+
+	var buf bytes.Buffer
+	dec := json.NewDecoder(bytes.NewReader(ndjson))
+	for dec.More() {
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		b, _ := json.MarshalIndent(v, "", "  ")
+		buf.Write(b)
+		buf.WriteByte('\n')
+	}
+	if got := ragelNDJSON(buf.Bytes()); got != 6 {
+		t.Errorf("ragelNDJSON(indented)=%d, want 6", got)
+	}
+	if got := indexByteNDJSON(buf.Bytes()); got == 6 {
+		t.Errorf("indexByteNDJSON(indented) unexpectedly found 6 records")
+	}
+}
+
+func BenchmarkNDJSONIndexByte(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		hits += indexByteNDJSON(ndjson)
+	}
+}
+
+func BenchmarkNDJSONRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		hits += ragelNDJSON(ndjson)
+	}
+}
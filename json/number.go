@@ -0,0 +1,266 @@
+
+//line number.rl:1
+package main
+
+// ScanJSONNumber splits an RFC 8259 number into its parts.  intPart holds
+// the integer part including any leading '-', fracPart the digits after the
+// '.', and expPart the exponent after the 'e' or 'E' including any sign.
+// fracPart and expPart are nil if absent, so a number with both nil is an
+// integer.  The slices point into data.
+func ScanJSONNumber(data []byte) (intPart, fracPart, expPart []byte, ok bool) {
+	intPart, fracPart, expPart, ok, _ = ScanJSONNumberAt(data)
+	return intPart, fracPart, expPart, ok
+}
+
+// ScanJSONNumberAt is like ScanJSONNumber but also returns the offset of the
+// first byte that can't continue the number, or -1 if data is a number.
+func ScanJSONNumberAt(data []byte) (intPart, fracPart, expPart []byte, ok bool, errPos int) {
+
+
+//line number.rl:18
+
+//line number.go:23
+const number_start int = 1
+const number_first_final int = 6
+const number_error int = 0
+
+const number_en_main int = 1
+
+
+//line number.rl:19
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	
+//line number.go:38
+	{
+	cs = number_start
+	}
+
+//line number.go:43
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 6:
+		goto st_case_6
+	case 3:
+		goto st_case_3
+	case 7:
+		goto st_case_7
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 45:
+			goto tr1
+		case 48:
+			goto tr2
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr3
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line number.rl:25
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line number.go:95
+		if data[p] == 48 {
+			goto st6
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st9
+		}
+		goto st0
+tr2:
+//line number.rl:25
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line number.go:112
+		switch data[p] {
+		case 46:
+			goto tr10
+		case 69:
+			goto tr11
+		case 101:
+			goto tr11
+		}
+		goto st0
+tr10:
+//line number.rl:26
+ intPart = data[mark:p] 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line number.go:131
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr6
+		}
+		goto st0
+tr6:
+//line number.rl:25
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line number.go:145
+		switch data[p] {
+		case 69:
+			goto tr13
+		case 101:
+			goto tr13
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st7
+		}
+		goto st0
+tr11:
+//line number.rl:26
+ intPart = data[mark:p] 
+	goto st4
+tr13:
+//line number.rl:27
+ fracPart = data[mark:p] 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line number.go:169
+		switch data[p] {
+		case 43:
+			goto tr7
+		case 45:
+			goto tr7
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+tr7:
+//line number.rl:25
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line number.go:189
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto st0
+tr8:
+//line number.rl:25
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line number.go:203
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto st0
+tr3:
+//line number.rl:25
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line number.go:217
+		switch data[p] {
+		case 46:
+			goto tr10
+		case 69:
+			goto tr11
+		case 101:
+			goto tr11
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st9
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 6, 9:
+//line number.rl:26
+ intPart = data[mark:p] 
+		case 7:
+//line number.rl:27
+ fracPart = data[mark:p] 
+		case 8:
+//line number.rl:28
+ expPart = data[mark:p] 
+//line number.go:252
+		}
+	}
+
+	_out: {}
+	}
+
+//line number.rl:38
+
+
+	if cs < number_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	return intPart, fracPart, expPart, true, -1
+}
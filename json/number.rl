@@ -0,0 +1,45 @@
+package main
+
+// ScanJSONNumber splits an RFC 8259 number into its parts.  intPart holds
+// the integer part including any leading '-', fracPart the digits after the
+// '.', and expPart the exponent after the 'e' or 'E' including any sign.
+// fracPart and expPart are nil if absent, so a number with both nil is an
+// integer.  The slices point into data.
+func ScanJSONNumber(data []byte) (intPart, fracPart, expPart []byte, ok bool) {
+	intPart, fracPart, expPart, ok, _ = ScanJSONNumberAt(data)
+	return intPart, fracPart, expPart, ok
+}
+
+// ScanJSONNumberAt is like ScanJSONNumber but also returns the offset of the
+// first byte that can't continue the number, or -1 if data is a number.
+func ScanJSONNumberAt(data []byte) (intPart, fracPart, expPart []byte, ok bool, errPos int) {
+
+%% machine number;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+
+	%%{
+	    action mark { mark = p }
+	    action int  { intPart = data[mark:p] }
+	    action frac { fracPart = data[mark:p] }
+	    action exp  { expPart = data[mark:p] }
+
+	    int = '-'? ( '0' | '1'..'9' digit* ) ;
+	    frac = digit+ ;
+	    exp = [+\-]? digit+ ;
+
+	    main := int >mark %int ( '.' frac >mark %frac )? ( [eE] exp >mark %exp )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < number_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	return intPart, fracPart, expPart, true, -1
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+)
+
+var number = []byte("-12345.6789e+10")
+
+var hits int
+
+func TestScanJSONNumber(t *testing.T) {
+	tests := []struct {
+		in              string
+		intp, frac, exp string
+		hasFrac, hasExp bool
+		ok              bool
+	}{
+		{in: "0", intp: "0", ok: true},
+		{in: "-0", intp: "-0", ok: true},
+		{in: "42", intp: "42", ok: true},
+		{in: "-12345.6789e+10", intp: "-12345", frac: "6789", exp: "+10", hasFrac: true, hasExp: true, ok: true},
+		{in: "0.5", intp: "0", frac: "5", hasFrac: true, ok: true},
+		{in: "1E3", intp: "1", exp: "3", hasExp: true, ok: true},
+		{in: "1e-07", intp: "1", exp: "-07", hasExp: true, ok: true},
+		{in: "6.02214076e23", intp: "6", frac: "02214076", exp: "23", hasFrac: true, hasExp: true, ok: true},
+		{in: "100.000", intp: "100", frac: "000", hasFrac: true, ok: true},
+
+		{in: "01"},
+		{in: "-01.5"},
+		{in: "1."},
+		{in: ".5"},
+		{in: "1.e5"},
+		{in: "1e"},
+		{in: "1e+"},
+		{in: "+1"},
+		{in: "-"},
+		{in: "1.5.5"},
+		{in: "0x10"},
+		{in: "Infinity"},
+		{in: "NaN"},
+		{in: " 1"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		intp, frac, exp, ok := ScanJSONNumber([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ScanJSONNumber(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if string(intp) != tt.intp || string(frac) != tt.frac || string(exp) != tt.exp || (frac != nil) != tt.hasFrac || (exp != nil) != tt.hasExp {
+			t.Errorf("ScanJSONNumber(%q)=(%q,%q,%q), want (%q,%q,%q)", tt.in, intp, frac, exp, tt.intp, tt.frac, tt.exp)
+		}
+	}
+}
+
+func TestScanJSONNumberAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(number), -1},
+		{"01", 1},
+		{"1.", 2},
+		{"-", 1},
+		{"1e+x", 3},
+	}
+
+	for _, tt := range tests {
+		_, _, _, ok, errPos := ScanJSONNumberAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanJSONNumberAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestJSONNumberAlternatives(t *testing.T) {
+	for _, s := range []string{"0", "-0", "42", string(number), "1e-07", "01", "1.", ".5", "+1"} {
+		_, _, _, want := ScanJSONNumber([]byte(s))
+		var n json.Number
+		if got := json.Unmarshal([]byte(s), &n) == nil; got != want {
+			t.Errorf("json.Unmarshal(%q, &json.Number) ok=%v, want %v", s, got, want)
+		}
+	}
+}
+
+// strconv.ParseFloat accepts a superset of JSON numbers, but does the
+// conversion as well
+func BenchmarkNumberParseFloat(b *testing.B) {
+	s := string(number)
+	for i := 0; i < b.N; i++ {
+		if _, err := strconv.ParseFloat(s, 64); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkNumberUnmarshal(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var n json.Number
+		if err := json.Unmarshal(number, &n); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkNumberRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := ScanJSONNumber(number); ok {
+			hits++
+		}
+	}
+}
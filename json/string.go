@@ -0,0 +1,424 @@
+
+//line string.rl:1
+package main
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+func unhex4(b []byte) rune {
+	var r rune
+	for _, c := range b[:4] {
+		switch {
+		case c <= '9':
+			c -= '0'
+		case c <= 'F':
+			c -= 'A' - 10
+		default:
+			c -= 'a' - 10
+		}
+		r = r<<4 | rune(c)
+	}
+	return r
+}
+
+// unescapeJSON decodes the escapes in the body of a string already
+// checked by ScanJSONString.
+func unescapeJSON(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			out = append(out, v[i])
+			continue
+		}
+		i++
+		switch v[i] {
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'u':
+			r := unhex4(v[i+1:])
+			i += 4
+			if utf16.IsSurrogate(r) {
+				r = utf16.DecodeRune(r, unhex4(v[i+3:]))
+				i += 6
+			}
+			out = utf8.AppendRune(out, r)
+		default:
+			out = append(out, v[i])
+		}
+	}
+	return out
+}
+
+// ScanJSONString scans the RFC 8259 string at the start of data, returning
+// its decoded content and the number of bytes up to and including the
+// closing quote.  content points into data unless the string contains
+// escapes.  A \u escape of a high surrogate must be followed by one of a
+// low surrogate, and a low surrogate may not appear alone.  The bytes of
+// the string are otherwise passed through unchanged, without checking
+// that they are valid UTF-8.
+func ScanJSONString(data []byte) (content []byte, n int, ok bool) {
+	content, n, ok, _ = ScanJSONStringAt(data)
+	return content, n, ok
+}
+
+// ScanJSONStringAt is like ScanJSONString but also returns the offset of the
+// first byte not allowed in the string, such as a control character or a
+// bad escape, len(data) if the string is unterminated, or -1.
+func ScanJSONStringAt(data []byte) (content []byte, n int, ok bool, errPos int) {
+
+
+//line string.rl:78
+
+//line string.go:83
+const json_string_start int = 1
+const json_string_first_final int = 15
+const json_string_error int = 0
+
+const json_string_en_main int = 1
+
+
+//line string.rl:79
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	escaped := false
+
+	
+//line string.go:100
+	{
+	cs = json_string_start
+	}
+
+//line string.go:105
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 15:
+		goto st_case_15
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 34 {
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 34:
+			goto tr2
+		case 92:
+			goto tr3
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto st2
+tr2:
+//line string.rl:88
+ p++; cs = 15; goto _out
+ 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line string.go:179
+		goto st0
+tr3:
+//line string.rl:87
+ escaped = true 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line string.go:190
+		switch data[p] {
+		case 34:
+			goto st2
+		case 47:
+			goto st2
+		case 92:
+			goto st2
+		case 98:
+			goto st2
+		case 102:
+			goto st2
+		case 110:
+			goto st2
+		case 114:
+			goto st2
+		case 116:
+			goto st2
+		case 117:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 68:
+			goto st8
+		case 100:
+			goto st8
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st5
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st5
+			}
+		default:
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st7
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st7
+			}
+		default:
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st2
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch {
+		case data[p] < 56:
+			if 48 <= data[p] {
+				goto st6
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 66:
+				if 97 <= data[p] && data[p] <= 98 {
+					goto st9
+				}
+			case data[p] >= 65:
+				goto st9
+			}
+		default:
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st11
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 92 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 117 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch data[p] {
+		case 68:
+			goto st14
+		case 100:
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch {
+		case data[p] > 70:
+			if 99 <= data[p] && data[p] <= 102 {
+				goto st6
+			}
+		case data[p] >= 67:
+			goto st6
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line string.rl:104
+
+
+	if cs < json_string_first_final {
+		return nil, 0, false, p
+	}
+
+	if escaped {
+		return unescapeJSON(data[1 : p-1]), p, true, -1
+	}
+
+	return data[1 : p-1], p, true, -1
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+func unhex4(b []byte) rune {
+	var r rune
+	for _, c := range b[:4] {
+		switch {
+		case c <= '9':
+			c -= '0'
+		case c <= 'F':
+			c -= 'A' - 10
+		default:
+			c -= 'a' - 10
+		}
+		r = r<<4 | rune(c)
+	}
+	return r
+}
+
+// unescapeJSON decodes the escapes in the body of a string already
+// checked by ScanJSONString.
+func unescapeJSON(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			out = append(out, v[i])
+			continue
+		}
+		i++
+		switch v[i] {
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'u':
+			r := unhex4(v[i+1:])
+			i += 4
+			if utf16.IsSurrogate(r) {
+				r = utf16.DecodeRune(r, unhex4(v[i+3:]))
+				i += 6
+			}
+			out = utf8.AppendRune(out, r)
+		default:
+			out = append(out, v[i])
+		}
+	}
+	return out
+}
+
+// ScanJSONString scans the RFC 8259 string at the start of data, returning
+// its decoded content and the number of bytes up to and including the
+// closing quote.  content points into data unless the string contains
+// escapes.  A \u escape of a high surrogate must be followed by one of a
+// low surrogate, and a low surrogate may not appear alone.  The bytes of
+// the string are otherwise passed through unchanged, without checking
+// that they are valid UTF-8.
+func ScanJSONString(data []byte) (content []byte, n int, ok bool) {
+	content, n, ok, _ = ScanJSONStringAt(data)
+	return content, n, ok
+}
+
+// ScanJSONStringAt is like ScanJSONString but also returns the offset of the
+// first byte not allowed in the string, such as a control character or a
+// bad escape, len(data) if the string is unterminated, or -1.
+func ScanJSONStringAt(data []byte) (content []byte, n int, ok bool, errPos int) {
+
+%% machine json_string;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	escaped := false
+
+	%%{
+	    action escaped { escaped = true }
+	    action done    { fbreak; }
+
+	    high = [dD] [89abAB] xdigit{2} ;
+	    low = [dD] [c-fC-F] xdigit{2} ;
+	    bmp = xdigit{4} - ( high | low ) ;
+
+	    unicode = 'u' ( bmp | high '\\u' low ) ;
+
+	    escape = '\\' @escaped ( ["\\/bfnrt] | unicode ) ;
+
+	    char = ( any - ( '"' | '\\' | 0x00..0x1f ) ) | escape ;
+
+	    main := '"' char* '"' @done ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < json_string_first_final {
+		return nil, 0, false, p
+	}
+
+	if escaped {
+		return unescapeJSON(data[1 : p-1]), p, true, -1
+	}
+
+	return data[1 : p-1], p, true, -1
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var jsonString = []byte(`"Café \"Le Relais\" 🍷\n1 rue de la Paix\/Paris"`)
+
+func TestScanJSONString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		n    int
+		ok   bool
+	}{
+		{in: `""`, want: "", n: 2, ok: true},
+		{in: `"hello"`, want: "hello", n: 7, ok: true},
+		{in: `"hello", "world"`, want: "hello", n: 7, ok: true},
+		{in: `"\"\\\/\b\f\n\r\t"`, want: "\"\\/\b\f\n\r\t", n: 18, ok: true},
+		{in: `"\u0041\u00e9\u20ac"`, want: "Aé€", n: 20, ok: true},
+		{in: `"\u0000"`, want: "\x00", n: 8, ok: true},
+		{in: `"\ud834\udd1e"`, want: "𝄞", n: 14, ok: true},
+		{in: `"\ud83c\udf77!"`, want: "🍷!", n: 15, ok: true},
+		{in: `"日本語"`, want: "日本語", n: 11, ok: true},
+		{in: "\"\x7f\"", want: "\x7f", n: 3, ok: true},
+		{in: string(jsonString), want: "Café \"Le Relais\" 🍷\n1 rue de la Paix/Paris", n: len(jsonString), ok: true},
+
+		{in: ``},
+		{in: `"`},
+		{in: `"abc`},
+		{in: `"abc\"`},
+		{in: `abc"`},
+		{in: ` "abc"`},
+		{in: `'abc'`},
+		{in: `"\a"`},
+		{in: `"\x41"`},
+		{in: `"\U0041"`},
+		{in: `"\u004"`},
+		{in: `"\u004g"`},
+		{in: "\"a\nb\""},
+		{in: "\"\x00\""},
+		// lone and backwards surrogates
+		{in: `"\ud83c"`},
+		{in: `"\ud83c!"`},
+		{in: `"\udf77"`},
+		{in: `"\udf77\ud83c"`},
+		{in: `"\ud83cA"`},
+		{in: `"\ud83c\ud83c"`},
+	}
+
+	for _, tt := range tests {
+		got, n, ok := ScanJSONString([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ScanJSONString(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && (string(got) != tt.want || n != tt.n) {
+			t.Errorf("ScanJSONString(%q)=(%q,%d), want (%q,%d)", tt.in, got, n, tt.want, tt.n)
+		}
+	}
+}
+
+func TestScanJSONStringAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(jsonString), -1},
+		{`"\x41"`, 2},
+		{`"\u12G4"`, 5},
+		{`"abc`, 4},
+	}
+
+	for _, tt := range tests {
+		_, _, ok, errPos := ScanJSONStringAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanJSONStringAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestScanJSONStringNoCopy(t *testing.T) {
+	in := []byte(`"no escapes here"`)
+	got, _, _ := ScanJSONString(in)
+	if &got[0] != &in[1] {
+		t.Errorf("ScanJSONString copied a string without escapes")
+	}
+}
+
+func TestJSONStringAlternatives(t *testing.T) {
+	// encoding/json accepts lone surrogates, replacing them with U+FFFD
+	for _, s := range []string{string(jsonString), `""`, `"€"`, `"\x41"`, "\"a\tb\"", `"abc`} {
+		want, _, wantOK := ScanJSONString([]byte(s))
+		var got string
+		if err := json.Unmarshal([]byte(s), &got); (err == nil) != wantOK || got != string(want) {
+			t.Errorf("json.Unmarshal(%q)=%q, %v, want %q", s, got, err, want)
+		}
+	}
+}
+
+func BenchmarkStringUnmarshal(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var s string
+		if err := json.Unmarshal(jsonString, &s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkStringRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := ScanJSONString(jsonString); ok {
+			hits++
+		}
+	}
+}
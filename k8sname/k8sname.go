@@ -0,0 +1,2631 @@
+
+//line k8sname.rl:1
+package main
+
+// NameKind selects the rules ValidateK8sName applies.
+type NameKind int
+
+const (
+	// DNSSubdomain is an RFC 1123 subdomain, used for most resources.
+	DNSSubdomain NameKind = iota
+
+	// DNSLabel is a single RFC 1123 label, used for namespaces and
+	// anything else that ends up as part of a hostname.
+	DNSLabel
+)
+
+// ValidateK8sName reports whether data is a valid Kubernetes object name of
+// the given kind.  Names are lowercase letters, digits, and hyphens, and
+// start and end with a letter or digit.  A label is at most 63 characters.
+// A subdomain is at most 253 characters and may be made of several such
+// components separated by dots; as in apimachinery, the components are not
+// held to the 63 character limit.
+func ValidateK8sName(data []byte, kind NameKind) bool {
+	ok, _ := ValidateK8sNameAt(data, kind)
+	return ok
+}
+
+// ValidateK8sNameAt is like ValidateK8sName but also returns the offset of
+// the first byte that makes the name invalid, or -1 if it is valid.  A
+// subdomain that is too long fails at the first byte past the limit.
+func ValidateK8sNameAt(data []byte, kind NameKind) (ok bool, errPos int) {
+
+
+//line k8sname.rl:32
+
+//line k8sname.go:37
+const k8sname_start int = 1
+const k8sname_first_final int = 65
+const k8sname_error int = 0
+
+const k8sname_en_main int = 1
+const k8sname_en_label int = 3
+
+
+//line k8sname.rl:33
+
+	if kind == DNSSubdomain && len(data) > 253 {
+		return false, 253
+	}
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	
+//line k8sname.go:57
+	{
+	cs = k8sname_start
+	}
+
+//line k8sname.rl:52
+
+
+	if kind == DNSLabel {
+		cs = k8sname_en_label
+	}
+
+	
+//line k8sname.go:70
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 65:
+		goto st_case_65
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 66:
+		goto st_case_66
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st65
+			}
+		case data[p] >= 48:
+			goto st65
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 46:
+			goto st1
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st65
+			}
+		case data[p] >= 48:
+			goto st65
+		}
+		goto st0
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 45 {
+			goto st2
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st65
+			}
+		case data[p] >= 48:
+			goto st65
+		}
+		goto st0
+	st_case_3:
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st66
+			}
+		case data[p] >= 48:
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 45 {
+			goto st4
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st128
+			}
+		case data[p] >= 48:
+			goto st128
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 45 {
+			goto st5
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st127
+			}
+		case data[p] >= 48:
+			goto st127
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 45 {
+			goto st6
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st126
+			}
+		case data[p] >= 48:
+			goto st126
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 45 {
+			goto st7
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st125
+			}
+		case data[p] >= 48:
+			goto st125
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 45 {
+			goto st8
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st124
+			}
+		case data[p] >= 48:
+			goto st124
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if data[p] == 45 {
+			goto st9
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st123
+			}
+		case data[p] >= 48:
+			goto st123
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if data[p] == 45 {
+			goto st10
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st122
+			}
+		case data[p] >= 48:
+			goto st122
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 45 {
+			goto st11
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st121
+			}
+		case data[p] >= 48:
+			goto st121
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 45 {
+			goto st12
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st120
+			}
+		case data[p] >= 48:
+			goto st120
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 45 {
+			goto st13
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st119
+			}
+		case data[p] >= 48:
+			goto st119
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 45 {
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st118
+			}
+		case data[p] >= 48:
+			goto st118
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 45 {
+			goto st15
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st117
+			}
+		case data[p] >= 48:
+			goto st117
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if data[p] == 45 {
+			goto st16
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st116
+			}
+		case data[p] >= 48:
+			goto st116
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 45 {
+			goto st17
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st115
+			}
+		case data[p] >= 48:
+			goto st115
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 45 {
+			goto st18
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st114
+			}
+		case data[p] >= 48:
+			goto st114
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 45 {
+			goto st19
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st113
+			}
+		case data[p] >= 48:
+			goto st113
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 45 {
+			goto st20
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st112
+			}
+		case data[p] >= 48:
+			goto st112
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 45 {
+			goto st21
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st111
+			}
+		case data[p] >= 48:
+			goto st111
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 45 {
+			goto st22
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st110
+			}
+		case data[p] >= 48:
+			goto st110
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 45 {
+			goto st23
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st109
+			}
+		case data[p] >= 48:
+			goto st109
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 45 {
+			goto st24
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st108
+			}
+		case data[p] >= 48:
+			goto st108
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 45 {
+			goto st25
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st107
+			}
+		case data[p] >= 48:
+			goto st107
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 45 {
+			goto st26
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st106
+			}
+		case data[p] >= 48:
+			goto st106
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 45 {
+			goto st27
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st105
+			}
+		case data[p] >= 48:
+			goto st105
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 45 {
+			goto st28
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st104
+			}
+		case data[p] >= 48:
+			goto st104
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 45 {
+			goto st29
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st103
+			}
+		case data[p] >= 48:
+			goto st103
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 45 {
+			goto st30
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st102
+			}
+		case data[p] >= 48:
+			goto st102
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 45 {
+			goto st31
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st101
+			}
+		case data[p] >= 48:
+			goto st101
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if data[p] == 45 {
+			goto st32
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st100
+			}
+		case data[p] >= 48:
+			goto st100
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 45 {
+			goto st33
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st99
+			}
+		case data[p] >= 48:
+			goto st99
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 45 {
+			goto st34
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st98
+			}
+		case data[p] >= 48:
+			goto st98
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 45 {
+			goto st35
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st97
+			}
+		case data[p] >= 48:
+			goto st97
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 45 {
+			goto st36
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st96
+			}
+		case data[p] >= 48:
+			goto st96
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 45 {
+			goto st37
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st95
+			}
+		case data[p] >= 48:
+			goto st95
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 45 {
+			goto st38
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st94
+			}
+		case data[p] >= 48:
+			goto st94
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 45 {
+			goto st39
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st93
+			}
+		case data[p] >= 48:
+			goto st93
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 45 {
+			goto st40
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st92
+			}
+		case data[p] >= 48:
+			goto st92
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 45 {
+			goto st41
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st91
+			}
+		case data[p] >= 48:
+			goto st91
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 45 {
+			goto st42
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st90
+			}
+		case data[p] >= 48:
+			goto st90
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		if data[p] == 45 {
+			goto st43
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st89
+			}
+		case data[p] >= 48:
+			goto st89
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if data[p] == 45 {
+			goto st44
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st88
+			}
+		case data[p] >= 48:
+			goto st88
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 45 {
+			goto st45
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st87
+			}
+		case data[p] >= 48:
+			goto st87
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 45 {
+			goto st46
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st86
+			}
+		case data[p] >= 48:
+			goto st86
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 45 {
+			goto st47
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		case data[p] >= 48:
+			goto st85
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		if data[p] == 45 {
+			goto st48
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st84
+			}
+		case data[p] >= 48:
+			goto st84
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 45 {
+			goto st49
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st83
+			}
+		case data[p] >= 48:
+			goto st83
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 45 {
+			goto st50
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st82
+			}
+		case data[p] >= 48:
+			goto st82
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 45 {
+			goto st51
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st81
+			}
+		case data[p] >= 48:
+			goto st81
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 45 {
+			goto st52
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st80
+			}
+		case data[p] >= 48:
+			goto st80
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		if data[p] == 45 {
+			goto st53
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st79
+			}
+		case data[p] >= 48:
+			goto st79
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 45 {
+			goto st54
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st78
+			}
+		case data[p] >= 48:
+			goto st78
+		}
+		goto st0
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		if data[p] == 45 {
+			goto st55
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st77
+			}
+		case data[p] >= 48:
+			goto st77
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 45 {
+			goto st56
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st76
+			}
+		case data[p] >= 48:
+			goto st76
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		if data[p] == 45 {
+			goto st57
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st75
+			}
+		case data[p] >= 48:
+			goto st75
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 45 {
+			goto st58
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st74
+			}
+		case data[p] >= 48:
+			goto st74
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 45 {
+			goto st59
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 45 {
+			goto st60
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st72
+			}
+		case data[p] >= 48:
+			goto st72
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 45 {
+			goto st61
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st71
+			}
+		case data[p] >= 48:
+			goto st71
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 45 {
+			goto st62
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st70
+			}
+		case data[p] >= 48:
+			goto st70
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 45 {
+			goto st63
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st69
+			}
+		case data[p] >= 48:
+			goto st69
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 45 {
+			goto st64
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st68
+			}
+		case data[p] >= 48:
+			goto st68
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st67
+			}
+		case data[p] >= 48:
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st67
+			}
+		case data[p] >= 48:
+			goto st67
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 45 {
+			goto st64
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st68
+			}
+		case data[p] >= 48:
+			goto st68
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 45 {
+			goto st63
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st69
+			}
+		case data[p] >= 48:
+			goto st69
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 45 {
+			goto st62
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st70
+			}
+		case data[p] >= 48:
+			goto st70
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 45 {
+			goto st61
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st71
+			}
+		case data[p] >= 48:
+			goto st71
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 45 {
+			goto st60
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st72
+			}
+		case data[p] >= 48:
+			goto st72
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 45 {
+			goto st59
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st73
+			}
+		case data[p] >= 48:
+			goto st73
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 45 {
+			goto st58
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st74
+			}
+		case data[p] >= 48:
+			goto st74
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 45 {
+			goto st57
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st75
+			}
+		case data[p] >= 48:
+			goto st75
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 45 {
+			goto st56
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st76
+			}
+		case data[p] >= 48:
+			goto st76
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 45 {
+			goto st55
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st77
+			}
+		case data[p] >= 48:
+			goto st77
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 45 {
+			goto st54
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st78
+			}
+		case data[p] >= 48:
+			goto st78
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 45 {
+			goto st53
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st79
+			}
+		case data[p] >= 48:
+			goto st79
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 45 {
+			goto st52
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st80
+			}
+		case data[p] >= 48:
+			goto st80
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 45 {
+			goto st51
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st81
+			}
+		case data[p] >= 48:
+			goto st81
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 45 {
+			goto st50
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st82
+			}
+		case data[p] >= 48:
+			goto st82
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 45 {
+			goto st49
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st83
+			}
+		case data[p] >= 48:
+			goto st83
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		if data[p] == 45 {
+			goto st48
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st84
+			}
+		case data[p] >= 48:
+			goto st84
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 45 {
+			goto st47
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st85
+			}
+		case data[p] >= 48:
+			goto st85
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 45 {
+			goto st46
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st86
+			}
+		case data[p] >= 48:
+			goto st86
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 45 {
+			goto st45
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st87
+			}
+		case data[p] >= 48:
+			goto st87
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		if data[p] == 45 {
+			goto st44
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st88
+			}
+		case data[p] >= 48:
+			goto st88
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		if data[p] == 45 {
+			goto st43
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st89
+			}
+		case data[p] >= 48:
+			goto st89
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 45 {
+			goto st42
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st90
+			}
+		case data[p] >= 48:
+			goto st90
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 45 {
+			goto st41
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st91
+			}
+		case data[p] >= 48:
+			goto st91
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		if data[p] == 45 {
+			goto st40
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st92
+			}
+		case data[p] >= 48:
+			goto st92
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		if data[p] == 45 {
+			goto st39
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st93
+			}
+		case data[p] >= 48:
+			goto st93
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		if data[p] == 45 {
+			goto st38
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st94
+			}
+		case data[p] >= 48:
+			goto st94
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		if data[p] == 45 {
+			goto st37
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st95
+			}
+		case data[p] >= 48:
+			goto st95
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 45 {
+			goto st36
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st96
+			}
+		case data[p] >= 48:
+			goto st96
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 45 {
+			goto st35
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st97
+			}
+		case data[p] >= 48:
+			goto st97
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		if data[p] == 45 {
+			goto st34
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st98
+			}
+		case data[p] >= 48:
+			goto st98
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if data[p] == 45 {
+			goto st33
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st99
+			}
+		case data[p] >= 48:
+			goto st99
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 45 {
+			goto st32
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st100
+			}
+		case data[p] >= 48:
+			goto st100
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 45 {
+			goto st31
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st101
+			}
+		case data[p] >= 48:
+			goto st101
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 45 {
+			goto st30
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st102
+			}
+		case data[p] >= 48:
+			goto st102
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 45 {
+			goto st29
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st103
+			}
+		case data[p] >= 48:
+			goto st103
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		if data[p] == 45 {
+			goto st28
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st104
+			}
+		case data[p] >= 48:
+			goto st104
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 45 {
+			goto st27
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st105
+			}
+		case data[p] >= 48:
+			goto st105
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 45 {
+			goto st26
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st106
+			}
+		case data[p] >= 48:
+			goto st106
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 45 {
+			goto st25
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st107
+			}
+		case data[p] >= 48:
+			goto st107
+		}
+		goto st0
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		if data[p] == 45 {
+			goto st24
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st108
+			}
+		case data[p] >= 48:
+			goto st108
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 45 {
+			goto st23
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st109
+			}
+		case data[p] >= 48:
+			goto st109
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 45 {
+			goto st22
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st110
+			}
+		case data[p] >= 48:
+			goto st110
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 45 {
+			goto st21
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st111
+			}
+		case data[p] >= 48:
+			goto st111
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 45 {
+			goto st20
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st112
+			}
+		case data[p] >= 48:
+			goto st112
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 45 {
+			goto st19
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st113
+			}
+		case data[p] >= 48:
+			goto st113
+		}
+		goto st0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 45 {
+			goto st18
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st114
+			}
+		case data[p] >= 48:
+			goto st114
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		if data[p] == 45 {
+			goto st17
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st115
+			}
+		case data[p] >= 48:
+			goto st115
+		}
+		goto st0
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		if data[p] == 45 {
+			goto st16
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st116
+			}
+		case data[p] >= 48:
+			goto st116
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 45 {
+			goto st15
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st117
+			}
+		case data[p] >= 48:
+			goto st117
+		}
+		goto st0
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		if data[p] == 45 {
+			goto st14
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st118
+			}
+		case data[p] >= 48:
+			goto st118
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 45 {
+			goto st13
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st119
+			}
+		case data[p] >= 48:
+			goto st119
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 45 {
+			goto st12
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st120
+			}
+		case data[p] >= 48:
+			goto st120
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 45 {
+			goto st11
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st121
+			}
+		case data[p] >= 48:
+			goto st121
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 45 {
+			goto st10
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st122
+			}
+		case data[p] >= 48:
+			goto st122
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 45 {
+			goto st9
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st123
+			}
+		case data[p] >= 48:
+			goto st123
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if data[p] == 45 {
+			goto st8
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st124
+			}
+		case data[p] >= 48:
+			goto st124
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		if data[p] == 45 {
+			goto st7
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st125
+			}
+		case data[p] >= 48:
+			goto st125
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 45 {
+			goto st6
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st126
+			}
+		case data[p] >= 48:
+			goto st126
+		}
+		goto st0
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		if data[p] == 45 {
+			goto st5
+		}
+		switch {
+		case data[p] > 57:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st127
+			}
+		case data[p] >= 48:
+			goto st127
+		}
+		goto st0
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line k8sname.rl:59
+
+	if cs < k8sname_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
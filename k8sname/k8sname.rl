@@ -0,0 +1,65 @@
+package main
+
+// NameKind selects the rules ValidateK8sName applies.
+type NameKind int
+
+const (
+	// DNSSubdomain is an RFC 1123 subdomain, used for most resources.
+	DNSSubdomain NameKind = iota
+
+	// DNSLabel is a single RFC 1123 label, used for namespaces and
+	// anything else that ends up as part of a hostname.
+	DNSLabel
+)
+
+// ValidateK8sName reports whether data is a valid Kubernetes object name of
+// the given kind.  Names are lowercase letters, digits, and hyphens, and
+// start and end with a letter or digit.  A label is at most 63 characters.
+// A subdomain is at most 253 characters and may be made of several such
+// components separated by dots; as in apimachinery, the components are not
+// held to the 63 character limit.
+func ValidateK8sName(data []byte, kind NameKind) bool {
+	ok, _ := ValidateK8sNameAt(data, kind)
+	return ok
+}
+
+// ValidateK8sNameAt is like ValidateK8sName but also returns the offset of
+// the first byte that makes the name invalid, or -1 if it is valid.  A
+// subdomain that is too long fails at the first byte past the limit.
+func ValidateK8sNameAt(data []byte, kind NameKind) (ok bool, errPos int) {
+
+%% machine k8sname;
+%% write data;
+
+	if kind == DNSSubdomain && len(data) > 253 {
+		return false, 253
+	}
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	%%{
+	    ldh = lower | digit ;
+
+	    component = ldh ( ( ldh | '-' )* ldh )? ;
+
+	    main := component ( '.' component )* ;
+
+	    label := ldh ( ( ldh | '-' ){0,61} ldh )? ;
+
+	    write init;
+	}%%
+
+	if kind == DNSLabel {
+		cs = k8sname_en_label
+	}
+
+	%% write exec;
+
+	if cs < k8sname_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var data = []byte("my-app-7c9d5b6f4-x2kq9.example.com")
+
+var hits int
+
+func TestValidateK8sName(t *testing.T) {
+	label63 := strings.Repeat("a", 63)
+	long := label63 + "." + label63 + "." + label63 + "." + strings.Repeat("b", 61)
+
+	tests := []struct {
+		name      string
+		subdomain bool
+		label     bool
+	}{
+		{name: string(data), subdomain: true},
+		{name: "kube-system", subdomain: true, label: true},
+		{name: "a", subdomain: true, label: true},
+		{name: "0", subdomain: true, label: true},
+		{name: "123-abc", subdomain: true, label: true},
+		{name: label63, subdomain: true, label: true},
+		{name: label63 + "a", subdomain: true},
+		{name: long, subdomain: true},
+		{name: long + "b"},
+		{name: "my.app", subdomain: true},
+		{name: "-app"},
+		{name: "app-"},
+		{name: "app.-svc"},
+		{name: "app..svc"},
+		{name: ".app"},
+		{name: "app."},
+		{name: "My-App"},
+		{name: "my_app"},
+		{name: "my app"},
+		{name: ""},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateK8sName([]byte(tt.name), DNSSubdomain); got != tt.subdomain {
+			t.Errorf("ValidateK8sName(%q, DNSSubdomain)=%v, want %v", tt.name, got, tt.subdomain)
+		}
+		if got := ValidateK8sName([]byte(tt.name), DNSLabel); got != tt.label {
+			t.Errorf("ValidateK8sName(%q, DNSLabel)=%v, want %v", tt.name, got, tt.label)
+		}
+	}
+}
+
+func TestValidateK8sNameAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		errPos int
+	}{
+		{string(data), -1},
+		{"Bad_Name", 0},
+		{"app.", 4},
+		{"my-app-", 7},
+		{strings.Repeat("a.", 127) + "ab", 253},
+	}
+
+	for _, tt := range tests {
+		ok, errPos := ValidateK8sNameAt([]byte(tt.name), DNSSubdomain)
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ValidateK8sNameAt(%q)=%v, %d, want errPos %d", tt.name, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestK8sNameAlternatives(t *testing.T) {
+	for _, name := range []string{string(data), "kube-system", strings.Repeat("a", 64), "Bad_Name", "app."} {
+		if got, want := len(validation.IsDNS1123Subdomain(name)) == 0, ValidateK8sName([]byte(name), DNSSubdomain); got != want {
+			t.Errorf("IsDNS1123Subdomain(%q)=%v, want %v", name, got, want)
+		}
+		if got, want := len(validation.IsDNS1123Label(name)) == 0, ValidateK8sName([]byte(name), DNSLabel); got != want {
+			t.Errorf("IsDNS1123Label(%q)=%v, want %v", name, got, want)
+		}
+	}
+}
+
+func BenchmarkApimachinery(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if len(validation.IsDNS1123Subdomain(s)) == 0 {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if ValidateK8sName(data, DNSSubdomain) {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,325 @@
+
+//line logfmt.rl:1
+package main
+
+// ScanLogfmt calls fn with each key=value pair in a logfmt line, in order.
+// A quoted value is passed without its quotes but with any backslash
+// escapes left in place.  A key with no '=' gets a nil value, and one with
+// nothing after the '=' an empty one.  Both k and v point into data.
+//
+// ScanLogfmt reports whether the whole line was well-formed; fn will
+// already have seen the pairs before any error.
+func ScanLogfmt(data []byte, fn func(k, v []byte)) bool {
+	ok, _ := ScanLogfmtAt(data, fn)
+	return ok
+}
+
+// ScanLogfmtAt is like ScanLogfmt but also returns the offset at which the
+// line stopped being logfmt, or -1 if it never did.
+func ScanLogfmtAt(data []byte, fn func(k, v []byte)) (ok bool, errPos int) {
+
+
+//line logfmt.rl:20
+
+//line logfmt.go:25
+const logfmt_start int = 4
+const logfmt_first_final int = 4
+const logfmt_error int = 0
+
+const logfmt_en_main int = 4
+
+
+//line logfmt.rl:21
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var key, val []byte
+
+	
+//line logfmt.go:41
+	{
+	cs = logfmt_start
+	}
+
+//line logfmt.go:46
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 4:
+		goto st_case_4
+	case 0:
+		goto st_case_0
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 8:
+		goto st_case_8
+	case 3:
+		goto st_case_3
+	}
+	goto st_out
+tr9:
+//line logfmt.rl:29
+ key = data[mark:p]; val = nil 
+//line logfmt.rl:32
+ fn(key, val) 
+	goto st4
+tr12:
+//line logfmt.rl:32
+ fn(key, val) 
+	goto st4
+tr15:
+//line logfmt.rl:31
+ val = data[mark:p] 
+//line logfmt.rl:32
+ fn(key, val) 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line logfmt.go:93
+		switch data[p] {
+		case 32:
+			goto st4
+		case 46:
+			goto tr8
+		case 95:
+			goto tr8
+		}
+		switch {
+		case data[p] < 48:
+			if 9 <= data[p] && data[p] <= 13 {
+				goto st4
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto tr8
+				}
+			case data[p] >= 65:
+				goto tr8
+			}
+		default:
+			goto tr8
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr8:
+//line logfmt.rl:28
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line logfmt.go:133
+		switch data[p] {
+		case 32:
+			goto tr9
+		case 46:
+			goto st5
+		case 61:
+			goto tr11
+		case 95:
+			goto st5
+		}
+		switch {
+		case data[p] < 48:
+			if 9 <= data[p] && data[p] <= 13 {
+				goto tr9
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st5
+				}
+			case data[p] >= 65:
+				goto st5
+			}
+		default:
+			goto st5
+		}
+		goto st0
+tr11:
+//line logfmt.rl:29
+ key = data[mark:p]; val = nil 
+//line logfmt.rl:30
+ val = data[p+1:p+1] 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line logfmt.go:173
+		switch data[p] {
+		case 32:
+			goto tr12
+		case 34:
+			goto st1
+		case 61:
+			goto st0
+		}
+		switch {
+		case data[p] < 9:
+				goto st0
+		case data[p] > 13:
+			if data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto tr12
+		}
+		goto tr13
+tr13:
+//line logfmt.rl:28
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line logfmt.go:202
+		switch data[p] {
+		case 32:
+			goto tr15
+		case 34:
+			goto st0
+		case 61:
+			goto st0
+		}
+		switch {
+		case data[p] < 9:
+				goto st0
+		case data[p] > 13:
+			if data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto tr15
+		}
+		goto st7
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 34:
+			goto tr1
+		case 92:
+			goto tr2
+		}
+		goto tr0
+tr0:
+//line logfmt.rl:28
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line logfmt.go:243
+		switch data[p] {
+		case 34:
+			goto tr4
+		case 92:
+			goto st3
+		}
+		goto st2
+tr1:
+//line logfmt.rl:28
+ mark = p 
+//line logfmt.rl:31
+ val = data[mark:p] 
+	goto st8
+tr4:
+//line logfmt.rl:31
+ val = data[mark:p] 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line logfmt.go:266
+		if data[p] == 32 {
+			goto tr12
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto tr12
+		}
+		goto st0
+tr2:
+//line logfmt.rl:28
+ mark = p 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line logfmt.go:283
+		goto st2
+	st_out:
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 5:
+//line logfmt.rl:29
+ key = data[mark:p]; val = nil 
+//line logfmt.rl:32
+ fn(key, val) 
+		case 6, 8:
+//line logfmt.rl:32
+ fn(key, val) 
+		case 7:
+//line logfmt.rl:31
+ val = data[mark:p] 
+//line logfmt.rl:32
+ fn(key, val) 
+//line logfmt.go:311
+		}
+	}
+
+	_out: {}
+	}
+
+//line logfmt.rl:45
+
+
+	if cs < logfmt_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
@@ -0,0 +1,52 @@
+package main
+
+// ScanLogfmt calls fn with each key=value pair in a logfmt line, in order.
+// A quoted value is passed without its quotes but with any backslash
+// escapes left in place.  A key with no '=' gets a nil value, and one with
+// nothing after the '=' an empty one.  Both k and v point into data.
+//
+// ScanLogfmt reports whether the whole line was well-formed; fn will
+// already have seen the pairs before any error.
+func ScanLogfmt(data []byte, fn func(k, v []byte)) bool {
+	ok, _ := ScanLogfmtAt(data, fn)
+	return ok
+}
+
+// ScanLogfmtAt is like ScanLogfmt but also returns the offset at which the
+// line stopped being logfmt, or -1 if it never did.
+func ScanLogfmtAt(data []byte, fn func(k, v []byte)) (ok bool, errPos int) {
+
+%% machine logfmt;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var key, val []byte
+
+	%%{
+	    action mark  { mark = p }
+	    action key   { key = data[mark:p]; val = nil }
+	    action empty { val = data[p+1:p+1] }
+	    action value { val = data[mark:p] }
+	    action pair  { fn(key, val) }
+
+	    key = [A-Za-z0-9_.]+ ;
+
+	    bare = ( 0x21..0xff - [="] )+ ;
+	    quoted = '"' ( [^"\\] | '\\' any )* >mark %value '"' ;
+
+	    pair = key >mark %key ( '=' @empty ( bare >mark %value | quoted )? )? ;
+
+	    main := space* ( pair %pair space+ )* ( pair %pair )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < logfmt_first_final {
+		return false, p
+	}
+
+	return true, -1
+}
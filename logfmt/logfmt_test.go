@@ -0,0 +1,121 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kr/logfmt"
+)
+
+var data = []byte(`at=info method=GET path=/ host=example.herokuapp.com request_id=8601b555-6a83-4c12-8269-97c8e32cdb22 fwd="204.204.204.204" dyno=web.1 connect=1ms service=18ms status=200 bytes=13 protocol=https`)
+
+var hits int
+
+type pair struct {
+	k, v string
+	nilv bool
+}
+
+func collect(line []byte) ([]pair, bool) {
+	var pairs []pair
+	ok := ScanLogfmt(line, func(k, v []byte) {
+		pairs = append(pairs, pair{k: string(k), v: string(v), nilv: v == nil})
+	})
+	return pairs, ok
+}
+
+func TestScanLogfmt(t *testing.T) {
+	tests := []struct {
+		line string
+		want []pair
+		ok   bool
+	}{
+		{
+			line: `a=1 b=two c="three four"`,
+			want: []pair{{k: "a", v: "1"}, {k: "b", v: "two"}, {k: "c", v: "three four"}},
+			ok:   true,
+		},
+		{
+			line: `  msg="say \"hi\"\n" path=C:\tmp  `,
+			want: []pair{{k: "msg", v: `say \"hi\"\n`}, {k: "path", v: `C:\tmp`}},
+			ok:   true,
+		},
+		{
+			line: `empty= quoted="" flag user.name=bob_1`,
+			want: []pair{{k: "empty", v: ""}, {k: "quoted", v: ""}, {k: "flag", nilv: true}, {k: "user.name", v: "bob_1"}},
+			ok:   true,
+		},
+		{
+			line: "level=warn\tcaller=main.go:42\n",
+			want: []pair{{k: "level", v: "warn"}, {k: "caller", v: "main.go:42"}},
+			ok:   true,
+		},
+		{line: ``, ok: true},
+		{line: `   `, ok: true},
+		{line: `a=1 b="unterminated`, want: []pair{{k: "a", v: "1"}}},
+		{line: `a="x"b=2`},
+		{line: `a=b=c`},
+		{line: `=1`},
+		{line: `key-name=1`},
+		{line: `a="1" "b"=2`, want: []pair{{k: "a", v: "1"}}},
+	}
+
+	for _, tt := range tests {
+		got, ok := collect([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ScanLogfmt(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ScanLogfmt(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestScanLogfmtAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{`msg="unterminated`, 17},
+		{`=value`, 0},
+	}
+
+	for _, tt := range tests {
+		ok, errPos := ScanLogfmtAt([]byte(tt.line), func(k, v []byte) {})
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanLogfmtAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestLogfmtAlternatives(t *testing.T) {
+	want, _ := collect(data)
+
+	var got []pair
+	err := logfmt.Unmarshal(data, logfmt.HandlerFunc(func(k, v []byte) error {
+		got = append(got, pair{k: string(k), v: string(v), nilv: v == nil})
+		return nil
+	}))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("logfmt.Unmarshal=%+v (%v), want %+v", got, err, want)
+	}
+}
+
+func BenchmarkKrLogfmt(b *testing.B) {
+	h := logfmt.HandlerFunc(func(k, v []byte) error {
+		hits++
+		return nil
+	})
+	for i := 0; i < b.N; i++ {
+		logfmt.Unmarshal(data, h)
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	fn := func(k, v []byte) { hits++ }
+	for i := 0; i < b.N; i++ {
+		ScanLogfmt(data, fn)
+	}
+}
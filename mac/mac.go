@@ -0,0 +1,1034 @@
+
+//line mac.rl:1
+package main
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+// ParseMAC parses a 48-bit MAC address written as aa:bb:cc:dd:ee:ff,
+// aa-bb-cc-dd-ee-ff, aabb.ccdd.eeff, or aabbccddeeff.  The hex digits may
+// be in either case, but the separators must all be the same.
+func ParseMAC(data []byte) ([6]byte, bool) {
+	mac, ok, _ := ParseMACAt(data)
+	return mac, ok
+}
+
+// ParseMACAt is like ParseMAC, and also returns the offset of the first
+// byte that isn't part of the address, or -1 if data is one.
+func ParseMACAt(data []byte) ([6]byte, bool, int) {
+
+
+//line mac.rl:26
+
+//line mac.go:31
+const mac_start int = 1
+const mac_first_final int = 42
+const mac_error int = 0
+
+const mac_en_main int = 1
+
+
+//line mac.rl:27
+
+	var mac [6]byte
+	var n int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	
+//line mac.go:49
+	{
+	cs = mac_start
+	}
+
+//line mac.go:54
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 42:
+		goto st_case_42
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	}
+	goto st_out
+	st_case_1:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line mac.go:175
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr2
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr2
+			}
+		default:
+			goto tr2
+		}
+		goto st0
+tr2:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line mac.go:198
+		switch data[p] {
+		case 45:
+			goto st4
+		case 58:
+			goto st30
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr6
+			}
+		default:
+			goto tr6
+		}
+		goto st0
+tr6:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line mac.go:245
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr7
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr7
+			}
+		default:
+			goto tr7
+		}
+		goto st0
+tr7:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line mac.go:268
+		if data[p] == 45 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr9:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line mac.go:300
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr10
+			}
+		default:
+			goto tr10
+		}
+		goto st0
+tr10:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line mac.go:323
+		if data[p] == 45 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr12
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr12
+			}
+		default:
+			goto tr12
+		}
+		goto st0
+tr12:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line mac.go:355
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr13
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr13
+			}
+		default:
+			goto tr13
+		}
+		goto st0
+tr13:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line mac.go:378
+		if data[p] == 45 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto st0
+tr15:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line mac.go:410
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr16
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr16
+			}
+		default:
+			goto tr16
+		}
+		goto st0
+tr16:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line mac.go:433
+		if data[p] == 45 {
+			goto st16
+		}
+		goto st0
+tr29:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line mac.go:447
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr18
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr18
+			}
+		default:
+			goto tr18
+		}
+		goto st0
+tr18:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line mac.go:470
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr19
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr19
+			}
+		default:
+			goto tr19
+		}
+		goto st0
+tr19:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line mac.go:493
+		goto st0
+tr4:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line mac.go:504
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr20
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr20
+			}
+		default:
+			goto tr20
+		}
+		goto st0
+tr20:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line mac.go:527
+		if data[p] == 46 {
+			goto st20
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr22
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr22
+			}
+		default:
+			goto tr22
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr23
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr23
+			}
+		default:
+			goto tr23
+		}
+		goto st0
+tr23:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line mac.go:571
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr24
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr24
+			}
+		default:
+			goto tr24
+		}
+		goto st0
+tr24:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line mac.go:594
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr25
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr25
+			}
+		default:
+			goto tr25
+		}
+		goto st0
+tr25:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line mac.go:617
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr26
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr26
+			}
+		default:
+			goto tr26
+		}
+		goto st0
+tr26:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line mac.go:640
+		if data[p] == 46 {
+			goto st25
+		}
+		goto st0
+tr32:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line mac.go:654
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr28
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr28
+			}
+		default:
+			goto tr28
+		}
+		goto st0
+tr28:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line mac.go:677
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr29
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr29
+			}
+		default:
+			goto tr29
+		}
+		goto st0
+tr22:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line mac.go:700
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr30
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr30
+			}
+		default:
+			goto tr30
+		}
+		goto st0
+tr30:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line mac.go:723
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr31
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr31
+			}
+		default:
+			goto tr31
+		}
+		goto st0
+tr31:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line mac.go:746
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr32
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr32
+			}
+		default:
+			goto tr32
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr33
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr33
+			}
+		default:
+			goto tr33
+		}
+		goto st0
+tr33:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line mac.go:787
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr34
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr34
+			}
+		default:
+			goto tr34
+		}
+		goto st0
+tr34:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line mac.go:810
+		if data[p] == 58 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr36
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr36
+			}
+		default:
+			goto tr36
+		}
+		goto st0
+tr36:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line mac.go:842
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr37:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line mac.go:865
+		if data[p] == 58 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr39
+			}
+		default:
+			goto tr39
+		}
+		goto st0
+tr39:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line mac.go:897
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr40
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr40
+			}
+		default:
+			goto tr40
+		}
+		goto st0
+tr40:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line mac.go:920
+		if data[p] == 58 {
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr42
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr42
+			}
+		default:
+			goto tr42
+		}
+		goto st0
+tr42:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line mac.go:952
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr43
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr43
+			}
+		default:
+			goto tr43
+		}
+		goto st0
+tr43:
+//line mac.rl:37
+ mac[n/2] = mac[n/2]<<4 | unhex((data[p])); n++ 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line mac.go:975
+		if data[p] == 58 {
+			goto st16
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line mac.rl:51
+
+
+	if cs < mac_first_final {
+		return [6]byte{}, false, p
+	}
+
+	return mac, true, -1
+}
@@ -0,0 +1,58 @@
+package main
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+// ParseMAC parses a 48-bit MAC address written as aa:bb:cc:dd:ee:ff,
+// aa-bb-cc-dd-ee-ff, aabb.ccdd.eeff, or aabbccddeeff.  The hex digits may
+// be in either case, but the separators must all be the same.
+func ParseMAC(data []byte) ([6]byte, bool) {
+	mac, ok, _ := ParseMACAt(data)
+	return mac, ok
+}
+
+// ParseMACAt is like ParseMAC, and also returns the offset of the first
+// byte that isn't part of the address, or -1 if data is one.
+func ParseMACAt(data []byte) ([6]byte, bool, int) {
+
+%% machine mac;
+%% write data;
+
+	var mac [6]byte
+	var n int
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	%%{
+	    # every format has exactly 12 digits, so n never runs past the end
+	    action nibble { mac[n/2] = mac[n/2]<<4 | unhex(fc); n++ }
+
+	    hex = xdigit @nibble ;
+	    octet = hex{2} ;
+
+	    colon = octet ( ':' octet ){5} ;
+	    hyphen = octet ( '-' octet ){5} ;
+	    dot = hex{4} '.' hex{4} '.' hex{4} ;
+	    bare = hex{12} ;
+
+	    main := colon | hyphen | dot | bare ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < mac_first_final {
+		return [6]byte{}, false, p
+	}
+
+	return mac, true, -1
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"net"
+	"testing"
+)
+
+var data = []byte("00:1a:2B:3c:4D:5e")
+
+var hits int
+
+func TestParseMAC(t *testing.T) {
+	want := [6]byte{0x00, 0x1a, 0x2b, 0x3c, 0x4d, 0x5e}
+
+	tests := []struct {
+		mac string
+		ok  bool
+	}{
+		{"00:1a:2b:3c:4d:5e", true},
+		{"00:1A:2B:3C:4D:5E", true},
+		{"00:1a:2B:3c:4D:5e", true},
+		{"00-1a-2b-3c-4d-5e", true},
+		{"00-1A-2B-3C-4D-5E", true},
+		{"001a.2b3c.4d5e", true},
+		{"001A.2B3C.4D5E", true},
+		{"001a2b3c4d5e", true},
+		{"001A2B3C4D5E", true},
+
+		// mixed separators
+		{"00:1a-2b:3c:4d:5e", false},
+		{"00-1a-2b-3c-4d:5e", false},
+		{"001a.2b3c-4d5e", false},
+		{"00:1a2b.3c4d5e", false},
+		// truncated
+		{"00:1a:2b:3c:4d", false},
+		{"00:1a:2b:3c:4d:5", false},
+		{"00:1a:2b:3c:4d:", false},
+		{"001a.2b3c.4d5", false},
+		{"001a2b3c4d5", false},
+		{"", false},
+		// too long
+		{"00:1a:2b:3c:4d:5e:6f", false},
+		{"001a2b3c4d5e6f", false},
+		// octets must be two digits
+		{"0:1a:2b:3c:4d:5e", false},
+		// not hex
+		{"00:1a:2b:3c:4d:5g", false},
+		{"001a2b3c4d5x", false},
+		// EUI-64 and InfiniBand addresses aren't handled
+		{"00:1a:2b:3c:4d:5e:6f:70", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseMAC([]byte(tt.mac))
+		if ok != tt.ok {
+			t.Errorf("ParseMAC(%q) ok=%v, want %v", tt.mac, ok, tt.ok)
+			continue
+		}
+		if ok && got != want {
+			t.Errorf("ParseMAC(%q)=% x, want % x", tt.mac, got, want)
+		}
+	}
+}
+
+func TestParseMACAt(t *testing.T) {
+	tests := []struct {
+		mac    string
+		errPos int
+	}{
+		{string(data), -1},
+		{"00:1a:2b-3c:4d:5e", 8},
+		{"00:1a:2g:3c:4d:5e", 7},
+		{"00:1a:2b:3c:4d", 14},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseMACAt([]byte(tt.mac))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseMACAt(%q)=%v, %d, want errPos %d", tt.mac, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// stdlibMAC has to conform to the [6]byte return; net.ParseMAC also takes
+// 8 and 20 byte addresses, but knows nothing of the bare hex form.
+func stdlibMAC(s string) ([6]byte, bool) {
+	hw, err := net.ParseMAC(s)
+	if err != nil || len(hw) != 6 {
+		return [6]byte{}, false
+	}
+	var mac [6]byte
+	copy(mac[:], hw)
+	return mac, true
+}
+
+func TestMACAlternatives(t *testing.T) {
+	for _, s := range []string{"00:1a:2B:3c:4D:5e", "00-1a-2b-3c-4d-5e", "001a.2b3c.4d5e"} {
+		want, _ := ParseMAC([]byte(s))
+		if got, ok := stdlibMAC(s); !ok || got != want {
+			t.Errorf("stdlibMAC(%q)=% x, want % x", s, got, want)
+		}
+	}
+}
+
+func BenchmarkStdlib(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := stdlibMAC(s); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseMAC(data); ok {
+			hits++
+		}
+	}
+}
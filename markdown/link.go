@@ -0,0 +1,1002 @@
+
+//line link.rl:1
+package main
+
+// MarkdownLink is a CommonMark link or image.  An inline link has a URL
+// and optional Title; a reference link has a Label instead.  The byte
+// slices point into the scanned data and still contain any backslash
+// escapes, and a URL written in angle brackets doesn't include them.
+type MarkdownLink struct {
+	Text      []byte
+	URL       []byte
+	Title     []byte
+	Label     []byte
+	Image     bool
+	Reference bool
+}
+
+// ScanMarkdownLink scans the inline link [text](url "title") or full or
+// collapsed reference link [text][label] at the start of data, returning
+// it and the number of bytes it takes up.  A leading '!' makes it an image.
+// The text may contain balanced brackets and the URL balanced parentheses,
+// nested up to 8 deep.  The title may be in double quotes, single quotes,
+// or parentheses.  For a collapsed reference, [text][], the Label is the
+// same as the Text.
+//
+// Shortcut references, [label] on its own, aren't recognized since telling
+// them apart from plain text in brackets needs the link definitions.
+func ScanMarkdownLink(data []byte) (MarkdownLink, int, bool) {
+	link, n, ok, _ := ScanMarkdownLinkAt(data)
+	return link, n, ok
+}
+
+// ScanMarkdownLinkAt is like ScanMarkdownLink but also returns the offset
+// of the first byte that can't be part of the link, len(data) if the link
+// isn't closed, or -1 if one was found.  Brackets or parentheses nested
+// too deeply fail at the one that goes past the limit.
+func ScanMarkdownLinkAt(data []byte) (MarkdownLink, int, bool, int) {
+
+
+//line link.rl:38
+
+//line link.go:43
+const markdown_link_start int = 1
+const markdown_link_first_final int = 35
+const markdown_link_error int = 0
+
+const markdown_link_en_bracket int = 31
+const markdown_link_en_paren int = 33
+const markdown_link_en_main int = 1
+
+
+//line link.rl:39
+
+	var link MarkdownLink
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	var stack [8]int
+	top := 0
+	deep := -1
+
+	
+//line link.go:67
+	{
+	cs = markdown_link_start
+	top = 0
+	}
+
+//line link.go:73
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	goto _resume
+
+_again:
+	switch cs {
+	case 1:
+		goto st1
+	case 0:
+		goto st0
+	case 2:
+		goto st2
+	case 3:
+		goto st3
+	case 4:
+		goto st4
+	case 5:
+		goto st5
+	case 6:
+		goto st6
+	case 7:
+		goto st7
+	case 8:
+		goto st8
+	case 35:
+		goto st35
+	case 9:
+		goto st9
+	case 10:
+		goto st10
+	case 11:
+		goto st11
+	case 12:
+		goto st12
+	case 13:
+		goto st13
+	case 14:
+		goto st14
+	case 15:
+		goto st15
+	case 16:
+		goto st16
+	case 17:
+		goto st17
+	case 18:
+		goto st18
+	case 19:
+		goto st19
+	case 20:
+		goto st20
+	case 21:
+		goto st21
+	case 22:
+		goto st22
+	case 23:
+		goto st23
+	case 24:
+		goto st24
+	case 25:
+		goto st25
+	case 26:
+		goto st26
+	case 27:
+		goto st27
+	case 28:
+		goto st28
+	case 29:
+		goto st29
+	case 30:
+		goto st30
+	case 31:
+		goto st31
+	case 32:
+		goto st32
+	case 36:
+		goto st36
+	case 33:
+		goto st33
+	case 37:
+		goto st37
+	case 34:
+		goto st34
+	}
+
+	if p++; p == pe {
+		goto _test_eof
+	}
+_resume:
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 35:
+		goto st_case_35
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 36:
+		goto st_case_36
+	case 33:
+		goto st_case_33
+	case 37:
+		goto st_case_37
+	case 34:
+		goto st_case_34
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 33:
+			goto tr1
+		case 91:
+			goto tr2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line link.rl:53
+ link.Image = true 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line link.go:268
+		if data[p] == 91 {
+			goto tr2
+		}
+		goto st0
+tr2:
+//line link.rl:54
+ mark = p + 1 
+	goto st3
+tr4:
+//line link.rl:62
+
+	        if top == len(stack) {
+	            deep = p
+	            p++; cs = 3; goto _out
+
+	        }
+	        stack[top] = 3; top++; goto st31
+
+	    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line link.go:294
+		switch data[p] {
+		case 91:
+			goto tr4
+		case 92:
+			goto st4
+		case 93:
+			goto tr6
+		}
+		goto st3
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		goto st3
+tr6:
+//line link.rl:55
+ link.Text = data[mark:p] 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line link.go:319
+		switch data[p] {
+		case 40:
+			goto st6
+		case 91:
+			goto tr8
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 9:
+			goto st6
+		case 10:
+			goto st7
+		case 32:
+			goto st6
+		case 40:
+			goto tr11
+		case 41:
+			goto tr12
+		case 60:
+			goto st23
+		case 92:
+			goto tr14
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr10
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 9:
+			goto st7
+		case 10:
+			goto st8
+		case 32:
+			goto st7
+		case 40:
+			goto tr11
+		case 41:
+			goto tr12
+		case 60:
+			goto st23
+		case 92:
+			goto tr14
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto tr10
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 9:
+			goto st8
+		case 32:
+			goto st8
+		case 41:
+			goto tr12
+		}
+		goto st0
+tr12:
+//line link.rl:60
+ p++; cs = 35; goto _out
+ 
+	goto st35
+tr20:
+//line link.rl:56
+ link.URL = data[mark:p] 
+//line link.rl:60
+ p++; cs = 35; goto _out
+ 
+	goto st35
+tr53:
+//line link.rl:59
+ link.Label = data[mark:p] 
+//line link.rl:60
+ p++; cs = 35; goto _out
+ 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line link.go:419
+		goto st0
+tr10:
+//line link.rl:52
+ mark = p 
+	goto st9
+tr11:
+//line link.rl:52
+ mark = p 
+//line link.rl:70
+
+	        if top == len(stack) {
+	            deep = p
+	            p++; cs = 9; goto _out
+
+	        }
+	        stack[top] = 9; top++; goto st33
+
+	    
+	goto st9
+tr19:
+//line link.rl:70
+
+	        if top == len(stack) {
+	            deep = p
+	            p++; cs = 9; goto _out
+
+	        }
+	        stack[top] = 9; top++; goto st33
+
+	    
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line link.go:456
+		switch data[p] {
+		case 9:
+			goto tr16
+		case 10:
+			goto tr17
+		case 32:
+			goto tr16
+		case 40:
+			goto tr19
+		case 41:
+			goto tr20
+		case 92:
+			goto st22
+		case 127:
+			goto st0
+		}
+		if data[p] <= 31 {
+			goto st0
+		}
+		goto st9
+tr16:
+//line link.rl:56
+ link.URL = data[mark:p] 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line link.go:486
+		switch data[p] {
+		case 9:
+			goto st10
+		case 10:
+			goto st11
+		case 32:
+			goto st10
+		case 34:
+			goto st12
+		case 39:
+			goto st16
+		case 40:
+			goto st19
+		case 41:
+			goto tr12
+		}
+		goto st0
+tr17:
+//line link.rl:56
+ link.URL = data[mark:p] 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line link.go:513
+		switch data[p] {
+		case 9:
+			goto st11
+		case 32:
+			goto st11
+		case 34:
+			goto st12
+		case 39:
+			goto st16
+		case 40:
+			goto st19
+		case 41:
+			goto tr12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 34:
+			goto tr28
+		case 92:
+			goto tr29
+		}
+		goto tr27
+tr27:
+//line link.rl:52
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line link.go:550
+		switch data[p] {
+		case 34:
+			goto tr31
+		case 92:
+			goto st15
+		}
+		goto st13
+tr28:
+//line link.rl:52
+ mark = p 
+//line link.rl:57
+ link.Title = data[mark:p] 
+	goto st14
+tr31:
+//line link.rl:57
+ link.Title = data[mark:p] 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line link.go:573
+		switch data[p] {
+		case 9:
+			goto st14
+		case 10:
+			goto st8
+		case 32:
+			goto st14
+		case 41:
+			goto tr12
+		}
+		goto st0
+tr29:
+//line link.rl:52
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line link.go:594
+		goto st13
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 39:
+			goto tr28
+		case 92:
+			goto tr35
+		}
+		goto tr34
+tr34:
+//line link.rl:52
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line link.go:617
+		switch data[p] {
+		case 39:
+			goto tr31
+		case 92:
+			goto st18
+		}
+		goto st17
+tr35:
+//line link.rl:52
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line link.go:634
+		goto st17
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch data[p] {
+		case 40:
+			goto st0
+		case 41:
+			goto tr28
+		case 92:
+			goto tr39
+		}
+		goto tr38
+tr38:
+//line link.rl:52
+ mark = p 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line link.go:659
+		switch data[p] {
+		case 40:
+			goto st0
+		case 41:
+			goto tr31
+		case 92:
+			goto st21
+		}
+		goto st20
+tr39:
+//line link.rl:52
+ mark = p 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line link.go:678
+		goto st20
+tr14:
+//line link.rl:52
+ mark = p 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line link.go:689
+		goto st9
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 60:
+			goto st0
+		case 62:
+			goto tr43
+		case 92:
+			goto tr44
+		}
+		goto tr42
+tr42:
+//line link.rl:52
+ mark = p 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line link.go:716
+		switch data[p] {
+		case 10:
+			goto st0
+		case 60:
+			goto st0
+		case 62:
+			goto tr46
+		case 92:
+			goto st26
+		}
+		goto st24
+tr43:
+//line link.rl:52
+ mark = p 
+//line link.rl:56
+ link.URL = data[mark:p] 
+	goto st25
+tr46:
+//line link.rl:56
+ link.URL = data[mark:p] 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line link.go:743
+		switch data[p] {
+		case 9:
+			goto st10
+		case 10:
+			goto st11
+		case 32:
+			goto st10
+		case 41:
+			goto tr12
+		}
+		goto st0
+tr44:
+//line link.rl:52
+ mark = p 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line link.go:764
+		goto st24
+tr8:
+//line link.rl:58
+ link.Reference = true 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line link.go:775
+		switch data[p] {
+		case 32:
+			goto tr49
+		case 91:
+			goto st0
+		case 92:
+			goto tr50
+		case 93:
+			goto tr12
+		}
+		if 9 <= data[p] && data[p] <= 13 {
+			goto tr49
+		}
+		goto tr48
+tr48:
+//line link.rl:52
+ mark = p 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line link.go:799
+		switch data[p] {
+		case 91:
+			goto st0
+		case 92:
+			goto st29
+		case 93:
+			goto tr53
+		}
+		goto st28
+tr50:
+//line link.rl:52
+ mark = p 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line link.go:818
+		goto st28
+tr49:
+//line link.rl:52
+ mark = p 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line link.go:829
+		switch data[p] {
+		case 32:
+			goto st30
+		case 92:
+			goto st29
+		}
+		switch {
+		case data[p] > 13:
+			if 91 <= data[p] && data[p] <= 93 {
+				goto st0
+			}
+		case data[p] >= 9:
+			goto st30
+		}
+		goto st28
+tr56:
+//line link.rl:62
+
+	        if top == len(stack) {
+	            deep = p
+	            p++; cs = 31; goto _out
+
+	        }
+	        stack[top] = 31; top++; goto st31
+
+	    
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line link.go:862
+		switch data[p] {
+		case 91:
+			goto tr56
+		case 92:
+			goto st32
+		case 93:
+			goto tr58
+		}
+		goto st31
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		goto st31
+tr58:
+	cs = 36
+//line link.rl:78
+ top--; cs = stack[top]
+goto _again
+ 
+	goto _again
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line link.go:890
+		goto st0
+tr60:
+//line link.rl:70
+
+	        if top == len(stack) {
+	            deep = p
+	            p++; cs = 33; goto _out
+
+	        }
+	        stack[top] = 33; top++; goto st33
+
+	    
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line link.go:909
+		switch data[p] {
+		case 40:
+			goto tr60
+		case 41:
+			goto tr61
+		case 92:
+			goto st34
+		case 127:
+			goto st0
+		}
+		if data[p] <= 32 {
+			goto st0
+		}
+		goto st33
+tr61:
+	cs = 37
+//line link.rl:78
+ top--; cs = stack[top]
+goto _again
+ 
+	goto _again
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line link.go:936
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		goto st33
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line link.rl:114
+
+
+	if deep >= 0 {
+		return MarkdownLink{}, 0, false, deep
+	}
+
+	if cs < markdown_link_first_final || top != 0 {
+		return MarkdownLink{}, 0, false, p
+	}
+
+	if link.Reference && link.Label == nil {
+		link.Label = link.Text
+	}
+
+	return link, p, true, -1
+}
@@ -0,0 +1,129 @@
+package main
+
+// MarkdownLink is a CommonMark link or image.  An inline link has a URL
+// and optional Title; a reference link has a Label instead.  The byte
+// slices point into the scanned data and still contain any backslash
+// escapes, and a URL written in angle brackets doesn't include them.
+type MarkdownLink struct {
+	Text      []byte
+	URL       []byte
+	Title     []byte
+	Label     []byte
+	Image     bool
+	Reference bool
+}
+
+// ScanMarkdownLink scans the inline link [text](url "title") or full or
+// collapsed reference link [text][label] at the start of data, returning
+// it and the number of bytes it takes up.  A leading '!' makes it an image.
+// The text may contain balanced brackets and the URL balanced parentheses,
+// nested up to 8 deep.  The title may be in double quotes, single quotes,
+// or parentheses.  For a collapsed reference, [text][], the Label is the
+// same as the Text.
+//
+// Shortcut references, [label] on its own, aren't recognized since telling
+// them apart from plain text in brackets needs the link definitions.
+func ScanMarkdownLink(data []byte) (MarkdownLink, int, bool) {
+	link, n, ok, _ := ScanMarkdownLinkAt(data)
+	return link, n, ok
+}
+
+// ScanMarkdownLinkAt is like ScanMarkdownLink but also returns the offset
+// of the first byte that can't be part of the link, len(data) if the link
+// isn't closed, or -1 if one was found.  Brackets or parentheses nested
+// too deeply fail at the one that goes past the limit.
+func ScanMarkdownLinkAt(data []byte) (MarkdownLink, int, bool, int) {
+
+%% machine markdown_link;
+%% write data;
+
+	var link MarkdownLink
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	var stack [8]int
+	top := 0
+	deep := -1
+
+	%%{
+	    action mark       { mark = p }
+	    action image      { link.Image = true }
+	    action text_start { mark = p + 1 }
+	    action text       { link.Text = data[mark:p] }
+	    action url        { link.URL = data[mark:p] }
+	    action title      { link.Title = data[mark:p] }
+	    action reference  { link.Reference = true }
+	    action label      { link.Label = data[mark:p] }
+	    action done       { fbreak; }
+
+	    action open_bracket {
+	        if top == len(stack) {
+	            deep = p
+	            fbreak;
+	        }
+	        fcall bracket;
+	    }
+
+	    action open_paren {
+	        if top == len(stack) {
+	            deep = p
+	            fbreak;
+	        }
+	        fcall paren;
+	    }
+
+	    action close { fret; }
+
+	    escaped = '\\' any ;
+
+	    text_char = ( any - [\[\]\\] ) | escaped ;
+	    text = ( text_char | '[' @open_bracket )* ;
+
+	    bracket := text ']' @close ;
+
+	    # spaces and tabs with at most one newline
+	    sp = [ \t]* ( '\n' [ \t]* )? ;
+	    sp1 = [ \t]+ ( '\n' [ \t]* )? | '\n' [ \t]* ;
+
+	    angle_url = '<' ( ( any - [<>\\\n] ) | escaped )* >mark %url '>' ;
+
+	    url_char = ( any - ( space | cntrl | 0x7f | [()\\] ) ) | escaped ;
+	    url_part = url_char | '(' @open_paren ;
+	    bare_url = ( ( url_part - '<' ) url_part* ) >mark %url ;
+
+	    paren := url_part* ')' @close ;
+
+	    title = '"' ( ( any - ["\\] ) | escaped )* >mark %title '"'
+	          | "'" ( ( any - ['\\] ) | escaped )* >mark %title "'"
+	          | '(' ( ( any - [()\\] ) | escaped )* >mark %title ')' ;
+
+	    inline = '(' sp ( ( angle_url | bare_url ) ( sp1 title )? )? sp ')' @done ;
+
+	    label_char = ( any - [\[\]\\] ) | escaped ;
+	    label = label_char* ( label_char - space ) label_char* ;
+
+	    reference = '[' @reference ( label >mark %label )? ']' @done ;
+
+	    main := ( '!' @image )? '[' @text_start text ']' @text ( inline | reference ) ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if deep >= 0 {
+		return MarkdownLink{}, 0, false, deep
+	}
+
+	if cs < markdown_link_first_final || top != 0 {
+		return MarkdownLink{}, 0, false, p
+	}
+
+	if link.Reference && link.Label == nil {
+		link.Label = link.Text
+	}
+
+	return link, p, true, -1
+}
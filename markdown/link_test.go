@@ -0,0 +1,138 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+var data = []byte(`[the [CommonMark] spec](https://spec.commonmark.org/0.31.2/#links_(inline) "Links (inline)")`)
+
+var hits int
+
+func TestScanMarkdownLink(t *testing.T) {
+	tests := []struct {
+		in   string
+		want MarkdownLink
+		n    int
+		ok   bool
+	}{
+		{
+			in: string(data),
+			want: MarkdownLink{
+				Text:  []byte("the [CommonMark] spec"),
+				URL:   []byte("https://spec.commonmark.org/0.31.2/#links_(inline)"),
+				Title: []byte("Links (inline)"),
+			},
+			n:  len(data),
+			ok: true,
+		},
+		{in: `[a](b)`, want: MarkdownLink{Text: []byte("a"), URL: []byte("b")}, n: 6, ok: true},
+		{in: `[a](b) and more`, want: MarkdownLink{Text: []byte("a"), URL: []byte("b")}, n: 6, ok: true},
+		{in: `![logo](/img/logo.png 'The logo')`, want: MarkdownLink{Text: []byte("logo"), URL: []byte("/img/logo.png"), Title: []byte("The logo"), Image: true}, n: 33, ok: true},
+		{in: `[a](b (title))`, want: MarkdownLink{Text: []byte("a"), URL: []byte("b"), Title: []byte("title")}, n: 14, ok: true},
+		{in: `[a](<b c> "d")`, want: MarkdownLink{Text: []byte("a"), URL: []byte("b c"), Title: []byte("d")}, n: 14, ok: true},
+		{in: `[a](<>)`, want: MarkdownLink{Text: []byte("a"), URL: []byte("")}, n: 7, ok: true},
+		{in: `[a]()`, want: MarkdownLink{Text: []byte("a")}, n: 5, ok: true},
+		{in: `[]( b )`, want: MarkdownLink{Text: []byte(""), URL: []byte("b")}, n: 7, ok: true},
+		{in: "[a](\n  b\n  \"t\"\n)", want: MarkdownLink{Text: []byte("a"), URL: []byte("b"), Title: []byte("t")}, n: 16, ok: true},
+		{in: `[a](b\)c)`, want: MarkdownLink{Text: []byte("a"), URL: []byte(`b\)c`)}, n: 9, ok: true},
+		{in: `[a\]b](c "say \"hi\"")`, want: MarkdownLink{Text: []byte(`a\]b`), URL: []byte("c"), Title: []byte(`say \"hi\"`)}, n: 22, ok: true},
+		{in: `[a](b"c")`, want: MarkdownLink{Text: []byte("a"), URL: []byte(`b"c"`)}, n: 9, ok: true},
+		{in: `[a](b(c(d)))`, want: MarkdownLink{Text: []byte("a"), URL: []byte("b(c(d))")}, n: 12, ok: true},
+		{in: `[[[nested]]](x)`, want: MarkdownLink{Text: []byte("[[nested]]"), URL: []byte("x")}, n: 15, ok: true},
+		{in: `[text][label]`, want: MarkdownLink{Text: []byte("text"), Label: []byte("label"), Reference: true}, n: 13, ok: true},
+		{in: `![text][ a b ]`, want: MarkdownLink{Text: []byte("text"), Label: []byte(" a b "), Image: true, Reference: true}, n: 14, ok: true},
+		{in: `[text][]`, want: MarkdownLink{Text: []byte("text"), Label: []byte("text"), Reference: true}, n: 8, ok: true},
+
+		{in: ``},
+		{in: `a [b](c)`},
+		{in: `[a]`},
+		{in: `[a] (b)`},
+		{in: `[a](b`},
+		{in: `[a](b c)`},
+		{in: `[a](b "c)`},
+		{in: `[a](b(c)`},
+		{in: `[a](<b)`},
+		{in: "[a](\n\nb)"},
+		{in: `[a][b`},
+		{in: `[a][ ]`},
+		{in: `[a][b[c]]`},
+		{in: `[[a](b)`},
+		{in: `[[[[[[[[[[too deep]]]]]]]]]](x)`},
+		{in: `[a](((((((((too deep)))))))))`},
+	}
+
+	for _, tt := range tests {
+		got, n, ok := ScanMarkdownLink([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ScanMarkdownLink(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && (!reflect.DeepEqual(got, tt.want) || n != tt.n) {
+			t.Errorf("ScanMarkdownLink(%q)=(%+v, %d), want (%+v, %d)", tt.in, got, n, tt.want, tt.n)
+		}
+	}
+}
+
+func TestScanMarkdownLinkAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{`[text] (url)`, 6},
+		{`[text](url "title)`, 18},
+		{`[text`, 5},
+	}
+
+	for _, tt := range tests {
+		_, _, ok, errPos := ScanMarkdownLinkAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanMarkdownLinkAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var md = goldmark.New()
+
+// goldmarkLink parses data as a whole document and returns the first link
+// it finds.  Along with the link it has to find the paragraph around it and
+// the emphasis and other inlines in the link text.
+func goldmarkLink(data []byte) (url, title []byte, ok bool) {
+	doc := md.Parser().Parse(text.NewReader(data))
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if l, isLink := n.(*ast.Link); isLink && entering {
+			url, title, ok = l.Destination, l.Title, true
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return url, title, ok
+}
+
+func TestMarkdownLinkAlternatives(t *testing.T) {
+	want, _, _ := ScanMarkdownLink(data)
+	if url, title, ok := goldmarkLink(data); !ok || string(url) != string(want.URL) || string(title) != string(want.Title) {
+		t.Errorf("goldmarkLink=(%q, %q, %v), want (%q, %q)", url, title, ok, want.URL, want.Title)
+	}
+}
+
+func BenchmarkGoldmark(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := goldmarkLink(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := ScanMarkdownLink(data); ok {
+			hits++
+		}
+	}
+}
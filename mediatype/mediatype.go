@@ -0,0 +1,1210 @@
+
+//line mediatype.rl:1
+package main
+
+import (
+	"bytes"
+	"strings"
+)
+
+func unescapeQuoted(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' {
+			i++
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+func decodePercent(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '%' {
+			out = append(out, unhex(v[i+1])<<4|unhex(v[i+2]))
+			i += 2
+			continue
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseMediaType parses a media type as found in a Content-Type header, or
+// a single media range from an Accept header, along with its parameters.
+//
+// Unlike mime.ParseMediaType, mtype and subtype are returned as they appear
+// in data rather than lower-cased, and parameter values point into data
+// unless they had to be unescaped or decoded.  Parameter names are
+// lower-cased.  params is nil if there are no parameters.
+//
+// An RFC 2231 extended parameter such as title*=utf-8''%E2%82%AC is decoded
+// and stored under its plain name, replacing any plain parameter of the same
+// name.  Only the utf-8 and us-ascii charsets are understood; parameters in
+// others are dropped.  RFC 2231 continuations are not supported.
+func ParseMediaType(data []byte) (mtype, subtype []byte, params map[string][]byte, ok bool) {
+	mtype, subtype, params, ok, _ = ParseMediaTypeAt(data)
+	return mtype, subtype, params, ok
+}
+
+// ParseMediaTypeAt is like ParseMediaType but also returns the offset of
+// the first byte that is out of place, or -1 if data parsed.  A parameter
+// given twice with different values fails at the second one's name.
+func ParseMediaTypeAt(data []byte) (mtype, subtype []byte, params map[string][]byte, ok bool, errPos int) {
+
+
+//line mediatype.rl:65
+
+//line mediatype.go:70
+const mediatype_start int = 1
+const mediatype_first_final int = 15
+const mediatype_error int = 0
+
+const mediatype_en_main int = 1
+
+
+//line mediatype.rl:66
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name, charset, value []byte
+	var key string
+	escaped, encoded := false, false
+	var extended map[string]bool
+
+	// the offset of the first conflicting parameter
+	nameAt, dup := 0, -1
+
+	
+//line mediatype.go:92
+	{
+	cs = mediatype_start
+	}
+
+//line mediatype.go:97
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 20:
+		goto st_case_20
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 21:
+		goto st_case_21
+	case 14:
+		goto st_case_14
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 33:
+			goto tr1
+		case 124:
+			goto tr1
+		case 126:
+			goto tr1
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr1
+				}
+			case data[p] >= 35:
+				goto tr1
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr1
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr1
+				}
+			default:
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line mediatype.rl:79
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line mediatype.go:198
+		switch data[p] {
+		case 33:
+			goto st2
+		case 47:
+			goto tr3
+		case 124:
+			goto st2
+		case 126:
+			goto st2
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st2
+				}
+			case data[p] >= 35:
+				goto st2
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st2
+				}
+			case data[p] >= 65:
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+tr3:
+//line mediatype.rl:80
+ mtype = data[mark:p] 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line mediatype.go:241
+		switch data[p] {
+		case 33:
+			goto tr4
+		case 124:
+			goto tr4
+		case 126:
+			goto tr4
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr4
+				}
+			case data[p] >= 35:
+				goto tr4
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr4
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr4
+				}
+			default:
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+tr4:
+//line mediatype.rl:79
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line mediatype.go:286
+		switch data[p] {
+		case 9:
+			goto tr24
+		case 32:
+			goto tr24
+		case 33:
+			goto st15
+		case 59:
+			goto tr26
+		case 124:
+			goto st15
+		case 126:
+			goto st15
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st15
+				}
+			case data[p] >= 35:
+				goto st15
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st15
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st15
+				}
+			default:
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+tr24:
+//line mediatype.rl:81
+ subtype = data[mark:p] 
+	goto st16
+tr30:
+//line mediatype.rl:79
+ mark = p 
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+	goto st16
+tr34:
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+	goto st16
+tr38:
+//line mediatype.rl:87
+
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+	goto st16
+tr41:
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line mediatype.go:422
+		switch data[p] {
+		case 9:
+			goto st16
+		case 32:
+			goto st16
+		case 59:
+			goto st17
+		}
+		goto st0
+tr26:
+//line mediatype.rl:81
+ subtype = data[mark:p] 
+	goto st17
+tr33:
+//line mediatype.rl:79
+ mark = p 
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+	goto st17
+tr37:
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+	goto st17
+tr40:
+//line mediatype.rl:87
+
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+	goto st17
+tr42:
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line mediatype.go:526
+		switch data[p] {
+		case 9:
+			goto st17
+		case 32:
+			goto st17
+		case 33:
+			goto tr29
+		case 43:
+			goto tr29
+		case 124:
+			goto tr29
+		case 126:
+			goto tr29
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 39:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto tr29
+				}
+			case data[p] >= 35:
+				goto tr29
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr29
+				}
+			case data[p] >= 65:
+				goto tr29
+			}
+		default:
+			goto tr29
+		}
+		goto st0
+tr29:
+//line mediatype.rl:79
+ mark = p 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line mediatype.go:573
+		switch data[p] {
+		case 33:
+			goto st4
+		case 42:
+			goto tr6
+		case 43:
+			goto st4
+		case 61:
+			goto tr7
+		case 124:
+			goto st4
+		case 126:
+			goto st4
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 39:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st4
+				}
+			case data[p] >= 35:
+				goto st4
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st4
+				}
+			case data[p] >= 65:
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+tr6:
+//line mediatype.rl:82
+ name, nameAt = data[mark:p], mark; escaped = false; encoded = false 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line mediatype.go:620
+		if data[p] == 61 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 33:
+			goto tr9
+		case 43:
+			goto tr9
+		case 45:
+			goto tr9
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 38:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr9
+				}
+			case data[p] >= 35:
+				goto tr9
+			}
+		case data[p] > 90:
+			switch {
+			case data[p] > 123:
+				if 125 <= data[p] && data[p] <= 126 {
+					goto tr9
+				}
+			case data[p] >= 94:
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr9:
+//line mediatype.rl:79
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line mediatype.go:670
+		switch data[p] {
+		case 33:
+			goto st7
+		case 39:
+			goto tr11
+		case 43:
+			goto st7
+		case 45:
+			goto st7
+		}
+		switch {
+		case data[p] < 65:
+			switch {
+			case data[p] > 38:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st7
+				}
+			case data[p] >= 35:
+				goto st7
+			}
+		case data[p] > 90:
+			switch {
+			case data[p] > 123:
+				if 125 <= data[p] && data[p] <= 126 {
+					goto st7
+				}
+			case data[p] >= 94:
+				goto st7
+			}
+		default:
+			goto st7
+		}
+		goto st0
+tr11:
+//line mediatype.rl:83
+ charset = data[mark:p] 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line mediatype.go:713
+		switch data[p] {
+		case 39:
+			goto st18
+		case 45:
+			goto st8
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st8
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		switch data[p] {
+		case 9:
+			goto tr30
+		case 32:
+			goto tr30
+		case 33:
+			goto tr31
+		case 37:
+			goto tr32
+		case 43:
+			goto tr31
+		case 59:
+			goto tr33
+		case 124:
+			goto tr31
+		case 126:
+			goto tr31
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 38:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto tr31
+				}
+			case data[p] >= 35:
+				goto tr31
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr31
+				}
+			case data[p] >= 65:
+				goto tr31
+			}
+		default:
+			goto tr31
+		}
+		goto st0
+tr15:
+//line mediatype.rl:85
+ encoded = true 
+	goto st19
+tr31:
+//line mediatype.rl:79
+ mark = p 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line mediatype.go:792
+		switch data[p] {
+		case 9:
+			goto tr34
+		case 32:
+			goto tr34
+		case 33:
+			goto st19
+		case 37:
+			goto st9
+		case 43:
+			goto st19
+		case 59:
+			goto tr37
+		case 124:
+			goto st19
+		case 126:
+			goto st19
+		}
+		switch {
+		case data[p] < 48:
+			switch {
+			case data[p] > 38:
+				if 45 <= data[p] && data[p] <= 46 {
+					goto st19
+				}
+			case data[p] >= 35:
+				goto st19
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st19
+				}
+			case data[p] >= 65:
+				goto st19
+			}
+		default:
+			goto st19
+		}
+		goto st0
+tr32:
+//line mediatype.rl:79
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line mediatype.go:843
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto st0
+tr7:
+//line mediatype.rl:82
+ name, nameAt = data[mark:p], mark; escaped = false; encoded = false 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line mediatype.go:884
+		switch data[p] {
+		case 34:
+			goto st12
+		case 124:
+			goto tr16
+		case 126:
+			goto tr16
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr16
+				}
+			case data[p] >= 33:
+				goto tr16
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr16
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr16
+				}
+			default:
+				goto tr16
+			}
+		default:
+			goto tr16
+		}
+		goto st0
+tr16:
+//line mediatype.rl:79
+ mark = p 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line mediatype.go:929
+		switch data[p] {
+		case 9:
+			goto tr38
+		case 32:
+			goto tr38
+		case 33:
+			goto st20
+		case 59:
+			goto tr40
+		case 124:
+			goto st20
+		case 126:
+			goto st20
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st20
+				}
+			case data[p] >= 35:
+				goto st20
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st20
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st20
+				}
+			default:
+				goto st20
+			}
+		default:
+			goto st20
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 34:
+			goto tr19
+		case 92:
+			goto tr20
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto tr18
+tr18:
+//line mediatype.rl:79
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line mediatype.go:1002
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto tr23
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st13
+tr19:
+//line mediatype.rl:79
+ mark = p 
+//line mediatype.rl:87
+
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    
+	goto st21
+tr22:
+//line mediatype.rl:87
+
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line mediatype.go:1045
+		switch data[p] {
+		case 9:
+			goto tr41
+		case 32:
+			goto tr41
+		case 59:
+			goto tr42
+		}
+		goto st0
+tr20:
+//line mediatype.rl:79
+ mark = p 
+//line mediatype.rl:84
+ escaped = true 
+	goto st14
+tr23:
+//line mediatype.rl:84
+ escaped = true 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line mediatype.go:1070
+		if data[p] == 127 {
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st13
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 15:
+//line mediatype.rl:81
+ subtype = data[mark:p] 
+		case 18:
+//line mediatype.rl:79
+ mark = p 
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+		case 19:
+//line mediatype.rl:107
+
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    
+		case 20:
+//line mediatype.rl:87
+
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+		case 21:
+//line mediatype.rl:94
+
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    
+//line mediatype.go:1192
+		}
+	}
+
+	_out: {}
+	}
+
+//line mediatype.rl:153
+
+
+	if cs < mediatype_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	if dup >= 0 {
+		return nil, nil, nil, false, dup
+	}
+
+	return mtype, subtype, params, true, -1
+}
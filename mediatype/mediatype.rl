@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+)
+
+func unescapeQuoted(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' {
+			i++
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+func decodePercent(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '%' {
+			out = append(out, unhex(v[i+1])<<4|unhex(v[i+2]))
+			i += 2
+			continue
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseMediaType parses a media type as found in a Content-Type header, or
+// a single media range from an Accept header, along with its parameters.
+//
+// Unlike mime.ParseMediaType, mtype and subtype are returned as they appear
+// in data rather than lower-cased, and parameter values point into data
+// unless they had to be unescaped or decoded.  Parameter names are
+// lower-cased.  params is nil if there are no parameters.
+//
+// An RFC 2231 extended parameter such as title*=utf-8''%E2%82%AC is decoded
+// and stored under its plain name, replacing any plain parameter of the same
+// name.  Only the utf-8 and us-ascii charsets are understood; parameters in
+// others are dropped.  RFC 2231 continuations are not supported.
+func ParseMediaType(data []byte) (mtype, subtype []byte, params map[string][]byte, ok bool) {
+	mtype, subtype, params, ok, _ = ParseMediaTypeAt(data)
+	return mtype, subtype, params, ok
+}
+
+// ParseMediaTypeAt is like ParseMediaType but also returns the offset of
+// the first byte that is out of place, or -1 if data parsed.  A parameter
+// given twice with different values fails at the second one's name.
+func ParseMediaTypeAt(data []byte) (mtype, subtype []byte, params map[string][]byte, ok bool, errPos int) {
+
+%% machine mediatype;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name, charset, value []byte
+	var key string
+	escaped, encoded := false, false
+	var extended map[string]bool
+
+	// the offset of the first conflicting parameter
+	nameAt, dup := 0, -1
+
+	%%{
+	    action mark    { mark = p }
+	    action mtype   { mtype = data[mark:p] }
+	    action subtype { subtype = data[mark:p] }
+	    action name    { name, nameAt = data[mark:p], mark; escaped = false; encoded = false }
+	    action charset { charset = data[mark:p] }
+	    action escape  { escaped = true }
+	    action encoded { encoded = true }
+
+	    action value {
+	        value = data[mark:p]
+	        if escaped {
+	            value = unescapeQuoted(value)
+	        }
+	    }
+
+	    action plain {
+	        key = strings.ToLower(string(name))
+	        if !extended[key] {
+	            if old, seen := params[key]; seen && !bytes.Equal(old, value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            params[key] = value
+	        }
+	    }
+
+	    action ext {
+	        if bytes.EqualFold(charset, []byte("utf-8")) || bytes.EqualFold(charset, []byte("us-ascii")) {
+	            key = strings.ToLower(string(name))
+	            value = data[mark:p]
+	            if encoded {
+	                value = decodePercent(value)
+	            }
+	            if extended[key] && !bytes.Equal(params[key], value) && dup < 0 {
+	                dup = nameAt
+	            }
+	            if params == nil {
+	                params = make(map[string][]byte)
+	            }
+	            if extended == nil {
+	                extended = make(map[string]bool)
+	            }
+	            params[key] = value
+	            extended[key] = true
+	        }
+	    }
+
+	    tchar = alnum | [!#$%&'*+\-.\^_`|~] ;
+	    token = tchar+ ;
+
+	    ows = [ \t]* ;
+
+	    qdtext = [\t ] | 0x21 | 0x23..0x5b | 0x5d..0x7e | 0x80..0xff ;
+	    quoted_pair = '\\' ( [\t ] | 0x21..0x7e | 0x80..0xff ) ;
+	    quoted_string = '"' ( qdtext | quoted_pair >escape )* >mark %value '"' ;
+
+	    # RFC 2231 section 7
+	    attr_char = alnum | [!#$&+\-.\^_`|~] ;
+	    mime_charset = ( alnum | [!#$%&+\-\^_`{}~] )+ ;
+	    language = ( alnum | '-' )* ;
+	    ext_value = mime_charset >mark %charset "'" language "'"
+	                ( attr_char | '%' xdigit xdigit >encoded )* >mark ;
+
+	    param = ( tchar - '*' )+ >mark %name
+	            ( '=' ( token >mark %value | quoted_string ) %plain
+	            | '*=' ext_value %ext ) ;
+
+	    main := token >mark %mtype '/' token >mark %subtype
+	            ( ows ';' ows param )* ( ows ';' )? ows ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < mediatype_first_final {
+		return nil, nil, nil, false, p
+	}
+
+	if dup >= 0 {
+		return nil, nil, nil, false, dup
+	}
+
+	return mtype, subtype, params, true, -1
+}
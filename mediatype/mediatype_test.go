@@ -0,0 +1,143 @@
+package main
+
+import (
+	"mime"
+	"reflect"
+	"testing"
+)
+
+var data = []byte(`multipart/form-data; charset=utf-8; boundary="----WebKitFormBoundary7MA4YWxkTrZu0gW"`)
+
+var hits int
+
+func TestParseMediaType(t *testing.T) {
+	tests := []struct {
+		in      string
+		mtype   string
+		subtype string
+		params  map[string][]byte
+		ok      bool
+	}{
+		{
+			in:      string(data),
+			mtype:   "multipart",
+			subtype: "form-data",
+			params: map[string][]byte{
+				"charset":  []byte("utf-8"),
+				"boundary": []byte("----WebKitFormBoundary7MA4YWxkTrZu0gW"),
+			},
+			ok: true,
+		},
+		{in: "text/plain", mtype: "text", subtype: "plain", ok: true},
+		{in: "Text/HTML;Charset=UTF-8", mtype: "Text", subtype: "HTML", params: map[string][]byte{"charset": []byte("UTF-8")}, ok: true},
+		{in: "*/*;q=0.8", mtype: "*", subtype: "*", params: map[string][]byte{"q": []byte("0.8")}, ok: true},
+		{in: "image/* ; q=0.5 ; ", mtype: "image", subtype: "*", params: map[string][]byte{"q": []byte("0.5")}, ok: true},
+		{
+			in:      `application/vnd.api+json; ext="https://jsonapi.org/ext/\"atomic\""; profile=""`,
+			mtype:   "application",
+			subtype: "vnd.api+json",
+			params: map[string][]byte{
+				"ext":     []byte(`https://jsonapi.org/ext/"atomic"`),
+				"profile": []byte(""),
+			},
+			ok: true,
+		},
+		{
+			// RFC 2231 section 4, and the extended form wins
+			in:      `application/x-stuff; title="EUR rates"; title*=utf-8'en'%E2%82%AC%20rates`,
+			mtype:   "application",
+			subtype: "x-stuff",
+			params:  map[string][]byte{"title": []byte("€ rates")},
+			ok:      true,
+		},
+		{
+			in:      `application/x-stuff; title*=us-ascii''plain; title="ignored"`,
+			mtype:   "application",
+			subtype: "x-stuff",
+			params:  map[string][]byte{"title": []byte("plain")},
+			ok:      true,
+		},
+		{
+			// unknown charsets are dropped
+			in:      `application/x-stuff; title="fallback"; title*=iso-8859-1''%A3`,
+			mtype:   "application",
+			subtype: "x-stuff",
+			params:  map[string][]byte{"title": []byte("fallback")},
+			ok:      true,
+		},
+		{in: "text/plain; charset=utf-8; charset=utf-8", mtype: "text", subtype: "plain", params: map[string][]byte{"charset": []byte("utf-8")}, ok: true},
+		{in: "text/plain; charset=utf-8; charset=latin1"},
+		{in: "text/plain; charset"},
+		{in: "text/plain; charset=\"utf-8"},
+		{in: "text/plain; title*=utf-8''%E2%8"},
+		{in: "text/plain; title*0=a; title*1=b"},
+		{in: "text/plain;; a=b"},
+		{in: "text/plain; a = b"},
+		{in: "text/"},
+		{in: "/plain"},
+		{in: "text plain"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		mtype, subtype, params, ok := ParseMediaType([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseMediaType(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && (string(mtype) != tt.mtype || string(subtype) != tt.subtype || !reflect.DeepEqual(params, tt.params)) {
+			t.Errorf("ParseMediaType(%q)=%q %q %q, want %q %q %q", tt.in, mtype, subtype, params, tt.mtype, tt.subtype, tt.params)
+		}
+	}
+}
+
+func TestParseMediaTypeAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"text/html; charset=utf-8; Charset=latin1", 26},
+		{"text", 4},
+		{"text/html; charset", 18},
+	}
+
+	for _, tt := range tests {
+		_, _, _, ok, errPos := ParseMediaTypeAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseMediaTypeAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestMediaTypeAlternatives(t *testing.T) {
+	mtype, subtype, params, _ := ParseMediaType(data)
+	want := string(mtype) + "/" + string(subtype)
+
+	got, gotParams, err := mime.ParseMediaType(string(data))
+	if err != nil || got != want || len(gotParams) != len(params) {
+		t.Fatalf("mime.ParseMediaType=%q %q (%v), want %q %q", got, gotParams, err, want, params)
+	}
+	for k, v := range params {
+		if gotParams[k] != string(v) {
+			t.Errorf("mime.ParseMediaType param %s=%q, want %q", k, gotParams[k], v)
+		}
+	}
+}
+
+func BenchmarkMime(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, _, err := mime.ParseMediaType(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, _, _, ok := ParseMediaType(data); ok {
+			hits++
+		}
+	}
+}
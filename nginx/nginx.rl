@@ -0,0 +1,81 @@
+package main
+
+// NginxLogEntry holds the fields of a line in nginx's default "combined"
+// access log format.  The byte slices point into the parsed line.
+type NginxLogEntry struct {
+	RemoteAddr    []byte
+	RemoteUser    []byte
+	TimeLocal     []byte
+	Request       []byte
+	Status        int
+	BodyBytesSent int
+	Referer       []byte
+	UserAgent     []byte
+}
+
+// ParseNginx parses a line of the form
+//
+//	$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent "$http_referer" "$http_user_agent"
+//
+// Quoted fields are returned without their surrounding quotes and with any
+// backslash escapes left as-is.
+func ParseNginx(data []byte) (NginxLogEntry, bool) {
+	e, ok, _ := ParseNginxAt(data)
+	return e, ok
+}
+
+// ParseNginxAt is like ParseNginx but also returns the offset of the first
+// byte that doesn't match the log format, or -1 if the line does.
+func ParseNginxAt(data []byte) (NginxLogEntry, bool, int) {
+
+%% machine nginx;
+%% write data;
+
+	var e NginxLogEntry
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+
+	%%{
+	    include timestamp "../patterns/timestamp.rl";
+
+	    action mark        { mark = p }
+	    action remote_addr { e.RemoteAddr = data[mark:p] }
+	    action remote_user { e.RemoteUser = data[mark:p] }
+	    action time_local  { e.TimeLocal = data[mark:p] }
+	    action request     { e.Request = data[mark:p] }
+	    action status      { e.Status = e.Status*10 + int(fc-'0') }
+	    action bytes_sent  { e.BodyBytesSent = e.BodyBytesSent*10 + int(fc-'0') }
+	    action referer     { e.Referer = data[mark:p] }
+	    action user_agent  { e.UserAgent = data[mark:p] }
+
+	    # IPv4 and IPv6 addresses both fit here
+	    addr = ( xdigit | '.' | ':' )+ ;
+
+	    user = ( any - space )+ ;
+
+	    # quoted strings may contain backslash-escaped characters
+	    quoted = ( ( any - ["\\] ) | '\\' any )* ;
+
+	    main := addr >mark %remote_addr
+	            ' - ' user >mark %remote_user
+	            ' [' clf_timestamp >mark %time_local ']'
+	            ' "' quoted >mark %request '"'
+	            ' ' digit{3} $status
+	            ' ' digit+ $bytes_sent
+	            ' "' quoted >mark %referer '"'
+	            ' "' quoted >mark %user_agent '"' ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < nginx_first_final {
+		return NginxLogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var data = []byte(`93.180.71.3 - - [17/May/2015:08:05:32 +0000] "GET /downloads/product_1 HTTP/1.1" 304 0 "-" "Debian APT-HTTP/1.3 (0.8.16~exp12ubuntu10.21)"`)
+
+var hits int
+
+func TestParseNginx(t *testing.T) {
+	tests := []struct {
+		line string
+		want NginxLogEntry
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: NginxLogEntry{
+				RemoteAddr:    []byte("93.180.71.3"),
+				RemoteUser:    []byte("-"),
+				TimeLocal:     []byte("17/May/2015:08:05:32 +0000"),
+				Request:       []byte("GET /downloads/product_1 HTTP/1.1"),
+				Status:        304,
+				BodyBytesSent: 0,
+				Referer:       []byte("-"),
+				UserAgent:     []byte("Debian APT-HTTP/1.3 (0.8.16~exp12ubuntu10.21)"),
+			},
+			ok: true,
+		},
+		{
+			line: `2001:db8::ff00:42:8329 - alice [01/Jan/2020:00:00:01 -0700] "POST /api/v1/items?q=a%20b HTTP/2.0" 201 1234 "https://example.com/a b" "curl/7.68.0"`,
+			want: NginxLogEntry{
+				RemoteAddr:    []byte("2001:db8::ff00:42:8329"),
+				RemoteUser:    []byte("alice"),
+				TimeLocal:     []byte("01/Jan/2020:00:00:01 -0700"),
+				Request:       []byte("POST /api/v1/items?q=a%20b HTTP/2.0"),
+				Status:        201,
+				BodyBytesSent: 1234,
+				Referer:       []byte("https://example.com/a b"),
+				UserAgent:     []byte("curl/7.68.0"),
+			},
+			ok: true,
+		},
+		{
+			line: `::1 - - [31/Dec/1999:23:59:59 +0100] "GET / HTTP/1.0" 200 612 "-" "Mozilla/5.0 (X11; \"quoted\" Linux)"`,
+			want: NginxLogEntry{
+				RemoteAddr:    []byte("::1"),
+				RemoteUser:    []byte("-"),
+				TimeLocal:     []byte("31/Dec/1999:23:59:59 +0100"),
+				Request:       []byte("GET / HTTP/1.0"),
+				Status:        200,
+				BodyBytesSent: 612,
+				Referer:       []byte("-"),
+				UserAgent:     []byte(`Mozilla/5.0 (X11; \"quoted\" Linux)`),
+			},
+			ok: true,
+		},
+		{
+			line: `10.0.0.1 - - [17/May/2015:08:05:32 +0000] "" 400 0 "" ""`,
+			want: NginxLogEntry{
+				RemoteAddr:    []byte("10.0.0.1"),
+				RemoteUser:    []byte("-"),
+				TimeLocal:     []byte("17/May/2015:08:05:32 +0000"),
+				Request:       []byte(""),
+				Status:        400,
+				BodyBytesSent: 0,
+				Referer:       []byte(""),
+				UserAgent:     []byte(""),
+			},
+			ok: true,
+		},
+		// missing user agent
+		{line: `10.0.0.1 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 200 0 "-"`},
+		// unterminated quoted string
+		{line: `10.0.0.1 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 200 0 "-" "curl`},
+		// bad month
+		{line: `10.0.0.1 - - [17/Foo/2015:08:05:32 +0000] "GET / HTTP/1.1" 200 0 "-" "-"`},
+		// no such hour or day
+		{line: `10.0.0.1 - - [17/May/2015:24:05:32 +0000] "GET / HTTP/1.1" 200 0 "-" "-"`},
+		{line: `10.0.0.1 - - [32/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 200 0 "-" "-"`},
+		// two digit status
+		{line: `10.0.0.1 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 20 0 "-" "-"`},
+		// trailing garbage
+		{line: `10.0.0.1 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 200 0 "-" "-" x`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseNginx([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseNginx(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseNginx(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParseNginxAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{`93.180.71.3 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 30 0 "-" "-"`, 64},
+		{`93.180.71.3 - - [17/May/2015:08:05:32 +0000] "GET / HTTP/1.1" 304 0 "-"`, 71},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseNginxAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseNginxAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var reNginx = regexp.MustCompile(`^(\S+) - (\S+) \[([^\]]+)\] "((?:[^"\\]|\\.)*)" (\d{3}) (\d+) "((?:[^"\\]|\\.)*)" "((?:[^"\\]|\\.)*)"$`)
+
+func regexNginx(line []byte) (NginxLogEntry, bool) {
+	m := reNginx.FindSubmatch(line)
+	if m == nil {
+		return NginxLogEntry{}, false
+	}
+	status, _ := strconv.Atoi(string(m[5]))
+	sent, _ := strconv.Atoi(string(m[6]))
+	return NginxLogEntry{
+		RemoteAddr:    m[1],
+		RemoteUser:    m[2],
+		TimeLocal:     m[3],
+		Request:       m[4],
+		Status:        status,
+		BodyBytesSent: sent,
+		Referer:       m[7],
+		UserAgent:     m[8],
+	}, true
+}
+
+// splitNginx is the usual hand-rolled approach: it doesn't cope with
+// escaped quotes but is good enough for most logs.
+func splitNginx(line string) (NginxLogEntry, bool) {
+	q := strings.Split(line, `"`)
+	if len(q) != 7 {
+		return NginxLogEntry{}, false
+	}
+	head := strings.Split(q[0], " ")
+	if len(head) != 6 {
+		return NginxLogEntry{}, false
+	}
+	mid := strings.Split(strings.TrimSpace(q[2]), " ")
+	if len(mid) != 2 {
+		return NginxLogEntry{}, false
+	}
+	status, err := strconv.Atoi(mid[0])
+	if err != nil {
+		return NginxLogEntry{}, false
+	}
+	sent, err := strconv.Atoi(mid[1])
+	if err != nil {
+		return NginxLogEntry{}, false
+	}
+	return NginxLogEntry{
+		RemoteAddr:    []byte(head[0]),
+		RemoteUser:    []byte(head[2]),
+		TimeLocal:     []byte(strings.Trim(head[3]+" "+head[4], "[]")),
+		Request:       []byte(q[1]),
+		Status:        status,
+		BodyBytesSent: sent,
+		Referer:       []byte(q[3]),
+		UserAgent:     []byte(q[5]),
+	}, true
+}
+
+func TestNginxAlternatives(t *testing.T) {
+	want, _ := ParseNginx(data)
+	if got, ok := regexNginx(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexNginx=%+v, want %+v", got, want)
+	}
+	if got, ok := splitNginx(string(data)); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("splitNginx=%+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexNginx(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkSplit(b *testing.B) {
+	line := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := splitNginx(line); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseNginx(data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,320 @@
+
+//line oid.rl:1
+package main
+
+import (
+	"bytes"
+	"math"
+)
+
+// ParseOID parses a dotted-decimal object identifier such as
+// 1.3.6.1.2.1.1.1.0 into its arcs.  A leading '.', as printed by net-snmp,
+// is allowed.  The first arc must be 0, 1, or 2, and under 0 and 1 the
+// second arc must be less than 40, as required by X.660.  Arcs may not have
+// leading zeros.
+func ParseOID(data []byte) ([]uint32, bool) {
+	arcs, ok, _ := ParseOIDAt(data)
+	return arcs, ok
+}
+
+// ParseOIDAt is like ParseOID but also returns the offset of the first
+// unexpected byte, or -1 if data is a valid OID.
+func ParseOIDAt(data []byte) ([]uint32, bool, int) {
+
+
+//line oid.rl:23
+
+//line oid.go:28
+const oid_start int = 1
+const oid_first_final int = 7
+const oid_error int = 0
+
+const oid_en_main int = 1
+
+
+//line oid.rl:24
+
+	arcs := make([]uint32, 0, bytes.Count(data, []byte{'.'})+1)
+	var v uint64
+	overflow := -1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	
+//line oid.go:45
+	{
+	cs = oid_start
+	}
+
+//line oid.go:50
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 7:
+		goto st_case_7
+	case 5:
+		goto st_case_5
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 6:
+		goto st_case_6
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 46:
+			goto st2
+		case 50:
+			goto tr3
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 50 {
+			goto tr3
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr2
+		}
+		goto st0
+tr2:
+//line oid.rl:32
+ v = 0 
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 3; goto _out
+
+	        }
+	    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line oid.go:123
+		if data[p] == 46 {
+			goto tr4
+		}
+		goto st0
+tr4:
+//line oid.rl:41
+ arcs = append(arcs, uint32(v)) 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line oid.go:137
+		if data[p] == 48 {
+			goto tr5
+		}
+		switch {
+		case data[p] > 51:
+			if data[p] <= 57 {
+				goto tr5
+			}
+		case data[p] >= 49:
+			goto tr6
+		}
+		goto st0
+tr5:
+//line oid.rl:32
+ v = 0 
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 7; goto _out
+
+	        }
+	    
+	goto st7
+tr10:
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 7; goto _out
+
+	        }
+	    
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line oid.go:179
+		if data[p] == 46 {
+			goto tr8
+		}
+		goto st0
+tr8:
+//line oid.rl:41
+ arcs = append(arcs, uint32(v)) 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line oid.go:193
+		if data[p] == 48 {
+			goto tr5
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr7
+		}
+		goto st0
+tr7:
+//line oid.rl:32
+ v = 0 
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 8; goto _out
+
+	        }
+	    
+	goto st8
+tr9:
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 8; goto _out
+
+	        }
+	    
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line oid.go:230
+		if data[p] == 46 {
+			goto tr8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr9
+		}
+		goto st0
+tr6:
+//line oid.rl:32
+ v = 0 
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 9; goto _out
+
+	        }
+	    
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line oid.go:256
+		if data[p] == 46 {
+			goto tr8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr10
+		}
+		goto st0
+tr3:
+//line oid.rl:32
+ v = 0 
+//line oid.rl:33
+
+	        v = v*10 + uint64((data[p])-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            p++; cs = 6; goto _out
+
+	        }
+	    
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line oid.go:282
+		if data[p] == 46 {
+			goto tr8
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 7, 8, 9:
+//line oid.rl:41
+ arcs = append(arcs, uint32(v)) 
+//line oid.go:303
+		}
+	}
+
+	_out: {}
+	}
+
+//line oid.rl:52
+
+
+	switch {
+	case overflow >= 0:
+		return nil, false, overflow
+	case cs < oid_first_final:
+		return nil, false, p
+	}
+
+	return arcs, true, -1
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"math"
+)
+
+// ParseOID parses a dotted-decimal object identifier such as
+// 1.3.6.1.2.1.1.1.0 into its arcs.  A leading '.', as printed by net-snmp,
+// is allowed.  The first arc must be 0, 1, or 2, and under 0 and 1 the
+// second arc must be less than 40, as required by X.660.  Arcs may not have
+// leading zeros.
+func ParseOID(data []byte) ([]uint32, bool) {
+	arcs, ok, _ := ParseOIDAt(data)
+	return arcs, ok
+}
+
+// ParseOIDAt is like ParseOID but also returns the offset of the first
+// unexpected byte, or -1 if data is a valid OID.
+func ParseOIDAt(data []byte) ([]uint32, bool, int) {
+
+%% machine oid;
+%% write data;
+
+	arcs := make([]uint32, 0, bytes.Count(data, []byte{'.'})+1)
+	var v uint64
+	overflow := -1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	%%{
+	    action start { v = 0 }
+	    action digit {
+	        v = v*10 + uint64(fc-'0')
+	        if v > math.MaxUint32 {
+	            overflow = p
+	            fbreak;
+	        }
+	    }
+
+	    action arc { arcs = append(arcs, uint32(v)) }
+
+	    arc = ( '0' | '1'..'9' digit* ) >start $digit %arc ;
+	    under_01 = ( digit | '1'..'3' digit ) >start $digit %arc ;
+
+	    main := '.'? ( [01] >start $digit %arc '.' under_01
+	                 | '2' >start $digit %arc '.' arc )
+	            ( '.' arc )* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	switch {
+	case overflow >= 0:
+		return nil, false, overflow
+	case cs < oid_first_final:
+		return nil, false, p
+	}
+
+	return arcs, true, -1
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var data = []byte("1.3.6.1.2.1.1.1.0")
+
+var hits int
+
+func TestParseOID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []uint32
+		ok   bool
+	}{
+		{"1.3.6.1.2.1.1.1.0", []uint32{1, 3, 6, 1, 2, 1, 1, 1, 0}, true},
+		{".1.3.6.1.4.1.2021.10.1.3.1", []uint32{1, 3, 6, 1, 4, 1, 2021, 10, 1, 3, 1}, true},
+		{"0.0", []uint32{0, 0}, true},
+		{"0.39", []uint32{0, 39}, true},
+		{"1.39.1", []uint32{1, 39, 1}, true},
+		{"2.999.3", []uint32{2, 999, 3}, true},
+		{"2.25.4294967295", []uint32{2, 25, 4294967295}, true},
+
+		{in: "3.1"},
+		{in: "0.40"},
+		{in: "1.40"},
+		{in: "1"},
+		{in: "1."},
+		{in: "1..3"},
+		{in: "1.3."},
+		{in: "..1.3"},
+		{in: "1.03"},
+		{in: "2.25.4294967296"},
+		{in: "2.25.99999999999"},
+		{in: "1.3.6.a"},
+		{in: "iso.3.6"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseOID([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseOID(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseOID(%q)=%v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseOIDAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"1.3.06.1", 5},
+		{"3.1", 0},
+		{"1.3.", 4},
+		{"1.3.4294967296", 13},
+		{"2.4294967295.99999999999", 22},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseOIDAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseOIDAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func splitOID(s string) ([]uint32, bool) {
+	parts := strings.Split(strings.TrimPrefix(s, "."), ".")
+	if len(parts) < 2 {
+		return nil, false
+	}
+	arcs := make([]uint32, len(parts))
+	for i, p := range parts {
+		v, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return nil, false
+		}
+		arcs[i] = uint32(v)
+	}
+	if arcs[0] > 2 || (arcs[0] < 2 && arcs[1] >= 40) {
+		return nil, false
+	}
+	return arcs, true
+}
+
+func TestOIDAlternatives(t *testing.T) {
+	want, _ := ParseOID(data)
+	if got, ok := splitOID(string(data)); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("splitOID=%v, want %v", got, want)
+	}
+}
+
+func BenchmarkSplit(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := splitOID(s); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseOID(data); ok {
+			hits++
+		}
+	}
+}
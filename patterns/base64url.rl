@@ -0,0 +1,15 @@
+%%{
+    # The URL and filename safe Base64 alphabet from RFC 4648 section 5,
+    # without padding, as JOSE uses it.  A final group of a single character
+    # can't hold a whole byte, so it isn't allowed.  Only the syntax is
+    # checked: DecodeBase64URL in base64/ adds its decoding actions and
+    # optional padding on top.
+    #
+    # base64url sets no variables.
+
+    machine base64url;
+
+    base64url_char = alnum | [\-_] ;
+
+    base64url = base64url_char{4}* base64url_char{2,3}? ;
+}%%
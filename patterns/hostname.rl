@@ -0,0 +1,15 @@
+%%{
+    # Host names made of RFC 1123 labels: letters, digits, and hyphens, not
+    # starting or ending with a hyphen, and at most 63 characters.  A trailing
+    # dot isn't allowed.  Since labels may be all digits, this also matches an
+    # IPv4 address.
+    #
+    # hostname sets no variables; capture it with entering and leaving actions
+    # where it is used.
+
+    machine hostname;
+
+    label = alnum ( ( alnum | '-' ){0,61} alnum )? ;
+
+    hostname = label ( '.' label )* ;
+}%%
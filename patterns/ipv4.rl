@@ -0,0 +1,19 @@
+%%{
+    # Dotted-quad IPv4 addresses.
+    #
+    # ipv4 sets no variables; capture it with entering and leaving actions where
+    # it is used, for example
+    #
+    #	ipv4 >mark %addr
+
+    machine ipv4;
+
+    # 0-255 without leading zeros
+    dec_octet = digit
+              | '1'..'9' digit
+              | '1' digit{2}
+              | '2' '0'..'4' digit
+              | '25' '0'..'5' ;
+
+    ipv4 = dec_octet '.' dec_octet '.' dec_octet '.' dec_octet ;
+}%%
@@ -0,0 +1,25 @@
+%%{
+    # IPv6 addresses in the text forms from RFC 4291 section 2.2, including "::"
+    # compression and a trailing dotted-quad, written out as in RFC 3986.  Zone
+    # IDs aren't included.
+    #
+    # ipv6 sets no variables; capture it with entering and leaving actions where
+    # it is used.
+
+    machine ipv6;
+
+    include ipv4 "ipv4.rl";
+
+    h16 = xdigit{1,4} ;
+    ls32 = h16 ':' h16 | ipv4 ;
+
+    ipv6 =                            ( h16 ':' ){6} ls32
+         |                       '::' ( h16 ':' ){5} ls32
+         | (                h16 )? '::' ( h16 ':' ){4} ls32
+         | ( ( h16 ':' ){,1} h16 )? '::' ( h16 ':' ){3} ls32
+         | ( ( h16 ':' ){,2} h16 )? '::' ( h16 ':' ){2} ls32
+         | ( ( h16 ':' ){,3} h16 )? '::'   h16 ':'      ls32
+         | ( ( h16 ':' ){,4} h16 )? '::'                ls32
+         | ( ( h16 ':' ){,5} h16 )? '::'                h16
+         | ( ( h16 ':' ){,6} h16 )? '::' ;
+}%%
@@ -0,0 +1,12 @@
+%%{
+    # Process IDs as they appear in log lines, such as the 42327 in
+    # "sshd[42327]:".  Linux allows up to 2^22, so at most seven digits.
+    #
+    # pid sets pid, an int which must be in scope and zero beforehand.
+
+    machine pid;
+
+    action pid_digit { pid = pid*10 + int(fc-'0') }
+
+    pid = ( '1'..'9' digit{0,6} ) $pid_digit ;
+}%%
@@ -0,0 +1,27 @@
+%%{
+    # Timestamps found in log files.
+    #
+    #	syslog_timestamp  Jan 18 06:41:30, from RFC 3164, with the day padded
+    #	                  with a space
+    #	clf_timestamp     10/Oct/2000:13:55:36 -0700, from the Common Log Format
+    #	                  used by Apache and nginx, without the brackets
+    #
+    # These set no variables; capture them with entering and leaving actions
+    # where they are used.
+
+    machine timestamp;
+
+    month = 'Jan' | 'Feb' | 'Mar' | 'Apr' | 'May' | 'Jun' |
+            'Jul' | 'Aug' | 'Sep' | 'Oct' | 'Nov' | 'Dec' ;
+
+    hour = '0'..'1' digit | '2' '0'..'3' ;
+    minute = '0'..'5' digit ;
+    second = '0'..'5' digit ;
+    hms = hour ':' minute ':' second ;
+
+    syslog_day = ' ' '1'..'9' | '1'..'2' digit | '3' '0'..'1' ;
+    syslog_timestamp = month ' ' syslog_day ' ' hms ;
+
+    clf_day = '0' '1'..'9' | '1'..'2' digit | '3' '0'..'1' ;
+    clf_timestamp = clf_day '/' month '/' digit{4} ':' hms ' ' [+\-] digit{4} ;
+}%%
@@ -0,0 +1,3800 @@
+
+//line pglog.rl:1
+package main
+
+import "bytes"
+
+// PgLogEntry holds the fields of a PostgreSQL log line.  The byte slices
+// point into the parsed line, except for csvlog values that contained
+// doubled quotes.
+type PgLogEntry struct {
+	Timestamp   []byte
+	PID         int
+	LineNum     int
+	User        []byte
+	Database    []byte
+	Application []byte
+	Client      []byte
+	Severity    []byte
+	Message     []byte
+}
+
+func pgValue(v []byte, escaped bool) []byte {
+	if escaped {
+		return bytes.ReplaceAll(v, []byte(`""`), []byte(`"`))
+	}
+	return v
+}
+
+// ParsePgLog parses a line from the stderr log written with
+//
+//	log_line_prefix = '%t [%p]: [%l-1] user=%u,db=%d,app=%a,client=%h '
+//
+// %m in place of %t is also accepted.  Fields PostgreSQL leaves blank, such
+// as the user of a background process, are reported as empty.
+func ParsePgLog(line []byte) (PgLogEntry, bool) {
+	e, ok, _ := parsePgLog(line, false)
+	return e, ok
+}
+
+// ParsePgLogAt is like ParsePgLog but also returns the offset of the first
+// byte that doesn't fit the prefix, or -1 if the line parsed.
+func ParsePgLogAt(line []byte) (PgLogEntry, bool, int) {
+	return parsePgLog(line, false)
+}
+
+// ParsePgCSVLog parses a record written with log_destination = 'csvlog' by
+// PostgreSQL 14 or later.  Client includes the port for TCP connections.
+// A trailing newline is allowed.
+func ParsePgCSVLog(line []byte) (PgLogEntry, bool) {
+	e, ok, _ := parsePgLog(line, true)
+	return e, ok
+}
+
+// ParsePgCSVLogAt is like ParsePgCSVLog but also returns the offset of the
+// first byte that is out of place in the record, or -1 if it parsed.
+func ParsePgCSVLogAt(line []byte) (PgLogEntry, bool, int) {
+	return parsePgLog(line, true)
+}
+
+func parsePgLog(data []byte, csv bool) (PgLogEntry, bool, int) {
+
+
+//line pglog.rl:61
+
+//line pglog.go:66
+const pglog_start int = 1
+const pglog_first_final int = 253
+const pglog_error int = 0
+
+const pglog_en_main int = 1
+const pglog_en_csvlog int = 132
+
+
+//line pglog.rl:62
+
+	var e PgLogEntry
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+
+	
+//line pglog.go:85
+	{
+	cs = pglog_start
+	}
+
+//line pglog.rl:138
+
+
+	if csv {
+		cs = pglog_en_csvlog
+	}
+
+	
+//line pglog.go:98
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 253:
+		goto st_case_253
+	case 254:
+		goto st_case_254
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 153:
+		goto st_case_153
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 180:
+		goto st_case_180
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 185:
+		goto st_case_185
+	case 186:
+		goto st_case_186
+	case 187:
+		goto st_case_187
+	case 188:
+		goto st_case_188
+	case 189:
+		goto st_case_189
+	case 190:
+		goto st_case_190
+	case 191:
+		goto st_case_191
+	case 192:
+		goto st_case_192
+	case 193:
+		goto st_case_193
+	case 194:
+		goto st_case_194
+	case 195:
+		goto st_case_195
+	case 196:
+		goto st_case_196
+	case 197:
+		goto st_case_197
+	case 198:
+		goto st_case_198
+	case 199:
+		goto st_case_199
+	case 200:
+		goto st_case_200
+	case 201:
+		goto st_case_201
+	case 202:
+		goto st_case_202
+	case 203:
+		goto st_case_203
+	case 204:
+		goto st_case_204
+	case 205:
+		goto st_case_205
+	case 206:
+		goto st_case_206
+	case 207:
+		goto st_case_207
+	case 208:
+		goto st_case_208
+	case 209:
+		goto st_case_209
+	case 210:
+		goto st_case_210
+	case 211:
+		goto st_case_211
+	case 212:
+		goto st_case_212
+	case 213:
+		goto st_case_213
+	case 214:
+		goto st_case_214
+	case 215:
+		goto st_case_215
+	case 216:
+		goto st_case_216
+	case 217:
+		goto st_case_217
+	case 218:
+		goto st_case_218
+	case 255:
+		goto st_case_255
+	case 256:
+		goto st_case_256
+	case 219:
+		goto st_case_219
+	case 220:
+		goto st_case_220
+	case 221:
+		goto st_case_221
+	case 222:
+		goto st_case_222
+	case 223:
+		goto st_case_223
+	case 224:
+		goto st_case_224
+	case 225:
+		goto st_case_225
+	case 226:
+		goto st_case_226
+	case 227:
+		goto st_case_227
+	case 228:
+		goto st_case_228
+	case 229:
+		goto st_case_229
+	case 230:
+		goto st_case_230
+	case 231:
+		goto st_case_231
+	case 232:
+		goto st_case_232
+	case 233:
+		goto st_case_233
+	case 234:
+		goto st_case_234
+	case 235:
+		goto st_case_235
+	case 236:
+		goto st_case_236
+	case 237:
+		goto st_case_237
+	case 238:
+		goto st_case_238
+	case 239:
+		goto st_case_239
+	case 240:
+		goto st_case_240
+	case 241:
+		goto st_case_241
+	case 242:
+		goto st_case_242
+	case 243:
+		goto st_case_243
+	case 244:
+		goto st_case_244
+	case 245:
+		goto st_case_245
+	case 246:
+		goto st_case_246
+	case 247:
+		goto st_case_247
+	case 248:
+		goto st_case_248
+	case 249:
+		goto st_case_249
+	case 250:
+		goto st_case_250
+	case 251:
+		goto st_case_251
+	case 252:
+		goto st_case_252
+	}
+	goto st_out
+	st_case_1:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line pglog.rl:71
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line pglog.go:638
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 45 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if data[p] == 45 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 32 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 58 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 58 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 32:
+			goto st21
+		case 46:
+			goto st128
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch data[p] {
+		case 43:
+			goto st22
+		case 45:
+			goto st22
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st127
+			}
+		case data[p] >= 65:
+			goto st127
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st125
+		}
+		goto st0
+tr26:
+//line pglog.rl:74
+ e.Timestamp = data[mark:p] 
+	goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+//line pglog.go:867
+		if data[p] == 91 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+tr29:
+//line pglog.rl:75
+ e.PID = e.PID*10 + int((data[p])-'0') 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line pglog.go:890
+		if data[p] == 93 {
+			goto st28
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 58 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 32 {
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 91 {
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr34
+		}
+		goto st0
+tr34:
+//line pglog.rl:76
+ e.LineNum = e.LineNum*10 + int((data[p])-'0') 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line pglog.go:943
+		if data[p] == 45 {
+			goto st33
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr34
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 93 {
+			goto st35
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st34
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 32 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 117 {
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 115 {
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 101 {
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 114 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 61 {
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 44 {
+			goto tr45
+		}
+		goto tr44
+tr44:
+//line pglog.rl:71
+ mark = p 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line pglog.go:1044
+		if data[p] == 44 {
+			goto tr47
+		}
+		goto st42
+tr45:
+//line pglog.rl:71
+ mark = p 
+//line pglog.rl:77
+ e.User = pgValue(data[mark:p], escaped) 
+	goto st43
+tr47:
+//line pglog.rl:77
+ e.User = pgValue(data[mark:p], escaped) 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line pglog.go:1064
+		if data[p] == 100 {
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 98 {
+			goto st45
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 61 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 44 {
+			goto tr52
+		}
+		goto tr51
+tr51:
+//line pglog.rl:71
+ mark = p 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line pglog.go:1105
+		if data[p] == 44 {
+			goto tr54
+		}
+		goto st47
+tr52:
+//line pglog.rl:71
+ mark = p 
+//line pglog.rl:78
+ e.Database = pgValue(data[mark:p], escaped) 
+	goto st48
+tr54:
+//line pglog.rl:78
+ e.Database = pgValue(data[mark:p], escaped) 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line pglog.go:1125
+		if data[p] == 97 {
+			goto st49
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 112 {
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 112 {
+			goto st51
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 61 {
+			goto st52
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		if data[p] == 44 {
+			goto tr60
+		}
+		goto tr59
+tr59:
+//line pglog.rl:71
+ mark = p 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line pglog.go:1175
+		if data[p] == 44 {
+			goto tr62
+		}
+		goto st53
+tr60:
+//line pglog.rl:71
+ mark = p 
+//line pglog.rl:79
+ e.Application = pgValue(data[mark:p], escaped) 
+	goto st54
+tr62:
+//line pglog.rl:79
+ e.Application = pgValue(data[mark:p], escaped) 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line pglog.go:1195
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 99:
+			goto st55
+		}
+		goto st53
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 108:
+			goto st56
+		}
+		goto st53
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 105:
+			goto st57
+		}
+		goto st53
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 101:
+			goto st58
+		}
+		goto st53
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 110:
+			goto st59
+		}
+		goto st53
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 116:
+			goto st60
+		}
+		goto st53
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 44:
+			goto tr62
+		case 61:
+			goto st61
+		}
+		goto st53
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 32 {
+			goto tr71
+		}
+		goto tr70
+tr70:
+//line pglog.rl:71
+ mark = p 
+	goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+//line pglog.go:1293
+		if data[p] == 32 {
+			goto tr73
+		}
+		goto st62
+tr71:
+//line pglog.rl:71
+ mark = p 
+//line pglog.rl:80
+ e.Client = pgValue(data[mark:p], escaped) 
+	goto st63
+tr73:
+//line pglog.rl:80
+ e.Client = pgValue(data[mark:p], escaped) 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line pglog.go:1313
+		switch data[p] {
+		case 67:
+			goto tr74
+		case 68:
+			goto tr75
+		case 69:
+			goto tr76
+		case 70:
+			goto tr77
+		case 72:
+			goto tr78
+		case 73:
+			goto tr79
+		case 76:
+			goto tr80
+		case 78:
+			goto tr81
+		case 80:
+			goto tr82
+		case 81:
+			goto tr83
+		case 83:
+			goto tr84
+		case 87:
+			goto tr85
+		}
+		goto st0
+tr74:
+//line pglog.rl:71
+ mark = p 
+	goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+//line pglog.go:1350
+		if data[p] == 79 {
+			goto st65
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 78 {
+			goto st66
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 84 {
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 69 {
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 88 {
+			goto st69
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 84 {
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 58 {
+			goto tr92
+		}
+		goto st0
+tr92:
+//line pglog.rl:81
+ e.Severity = data[mark:p] 
+	goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+//line pglog.go:1418
+		if data[p] == 32 {
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 32 {
+			goto st253
+		}
+		goto st0
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		goto tr285
+tr285:
+//line pglog.rl:71
+ mark = p 
+	goto st254
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+//line pglog.go:1447
+		goto st254
+tr75:
+//line pglog.rl:71
+ mark = p 
+	goto st73
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+//line pglog.go:1458
+		if data[p] == 69 {
+			goto st74
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 66:
+			goto st75
+		case 84:
+			goto st78
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 85 {
+			goto st76
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 71 {
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if 49 <= data[p] && data[p] <= 53 {
+			goto st70
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 65 {
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 73 {
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 76 {
+			goto st70
+		}
+		goto st0
+tr76:
+//line pglog.rl:71
+ mark = p 
+	goto st81
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+//line pglog.go:1538
+		if data[p] == 82 {
+			goto st82
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 82 {
+			goto st83
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 79 {
+			goto st84
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 82 {
+			goto st70
+		}
+		goto st0
+tr77:
+//line pglog.rl:71
+ mark = p 
+	goto st85
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+//line pglog.go:1579
+		if data[p] == 65 {
+			goto st86
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 84 {
+			goto st87
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 65 {
+			goto st80
+		}
+		goto st0
+tr78:
+//line pglog.rl:71
+ mark = p 
+	goto st88
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+//line pglog.go:1611
+		if data[p] == 73 {
+			goto st89
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		if data[p] == 78 {
+			goto st69
+		}
+		goto st0
+tr79:
+//line pglog.rl:71
+ mark = p 
+	goto st90
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+//line pglog.go:1634
+		if data[p] == 78 {
+			goto st91
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 70 {
+			goto st92
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 79 {
+			goto st70
+		}
+		goto st0
+tr80:
+//line pglog.rl:71
+ mark = p 
+	goto st93
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+//line pglog.go:1666
+		if data[p] == 79 {
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch data[p] {
+		case 67:
+			goto st95
+		case 71:
+			goto st70
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		if data[p] == 65 {
+			goto st96
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		if data[p] == 84 {
+			goto st97
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 73 {
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 79 {
+			goto st99
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		if data[p] == 78 {
+			goto st70
+		}
+		goto st0
+tr81:
+//line pglog.rl:71
+ mark = p 
+	goto st100
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+//line pglog.go:1737
+		if data[p] == 79 {
+			goto st101
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 84 {
+			goto st102
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 73 {
+			goto st103
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 67 {
+			goto st104
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 69 {
+			goto st70
+		}
+		goto st0
+tr82:
+//line pglog.rl:71
+ mark = p 
+	goto st105
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+//line pglog.go:1787
+		if data[p] == 65 {
+			goto st106
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 78 {
+			goto st107
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 73 {
+			goto st108
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 67 {
+			goto st70
+		}
+		goto st0
+tr83:
+//line pglog.rl:71
+ mark = p 
+	goto st109
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+//line pglog.go:1828
+		if data[p] == 85 {
+			goto st110
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 69 {
+			goto st111
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 82 {
+			goto st112
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 89 {
+			goto st70
+		}
+		goto st0
+tr84:
+//line pglog.rl:71
+ mark = p 
+	goto st113
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+//line pglog.go:1869
+		if data[p] == 84 {
+			goto st114
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 65 {
+			goto st115
+		}
+		goto st0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 84 {
+			goto st116
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		if data[p] == 69 {
+			goto st117
+		}
+		goto st0
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		if data[p] == 77 {
+			goto st118
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 69 {
+			goto st89
+		}
+		goto st0
+tr85:
+//line pglog.rl:71
+ mark = p 
+	goto st119
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+//line pglog.go:1928
+		if data[p] == 65 {
+			goto st120
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 82 {
+			goto st121
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 78 {
+			goto st122
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 73 {
+			goto st123
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 78 {
+			goto st124
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 71 {
+			goto st70
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st126
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		if data[p] == 32 {
+			goto tr26
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 32 {
+			goto tr26
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st127
+			}
+		case data[p] >= 65:
+			goto st127
+		}
+		goto st0
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st129
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st130
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st131
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		if data[p] == 32 {
+			goto st21
+		}
+		goto st0
+	st_case_132:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr140
+		}
+		goto st0
+tr140:
+//line pglog.rl:71
+ mark = p 
+	goto st133
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+//line pglog.go:2063
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st134
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st135
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st136
+		}
+		goto st0
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		if data[p] == 45 {
+			goto st137
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st138
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st139
+		}
+		goto st0
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		if data[p] == 45 {
+			goto st140
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st141
+		}
+		goto st0
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st142
+		}
+		goto st0
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		if data[p] == 32 {
+			goto st143
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st144
+		}
+		goto st0
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st145
+		}
+		goto st0
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		if data[p] == 58 {
+			goto st146
+		}
+		goto st0
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st147
+		}
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st148
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 58 {
+			goto st149
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st150
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st151
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		switch data[p] {
+		case 32:
+			goto st152
+		case 46:
+			goto st249
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		switch data[p] {
+		case 43:
+			goto st153
+		case 45:
+			goto st153
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st248
+			}
+		case data[p] >= 65:
+			goto st248
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st154
+		}
+		goto st0
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st155
+		}
+		goto st0
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		if data[p] == 44 {
+			goto tr165
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st246
+		}
+		goto st0
+tr165:
+//line pglog.rl:74
+ e.Timestamp = data[mark:p] 
+	goto st156
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+//line pglog.go:2292
+		switch data[p] {
+		case 34:
+			goto st157
+		case 44:
+			goto st160
+		}
+		goto st0
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		if data[p] == 34 {
+			goto tr170
+		}
+		goto tr169
+tr169:
+//line pglog.rl:72
+ mark = p; escaped = false 
+	goto st158
+tr173:
+//line pglog.rl:73
+ escaped = true 
+	goto st158
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+//line pglog.go:2322
+		if data[p] == 34 {
+			goto tr172
+		}
+		goto st158
+tr170:
+//line pglog.rl:72
+ mark = p; escaped = false 
+//line pglog.rl:77
+ e.User = pgValue(data[mark:p], escaped) 
+	goto st159
+tr172:
+//line pglog.rl:77
+ e.User = pgValue(data[mark:p], escaped) 
+	goto st159
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+//line pglog.go:2342
+		switch data[p] {
+		case 34:
+			goto tr173
+		case 44:
+			goto st160
+		}
+		goto st0
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch data[p] {
+		case 34:
+			goto st161
+		case 44:
+			goto st164
+		}
+		goto st0
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		if data[p] == 34 {
+			goto tr177
+		}
+		goto tr176
+tr176:
+//line pglog.rl:72
+ mark = p; escaped = false 
+	goto st162
+tr180:
+//line pglog.rl:73
+ escaped = true 
+	goto st162
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+//line pglog.go:2384
+		if data[p] == 34 {
+			goto tr179
+		}
+		goto st162
+tr177:
+//line pglog.rl:72
+ mark = p; escaped = false 
+//line pglog.rl:78
+ e.Database = pgValue(data[mark:p], escaped) 
+	goto st163
+tr179:
+//line pglog.rl:78
+ e.Database = pgValue(data[mark:p], escaped) 
+	goto st163
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+//line pglog.go:2404
+		switch data[p] {
+		case 34:
+			goto tr180
+		case 44:
+			goto st164
+		}
+		goto st0
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr181
+		}
+		goto st0
+tr181:
+//line pglog.rl:75
+ e.PID = e.PID*10 + int((data[p])-'0') 
+	goto st165
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+//line pglog.go:2430
+		if data[p] == 44 {
+			goto st166
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr181
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch data[p] {
+		case 34:
+			goto st167
+		case 44:
+			goto st170
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 34 {
+			goto tr186
+		}
+		goto tr185
+tr185:
+//line pglog.rl:72
+ mark = p; escaped = false 
+	goto st168
+tr189:
+//line pglog.rl:73
+ escaped = true 
+	goto st168
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+//line pglog.go:2472
+		if data[p] == 34 {
+			goto tr188
+		}
+		goto st168
+tr186:
+//line pglog.rl:72
+ mark = p; escaped = false 
+//line pglog.rl:80
+ e.Client = pgValue(data[mark:p], escaped) 
+	goto st169
+tr188:
+//line pglog.rl:80
+ e.Client = pgValue(data[mark:p], escaped) 
+	goto st169
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+//line pglog.go:2492
+		switch data[p] {
+		case 34:
+			goto tr189
+		case 44:
+			goto st170
+		}
+		goto st0
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st171
+		}
+		goto st170
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr191
+		}
+		goto st0
+tr191:
+//line pglog.rl:76
+ e.LineNum = e.LineNum*10 + int((data[p])-'0') 
+	goto st172
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+//line pglog.go:2532
+		if data[p] == 44 {
+			goto st173
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr191
+		}
+		goto st0
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		switch data[p] {
+		case 34:
+			goto st174
+		case 44:
+			goto st176
+		}
+		goto st0
+tr196:
+//line pglog.rl:73
+ escaped = true 
+	goto st174
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+//line pglog.go:2561
+		if data[p] == 34 {
+			goto st175
+		}
+		goto st174
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		switch data[p] {
+		case 34:
+			goto tr196
+		case 44:
+			goto st176
+		}
+		goto st0
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st177
+		}
+		goto st176
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st178
+		}
+		goto st177
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st179
+		}
+		goto st178
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		switch data[p] {
+		case 68:
+			goto tr200
+		case 69:
+			goto tr201
+		case 70:
+			goto tr202
+		case 73:
+			goto tr203
+		case 76:
+			goto tr204
+		case 78:
+			goto tr205
+		case 80:
+			goto tr206
+		case 87:
+			goto tr207
+		}
+		goto st0
+tr200:
+//line pglog.rl:71
+ mark = p 
+	goto st180
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+//line pglog.go:2653
+		if data[p] == 69 {
+			goto st181
+		}
+		goto st0
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		if data[p] == 66 {
+			goto st182
+		}
+		goto st0
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 85 {
+			goto st183
+		}
+		goto st0
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 71 {
+			goto st184
+		}
+		goto st0
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		if 49 <= data[p] && data[p] <= 53 {
+			goto st185
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		if data[p] == 44 {
+			goto tr213
+		}
+		goto st0
+tr213:
+//line pglog.rl:81
+ e.Severity = data[mark:p] 
+	goto st186
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+//line pglog.go:2712
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st187
+		}
+		goto st186
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 34 {
+			goto st188
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		if data[p] == 34 {
+			goto tr218
+		}
+		goto tr217
+tr217:
+//line pglog.rl:72
+ mark = p; escaped = false 
+	goto st189
+tr221:
+//line pglog.rl:73
+ escaped = true 
+	goto st189
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+//line pglog.go:2753
+		if data[p] == 34 {
+			goto tr220
+		}
+		goto st189
+tr218:
+//line pglog.rl:72
+ mark = p; escaped = false 
+//line pglog.rl:82
+ e.Message = pgValue(data[mark:p], escaped) 
+	goto st190
+tr220:
+//line pglog.rl:82
+ e.Message = pgValue(data[mark:p], escaped) 
+	goto st190
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+//line pglog.go:2773
+		switch data[p] {
+		case 34:
+			goto tr221
+		case 44:
+			goto st191
+		}
+		goto st0
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		switch data[p] {
+		case 34:
+			goto st192
+		case 44:
+			goto st194
+		}
+		goto st0
+tr226:
+//line pglog.rl:73
+ escaped = true 
+	goto st192
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+//line pglog.go:2802
+		if data[p] == 34 {
+			goto st193
+		}
+		goto st192
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		switch data[p] {
+		case 34:
+			goto tr226
+		case 44:
+			goto st194
+		}
+		goto st0
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		switch data[p] {
+		case 34:
+			goto st195
+		case 44:
+			goto st197
+		}
+		goto st0
+tr230:
+//line pglog.rl:73
+ escaped = true 
+	goto st195
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+//line pglog.go:2840
+		if data[p] == 34 {
+			goto st196
+		}
+		goto st195
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		switch data[p] {
+		case 34:
+			goto tr230
+		case 44:
+			goto st197
+		}
+		goto st0
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		switch data[p] {
+		case 34:
+			goto st198
+		case 44:
+			goto st200
+		}
+		goto st0
+tr234:
+//line pglog.rl:73
+ escaped = true 
+	goto st198
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+//line pglog.go:2878
+		if data[p] == 34 {
+			goto st199
+		}
+		goto st198
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		switch data[p] {
+		case 34:
+			goto tr234
+		case 44:
+			goto st200
+		}
+		goto st0
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st201
+		}
+		goto st200
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch data[p] {
+		case 34:
+			goto st202
+		case 44:
+			goto st204
+		}
+		goto st0
+tr239:
+//line pglog.rl:73
+ escaped = true 
+	goto st202
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+//line pglog.go:2930
+		if data[p] == 34 {
+			goto st203
+		}
+		goto st202
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		switch data[p] {
+		case 34:
+			goto tr239
+		case 44:
+			goto st204
+		}
+		goto st0
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		switch data[p] {
+		case 34:
+			goto st205
+		case 44:
+			goto st207
+		}
+		goto st0
+tr243:
+//line pglog.rl:73
+ escaped = true 
+	goto st205
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+//line pglog.go:2968
+		if data[p] == 34 {
+			goto st206
+		}
+		goto st205
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 44:
+			goto st207
+		}
+		goto st0
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st208
+		}
+		goto st207
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		switch data[p] {
+		case 34:
+			goto st209
+		case 44:
+			goto st211
+		}
+		goto st0
+tr248:
+//line pglog.rl:73
+ escaped = true 
+	goto st209
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+//line pglog.go:3020
+		if data[p] == 34 {
+			goto st210
+		}
+		goto st209
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		switch data[p] {
+		case 34:
+			goto tr248
+		case 44:
+			goto st211
+		}
+		goto st0
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		switch data[p] {
+		case 34:
+			goto st212
+		case 44:
+			goto st215
+		}
+		goto st0
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		if data[p] == 34 {
+			goto tr252
+		}
+		goto tr251
+tr251:
+//line pglog.rl:72
+ mark = p; escaped = false 
+	goto st213
+tr255:
+//line pglog.rl:73
+ escaped = true 
+	goto st213
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+//line pglog.go:3071
+		if data[p] == 34 {
+			goto tr254
+		}
+		goto st213
+tr252:
+//line pglog.rl:72
+ mark = p; escaped = false 
+//line pglog.rl:79
+ e.Application = pgValue(data[mark:p], escaped) 
+	goto st214
+tr254:
+//line pglog.rl:79
+ e.Application = pgValue(data[mark:p], escaped) 
+	goto st214
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+//line pglog.go:3091
+		switch data[p] {
+		case 34:
+			goto tr255
+		case 44:
+			goto st215
+		}
+		goto st0
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		switch data[p] {
+		case 34:
+			goto st216
+		case 44:
+			goto st218
+		}
+		goto st0
+tr259:
+//line pglog.rl:73
+ escaped = true 
+	goto st216
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+//line pglog.go:3120
+		if data[p] == 34 {
+			goto st217
+		}
+		goto st216
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		switch data[p] {
+		case 34:
+			goto tr259
+		case 44:
+			goto st218
+		}
+		goto st0
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto st0
+		case 44:
+			goto st255
+		}
+		goto st218
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		switch data[p] {
+		case 10:
+			goto st256
+		case 34:
+			goto st0
+		case 44:
+			goto st0
+		}
+		goto st255
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		goto st0
+tr201:
+//line pglog.rl:71
+ mark = p 
+	goto st219
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+//line pglog.go:3180
+		if data[p] == 82 {
+			goto st220
+		}
+		goto st0
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		if data[p] == 82 {
+			goto st221
+		}
+		goto st0
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		if data[p] == 79 {
+			goto st222
+		}
+		goto st0
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		if data[p] == 82 {
+			goto st185
+		}
+		goto st0
+tr202:
+//line pglog.rl:71
+ mark = p 
+	goto st223
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+//line pglog.go:3221
+		if data[p] == 65 {
+			goto st224
+		}
+		goto st0
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		if data[p] == 84 {
+			goto st225
+		}
+		goto st0
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 65 {
+			goto st226
+		}
+		goto st0
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 76 {
+			goto st185
+		}
+		goto st0
+tr203:
+//line pglog.rl:71
+ mark = p 
+	goto st227
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+//line pglog.go:3262
+		if data[p] == 78 {
+			goto st228
+		}
+		goto st0
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if data[p] == 70 {
+			goto st229
+		}
+		goto st0
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 79 {
+			goto st185
+		}
+		goto st0
+tr204:
+//line pglog.rl:71
+ mark = p 
+	goto st230
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+//line pglog.go:3294
+		if data[p] == 79 {
+			goto st231
+		}
+		goto st0
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 71 {
+			goto st185
+		}
+		goto st0
+tr205:
+//line pglog.rl:71
+ mark = p 
+	goto st232
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+//line pglog.go:3317
+		if data[p] == 79 {
+			goto st233
+		}
+		goto st0
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		if data[p] == 84 {
+			goto st234
+		}
+		goto st0
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 73 {
+			goto st235
+		}
+		goto st0
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if data[p] == 67 {
+			goto st236
+		}
+		goto st0
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		if data[p] == 69 {
+			goto st185
+		}
+		goto st0
+tr206:
+//line pglog.rl:71
+ mark = p 
+	goto st237
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+//line pglog.go:3367
+		if data[p] == 65 {
+			goto st238
+		}
+		goto st0
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		if data[p] == 78 {
+			goto st239
+		}
+		goto st0
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		if data[p] == 73 {
+			goto st240
+		}
+		goto st0
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		if data[p] == 67 {
+			goto st185
+		}
+		goto st0
+tr207:
+//line pglog.rl:71
+ mark = p 
+	goto st241
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+//line pglog.go:3408
+		if data[p] == 65 {
+			goto st242
+		}
+		goto st0
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		if data[p] == 82 {
+			goto st243
+		}
+		goto st0
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		if data[p] == 78 {
+			goto st244
+		}
+		goto st0
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		if data[p] == 73 {
+			goto st245
+		}
+		goto st0
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 78 {
+			goto st231
+		}
+		goto st0
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st247
+		}
+		goto st0
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		if data[p] == 44 {
+			goto tr165
+		}
+		goto st0
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		if data[p] == 44 {
+			goto tr165
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st248
+			}
+		case data[p] >= 65:
+			goto st248
+		}
+		goto st0
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st250
+		}
+		goto st0
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st251
+		}
+		goto st0
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st252
+		}
+		goto st0
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		if data[p] == 32 {
+			goto st152
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof253: cs = 253; goto _test_eof
+	_test_eof254: cs = 254; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+	_test_eof187: cs = 187; goto _test_eof
+	_test_eof188: cs = 188; goto _test_eof
+	_test_eof189: cs = 189; goto _test_eof
+	_test_eof190: cs = 190; goto _test_eof
+	_test_eof191: cs = 191; goto _test_eof
+	_test_eof192: cs = 192; goto _test_eof
+	_test_eof193: cs = 193; goto _test_eof
+	_test_eof194: cs = 194; goto _test_eof
+	_test_eof195: cs = 195; goto _test_eof
+	_test_eof196: cs = 196; goto _test_eof
+	_test_eof197: cs = 197; goto _test_eof
+	_test_eof198: cs = 198; goto _test_eof
+	_test_eof199: cs = 199; goto _test_eof
+	_test_eof200: cs = 200; goto _test_eof
+	_test_eof201: cs = 201; goto _test_eof
+	_test_eof202: cs = 202; goto _test_eof
+	_test_eof203: cs = 203; goto _test_eof
+	_test_eof204: cs = 204; goto _test_eof
+	_test_eof205: cs = 205; goto _test_eof
+	_test_eof206: cs = 206; goto _test_eof
+	_test_eof207: cs = 207; goto _test_eof
+	_test_eof208: cs = 208; goto _test_eof
+	_test_eof209: cs = 209; goto _test_eof
+	_test_eof210: cs = 210; goto _test_eof
+	_test_eof211: cs = 211; goto _test_eof
+	_test_eof212: cs = 212; goto _test_eof
+	_test_eof213: cs = 213; goto _test_eof
+	_test_eof214: cs = 214; goto _test_eof
+	_test_eof215: cs = 215; goto _test_eof
+	_test_eof216: cs = 216; goto _test_eof
+	_test_eof217: cs = 217; goto _test_eof
+	_test_eof218: cs = 218; goto _test_eof
+	_test_eof255: cs = 255; goto _test_eof
+	_test_eof256: cs = 256; goto _test_eof
+	_test_eof219: cs = 219; goto _test_eof
+	_test_eof220: cs = 220; goto _test_eof
+	_test_eof221: cs = 221; goto _test_eof
+	_test_eof222: cs = 222; goto _test_eof
+	_test_eof223: cs = 223; goto _test_eof
+	_test_eof224: cs = 224; goto _test_eof
+	_test_eof225: cs = 225; goto _test_eof
+	_test_eof226: cs = 226; goto _test_eof
+	_test_eof227: cs = 227; goto _test_eof
+	_test_eof228: cs = 228; goto _test_eof
+	_test_eof229: cs = 229; goto _test_eof
+	_test_eof230: cs = 230; goto _test_eof
+	_test_eof231: cs = 231; goto _test_eof
+	_test_eof232: cs = 232; goto _test_eof
+	_test_eof233: cs = 233; goto _test_eof
+	_test_eof234: cs = 234; goto _test_eof
+	_test_eof235: cs = 235; goto _test_eof
+	_test_eof236: cs = 236; goto _test_eof
+	_test_eof237: cs = 237; goto _test_eof
+	_test_eof238: cs = 238; goto _test_eof
+	_test_eof239: cs = 239; goto _test_eof
+	_test_eof240: cs = 240; goto _test_eof
+	_test_eof241: cs = 241; goto _test_eof
+	_test_eof242: cs = 242; goto _test_eof
+	_test_eof243: cs = 243; goto _test_eof
+	_test_eof244: cs = 244; goto _test_eof
+	_test_eof245: cs = 245; goto _test_eof
+	_test_eof246: cs = 246; goto _test_eof
+	_test_eof247: cs = 247; goto _test_eof
+	_test_eof248: cs = 248; goto _test_eof
+	_test_eof249: cs = 249; goto _test_eof
+	_test_eof250: cs = 250; goto _test_eof
+	_test_eof251: cs = 251; goto _test_eof
+	_test_eof252: cs = 252; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 253:
+//line pglog.rl:71
+ mark = p 
+//line pglog.rl:82
+ e.Message = pgValue(data[mark:p], escaped) 
+		case 254:
+//line pglog.rl:82
+ e.Message = pgValue(data[mark:p], escaped) 
+//line pglog.go:3787
+		}
+	}
+
+	_out: {}
+	}
+
+//line pglog.rl:145
+
+	if cs < pglog_first_final {
+		return PgLogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
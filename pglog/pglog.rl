@@ -0,0 +1,151 @@
+package main
+
+import "bytes"
+
+// PgLogEntry holds the fields of a PostgreSQL log line.  The byte slices
+// point into the parsed line, except for csvlog values that contained
+// doubled quotes.
+type PgLogEntry struct {
+	Timestamp   []byte
+	PID         int
+	LineNum     int
+	User        []byte
+	Database    []byte
+	Application []byte
+	Client      []byte
+	Severity    []byte
+	Message     []byte
+}
+
+func pgValue(v []byte, escaped bool) []byte {
+	if escaped {
+		return bytes.ReplaceAll(v, []byte(`""`), []byte(`"`))
+	}
+	return v
+}
+
+// ParsePgLog parses a line from the stderr log written with
+//
+//	log_line_prefix = '%t [%p]: [%l-1] user=%u,db=%d,app=%a,client=%h '
+//
+// %m in place of %t is also accepted.  Fields PostgreSQL leaves blank, such
+// as the user of a background process, are reported as empty.
+func ParsePgLog(line []byte) (PgLogEntry, bool) {
+	e, ok, _ := parsePgLog(line, false)
+	return e, ok
+}
+
+// ParsePgLogAt is like ParsePgLog but also returns the offset of the first
+// byte that doesn't fit the prefix, or -1 if the line parsed.
+func ParsePgLogAt(line []byte) (PgLogEntry, bool, int) {
+	return parsePgLog(line, false)
+}
+
+// ParsePgCSVLog parses a record written with log_destination = 'csvlog' by
+// PostgreSQL 14 or later.  Client includes the port for TCP connections.
+// A trailing newline is allowed.
+func ParsePgCSVLog(line []byte) (PgLogEntry, bool) {
+	e, ok, _ := parsePgLog(line, true)
+	return e, ok
+}
+
+// ParsePgCSVLogAt is like ParsePgCSVLog but also returns the offset of the
+// first byte that is out of place in the record, or -1 if it parsed.
+func ParsePgCSVLogAt(line []byte) (PgLogEntry, bool, int) {
+	return parsePgLog(line, true)
+}
+
+func parsePgLog(data []byte, csv bool) (PgLogEntry, bool, int) {
+
+%% machine pglog;
+%% write data;
+
+	var e PgLogEntry
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+
+	%%{
+	    action mark        { mark = p }
+	    action qmark       { mark = p; escaped = false }
+	    action escaped     { escaped = true }
+	    action timestamp   { e.Timestamp = data[mark:p] }
+	    action pid         { e.PID = e.PID*10 + int(fc-'0') }
+	    action line_num    { e.LineNum = e.LineNum*10 + int(fc-'0') }
+	    action user        { e.User = pgValue(data[mark:p], escaped) }
+	    action database    { e.Database = pgValue(data[mark:p], escaped) }
+	    action application { e.Application = pgValue(data[mark:p], escaped) }
+	    action client      { e.Client = pgValue(data[mark:p], escaped) }
+	    action severity    { e.Severity = data[mark:p] }
+	    action message     { e.Message = pgValue(data[mark:p], escaped) }
+
+	    # %t and %m print the zone abbreviation from the tz database, which
+	    # is a bare offset such as "+03" for some zones
+	    zone = alpha+ | [+\-] digit{2} digit{2}? ;
+	    timestamp = digit{4} '-' digit{2} '-' digit{2} ' ' digit{2} ':' digit{2} ':' digit{2} ( '.' digit{3} )? ' ' zone ;
+
+	    level = 'DEBUG' '1'..'5' | 'INFO' | 'NOTICE' | 'WARNING' | 'ERROR' | 'LOG' | 'FATAL' | 'PANIC' ;
+
+	    # the stderr log writes the parts of a report that csvlog gives
+	    # columns of their own as lines of their own
+	    severity = level | 'DETAIL' | 'HINT' | 'QUERY' | 'CONTEXT' | 'LOCATION' | 'STATEMENT' ;
+
+	    main := timestamp >mark %timestamp
+	            ' [' digit+ $pid ']: [' digit+ $line_num '-' digit+ '] '
+	            'user=' [^,]* >mark %user
+	            ',db=' [^,]* >mark %database
+	            # application_name may contain anything
+	            ',app=' ( any* -- ',client=' ) >mark %application
+	            ',client=' [^ ]* >mark %client
+	            ' ' severity >mark %severity
+	            ':  ' any* >mark %message ;
+
+	    text = ( ( any - '"' ) | '""' @escaped )* ;
+	    quoted = '"' text '"' ;
+	    plain = [^,"\n]* ;
+
+	    csvlog := timestamp >mark %timestamp
+	              ',' ( '"' text >qmark %user '"' )?
+	              ',' ( '"' text >qmark %database '"' )?
+	              ',' digit+ $pid
+	              ',' ( '"' text >qmark %client '"' )?
+	              ',' plain                            # session_id
+	              ',' digit+ $line_num
+	              ',' quoted?                          # command_tag
+	              ',' plain                            # session_start_time
+	              ',' plain                            # virtual_transaction_id
+	              ',' plain                            # transaction_id
+	              ',' level >mark %severity
+	              ',' plain                            # sql_state_code
+	              ',' '"' text >qmark %message '"'
+	              ',' quoted?                          # detail
+	              ',' quoted?                          # hint
+	              ',' quoted?                          # internal_query
+	              ',' plain                            # internal_query_pos
+	              ',' quoted?                          # context
+	              ',' quoted?                          # query
+	              ',' plain                            # query_pos
+	              ',' quoted?                          # location
+	              ',' ( '"' text >qmark %application '"' )?
+	              ',' quoted?                          # backend_type
+	              ',' plain                            # leader_pid
+	              ',' plain                            # query_id
+	              '\n'? ;
+
+	    write init;
+	}%%
+
+	if csv {
+		cs = pglog_en_csvlog
+	}
+
+	%% write exec;
+
+	if cs < pglog_first_final {
+		return PgLogEntry{}, false, p
+	}
+
+	return e, true, -1
+}
@@ -0,0 +1,354 @@
+package main
+
+import (
+	"encoding/csv"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+// sample lines from PostgreSQL 14 and 15 servers
+var stderrLine = []byte(`2023-06-01 14:05:43 UTC [3122]: [3-1] user=app,db=shop,app=psql,client=10.0.0.12 ERROR:  relation "orders_old" does not exist at character 15`)
+
+var csvLine = []byte(`2023-06-01 14:05:43.201 UTC,"app","shop",3122,"10.0.0.12:52144",6478a5f1.c32,3,"SELECT",2023-06-01 14:05:37 UTC,4/17,0,ERROR,42P01,"relation ""orders_old"" does not exist",,,,,,"SELECT * FROM orders_old;",15,,"psql","client backend",,0` + "\n")
+
+var hits int
+
+func TestParsePgLog(t *testing.T) {
+	tests := []struct {
+		line string
+		want PgLogEntry
+		ok   bool
+	}{
+		{
+			line: string(stderrLine),
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-06-01 14:05:43 UTC"),
+				PID:         3122,
+				LineNum:     3,
+				User:        []byte("app"),
+				Database:    []byte("shop"),
+				Application: []byte("psql"),
+				Client:      []byte("10.0.0.12"),
+				Severity:    []byte("ERROR"),
+				Message:     []byte(`relation "orders_old" does not exist at character 15`),
+			},
+			ok: true,
+		},
+		{
+			line: `2023-06-01 14:05:43 UTC [3122]: [4-1] user=app,db=shop,app=psql,client=10.0.0.12 STATEMENT:  SELECT * FROM orders_old;`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-06-01 14:05:43 UTC"),
+				PID:         3122,
+				LineNum:     4,
+				User:        []byte("app"),
+				Database:    []byte("shop"),
+				Application: []byte("psql"),
+				Client:      []byte("10.0.0.12"),
+				Severity:    []byte("STATEMENT"),
+				Message:     []byte("SELECT * FROM orders_old;"),
+			},
+			ok: true,
+		},
+		{
+			// background processes have no user, database, or client
+			line: `2023-06-01 14:02:11 UTC [2811]: [1-1] user=,db=,app=,client= LOG:  checkpoint starting: time`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-06-01 14:02:11 UTC"),
+				PID:         2811,
+				LineNum:     1,
+				User:        []byte(""),
+				Database:    []byte(""),
+				Application: []byte(""),
+				Client:      []byte(""),
+				Severity:    []byte("LOG"),
+				Message:     []byte("checkpoint starting: time"),
+			},
+			ok: true,
+		},
+		{
+			line: `2023-06-01 14:06:02 UTC [3190]: [1-1] user=[unknown],db=[unknown],app=[unknown],client=10.0.0.9 LOG:  connection received: host=10.0.0.9 port=52188`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-06-01 14:06:02 UTC"),
+				PID:         3190,
+				LineNum:     1,
+				User:        []byte("[unknown]"),
+				Database:    []byte("[unknown]"),
+				Application: []byte("[unknown]"),
+				Client:      []byte("10.0.0.9"),
+				Severity:    []byte("LOG"),
+				Message:     []byte("connection received: host=10.0.0.9 port=52188"),
+			},
+			ok: true,
+		},
+		{
+			// PostgreSQL 15 with %m, a numeric zone, and spaces in the application name
+			line: `2023-02-10 09:00:01.512 +03 [4410]: [2-1] user=postgres,db=postgres,app=pgAdmin 4 - DB:postgres,client=[local] FATAL:  terminating connection due to administrator command`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-02-10 09:00:01.512 +03"),
+				PID:         4410,
+				LineNum:     2,
+				User:        []byte("postgres"),
+				Database:    []byte("postgres"),
+				Application: []byte("pgAdmin 4 - DB:postgres"),
+				Client:      []byte("[local]"),
+				Severity:    []byte("FATAL"),
+				Message:     []byte("terminating connection due to administrator command"),
+			},
+			ok: true,
+		},
+		{
+			// the application name ends at the first ",client="
+			line: `2023-02-10 09:00:01 CET [4410]: [2-1] user=u,db=d,app=a,b,client=x,client=[local] DEBUG2:  x`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-02-10 09:00:01 CET"),
+				PID:         4410,
+				LineNum:     2,
+				User:        []byte("u"),
+				Database:    []byte("d"),
+				Application: []byte("a,b"),
+				Client:      []byte("x,client=[local]"),
+				Severity:    []byte("DEBUG2"),
+				Message:     []byte("x"),
+			},
+			ok: true,
+		},
+		// one space after the severity
+		{line: `2023-06-01 14:02:11 UTC [2811]: [1-1] user=,db=,app=,client= LOG: checkpoint starting: time`},
+		// unknown severity
+		{line: `2023-06-01 14:02:11 UTC [2811]: [1-1] user=,db=,app=,client= TRACE:  x`},
+		// some other log_line_prefix
+		{line: `2023-06-01 14:02:11 UTC [2811] LOG:  checkpoint starting: time`},
+		{line: `2023-06-01 14:02:11 [2811]: [1-1] user=,db=,app=,client= LOG:  x`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePgLog([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParsePgLog(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePgLog(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParsePgLogAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(stderrLine), -1},
+		// the database name runs on looking for the app= that never comes
+		{`2023-06-01 14:05:43 UTC [3122]: [3-1] user=app,db=shop ERROR:  x`, 64},
+		{`2023-06-01 14:05:43 UTC 3122: ERROR:  x`, 24},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParsePgLogAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParsePgLogAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestParsePgCSVLog(t *testing.T) {
+	tests := []struct {
+		line string
+		want PgLogEntry
+		ok   bool
+	}{
+		{
+			line: string(csvLine),
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-06-01 14:05:43.201 UTC"),
+				PID:         3122,
+				LineNum:     3,
+				User:        []byte("app"),
+				Database:    []byte("shop"),
+				Application: []byte("psql"),
+				Client:      []byte("10.0.0.12:52144"),
+				Severity:    []byte("ERROR"),
+				Message:     []byte(`relation "orders_old" does not exist`),
+			},
+			ok: true,
+		},
+		{
+			// the postmaster has no user, database, or client
+			line: `2022-05-10 12:34:56.789 UTC,,,1,,627a5b90.1,1,,2022-05-10 12:34:56 UTC,,0,LOG,00000,"starting PostgreSQL 14.2 on x86_64-pc-linux-gnu, compiled by gcc (GCC) 8.5.0, 64-bit",,,,,,,,,"","postmaster",,0`,
+			want: PgLogEntry{
+				Timestamp:   []byte("2022-05-10 12:34:56.789 UTC"),
+				PID:         1,
+				LineNum:     1,
+				Application: []byte(""),
+				Severity:    []byte("LOG"),
+				Message:     []byte("starting PostgreSQL 14.2 on x86_64-pc-linux-gnu, compiled by gcc (GCC) 8.5.0, 64-bit"),
+			},
+			ok: true,
+		},
+		{
+			// PostgreSQL 15, with a statement spanning lines
+			line: "2023-02-10 09:00:01.512 CET,\"postgres\",\"postgres\",4410,\"[local]\",63e5f9a1.113a,2,\"idle\",2023-02-10 09:00:00 CET,3/5,0,LOG,00000,\"statement: SELECT 1\n  FROM pg_class;\",,,,,,,,,\"psql\",\"client backend\",,-4846527573322205403\n",
+			want: PgLogEntry{
+				Timestamp:   []byte("2023-02-10 09:00:01.512 CET"),
+				PID:         4410,
+				LineNum:     2,
+				User:        []byte("postgres"),
+				Database:    []byte("postgres"),
+				Application: []byte("psql"),
+				Client:      []byte("[local]"),
+				Severity:    []byte("LOG"),
+				Message:     []byte("statement: SELECT 1\n  FROM pg_class;"),
+			},
+			ok: true,
+		},
+		// PostgreSQL 13 has no leader_pid or query_id
+		{line: `2022-05-10 12:34:56.789 UTC,,,1,,627a5b90.1,1,,2022-05-10 12:34:56 UTC,,0,LOG,00000,"starting",,,,,,,,,"","postmaster"`},
+		// DETAIL is a column of its own
+		{line: `2022-05-10 12:34:56.789 UTC,,,1,,627a5b90.1,1,,2022-05-10 12:34:56 UTC,,0,DETAIL,00000,"x",,,,,,,,,"","postmaster",,0`},
+		// unterminated quote
+		{line: `2022-05-10 12:34:56.789 UTC,,,1,,627a5b90.1,1,,2022-05-10 12:34:56 UTC,,0,LOG,00000,"x,,,,,,,,,"","postmaster",,0`},
+		// a stderr line is not a csvlog record
+		{line: string(stderrLine)},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePgCSVLog([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParsePgCSVLog(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePgCSVLog(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestParsePgCSVLogAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(csvLine), -1},
+		{`2023-06-01 14:05:43.201 UTC,"app`, 32},
+		{`2023-06-01 14:05:43 UTC,"app","shop",x`, 37},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParsePgCSVLogAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParsePgCSVLogAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var rePgLog = regexp.MustCompile(`^(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d(?:\.\d{3})? (?:[A-Za-z]+|[+-]\d{2}(?:\d{2})?)) \[(\d+)\]: \[(\d+)-\d+\] user=([^,]*),db=([^,]*),app=(.*?),client=([^ ]*) (DEBUG[1-5]|INFO|NOTICE|WARNING|ERROR|LOG|FATAL|PANIC|DETAIL|HINT|QUERY|CONTEXT|LOCATION|STATEMENT):  (.*)$`)
+
+func regexPgLog(line []byte) (PgLogEntry, bool) {
+	m := rePgLog.FindSubmatch(line)
+	if m == nil {
+		return PgLogEntry{}, false
+	}
+	pid, _ := strconv.Atoi(string(m[2]))
+	n, _ := strconv.Atoi(string(m[3]))
+	return PgLogEntry{
+		Timestamp:   m[1],
+		PID:         pid,
+		LineNum:     n,
+		User:        m[4],
+		Database:    m[5],
+		Application: m[6],
+		Client:      m[7],
+		Severity:    m[8],
+		Message:     m[9],
+	}, true
+}
+
+func encodingCSVPgLog(r *csv.Reader) (PgLogEntry, bool) {
+	rec, err := r.Read()
+	if err != nil || len(rec) != 26 {
+		return PgLogEntry{}, false
+	}
+	pid, err := strconv.Atoi(rec[3])
+	if err != nil {
+		return PgLogEntry{}, false
+	}
+	n, err := strconv.Atoi(rec[6])
+	if err != nil {
+		return PgLogEntry{}, false
+	}
+	return PgLogEntry{
+		Timestamp:   []byte(rec[0]),
+		PID:         pid,
+		LineNum:     n,
+		User:        []byte(rec[1]),
+		Database:    []byte(rec[2]),
+		Application: []byte(rec[22]),
+		Client:      []byte(rec[4]),
+		Severity:    []byte(rec[11]),
+		Message:     []byte(rec[13]),
+	}, true
+}
+
+func TestPgLogAlternatives(t *testing.T) {
+	want, _ := ParsePgLog(stderrLine)
+	if got, ok := regexPgLog(stderrLine); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexPgLog=%+v, want %+v", got, want)
+	}
+
+	want, _ = ParsePgCSVLog(csvLine)
+	r := csv.NewReader(&repeatReader{b: csvLine})
+	if got, ok := encodingCSVPgLog(r); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("encodingCSVPgLog=%+v, want %+v", got, want)
+	}
+}
+
+// repeatReader supplies csv.Reader with an endless stream of the same record.
+type repeatReader struct {
+	b []byte
+	i int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	n := copy(p, r.b[r.i:])
+	r.i = (r.i + n) % len(r.b)
+	return n, nil
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexPgLog(stderrLine); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParsePgLog(stderrLine); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkEncodingCSV(b *testing.B) {
+	r := csv.NewReader(&repeatReader{b: csvLine})
+	r.ReuseRecord = true
+	for i := 0; i < b.N; i++ {
+		if _, ok := encodingCSVPgLog(r); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagelCSV(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParsePgCSVLog(csvLine); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,1650 @@
+
+//line prometheus.rl:1
+package main
+
+import "strconv"
+
+// PromLineKind says what sort of line ParsePrometheusLine found.
+type PromLineKind int
+
+const (
+	PromSample PromLineKind = iota
+	PromHelp
+	PromType
+	PromComment
+)
+
+// PromMetric is a single line of the Prometheus text exposition format.
+// For a sample, Name, Labels, Value, and Timestamp are set; Timestamp is
+// zero when the line has none.  For a "# HELP" or "# TYPE" line, Name is
+// the metric described and Text is the docstring or type.  Blank lines and
+// other comments are reported as PromComment with nothing else set.
+//
+// The byte slices point into the parsed line except for label values and
+// docstrings containing escapes.
+type PromMetric struct {
+	Kind      PromLineKind
+	Name      []byte
+	Labels    []PromLabel
+	Value     float64
+	Timestamp int64
+	Text      []byte
+}
+
+// PromLabel is a label attached to a sample.  Value has had its \" \\ and
+// \n escapes removed.
+type PromLabel struct {
+	Name  []byte
+	Value []byte
+}
+
+func unescapeProm(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) {
+			i++
+			if v[i] == 'n' {
+				out = append(out, '\n')
+				continue
+			}
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParsePrometheusLine parses one line, without its trailing newline, of the
+// text format served from a Prometheus /metrics endpoint, such as
+//
+//	http_requests_total{method="GET",code="200"} 1234.5 1395066363000
+//
+// Values may be any float understood by strconv.ParseFloat's decimal
+// syntax, or NaN, +Inf, or -Inf.  Only the five metric types from the
+// format specification are accepted on a TYPE line, and only the \\ and
+// \n escapes in a docstring.
+func ParsePrometheusLine(data []byte) (PromMetric, bool) {
+	m, ok, _ := ParsePrometheusLineAt(data)
+	return m, ok
+}
+
+// ParsePrometheusLineAt is like ParsePrometheusLine but also returns the
+// offset of the first byte out of place in the line, or -1 if there is
+// none.
+func ParsePrometheusLineAt(data []byte) (PromMetric, bool, int) {
+
+
+//line prometheus.rl:74
+
+//line prometheus.go:79
+const prometheus_start int = 59
+const prometheus_first_final int = 59
+const prometheus_error int = 0
+
+const prometheus_en_main int = 59
+
+
+//line prometheus.rl:75
+
+	var m PromMetric
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name []byte
+	escaped := false
+	neg := false
+
+	
+//line prometheus.go:99
+	{
+	cs = prometheus_start
+	}
+
+//line prometheus.go:104
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 59:
+		goto st_case_59
+	case 0:
+		goto st_case_0
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 3:
+		goto st_case_3
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 42:
+		goto st_case_42
+	case 76:
+		goto st_case_76
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 79:
+		goto st_case_79
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	}
+	goto st_out
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch data[p] {
+		case 9:
+			goto st59
+		case 32:
+			goto st59
+		case 35:
+			goto st60
+		case 58:
+			goto tr81
+		case 95:
+			goto tr81
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr81
+			}
+		case data[p] >= 65:
+			goto tr81
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 9:
+			goto st62
+		case 10:
+			goto st0
+		case 32:
+			goto st62
+		}
+		goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 10 {
+			goto st0
+		}
+		goto st61
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 9:
+			goto st62
+		case 10:
+			goto st0
+		case 32:
+			goto st62
+		case 72:
+			goto st63
+		case 84:
+			goto st69
+		}
+		goto st61
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 69:
+			goto st64
+		}
+		goto st61
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 76:
+			goto st65
+		}
+		goto st61
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 80:
+			goto st1
+		}
+		goto st61
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 9:
+			goto tr1
+		case 10:
+			goto st0
+		case 32:
+			goto tr1
+		}
+		goto st61
+tr1:
+//line prometheus.rl:108
+ m.Kind = PromHelp; escaped = false 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line prometheus.go:402
+		switch data[p] {
+		case 9:
+			goto st2
+		case 32:
+			goto st2
+		case 58:
+			goto tr4
+		case 95:
+			goto tr4
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr4
+			}
+		case data[p] >= 65:
+			goto tr4
+		}
+		goto st0
+tr4:
+//line prometheus.rl:86
+ mark = p 
+	goto st66
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+//line prometheus.go:431
+		switch data[p] {
+		case 9:
+			goto tr88
+		case 32:
+			goto tr88
+		case 95:
+			goto st66
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st66
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto st0
+tr88:
+//line prometheus.rl:87
+ m.Name = data[mark:p] 
+	goto st67
+tr91:
+//line prometheus.rl:86
+ mark = p 
+	goto st67
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+//line prometheus.go:466
+		switch data[p] {
+		case 9:
+			goto tr91
+		case 10:
+			goto st0
+		case 32:
+			goto tr91
+		case 92:
+			goto tr92
+		}
+		goto tr90
+tr90:
+//line prometheus.rl:86
+ mark = p 
+	goto st68
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+//line prometheus.go:487
+		switch data[p] {
+		case 10:
+			goto st0
+		case 92:
+			goto tr93
+		}
+		goto st68
+tr92:
+//line prometheus.rl:86
+ mark = p 
+//line prometheus.rl:90
+ escaped = true 
+	goto st3
+tr93:
+//line prometheus.rl:90
+ escaped = true 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line prometheus.go:510
+		switch data[p] {
+		case 92:
+			goto st68
+		case 110:
+			goto st68
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 89:
+			goto st70
+		}
+		goto st61
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 80:
+			goto st71
+		}
+		goto st61
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 69:
+			goto st4
+		}
+		goto st61
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 9:
+			goto tr6
+		case 10:
+			goto st0
+		case 32:
+			goto tr6
+		}
+		goto st61
+tr6:
+//line prometheus.rl:109
+ m.Kind = PromType 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line prometheus.go:577
+		switch data[p] {
+		case 9:
+			goto st5
+		case 32:
+			goto st5
+		case 58:
+			goto tr8
+		case 95:
+			goto tr8
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr8
+			}
+		case data[p] >= 65:
+			goto tr8
+		}
+		goto st0
+tr8:
+//line prometheus.rl:86
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line prometheus.go:606
+		switch data[p] {
+		case 9:
+			goto tr9
+		case 32:
+			goto tr9
+		case 95:
+			goto st6
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st6
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+tr9:
+//line prometheus.rl:87
+ m.Name = data[mark:p] 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line prometheus.go:637
+		switch data[p] {
+		case 9:
+			goto st7
+		case 32:
+			goto st7
+		case 99:
+			goto tr12
+		case 103:
+			goto tr13
+		case 104:
+			goto tr14
+		case 115:
+			goto tr15
+		case 117:
+			goto tr16
+		}
+		goto st0
+tr12:
+//line prometheus.rl:86
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line prometheus.go:664
+		if data[p] == 111 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if data[p] == 117 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 110 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 116 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 101 {
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 114 {
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 9:
+			goto tr97
+		case 32:
+			goto tr97
+		}
+		goto st0
+tr97:
+//line prometheus.rl:112
+
+	        if escaped {
+	            m.Text = unescapeProm(data[mark:p])
+	        } else {
+	            m.Text = data[mark:p]
+	        }
+	    
+	goto st73
+tr103:
+//line prometheus.rl:106
+ if neg { m.Timestamp = -m.Timestamp } 
+	goto st73
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+//line prometheus.go:745
+		switch data[p] {
+		case 9:
+			goto st73
+		case 32:
+			goto st73
+		}
+		goto st0
+tr13:
+//line prometheus.rl:86
+ mark = p 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line prometheus.go:762
+		if data[p] == 97 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if data[p] == 117 {
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 103 {
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 101 {
+			goto st72
+		}
+		goto st0
+tr14:
+//line prometheus.rl:86
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line prometheus.go:803
+		if data[p] == 105 {
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 115 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 116 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 111 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 103 {
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 114 {
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 97 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 109 {
+			goto st72
+		}
+		goto st0
+tr15:
+//line prometheus.rl:86
+ mark = p 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line prometheus.go:880
+		if data[p] == 117 {
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 109 {
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 109 {
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 97 {
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 114 {
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if data[p] == 121 {
+			goto st72
+		}
+		goto st0
+tr16:
+//line prometheus.rl:86
+ mark = p 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line prometheus.go:939
+		if data[p] == 110 {
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 116 {
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 121 {
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 112 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 101 {
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 100 {
+			goto st72
+		}
+		goto st0
+tr81:
+//line prometheus.rl:86
+ mark = p 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line prometheus.go:998
+		switch data[p] {
+		case 9:
+			goto tr43
+		case 32:
+			goto tr43
+		case 95:
+			goto st38
+		case 123:
+			goto tr45
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 58 {
+				goto st38
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto st0
+tr43:
+//line prometheus.rl:87
+ m.Name = data[mark:p] 
+	goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+//line prometheus.go:1031
+		switch data[p] {
+		case 9:
+			goto st39
+		case 32:
+			goto st39
+		case 43:
+			goto tr47
+		case 45:
+			goto tr47
+		case 46:
+			goto tr48
+		case 73:
+			goto tr50
+		case 78:
+			goto tr51
+		case 123:
+			goto st49
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr49
+		}
+		goto st0
+tr47:
+//line prometheus.rl:86
+ mark = p 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line prometheus.go:1063
+		switch data[p] {
+		case 46:
+			goto st41
+		case 73:
+			goto st45
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st78
+		}
+		goto st0
+tr48:
+//line prometheus.rl:86
+ mark = p 
+	goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+//line prometheus.go:1083
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st74
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 9:
+			goto tr99
+		case 32:
+			goto tr99
+		case 69:
+			goto st43
+		case 101:
+			goto st43
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st74
+		}
+		goto st0
+tr99:
+//line prometheus.rl:102
+ m.Value, _ = strconv.ParseFloat(string(data[mark:p]), 64) 
+	goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+//line prometheus.go:1116
+		switch data[p] {
+		case 9:
+			goto st75
+		case 32:
+			goto st75
+		case 45:
+			goto tr102
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr57
+		}
+		goto st0
+tr102:
+//line prometheus.rl:104
+ neg = true 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line prometheus.go:1138
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr57
+		}
+		goto st0
+tr57:
+//line prometheus.rl:105
+ m.Timestamp = m.Timestamp*10 + int64((data[p])-'0') 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line prometheus.go:1152
+		switch data[p] {
+		case 9:
+			goto tr103
+		case 32:
+			goto tr103
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr57
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 43:
+			goto st44
+		case 45:
+			goto st44
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st77
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch data[p] {
+		case 9:
+			goto tr99
+		case 32:
+			goto tr99
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st77
+		}
+		goto st0
+tr49:
+//line prometheus.rl:86
+ mark = p 
+	goto st78
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+//line prometheus.go:1211
+		switch data[p] {
+		case 9:
+			goto tr99
+		case 32:
+			goto tr99
+		case 46:
+			goto st74
+		case 69:
+			goto st43
+		case 101:
+			goto st43
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st78
+		}
+		goto st0
+tr50:
+//line prometheus.rl:86
+ mark = p 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line prometheus.go:1237
+		if data[p] == 110 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 102 {
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch data[p] {
+		case 9:
+			goto tr99
+		case 32:
+			goto tr99
+		}
+		goto st0
+tr51:
+//line prometheus.rl:86
+ mark = p 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line prometheus.go:1272
+		if data[p] == 97 {
+			goto st48
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 78 {
+			goto st79
+		}
+		goto st0
+tr45:
+//line prometheus.rl:87
+ m.Name = data[mark:p] 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line prometheus.go:1295
+		switch data[p] {
+		case 9:
+			goto st49
+		case 32:
+			goto st49
+		case 95:
+			goto tr63
+		case 125:
+			goto st56
+		}
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr63
+			}
+		case data[p] >= 65:
+			goto tr63
+		}
+		goto st0
+tr63:
+//line prometheus.rl:86
+ mark = p 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line prometheus.go:1324
+		switch data[p] {
+		case 9:
+			goto tr65
+		case 32:
+			goto tr65
+		case 61:
+			goto tr67
+		case 95:
+			goto st50
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st50
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st50
+			}
+		default:
+			goto st50
+		}
+		goto st0
+tr65:
+//line prometheus.rl:89
+ name = data[mark:p]; escaped = false 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line prometheus.go:1357
+		switch data[p] {
+		case 9:
+			goto st51
+		case 32:
+			goto st51
+		case 61:
+			goto st52
+		}
+		goto st0
+tr67:
+//line prometheus.rl:89
+ name = data[mark:p]; escaped = false 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line prometheus.go:1376
+		switch data[p] {
+		case 9:
+			goto st52
+		case 32:
+			goto st52
+		case 34:
+			goto st53
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto tr72
+		case 92:
+			goto tr73
+		}
+		goto tr71
+tr71:
+//line prometheus.rl:86
+ mark = p 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line prometheus.go:1409
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto tr75
+		case 92:
+			goto tr76
+		}
+		goto st54
+tr72:
+//line prometheus.rl:86
+ mark = p 
+//line prometheus.rl:92
+
+	        if escaped {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: unescapeProm(data[mark:p])})
+	        } else {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: data[mark:p]})
+	        }
+	    
+	goto st55
+tr75:
+//line prometheus.rl:92
+
+	        if escaped {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: unescapeProm(data[mark:p])})
+	        } else {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: data[mark:p]})
+	        }
+	    
+	goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+//line prometheus.go:1446
+		switch data[p] {
+		case 9:
+			goto st55
+		case 32:
+			goto st55
+		case 44:
+			goto st49
+		case 125:
+			goto st56
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 9:
+			goto st57
+		case 32:
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 9:
+			goto st57
+		case 32:
+			goto st57
+		case 43:
+			goto tr47
+		case 45:
+			goto tr47
+		case 46:
+			goto tr48
+		case 73:
+			goto tr50
+		case 78:
+			goto tr51
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr49
+		}
+		goto st0
+tr73:
+//line prometheus.rl:86
+ mark = p 
+//line prometheus.rl:90
+ escaped = true 
+	goto st58
+tr76:
+//line prometheus.rl:90
+ escaped = true 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line prometheus.go:1510
+		switch data[p] {
+		case 34:
+			goto st54
+		case 92:
+			goto st54
+		case 110:
+			goto st54
+		}
+		goto st0
+	st_out:
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 59, 60, 61, 62, 63, 64, 65, 69, 70, 71:
+//line prometheus.rl:110
+ m.Kind = PromComment 
+		case 66:
+//line prometheus.rl:87
+ m.Name = data[mark:p] 
+		case 67:
+//line prometheus.rl:86
+ mark = p 
+//line prometheus.rl:112
+
+	        if escaped {
+	            m.Text = unescapeProm(data[mark:p])
+	        } else {
+	            m.Text = data[mark:p]
+	        }
+	    
+		case 68, 72:
+//line prometheus.rl:112
+
+	        if escaped {
+	            m.Text = unescapeProm(data[mark:p])
+	        } else {
+	            m.Text = data[mark:p]
+	        }
+	    
+		case 74, 77, 78, 79:
+//line prometheus.rl:102
+ m.Value, _ = strconv.ParseFloat(string(data[mark:p]), 64) 
+		case 76:
+//line prometheus.rl:106
+ if neg { m.Timestamp = -m.Timestamp } 
+//line prometheus.go:1636
+		}
+	}
+
+	_out: {}
+	}
+
+//line prometheus.rl:154
+
+
+	if cs < prometheus_first_final {
+		return PromMetric{}, false, p
+	}
+
+	return m, true, -1
+}
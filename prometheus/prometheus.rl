@@ -0,0 +1,161 @@
+package main
+
+import "strconv"
+
+// PromLineKind says what sort of line ParsePrometheusLine found.
+type PromLineKind int
+
+const (
+	PromSample PromLineKind = iota
+	PromHelp
+	PromType
+	PromComment
+)
+
+// PromMetric is a single line of the Prometheus text exposition format.
+// For a sample, Name, Labels, Value, and Timestamp are set; Timestamp is
+// zero when the line has none.  For a "# HELP" or "# TYPE" line, Name is
+// the metric described and Text is the docstring or type.  Blank lines and
+// other comments are reported as PromComment with nothing else set.
+//
+// The byte slices point into the parsed line except for label values and
+// docstrings containing escapes.
+type PromMetric struct {
+	Kind      PromLineKind
+	Name      []byte
+	Labels    []PromLabel
+	Value     float64
+	Timestamp int64
+	Text      []byte
+}
+
+// PromLabel is a label attached to a sample.  Value has had its \" \\ and
+// \n escapes removed.
+type PromLabel struct {
+	Name  []byte
+	Value []byte
+}
+
+func unescapeProm(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) {
+			i++
+			if v[i] == 'n' {
+				out = append(out, '\n')
+				continue
+			}
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParsePrometheusLine parses one line, without its trailing newline, of the
+// text format served from a Prometheus /metrics endpoint, such as
+//
+//	http_requests_total{method="GET",code="200"} 1234.5 1395066363000
+//
+// Values may be any float understood by strconv.ParseFloat's decimal
+// syntax, or NaN, +Inf, or -Inf.  Only the five metric types from the
+// format specification are accepted on a TYPE line, and only the \\ and
+// \n escapes in a docstring.
+func ParsePrometheusLine(data []byte) (PromMetric, bool) {
+	m, ok, _ := ParsePrometheusLineAt(data)
+	return m, ok
+}
+
+// ParsePrometheusLineAt is like ParsePrometheusLine but also returns the
+// offset of the first byte out of place in the line, or -1 if there is
+// none.
+func ParsePrometheusLineAt(data []byte) (PromMetric, bool, int) {
+
+%% machine prometheus;
+%% write data;
+
+	var m PromMetric
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name []byte
+	escaped := false
+	neg := false
+
+	%%{
+	    action mark { mark = p }
+	    action name { m.Name = data[mark:p] }
+
+	    action label_name { name = data[mark:p]; escaped = false }
+	    action escape     { escaped = true }
+
+	    action label_value {
+	        if escaped {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: unescapeProm(data[mark:p])})
+	        } else {
+	            m.Labels = append(m.Labels, PromLabel{Name: name, Value: data[mark:p]})
+	        }
+	    }
+
+	    # the grammar has already checked the syntax, and out of range
+	    # values come back as infinities
+	    action value { m.Value, _ = strconv.ParseFloat(string(data[mark:p]), 64) }
+
+	    action ts_neg   { neg = true }
+	    action ts_digit { m.Timestamp = m.Timestamp*10 + int64(fc-'0') }
+	    action ts       { if neg { m.Timestamp = -m.Timestamp } }
+
+	    action help    { m.Kind = PromHelp; escaped = false }
+	    action type    { m.Kind = PromType }
+	    action comment { m.Kind = PromComment }
+
+	    action text {
+	        if escaped {
+	            m.Text = unescapeProm(data[mark:p])
+	        } else {
+	            m.Text = data[mark:p]
+	        }
+	    }
+
+	    ws = [ \t] ;
+
+	    metric_name = [a-zA-Z_:] [a-zA-Z0-9_:]* ;
+	    label_name = [a-zA-Z_] [a-zA-Z0-9_]* ;
+
+	    label_value = ( [^"\\\n] | '\\' >escape ["\\n] )* ;
+
+	    label = label_name >mark %label_name ws* '=' ws* '"' label_value >mark %label_value '"' ;
+
+	    labels = '{' ws* ( label ws* ( ',' ws* label ws* )* ( ',' ws* )? )? '}' ;
+
+	    exponent = [eE] [+\-]? digit+ ;
+	    number = ( digit+ ( '.' digit* )? | '.' digit+ ) exponent? ;
+	    value = ( [+\-]? ( number | 'Inf' ) | 'NaN' ) >mark %value ;
+
+	    timestamp = ( '-' @ts_neg )? digit+ $ts_digit %ts ;
+
+	    sample = metric_name >mark %name ws* labels? ws+ value ( ws+ timestamp )? ws* ;
+
+	    docstring = ( [^\\\n] | '\\' >escape [\\n] )* ;
+	    metric_type = 'counter' | 'gauge' | 'histogram' | 'summary' | 'untyped' ;
+
+	    help = 'HELP' %help ws+ metric_name >mark %name ( ws+ docstring >mark %text )? ;
+	    type = 'TYPE' %type ws+ metric_name >mark %name ws+ metric_type >mark %text ws* ;
+
+	    # anything else after a '#' is free text
+	    other = ( [^\n]* - ( ws+ ( 'HELP' | 'TYPE' ) ( ws [^\n]* )? ) ) %comment ;
+
+	    comment = '#' ( ws+ ( help | type ) | other ) ;
+
+	    main := ws* ( sample | comment | '' %comment ) ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < prometheus_first_final {
+		return PromMetric{}, false, p
+	}
+
+	return m, true, -1
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+)
+
+// from the exposition format documentation
+var data = []byte(`# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200"} 1027 1395066363000
+http_requests_total{method="post",code="400"}    3 1395066363000
+
+# Escaping in label values:
+msdos_file_access_time_seconds{path="C:\\DIR\\FILE.TXT",error="Cannot find file:\n\"FILE.TXT\""} 1.458255915e9
+
+# Minimalistic line:
+metric_without_timestamp_and_labels 12.47
+
+# A weird metric from before the epoch:
+something_weird{problem="division by zero"} +Inf -3982045
+
+# A histogram, which has a pretty complex representation in the text format:
+# HELP http_request_duration_seconds A histogram of the request duration.
+# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{le="0.05"} 24054
+http_request_duration_seconds_bucket{le="0.1"} 33444
+http_request_duration_seconds_bucket{le="0.2"} 100392
+http_request_duration_seconds_bucket{le="0.5"} 129389
+http_request_duration_seconds_bucket{le="1"} 133988
+http_request_duration_seconds_bucket{le="+Inf"} 144320
+http_request_duration_seconds_sum 53423
+http_request_duration_seconds_count 144320
+`)
+
+var hits int
+
+func TestParsePrometheusLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want PromMetric
+		ok   bool
+	}{
+		{
+			line: `http_requests_total{method="GET",code="200"} 1234.5 1395066363000`,
+			want: PromMetric{
+				Name: []byte("http_requests_total"),
+				Labels: []PromLabel{
+					{Name: []byte("method"), Value: []byte("GET")},
+					{Name: []byte("code"), Value: []byte("200")},
+				},
+				Value:     1234.5,
+				Timestamp: 1395066363000,
+			},
+			ok: true,
+		},
+		{
+			line: `msdos_file_access_time_seconds{path="C:\\DIR\\FILE.TXT",error="Cannot find file:\n\"FILE.TXT\""} 1.458255915e9`,
+			want: PromMetric{
+				Name: []byte("msdos_file_access_time_seconds"),
+				Labels: []PromLabel{
+					{Name: []byte("path"), Value: []byte(`C:\DIR\FILE.TXT`)},
+					{Name: []byte("error"), Value: []byte("Cannot find file:\n\"FILE.TXT\"")},
+				},
+				Value: 1.458255915e9,
+			},
+			ok: true,
+		},
+		{
+			line: `something_weird{problem="division by zero"} +Inf -3982045`,
+			want: PromMetric{
+				Name:      []byte("something_weird"),
+				Labels:    []PromLabel{{Name: []byte("problem"), Value: []byte("division by zero")}},
+				Value:     math.Inf(1),
+				Timestamp: -3982045,
+			},
+			ok: true,
+		},
+		{line: `up 1`, want: PromMetric{Name: []byte("up"), Value: 1}, ok: true},
+		{line: `neg -Inf`, want: PromMetric{Name: []byte("neg"), Value: math.Inf(-1)}, ok: true},
+		{line: `small -.5e-3`, want: PromMetric{Name: []byte("small"), Value: -.5e-3}, ok: true},
+		{
+			// blanks are allowed around the labels, along with a trailing comma
+			line: "  a:b { x = \"\" , y=\"1\", } \t2 ",
+			want: PromMetric{
+				Name: []byte("a:b"),
+				Labels: []PromLabel{
+					{Name: []byte("x"), Value: []byte("")},
+					{Name: []byte("y"), Value: []byte("1")},
+				},
+				Value: 2,
+			},
+			ok: true,
+		},
+		{line: `empty{} 0`, want: PromMetric{Name: []byte("empty")}, ok: true},
+		{
+			line: `# HELP http_requests_total The total number\nof \\ HTTP requests.`,
+			want: PromMetric{Kind: PromHelp, Name: []byte("http_requests_total"), Text: []byte("The total number\nof \\ HTTP requests.")},
+			ok:   true,
+		},
+		{line: `# HELP up`, want: PromMetric{Kind: PromHelp, Name: []byte("up")}, ok: true},
+		{line: `# TYPE up gauge`, want: PromMetric{Kind: PromType, Name: []byte("up"), Text: []byte("gauge")}, ok: true},
+		{line: `# a comment`, want: PromMetric{Kind: PromComment}, ok: true},
+		{line: `#HELP this is a comment`, want: PromMetric{Kind: PromComment}, ok: true},
+		{line: `#`, want: PromMetric{Kind: PromComment}, ok: true},
+		{line: ``, want: PromMetric{Kind: PromComment}, ok: true},
+		{line: " \t", want: PromMetric{Kind: PromComment}, ok: true},
+
+		// NaN is only allowed spelled the one way and without a sign
+		{line: `nan nan`},
+		{line: `nan -NaN`},
+		{line: `missing_value`},
+		{line: `missing_value{a="b"}`},
+		{line: `1bad_name 1`},
+		{line: `bad{1a="b"} 1`},
+		{line: `bad{a="b} 1`},
+		{line: `bad{a=b} 1`},
+		{line: `bad{a="\t"} 1`},
+		{line: `bad 1 1.5`},
+		{line: `bad 1 2 3`},
+		{line: "bad{a=\"\n\"} 1"},
+		{line: `# TYPE up gauges`},
+		{line: `# TYPE up`},
+		{line: `# HELP`},
+		{line: `# HELP up bad \t escape`},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParsePrometheusLine([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParsePrometheusLine(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePrometheusLine(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+
+	// NaN never compares equal, so check for it separately
+	if got, ok := ParsePrometheusLine([]byte(`nan NaN`)); !ok || !math.IsNaN(got.Value) {
+		t.Errorf("ParsePrometheusLine(`nan NaN`)=%+v, %v, want NaN", got, ok)
+	}
+}
+
+func TestParsePrometheusLineAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{`http_requests_total{method="post",code="200"} 1027 1395066363000`, -1},
+		{`http_requests_total{method=post} 1027`, 27},
+		{`http_requests_total{method="post"}`, 34},
+		{`2xx_total 1`, 0},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParsePrometheusLineAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParsePrometheusLineAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func ragelPrometheus(data []byte) (int, bool) {
+	var n int
+	for len(data) > 0 {
+		line := data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			data = nil
+		}
+		m, ok := ParsePrometheusLine(line)
+		if !ok {
+			return 0, false
+		}
+		if m.Kind == PromSample {
+			n++
+		}
+	}
+	return n, true
+}
+
+// expfmtPrometheus uses the text parser from prometheus/common that
+// client_golang and the Prometheus server rely on.  It does more work than
+// ParsePrometheusLine, grouping the samples into metric families and
+// folding histogram buckets together.
+func expfmtPrometheus(data []byte) (int, bool) {
+	p := expfmt.NewTextParser(model.LegacyValidation)
+	families, err := p.TextToMetricFamilies(bytes.NewReader(data))
+	if err != nil {
+		return 0, false
+	}
+	var n int
+	for _, mf := range families {
+		for _, m := range mf.Metric {
+			if h := m.GetHistogram(); h != nil {
+				// buckets plus _sum and _count
+				n += len(h.Bucket) + 2
+				continue
+			}
+			n++
+		}
+	}
+	return n, true
+}
+
+func TestPrometheusAlternatives(t *testing.T) {
+	want, ok := ragelPrometheus(data)
+	if !ok || want != 13 {
+		t.Fatalf("ragelPrometheus=%d, %v, want 13", want, ok)
+	}
+	if got, ok := expfmtPrometheus(data); !ok || got != want {
+		t.Errorf("expfmtPrometheus=%d, %v, want %d", got, ok, want)
+	}
+}
+
+func BenchmarkExpfmt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := expfmtPrometheus(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ragelPrometheus(data); ok {
+			hits++
+		}
+	}
+}
@@ -1,6 +1,14 @@
 package main
 
 func matchSSHD(data []byte) bool {
+	ok, _ := matchSSHDAt(data)
+	return ok
+}
+
+// matchSSHDAt is matchSSHD, but on failure it also returns the offset where
+// the machine gave up, or -1 on success.  Since the pattern may start
+// anywhere, the machine never rejects a byte and that is always len(data).
+func matchSSHDAt(data []byte) (ok bool, errPos int) {
 
 %% machine scanner;
 %% write data;
@@ -10,11 +18,122 @@ func matchSSHD(data []byte) bool {
         _ = eof
 
 	%%{
-	    main := any* 'sshd[' digit{5} ']:' space* 'Failed' @{ return true } ;
+	    main := any* 'sshd[' digit{5} ']:' space* 'Failed' @{ return true, -1 } ;
+
+	    write init;
+	    write exec;
+	}%%
+
+        return false, p
+}
+
+%% machine resumable_sshd;
+%% write data;
+
+// ResumableSSHD is matchSSHD for input that arrives a piece at a time.  It
+// keeps the state machine's current state between calls to Feed, so the
+// pattern is found even when it is split across chunks and nothing needs to
+// be buffered.
+type ResumableSSHD struct {
+	state int
+}
+
+// NewResumableSSHD returns a ResumableSSHD ready for its first chunk.
+func NewResumableSSHD() *ResumableSSHD {
+	return &ResumableSSHD{state: resumable_sshd_start}
+}
+
+// Feed runs the machine over chunk.  If the pattern is completed within it,
+// Feed stops there and returns true along with the number of bytes of chunk
+// used.  Feeding the rest of chunk continues the search for the next match.
+func (r *ResumableSSHD) Feed(chunk []byte) (matched bool, consumed int) {
+	data := chunk
+	cs, p, pe := r.state, 0, len(data)
+
+	// there's no end of input while streaming
+	eof := -1
+	_ = eof
+
+	%%{
+	    main := any* 'sshd[' digit{5} ']:' space* 'Failed' @{ matched = true; fbreak; } ;
+
+	    write exec;
+	}%%
+
+	r.state = cs
+
+	return matched, p
+}
+
+// SSHDFailure is a failed login attempt logged by sshd.  The byte slices
+// point into the parsed line.
+type SSHDFailure struct {
+	Time   []byte
+	Host   []byte
+	PID    int
+	Method []byte
+	User   []byte
+	Addr   []byte
+	Port   int
+}
+
+// ParseSSHDFailure parses the line matched by matchSSHD into its fields.
+// The timestamp, host name, PID, and address patterns come from the shared
+// machines in the patterns directory.
+func ParseSSHDFailure(data []byte) (SSHDFailure, bool) {
+	f, ok, _ := ParseSSHDFailureAt(data)
+	return f, ok
+}
+
+// ParseSSHDFailureAt is like ParseSSHDFailure but also returns the offset
+// of the first byte that doesn't fit, len(data) if the line stops short,
+// or -1 if it parsed.
+func ParseSSHDFailureAt(data []byte) (SSHDFailure, bool, int) {
+
+%% machine sshd_failure;
+%% write data;
+
+	var f SSHDFailure
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+	_ = eof
+
+	mark := 0
+	pid := 0
+
+	%%{
+	    include timestamp "../patterns/timestamp.rl";
+	    include hostname "../patterns/hostname.rl";
+	    include pid "../patterns/pid.rl";
+	    include ipv6 "../patterns/ipv6.rl";
+
+	    action mark   { mark = p }
+	    action time   { f.Time = data[mark:p] }
+	    action host   { f.Host = data[mark:p] }
+	    action method { f.Method = data[mark:p] }
+	    action user   { f.User = data[mark:p] }
+	    action addr   { f.Addr = data[mark:p] }
+	    action port   { f.Port = f.Port*10 + int(fc-'0') }
+
+	    word = ( any - space )+ ;
+
+	    main := syslog_timestamp >mark %time
+	            ' ' hostname >mark %host
+	            ' sshd[' pid ']: Failed ' word >mark %method
+	            ' for ' ( 'invalid user ' )? word >mark %user
+	            ' from ' ( ipv4 | ipv6 ) >mark %addr
+	            ' port ' digit{1,5} $port
+	            ' ssh2' ;
 
 	    write init;
 	    write exec;
 	}%%
 
-        return false
+	if cs < sshd_failure_first_final {
+		return SSHDFailure{}, false, p
+	}
+
+	f.PID = pid
+
+	return f, true, -1
 }
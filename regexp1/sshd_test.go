@@ -1,7 +1,10 @@
 package main
 
 import (
+	"bytes"
+	"reflect"
 	"regexp"
+	"strconv"
 	"testing"
 )
 
@@ -26,3 +29,232 @@ func BenchmarkRagel(b *testing.B) {
 		}
 	}
 }
+
+// chunk splits data into pieces of at most n bytes, as they might be read
+// from a network connection.
+func chunk(data []byte, n int) [][]byte {
+	var chunks [][]byte
+	for len(data) > n {
+		chunks = append(chunks, data[:n])
+		data = data[n:]
+	}
+	return append(chunks, data)
+}
+
+func TestResumableSSHD(t *testing.T) {
+	end := bytes.Index(data, []byte("Failed")) + len("Failed")
+
+	for n := 1; n <= len(data); n++ {
+		r := NewResumableSSHD()
+		var total int
+		var matched bool
+		for _, c := range chunk(data, n) {
+			m, consumed := r.Feed(c)
+			total += consumed
+			if m {
+				matched = true
+				break
+			}
+		}
+		if !matched || total != end {
+			t.Errorf("chunks of %d: matched=%v after %d bytes, want true after %d", n, matched, total, end)
+		}
+	}
+
+	// the rest of the chunk after a match is searched for the next one
+	two := append(append([]byte{}, data...), data...)
+	r := NewResumableSSHD()
+	var matches int
+	for c := two; len(c) > 0; {
+		m, consumed := r.Feed(c)
+		if m {
+			matches++
+		}
+		c = c[consumed:]
+	}
+	if matches != 2 {
+		t.Errorf("Feed found %d matches in two lines, want 2", matches)
+	}
+
+	r = NewResumableSSHD()
+	for _, c := range chunk([]byte("Jan 18 06:41:30 corecompute sshd[4232]: Failed password"), 7) {
+		if m, _ := r.Feed(c); m {
+			t.Errorf("Feed matched a four digit pid")
+		}
+	}
+}
+
+var chunks = chunk(data, 16)
+
+// BenchmarkResumableBuffered collects all the chunks before running
+// matchSSHD over the whole line.
+func BenchmarkResumableBuffered(b *testing.B) {
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		buf = buf[:0]
+		for _, c := range chunks {
+			buf = append(buf, c...)
+		}
+		if matchSSHD(buf) {
+			hits++
+		}
+	}
+}
+
+func BenchmarkResumableRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		r := ResumableSSHD{state: resumable_sshd_start}
+		for _, c := range chunks {
+			if m, _ := r.Feed(c); m {
+				hits++
+				break
+			}
+		}
+	}
+}
+
+func TestParseSSHDFailure(t *testing.T) {
+	tests := []struct {
+		line string
+		want SSHDFailure
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: SSHDFailure{
+				Time:   []byte("Jan 18 06:41:30"),
+				Host:   []byte("corecompute"),
+				PID:    42327,
+				Method: []byte("keyboard-interactive/pam"),
+				User:   []byte("root"),
+				Addr:   []byte("112.100.68.182"),
+				Port:   48803,
+			},
+			ok: true,
+		},
+		{
+			line: `Feb  3 23:59:01 bastion.example.com sshd[812]: Failed password for invalid user admin from 2001:db8::1:7 port 22 ssh2`,
+			want: SSHDFailure{
+				Time:   []byte("Feb  3 23:59:01"),
+				Host:   []byte("bastion.example.com"),
+				PID:    812,
+				Method: []byte("password"),
+				User:   []byte("admin"),
+				Addr:   []byte("2001:db8::1:7"),
+				Port:   22,
+			},
+			ok: true,
+		},
+		{
+			line: `Dec 31 00:00:00 h sshd[1]: Failed publickey for invalid from ::ffff:192.0.2.1 port 1 ssh2`,
+			want: SSHDFailure{
+				Time:   []byte("Dec 31 00:00:00"),
+				Host:   []byte("h"),
+				PID:    1,
+				Method: []byte("publickey"),
+				User:   []byte("invalid"),
+				Addr:   []byte("::ffff:192.0.2.1"),
+				Port:   1,
+			},
+			ok: true,
+		},
+
+		{line: `Jan 18 06:41:30 corecompute sshd[42327]: Accepted publickey for root from 112.100.68.182 port 48803 ssh2`},
+		{line: `Jan 18 06:41:30 corecompute sshd[042327]: Failed password for root from 112.100.68.182 port 48803 ssh2`},
+		{line: `Jan 18 06:41:30 -corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`},
+		{line: `Jan 18 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.256 port 48803 ssh2`},
+		{line: `Jan 18 06:41:30 corecompute sshd[42327]: Failed password for root from 2001:db8:::1 port 48803 ssh2`},
+		{line: `Jan 32 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`},
+		{line: `Jan 8 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseSSHDFailure([]byte(tt.line))
+		if ok != tt.ok {
+			t.Errorf("ParseSSHDFailure(%q) ok=%v, want %v", tt.line, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseSSHDFailure(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestMatchSSHDAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		{`sshd[4232]: Failed`, 18},
+		{`Jan 18 06:41:30 corecompute sshd[42327]: Accepted publickey for root`, 68},
+		{``, 0},
+	}
+
+	for _, tt := range tests {
+		ok, errPos := matchSSHDAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("matchSSHDAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestParseSSHDFailureAt(t *testing.T) {
+	tests := []struct {
+		line   string
+		errPos int
+	}{
+		{string(data), -1},
+		// days are space padded, and there is no 32nd
+		{`Jan 8 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`, 4},
+		{`Jan 32 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`, 5},
+		{`Jan 18 06:41:30 -corecompute sshd[42327]: Failed password for root from 112.100.68.182 port 48803 ssh2`, 16},
+		{`Jan 18 06:41:30 corecompute sshd[042327]: Failed password for root from 112.100.68.182 port 48803 ssh2`, 33},
+		{`Jan 18 06:41:30 corecompute sshd[42327]: Accepted publickey for root from 112.100.68.182 port 48803 ssh2`, 41},
+		{`Jan 18 06:41:30 corecompute sshd[42327]: Failed password for root from 112.100.68.256 port 48803 ssh2`, 84},
+		// cut off after the host name
+		{`Jan 18 06:41:30 corecompute`, 27},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseSSHDFailureAt([]byte(tt.line))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseSSHDFailureAt(%q)=%v, %d, want errPos %d", tt.line, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var reSSHDFailure = regexp.MustCompile(`^(\w{3} [ \d]\d \d{2}:\d{2}:\d{2}) (\S+) sshd\[(\d+)\]: Failed (\S+) for (?:invalid user )?(\S+) from ([\d.]+|[\da-fA-F:.]+) port (\d+) ssh2$`)
+
+func regexSSHDFailure(line []byte) (SSHDFailure, bool) {
+	m := reSSHDFailure.FindSubmatch(line)
+	if m == nil {
+		return SSHDFailure{}, false
+	}
+	pid, _ := strconv.Atoi(string(m[3]))
+	port, _ := strconv.Atoi(string(m[7]))
+	return SSHDFailure{Time: m[1], Host: m[2], PID: pid, Method: m[4], User: m[5], Addr: m[6], Port: port}, true
+}
+
+func TestSSHDFailureAlternatives(t *testing.T) {
+	want, _ := ParseSSHDFailure(data)
+	if got, ok := regexSSHDFailure(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexSSHDFailure=%+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkFailureRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexSSHDFailure(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkFailureRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseSSHDFailure(data); ok {
+			hits++
+		}
+	}
+}
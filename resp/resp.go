@@ -0,0 +1,704 @@
+
+//line resp.rl:1
+package main
+
+import "math"
+
+// RESPFrame is a single RESP3 frame.  Arrays are returned as a header
+// frame with Int set to the number of elements, which follow as separate
+// frames.
+type RESPFrame struct {
+	// Type is the leading type byte: one of + - : $ * _ # , (
+	Type byte
+
+	// Data holds the payload of simple strings, errors, and bulk strings,
+	// and the text of doubles and big numbers.  It points into the input.
+	Data []byte
+
+	// Int holds the value of an integer or the length of an array.
+	Int int64
+
+	// Bool holds the value of a boolean.
+	Bool bool
+
+	// Null is set for RESP3 nulls and for the RESP2 null bulk string and
+	// null array ($-1 and *-1).
+	Null bool
+}
+
+// ScanRESPFrame parses the first frame in b and returns it along with the
+// number of bytes it took up.  If ok is false and n == len(b), b holds the
+// start of a valid frame and more data is needed; otherwise n is the offset
+// of the first bad byte.
+func ScanRESPFrame(b []byte) (f RESPFrame, n int, ok bool) {
+
+
+//line resp.rl:34
+
+//line resp.go:39
+const resp_start int = 1
+const resp_first_final int = 31
+const resp_error int = 0
+
+const resp_en_main int = 1
+
+
+//line resp.rl:35
+
+	data := b
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	neg := false
+	// a negative integer can go one further than a positive one
+	var mag, limit uint64 = 0, math.MaxInt64
+	overflow := -1
+	short := false
+
+	
+//line resp.go:62
+	{
+	cs = resp_start
+	}
+
+//line resp.go:67
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 31:
+		goto st_case_31
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 35:
+			goto tr1
+		case 36:
+			goto tr2
+		case 40:
+			goto tr3
+		case 42:
+			goto tr4
+		case 44:
+			goto tr6
+		case 58:
+			goto tr7
+		case 95:
+			goto tr8
+		}
+		if 43 <= data[p] && data[p] <= 45 {
+			goto tr5
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line resp.go:173
+		switch data[p] {
+		case 102:
+			goto st3
+		case 116:
+			goto tr10
+		}
+		goto st0
+tr10:
+//line resp.rl:54
+ f.Bool = true 
+	goto st3
+tr19:
+//line resp.rl:68
+
+	        if f.Int > int64(pe-(p+1)) {
+	            short = true
+	            p++; cs = 3; goto _out
+
+	        }
+	        f.Data = data[p+1 : p+1+int(f.Int)]
+	        p = (p + 1 + int(f.Int)) - 1
+
+	    
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line resp.go:203
+		if data[p] == 13 {
+			goto st4
+		}
+		goto st0
+tr23:
+//line resp.rl:51
+ f.Data = data[mark:p] 
+	goto st4
+tr26:
+//line resp.rl:49
+ mark = p 
+//line resp.rl:51
+ f.Data = data[mark:p] 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line resp.go:223
+		if data[p] == 10 {
+			goto tr12
+		}
+		goto st0
+tr12:
+//line resp.rl:55
+ p++; cs = 31; goto _out
+ 
+	goto st31
+tr17:
+//line resp.rl:53
+ f.Null = true 
+//line resp.rl:55
+ p++; cs = 31; goto _out
+ 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line resp.go:245
+		goto st0
+tr2:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line resp.go:256
+		if data[p] == 45 {
+			goto st6
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if data[p] == 49 {
+			goto st7
+		}
+		goto st0
+tr8:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line resp.go:282
+		if data[p] == 13 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if data[p] == 10 {
+			goto tr17
+		}
+		goto st0
+tr14:
+//line resp.rl:57
+
+	        if mag > (limit-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 9; goto _out
+
+	        }
+	        mag = mag*10 + uint64((data[p])-'0')
+	        f.Int = int64(mag)
+	    
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line resp.go:313
+		if data[p] == 13 {
+			goto st10
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 10 {
+			goto tr19
+		}
+		goto st0
+tr3:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line resp.go:339
+		switch data[p] {
+		case 43:
+			goto tr20
+		case 45:
+			goto tr20
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr21
+		}
+		goto st0
+tr20:
+//line resp.rl:49
+ mark = p 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line resp.go:359
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st13
+		}
+		goto st0
+tr21:
+//line resp.rl:49
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line resp.go:373
+		if data[p] == 13 {
+			goto tr23
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st13
+		}
+		goto st0
+tr4:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line resp.go:390
+		if data[p] == 45 {
+			goto st6
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr24:
+//line resp.rl:57
+
+	        if mag > (limit-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 15; goto _out
+
+	        }
+	        mag = mag*10 + uint64((data[p])-'0')
+	        f.Int = int64(mag)
+	    
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line resp.go:415
+		if data[p] == 13 {
+			goto st4
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr5:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line resp.go:432
+		switch data[p] {
+		case 10:
+			goto st0
+		case 13:
+			goto tr26
+		}
+		goto tr25
+tr25:
+//line resp.rl:49
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line resp.go:449
+		switch data[p] {
+		case 10:
+			goto st0
+		case 13:
+			goto tr23
+		}
+		goto st17
+tr6:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line resp.go:466
+		switch data[p] {
+		case 43:
+			goto tr28
+		case 45:
+			goto tr28
+		case 105:
+			goto tr30
+		case 110:
+			goto tr31
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr29
+		}
+		goto st0
+tr28:
+//line resp.rl:49
+ mark = p 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line resp.go:490
+		if data[p] == 105 {
+			goto st24
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+tr29:
+//line resp.rl:49
+ mark = p 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line resp.go:507
+		switch data[p] {
+		case 13:
+			goto tr23
+		case 46:
+			goto st21
+		case 69:
+			goto st23
+		case 101:
+			goto st23
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st20
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		switch data[p] {
+		case 13:
+			goto tr23
+		case 69:
+			goto st23
+		case 101:
+			goto st23
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st22
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 43:
+			goto st12
+		case 45:
+			goto st12
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st13
+		}
+		goto st0
+tr30:
+//line resp.rl:49
+ mark = p 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line resp.go:572
+		if data[p] == 110 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 102 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 13 {
+			goto tr23
+		}
+		goto st0
+tr31:
+//line resp.rl:49
+ mark = p 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line resp.go:604
+		if data[p] == 97 {
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 110 {
+			goto st26
+		}
+		goto st0
+tr7:
+//line resp.rl:50
+ f.Type = (data[p]) 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line resp.go:627
+		switch data[p] {
+		case 43:
+			goto st30
+		case 45:
+			goto tr42
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+tr42:
+//line resp.rl:52
+ neg = true; limit++ 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line resp.go:647
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr24
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line resp.rl:101
+
+
+	switch {
+	case overflow >= 0:
+		return RESPFrame{}, overflow, false
+	case short:
+		return RESPFrame{}, len(data), false
+	case cs < resp_first_final:
+		return RESPFrame{}, p, false
+	}
+
+	if neg {
+		f.Int = -f.Int
+	}
+
+	return f, p, true
+}
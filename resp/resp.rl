@@ -0,0 +1,117 @@
+package main
+
+import "math"
+
+// RESPFrame is a single RESP3 frame.  Arrays are returned as a header
+// frame with Int set to the number of elements, which follow as separate
+// frames.
+type RESPFrame struct {
+	// Type is the leading type byte: one of + - : $ * _ # , (
+	Type byte
+
+	// Data holds the payload of simple strings, errors, and bulk strings,
+	// and the text of doubles and big numbers.  It points into the input.
+	Data []byte
+
+	// Int holds the value of an integer or the length of an array.
+	Int int64
+
+	// Bool holds the value of a boolean.
+	Bool bool
+
+	// Null is set for RESP3 nulls and for the RESP2 null bulk string and
+	// null array ($-1 and *-1).
+	Null bool
+}
+
+// ScanRESPFrame parses the first frame in b and returns it along with the
+// number of bytes it took up.  If ok is false and n == len(b), b holds the
+// start of a valid frame and more data is needed; otherwise n is the offset
+// of the first bad byte.
+func ScanRESPFrame(b []byte) (f RESPFrame, n int, ok bool) {
+
+%% machine resp;
+%% write data;
+
+	data := b
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	neg := false
+	// a negative integer can go one further than a positive one
+	var mag, limit uint64 = 0, math.MaxInt64
+	overflow := -1
+	short := false
+
+	%%{
+	    action mark  { mark = p }
+	    action type  { f.Type = fc }
+	    action data  { f.Data = data[mark:p] }
+	    action neg   { neg = true; limit++ }
+	    action null  { f.Null = true }
+	    action true  { f.Bool = true }
+	    action done  { fbreak; }
+
+	    action digit {
+	        if mag > (limit-uint64(fc-'0'))/10 {
+	            overflow = p
+	            fbreak;
+	        }
+	        mag = mag*10 + uint64(fc-'0')
+	        f.Int = int64(mag)
+	    }
+
+	    # On the LF ending the length line of a bulk string, jump straight
+	    # over the payload to its trailing CRLF.
+	    action bulk {
+	        if f.Int > int64(pe-(p+1)) {
+	            short = true
+	            fbreak;
+	        }
+	        f.Data = data[p+1 : p+1+int(f.Int)]
+	        fexec p + 1 + int(f.Int);
+	    }
+
+	    crlf = '\r\n' ;
+	    line = ( any - [\r\n] )* ;
+
+	    integer = ( [+] | '-' @neg )? digit+ $digit ;
+
+	    simple_string = '+' line >mark %data crlf ;
+	    simple_error = '-' line >mark %data crlf ;
+	    number = ':' integer crlf ;
+	    bulk_string = '$' ( digit+ $digit '\r\n' @bulk crlf | '-1' crlf @null ) ;
+	    array = '*' ( digit+ $digit crlf | '-1' crlf @null ) ;
+	    null_frame = '_' crlf @null ;
+	    boolean = '#' ( 't' @true | 'f' ) crlf ;
+
+	    double_value = [+\-]? ( digit+ ( '.' digit+ )? ( [eE] [+\-]? digit+ )? | 'inf' ) | 'nan' ;
+	    double = ',' double_value >mark %data crlf ;
+	    big_number = '(' ( [+\-]? digit+ ) >mark %data crlf ;
+
+	    frame = simple_string | simple_error | number | bulk_string | array
+	          | null_frame | boolean | double | big_number ;
+
+	    main := frame >type @done ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	switch {
+	case overflow >= 0:
+		return RESPFrame{}, overflow, false
+	case short:
+		return RESPFrame{}, len(data), false
+	case cs < resp_first_final:
+		return RESPFrame{}, p, false
+	}
+
+	if neg {
+		f.Int = -f.Int
+	}
+
+	return f, p, true
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// a pipelined reply: an array holding a bulk string, an integer, and a null
+var data = []byte("*3\r\n$12\r\nhello\r\nworld\r\n:-42\r\n_\r\n+OK\r\n")
+
+var hits int
+
+func TestScanRESPFrame(t *testing.T) {
+	tests := []struct {
+		in   string
+		want RESPFrame
+		n    int
+		ok   bool
+	}{
+		{"+OK\r\n", RESPFrame{Type: '+', Data: []byte("OK")}, 5, true},
+		{"+\r\n", RESPFrame{Type: '+', Data: []byte("")}, 3, true},
+		{"-ERR unknown command 'foo'\r\n", RESPFrame{Type: '-', Data: []byte("ERR unknown command 'foo'")}, 28, true},
+		{":1000\r\n", RESPFrame{Type: ':', Int: 1000}, 7, true},
+		{":-42\r\n", RESPFrame{Type: ':', Int: -42}, 6, true},
+		{":+7\r\n", RESPFrame{Type: ':', Int: 7}, 5, true},
+		{":9223372036854775807\r\n", RESPFrame{Type: ':', Int: 9223372036854775807}, 22, true},
+		{":-9223372036854775808\r\n", RESPFrame{Type: ':', Int: -9223372036854775808}, 23, true},
+		{"$5\r\nhello\r\n", RESPFrame{Type: '$', Data: []byte("hello"), Int: 5}, 11, true},
+		{"$0\r\n\r\n", RESPFrame{Type: '$', Data: []byte("")}, 6, true},
+		// the payload is binary safe
+		{"$4\r\n\r\n\x00\xff\r\n", RESPFrame{Type: '$', Data: []byte("\r\n\x00\xff"), Int: 4}, 10, true},
+		{"$-1\r\n", RESPFrame{Type: '$', Null: true}, 5, true},
+		{"*2\r\n", RESPFrame{Type: '*', Int: 2}, 4, true},
+		{"*-1\r\n", RESPFrame{Type: '*', Null: true}, 5, true},
+		{"_\r\n", RESPFrame{Type: '_', Null: true}, 3, true},
+		{"#t\r\n", RESPFrame{Type: '#', Bool: true}, 4, true},
+		{"#f\r\n", RESPFrame{Type: '#'}, 4, true},
+		{",1.23\r\n", RESPFrame{Type: ',', Data: []byte("1.23")}, 7, true},
+		{",-1.5e-10\r\n", RESPFrame{Type: ',', Data: []byte("-1.5e-10")}, 11, true},
+		{",inf\r\n", RESPFrame{Type: ',', Data: []byte("inf")}, 6, true},
+		{",-inf\r\n", RESPFrame{Type: ',', Data: []byte("-inf")}, 7, true},
+		{",nan\r\n", RESPFrame{Type: ',', Data: []byte("nan")}, 6, true},
+		{"(3492890328409238509324850943850943825024385\r\n", RESPFrame{Type: '(', Data: []byte("3492890328409238509324850943850943825024385")}, 46, true},
+		// only the first frame is consumed
+		{"+OK\r\n+MORE\r\n", RESPFrame{Type: '+', Data: []byte("OK")}, 5, true},
+
+		// incomplete frames need more data
+		{in: "", n: 0},
+		{in: "+OK", n: 3},
+		{in: "+OK\r", n: 4},
+		{in: "$5\r\nhel", n: 7},
+		{in: "$5\r\nhello", n: 9},
+		{in: "$5\r\nhello\r", n: 10},
+		{in: "*3", n: 2},
+
+		// malformed frames report where they went wrong
+		{in: "!oops\r\n", n: 0},
+		{in: "+OK\n", n: 3},
+		{in: "+O\rK\r\n", n: 3},
+		{in: ":12a\r\n", n: 3},
+		{in: ":\r\n", n: 1},
+		{in: "$5\r\nhello!\r\n", n: 9},
+		{in: "$-2\r\n", n: 2},
+		{in: "#x\r\n", n: 1},
+		{in: ",1.\r\n", n: 3},
+		// too big for an int64, which is noticed at the digit that overflows
+		{in: ":9223372036854775808\r\n", n: 19},
+		{in: ":-9223372036854775809\r\n", n: 20},
+		{in: "$9223372036854775808\r\n", n: 19},
+		{in: "$18446744073709551615\r\nx\r\n", n: 20},
+	}
+
+	for _, tt := range tests {
+		got, n, ok := ScanRESPFrame([]byte(tt.in))
+		if ok != tt.ok || n != tt.n {
+			t.Errorf("ScanRESPFrame(%q) n=%d ok=%v, want n=%d ok=%v", tt.in, n, ok, tt.n, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ScanRESPFrame(%q)=%+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestScanRESPStream(t *testing.T) {
+	want := []RESPFrame{
+		{Type: '*', Int: 3},
+		{Type: '$', Data: []byte("hello\r\nworld"), Int: 12},
+		{Type: ':', Int: -42},
+		{Type: '_', Null: true},
+		{Type: '+', Data: []byte("OK")},
+	}
+
+	var got []RESPFrame
+	for b := data; len(b) > 0; {
+		f, n, ok := ScanRESPFrame(b)
+		if !ok {
+			t.Fatalf("ScanRESPFrame(%q) failed at %d", b, n)
+		}
+		got = append(got, f)
+		b = b[n:]
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("frames=%+v, want %+v", got, want)
+	}
+}
+
+// manualRESP handles just enough of the protocol to walk data, in the style
+// of a typical hand-written client.
+func manualRESP(b []byte) (RESPFrame, int, bool) {
+	i := bytes.Index(b, []byte("\r\n"))
+	if i < 1 {
+		return RESPFrame{}, 0, false
+	}
+	f := RESPFrame{Type: b[0]}
+	line := b[1:i]
+	n := i + 2
+	switch f.Type {
+	case '+', '-', ',', '(':
+		f.Data = line
+	case ':', '*':
+		v, err := strconv.ParseInt(string(line), 10, 64)
+		if err != nil {
+			return RESPFrame{}, 0, false
+		}
+		f.Int, f.Null = v, v == -1 && f.Type == '*'
+	case '$':
+		v, err := strconv.Atoi(string(line))
+		if err != nil {
+			return RESPFrame{}, 0, false
+		}
+		if v == -1 {
+			f.Null = true
+			break
+		}
+		if len(b) < n+v+2 {
+			return RESPFrame{}, 0, false
+		}
+		f.Data, f.Int = b[n:n+v], int64(v)
+		n += v + 2
+	case '_':
+		f.Null = true
+	case '#':
+		f.Bool = string(line) == "t"
+	default:
+		return RESPFrame{}, 0, false
+	}
+	return f, n, true
+}
+
+func TestRESPAlternatives(t *testing.T) {
+	for b := data; len(b) > 0; {
+		want, wn, _ := ScanRESPFrame(b)
+		got, n, ok := manualRESP(b)
+		if !ok || n != wn || !reflect.DeepEqual(got, want) {
+			t.Fatalf("manualRESP(%q)=%+v,%d, want %+v,%d", b, got, n, want, wn)
+		}
+		b = b[n:]
+	}
+}
+
+func BenchmarkManual(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for p := data; len(p) > 0; {
+			_, n, ok := manualRESP(p)
+			if !ok {
+				break
+			}
+			hits++
+			p = p[n:]
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for p := data; len(p) > 0; {
+			_, n, ok := ScanRESPFrame(p)
+			if !ok {
+				break
+			}
+			hits++
+			p = p[n:]
+		}
+	}
+}
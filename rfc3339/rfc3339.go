@@ -0,0 +1,1032 @@
+
+//line rfc3339.rl:1
+package main
+
+import "time"
+
+// zones holds a fixed zone for every quarter-hour offset, which covers all
+// offsets in use, so that ParseRFC3339 needn't allocate one per call.
+var zones = func() (z [2*24*4 + 1]*time.Location) {
+	for i := range z {
+		z[i] = time.FixedZone("", (i-24*4)*15*60)
+	}
+	return z
+}()
+
+// ParseRFC3339 parses an RFC 3339 timestamp, along with the common ISO 8601
+// variations on it:
+//
+//	2006-01-02                       date only, taken as midnight UTC
+//	2006-W01-1, 2006-W01             week dates, defaulting to Monday
+//	2006-01-02T15:04                 seconds may be omitted
+//	2006-01-02T15:04:05.999999999Z   1 to 9 digits of fractional seconds
+//	2006-01-02 15:04:05+07:00        'T', 't', or ' ' between date and time
+//	2006-01-02T15:04:05-0700         offsets as Z, ±hh:mm, ±hhmm, or ±hh
+//
+// A time must have an offset.  Times with a zero offset are returned in
+// UTC; otherwise in a fixed zone for the offset.
+func ParseRFC3339(data []byte) (time.Time, bool) {
+	t, ok, _ := ParseRFC3339At(data)
+	return t, ok
+}
+
+// ParseRFC3339At is like ParseRFC3339 but also returns the offset of the
+// first byte that is out of place, or -1 if data parsed.  A date that
+// doesn't exist fails at the day, or at the week for a week 53 in a year
+// with only 52.
+func ParseRFC3339At(data []byte) (time.Time, bool, int) {
+
+
+//line rfc3339.rl:38
+
+//line rfc3339.go:43
+const rfc3339_start int = 1
+const rfc3339_first_final int = 44
+const rfc3339_error int = 0
+
+const rfc3339_en_main int = 1
+
+
+//line rfc3339.rl:39
+
+	var year, month, day, week, weekday int
+	var hour, minute, sec, nsec int
+	var offset, offHour, offMin int
+
+	scale := 100000000
+	sign := 1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	
+//line rfc3339.go:65
+	{
+	cs = rfc3339_start
+	}
+
+//line rfc3339.go:70
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 44:
+		goto st_case_44
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 45:
+		goto st_case_45
+	case 19:
+		goto st_case_19
+	case 46:
+		goto st_case_46
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 47:
+		goto st_case_47
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 48:
+		goto st_case_48
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	}
+	goto st_out
+	st_case_1:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line rfc3339.rl:52
+ year = year*10 + int((data[p])-'0') 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line rfc3339.go:194
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr2
+		}
+		goto st0
+tr2:
+//line rfc3339.rl:52
+ year = year*10 + int((data[p])-'0') 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line rfc3339.go:208
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr3
+		}
+		goto st0
+tr3:
+//line rfc3339.rl:52
+ year = year*10 + int((data[p])-'0') 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line rfc3339.go:222
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr4
+		}
+		goto st0
+tr4:
+//line rfc3339.rl:52
+ year = year*10 + int((data[p])-'0') 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line rfc3339.go:236
+		if data[p] == 45 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 48:
+			goto tr6
+		case 49:
+			goto tr7
+		case 87:
+			goto st39
+		}
+		goto st0
+tr6:
+//line rfc3339.rl:53
+ month = month*10 + int((data[p])-'0') 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line rfc3339.go:264
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr9
+		}
+		goto st0
+tr9:
+//line rfc3339.rl:53
+ month = month*10 + int((data[p])-'0') 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line rfc3339.go:278
+		if data[p] == 45 {
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 48:
+			goto tr11
+		case 51:
+			goto tr13
+		}
+		if 49 <= data[p] && data[p] <= 50 {
+			goto tr12
+		}
+		goto st0
+tr11:
+//line rfc3339.rl:54
+ day = day*10 + int((data[p])-'0') 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line rfc3339.go:307
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+tr14:
+//line rfc3339.rl:54
+ day = day*10 + int((data[p])-'0') 
+	goto st44
+tr46:
+//line rfc3339.rl:56
+ weekday = int((data[p]) - '0') 
+	goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+//line rfc3339.go:325
+		switch data[p] {
+		case 32:
+			goto st11
+		case 84:
+			goto st11
+		case 116:
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 50 {
+			goto tr16
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr15
+		}
+		goto st0
+tr15:
+//line rfc3339.rl:57
+ hour = hour*10 + int((data[p])-'0') 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line rfc3339.go:356
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr17
+		}
+		goto st0
+tr17:
+//line rfc3339.rl:57
+ hour = hour*10 + int((data[p])-'0') 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line rfc3339.go:370
+		if data[p] == 58 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr19
+		}
+		goto st0
+tr19:
+//line rfc3339.rl:58
+ minute = minute*10 + int((data[p])-'0') 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line rfc3339.go:393
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line rfc3339.rl:58
+ minute = minute*10 + int((data[p])-'0') 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line rfc3339.go:407
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 58:
+			goto st22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		goto st0
+tr22:
+//line rfc3339.rl:63
+ sign = -1 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line rfc3339.go:430
+		if data[p] == 50 {
+			goto tr26
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr25
+		}
+		goto st0
+tr25:
+//line rfc3339.rl:61
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line rfc3339.go:447
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr27
+		}
+		goto st0
+tr27:
+//line rfc3339.rl:61
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line rfc3339.go:461
+		if data[p] == 58 {
+			goto st20
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr29
+		}
+		goto st0
+tr29:
+//line rfc3339.rl:62
+ offMin = offMin*10 + int((data[p])-'0') 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line rfc3339.go:478
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr28
+		}
+		goto st0
+tr28:
+//line rfc3339.rl:62
+ offMin = offMin*10 + int((data[p])-'0') 
+	goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+//line rfc3339.go:492
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr29
+		}
+		goto st0
+tr26:
+//line rfc3339.rl:61
+ offHour = offHour*10 + int((data[p])-'0') 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line rfc3339.go:512
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr27
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr30
+		}
+		goto st0
+tr30:
+//line rfc3339.rl:59
+ sec = sec*10 + int((data[p])-'0') 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line rfc3339.go:535
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr31
+		}
+		goto st0
+tr31:
+//line rfc3339.rl:59
+ sec = sec*10 + int((data[p])-'0') 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line rfc3339.go:549
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 46:
+			goto st25
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr33
+		}
+		goto st0
+tr33:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line rfc3339.go:581
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr34
+		}
+		goto st0
+tr34:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line rfc3339.go:605
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr35
+		}
+		goto st0
+tr35:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line rfc3339.go:629
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr36
+		}
+		goto st0
+tr36:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line rfc3339.go:653
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr37
+		}
+		goto st0
+tr37:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line rfc3339.go:677
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr38
+		}
+		goto st0
+tr38:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line rfc3339.go:701
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr39
+		}
+		goto st0
+tr39:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line rfc3339.go:725
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr40
+		}
+		goto st0
+tr40:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line rfc3339.go:749
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr41
+		}
+		goto st0
+tr41:
+//line rfc3339.rl:60
+ nsec += int((data[p])-'0') * scale; scale /= 10 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line rfc3339.go:773
+		switch data[p] {
+		case 43:
+			goto st17
+		case 45:
+			goto tr22
+		case 90:
+			goto st47
+		case 122:
+			goto st47
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		goto st0
+tr16:
+//line rfc3339.rl:57
+ hour = hour*10 + int((data[p])-'0') 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line rfc3339.go:800
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr17
+		}
+		goto st0
+tr12:
+//line rfc3339.rl:54
+ day = day*10 + int((data[p])-'0') 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line rfc3339.go:814
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr14
+		}
+		goto st0
+tr13:
+//line rfc3339.rl:54
+ day = day*10 + int((data[p])-'0') 
+	goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+//line rfc3339.go:828
+		if 48 <= data[p] && data[p] <= 49 {
+			goto tr14
+		}
+		goto st0
+tr7:
+//line rfc3339.rl:53
+ month = month*10 + int((data[p])-'0') 
+	goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+//line rfc3339.go:842
+		if 48 <= data[p] && data[p] <= 50 {
+			goto tr9
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 48:
+			goto tr42
+		case 53:
+			goto tr44
+		}
+		if 49 <= data[p] && data[p] <= 52 {
+			goto tr43
+		}
+		goto st0
+tr42:
+//line rfc3339.rl:55
+ week = week*10 + int((data[p])-'0') 
+	goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+//line rfc3339.go:871
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr45
+		}
+		goto st0
+tr45:
+//line rfc3339.rl:55
+ week = week*10 + int((data[p])-'0') 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line rfc3339.go:885
+		switch data[p] {
+		case 32:
+			goto st11
+		case 45:
+			goto st41
+		case 84:
+			goto st11
+		case 116:
+			goto st11
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if 49 <= data[p] && data[p] <= 55 {
+			goto tr46
+		}
+		goto st0
+tr43:
+//line rfc3339.rl:55
+ week = week*10 + int((data[p])-'0') 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line rfc3339.go:915
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr45
+		}
+		goto st0
+tr44:
+//line rfc3339.rl:55
+ week = week*10 + int((data[p])-'0') 
+	goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+//line rfc3339.go:929
+		if 48 <= data[p] && data[p] <= 51 {
+			goto tr45
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 45, 46:
+//line rfc3339.rl:64
+ offset = sign * (offHour*3600 + offMin*60) 
+//line rfc3339.go:989
+		}
+	}
+
+	_out: {}
+	}
+
+//line rfc3339.rl:92
+
+
+	if cs < rfc3339_first_final {
+		return time.Time{}, false, p
+	}
+
+	loc := time.UTC
+	switch {
+	case offset == 0:
+	case offset%(15*60) == 0:
+		loc = zones[offset/(15*60)+24*4]
+	default:
+		loc = time.FixedZone("", offset)
+	}
+
+	if week != 0 {
+		if weekday == 0 {
+			weekday = 1
+		}
+		// week 1 is the week containing January 4th
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := 4 - (int(jan4.Weekday())+6)%7
+		t := time.Date(year, time.January, monday+(week-1)*7+weekday-1, hour, minute, sec, nsec, loc)
+		if y, w := t.ISOWeek(); y != year || w != week {
+			return time.Time{}, false, len("2006-W")
+		}
+		return t, true, -1
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, sec, nsec, loc)
+	if t.Day() != day {
+		// February 30th and friends
+		return time.Time{}, false, len("2006-01-")
+	}
+
+	return t, true, -1
+}
@@ -0,0 +1,128 @@
+package main
+
+import "time"
+
+// zones holds a fixed zone for every quarter-hour offset, which covers all
+// offsets in use, so that ParseRFC3339 needn't allocate one per call.
+var zones = func() (z [2*24*4 + 1]*time.Location) {
+	for i := range z {
+		z[i] = time.FixedZone("", (i-24*4)*15*60)
+	}
+	return z
+}()
+
+// ParseRFC3339 parses an RFC 3339 timestamp, along with the common ISO 8601
+// variations on it:
+//
+//	2006-01-02                       date only, taken as midnight UTC
+//	2006-W01-1, 2006-W01             week dates, defaulting to Monday
+//	2006-01-02T15:04                 seconds may be omitted
+//	2006-01-02T15:04:05.999999999Z   1 to 9 digits of fractional seconds
+//	2006-01-02 15:04:05+07:00        'T', 't', or ' ' between date and time
+//	2006-01-02T15:04:05-0700         offsets as Z, ±hh:mm, ±hhmm, or ±hh
+//
+// A time must have an offset.  Times with a zero offset are returned in
+// UTC; otherwise in a fixed zone for the offset.
+func ParseRFC3339(data []byte) (time.Time, bool) {
+	t, ok, _ := ParseRFC3339At(data)
+	return t, ok
+}
+
+// ParseRFC3339At is like ParseRFC3339 but also returns the offset of the
+// first byte that is out of place, or -1 if data parsed.  A date that
+// doesn't exist fails at the day, or at the week for a week 53 in a year
+// with only 52.
+func ParseRFC3339At(data []byte) (time.Time, bool, int) {
+
+%% machine rfc3339;
+%% write data;
+
+	var year, month, day, week, weekday int
+	var hour, minute, sec, nsec int
+	var offset, offHour, offMin int
+
+	scale := 100000000
+	sign := 1
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	%%{
+	    action year     { year = year*10 + int(fc-'0') }
+	    action month    { month = month*10 + int(fc-'0') }
+	    action day      { day = day*10 + int(fc-'0') }
+	    action week     { week = week*10 + int(fc-'0') }
+	    action weekday  { weekday = int(fc - '0') }
+	    action hour     { hour = hour*10 + int(fc-'0') }
+	    action minute   { minute = minute*10 + int(fc-'0') }
+	    action sec      { sec = sec*10 + int(fc-'0') }
+	    action nsec     { nsec += int(fc-'0') * scale; scale /= 10 }
+	    action off_hour { offHour = offHour*10 + int(fc-'0') }
+	    action off_min  { offMin = offMin*10 + int(fc-'0') }
+	    action negative { sign = -1 }
+	    action offset   { offset = sign * (offHour*3600 + offMin*60) }
+
+	    date_fullyear = digit{4} $year ;
+	    date_month = ( '0' '1'..'9' | '1' '0'..'2' ) $month ;
+	    date_mday = ( '0' '1'..'9' | '1'..'2' digit | '3' '0'..'1' ) $day ;
+	    date_week = ( '0' '1'..'9' | '1'..'4' digit | '5' '0'..'3' ) $week ;
+	    date_wday = '1'..'7' $weekday ;
+
+	    calendar_date = date_fullyear '-' date_month '-' date_mday ;
+	    week_date = date_fullyear '-W' date_week ( '-' date_wday )? ;
+
+	    time_hour = ( '0'..'1' digit | '2' '0'..'3' ) $hour ;
+	    time_minute = ( '0'..'5' digit ) $minute ;
+	    time_second = ( '0'..'5' digit ) $sec ;
+	    time_secfrac = '.' digit{1,9} $nsec ;
+
+	    off_hour = ( '0'..'1' digit | '2' '0'..'3' ) $off_hour ;
+	    off_minute = ( '0'..'5' digit ) $off_min ;
+	    time_numoffset = ( '+' | '-' @negative ) off_hour ( ':'? off_minute )? ;
+	    time_offset = 'Z' | 'z' | time_numoffset %offset ;
+
+	    partial_time = time_hour ':' time_minute ( ':' time_second time_secfrac? )? ;
+	    full_time = partial_time time_offset ;
+
+	    main := ( calendar_date | week_date ) ( [Tt ] full_time )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < rfc3339_first_final {
+		return time.Time{}, false, p
+	}
+
+	loc := time.UTC
+	switch {
+	case offset == 0:
+	case offset%(15*60) == 0:
+		loc = zones[offset/(15*60)+24*4]
+	default:
+		loc = time.FixedZone("", offset)
+	}
+
+	if week != 0 {
+		if weekday == 0 {
+			weekday = 1
+		}
+		// week 1 is the week containing January 4th
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := 4 - (int(jan4.Weekday())+6)%7
+		t := time.Date(year, time.January, monday+(week-1)*7+weekday-1, hour, minute, sec, nsec, loc)
+		if y, w := t.ISOWeek(); y != year || w != week {
+			return time.Time{}, false, len("2006-W")
+		}
+		return t, true, -1
+	}
+
+	t := time.Date(year, time.Month(month), day, hour, minute, sec, nsec, loc)
+	if t.Day() != day {
+		// February 30th and friends
+		return time.Time{}, false, len("2006-01-")
+	}
+
+	return t, true, -1
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+var data = []byte("2015-05-17T08:05:32.123456789+02:00")
+
+var hits int
+
+func TestParseRFC3339(t *testing.T) {
+	utc := time.UTC
+	plus2 := time.FixedZone("", 2*3600)
+	minus0730 := time.FixedZone("", -(7*3600 + 30*60))
+
+	tests := []struct {
+		in   string
+		want time.Time
+		ok   bool
+	}{
+		{"2015-05-17T08:05:32.123456789+02:00", time.Date(2015, 5, 17, 8, 5, 32, 123456789, plus2), true},
+		{"1985-04-12T23:20:50.52Z", time.Date(1985, 4, 12, 23, 20, 50, 520000000, utc), true},
+		{"1996-12-19T16:39:57-08:00", time.Date(1996, 12, 19, 16, 39, 57, 0, time.FixedZone("", -8*3600)), true},
+		{"1937-01-01T12:00:27.87+00:20", time.Date(1937, 1, 1, 12, 0, 27, 870000000, time.FixedZone("", 20*60)), true},
+		{"2006-01-02T15:04:05.1Z", time.Date(2006, 1, 2, 15, 4, 5, 100000000, utc), true},
+		{"2006-01-02T15:04:05.000001Z", time.Date(2006, 1, 2, 15, 4, 5, 1000, utc), true},
+		{"2006-01-02t15:04:05z", time.Date(2006, 1, 2, 15, 4, 5, 0, utc), true},
+		{"2006-01-02 15:04:05+00:00", time.Date(2006, 1, 2, 15, 4, 5, 0, utc), true},
+		{"2006-01-02T15:04:05-0730", time.Date(2006, 1, 2, 15, 4, 5, 0, minus0730), true},
+		{"2006-01-02T15:04:05+02", time.Date(2006, 1, 2, 15, 4, 5, 0, plus2), true},
+		{"2006-01-02T15:04Z", time.Date(2006, 1, 2, 15, 4, 0, 0, utc), true},
+		{"2006-01-02", time.Date(2006, 1, 2, 0, 0, 0, 0, utc), true},
+		{"2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, utc), true},
+		// week dates: 2009-W01-1 is 2008-12-29, 2009-W53-7 is 2010-01-03
+		{"2009-W01-1", time.Date(2008, 12, 29, 0, 0, 0, 0, utc), true},
+		{"2009-W53-7", time.Date(2010, 1, 3, 0, 0, 0, 0, utc), true},
+		{"2004-W53-6", time.Date(2005, 1, 1, 0, 0, 0, 0, utc), true},
+		{"2008-W39-6T09:30Z", time.Date(2008, 9, 27, 9, 30, 0, 0, utc), true},
+		{"2020-W10", time.Date(2020, 3, 2, 0, 0, 0, 0, utc), true},
+
+		{in: "2006-01-02T15:04:05"},
+		{in: "2006-01-02T15:04:05.Z"},
+		{in: "2006-01-02T15:04:05.1234567890Z"},
+		{in: "2006-13-02T15:04:05Z"},
+		{in: "2006-01-32T15:04:05Z"},
+		{in: "2006-02-29"},
+		{in: "2006-04-31"},
+		{in: "2006-01-02T24:00:00Z"},
+		{in: "2006-01-02T15:60:00Z"},
+		{in: "2006-01-02T15:04:05+24:00"},
+		{in: "2006-01-02T15:04:05+2:00"},
+		{in: "2006-1-2"},
+		{in: "06-01-02"},
+		{in: "2006-W00-1"},
+		{in: "2006-W01-8"},
+		{in: "2006-W54"},
+		// 2006 only has 52 weeks
+		{in: "2006-W53-1"},
+		{in: "2006-01-02T15:04:05Z "},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseRFC3339([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseRFC3339(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		_, gotOff := got.Zone()
+		_, wantOff := tt.want.Zone()
+		if !got.Equal(tt.want) || gotOff != wantOff {
+			t.Errorf("ParseRFC3339(%q)=%v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseRFC3339At(t *testing.T) {
+	tests := []struct {
+		ts     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"2015-02-29T08:05:32Z", 8},
+		{"2015-05-17X08:05:32Z", 10},
+		{"2015-05-17T08:05:32", 19},
+		{"2015-05-17T24:05:32Z", 12},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseRFC3339At([]byte(tt.ts))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseRFC3339At(%q)=%v, %d, want errPos %d", tt.ts, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// timestamps returns a million RFC 3339 timestamps such as might be found
+// in a log file, with varying fractional seconds and offsets.
+func timestamps() [][]byte {
+	zones := []*time.Location{time.UTC, time.FixedZone("", -7*3600), time.FixedZone("", 5*3600+30*60)}
+	t := time.Date(2021, 3, 14, 1, 59, 26, 535897932, time.UTC)
+	ts := make([][]byte, 1000000)
+	for i := range ts {
+		t = t.Add(1234567891 * time.Nanosecond)
+		ts[i] = []byte(t.In(zones[i%len(zones)]).Format(time.RFC3339Nano))
+	}
+	return ts
+}
+
+func TestRFC3339Alternatives(t *testing.T) {
+	for _, ts := range timestamps()[:1000] {
+		want, err := time.Parse(time.RFC3339Nano, string(ts))
+		if err != nil {
+			t.Fatalf("time.Parse(%q): %v", ts, err)
+		}
+		if got, ok := ParseRFC3339(ts); !ok || !got.Equal(want) {
+			t.Errorf("ParseRFC3339(%q)=%v, want %v", ts, got, want)
+		}
+	}
+}
+
+func BenchmarkTimeParse(b *testing.B) {
+	ts := timestamps()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range ts {
+			if _, err := time.Parse(time.RFC3339Nano, string(s)); err == nil {
+				hits++
+			}
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	ts := timestamps()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, s := range ts {
+			if _, ok := ParseRFC3339(s); ok {
+				hits++
+			}
+		}
+	}
+}
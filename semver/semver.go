@@ -0,0 +1,528 @@
+
+//line semver.rl:1
+package main
+
+import "math"
+
+// SemVer is a version number as defined by Semantic Versioning 2.0.0.
+// PreRelease and BuildMetadata point into the parsed input and are nil if
+// absent.
+type SemVer struct {
+	Major         uint64
+	Minor         uint64
+	Patch         uint64
+	PreRelease    []byte
+	BuildMetadata []byte
+}
+
+// ParseSemVer parses a version such as 1.0.0-alpha.1+001.  There is no
+// leading 'v'.
+func ParseSemVer(data []byte) (SemVer, bool) {
+	v, ok, _ := ParseSemVerAt(data)
+	return v, ok
+}
+
+// ParseSemVerAt is like ParseSemVer but also returns the offset of the
+// first byte that makes data an invalid version, or -1 if it is valid.
+func ParseSemVerAt(data []byte) (SemVer, bool, int) {
+
+
+//line semver.rl:28
+
+//line semver.go:33
+const semver_start int = 1
+const semver_first_final int = 13
+const semver_error int = 0
+
+const semver_en_main int = 1
+
+
+//line semver.rl:29
+
+	var v SemVer
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	overflow := -1
+
+	
+//line semver.go:51
+	{
+	cs = semver_start
+	}
+
+//line semver.go:56
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 13:
+		goto st_case_13
+	case 6:
+		goto st_case_6
+	case 14:
+		goto st_case_14
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 15:
+		goto st_case_15
+	case 9:
+		goto st_case_9
+	case 16:
+		goto st_case_16
+	case 10:
+		goto st_case_10
+	case 17:
+		goto st_case_17
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 48 {
+			goto tr1
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line semver.rl:42
+
+	        if v.Major > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 2; goto _out
+
+	        }
+	        v.Major = v.Major*10 + uint64((data[p])-'0')
+	    
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line semver.go:128
+		if data[p] == 46 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 48 {
+			goto tr4
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr5
+		}
+		goto st0
+tr4:
+//line semver.rl:50
+
+	        if v.Minor > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 4; goto _out
+
+	        }
+	        v.Minor = v.Minor*10 + uint64((data[p])-'0')
+	    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line semver.go:161
+		if data[p] == 46 {
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 48 {
+			goto tr7
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+tr7:
+//line semver.rl:58
+
+	        if v.Patch > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 13; goto _out
+
+	        }
+	        v.Patch = v.Patch*10 + uint64((data[p])-'0')
+	    
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line semver.go:194
+		switch data[p] {
+		case 43:
+			goto st6
+		case 45:
+			goto st8
+		}
+		goto st0
+tr19:
+//line semver.rl:39
+ v.PreRelease = data[mark:p] 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line semver.go:211
+		if data[p] == 45 {
+			goto tr9
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr9:
+//line semver.rl:38
+ mark = p 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line semver.go:237
+		switch data[p] {
+		case 45:
+			goto st14
+		case 46:
+			goto st7
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st14
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st14
+			}
+		default:
+			goto st14
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 45 {
+			goto st14
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st14
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st14
+			}
+		default:
+			goto st14
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 45:
+			goto tr11
+		case 48:
+			goto tr12
+		}
+		switch {
+		case data[p] < 65:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto tr11
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr11
+			}
+		default:
+			goto tr11
+		}
+		goto st0
+tr11:
+//line semver.rl:38
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line semver.go:311
+		switch data[p] {
+		case 43:
+			goto tr19
+		case 45:
+			goto st15
+		case 46:
+			goto st9
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st15
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 45:
+			goto st15
+		case 48:
+			goto st16
+		}
+		switch {
+		case data[p] < 65:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st15
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+tr12:
+//line semver.rl:38
+ mark = p 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line semver.go:366
+		switch data[p] {
+		case 43:
+			goto tr19
+		case 45:
+			goto st15
+		case 46:
+			goto st9
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 45 {
+			goto st15
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+tr8:
+//line semver.rl:58
+
+	        if v.Patch > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 17; goto _out
+
+	        }
+	        v.Patch = v.Patch*10 + uint64((data[p])-'0')
+	    
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line semver.go:425
+		switch data[p] {
+		case 43:
+			goto st6
+		case 45:
+			goto st8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+tr5:
+//line semver.rl:50
+
+	        if v.Minor > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 11; goto _out
+
+	        }
+	        v.Minor = v.Minor*10 + uint64((data[p])-'0')
+	    
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line semver.go:452
+		if data[p] == 46 {
+			goto st5
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr5
+		}
+		goto st0
+tr2:
+//line semver.rl:42
+
+	        if v.Major > (math.MaxUint64-uint64((data[p])-'0'))/10 {
+	            overflow = p
+	            p++; cs = 12; goto _out
+
+	        }
+	        v.Major = v.Major*10 + uint64((data[p])-'0')
+	    
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line semver.go:476
+		if data[p] == 46 {
+			goto st3
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr2
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 14:
+//line semver.rl:40
+ v.BuildMetadata = data[mark:p] 
+		case 15, 16:
+//line semver.rl:39
+ v.PreRelease = data[mark:p] 
+//line semver.go:511
+		}
+	}
+
+	_out: {}
+	}
+
+//line semver.rl:86
+
+
+	switch {
+	case overflow >= 0:
+		return SemVer{}, false, overflow
+	case cs < semver_first_final:
+		return SemVer{}, false, p
+	}
+
+	return v, true, -1
+}
@@ -0,0 +1,96 @@
+package main
+
+import "math"
+
+// SemVer is a version number as defined by Semantic Versioning 2.0.0.
+// PreRelease and BuildMetadata point into the parsed input and are nil if
+// absent.
+type SemVer struct {
+	Major         uint64
+	Minor         uint64
+	Patch         uint64
+	PreRelease    []byte
+	BuildMetadata []byte
+}
+
+// ParseSemVer parses a version such as 1.0.0-alpha.1+001.  There is no
+// leading 'v'.
+func ParseSemVer(data []byte) (SemVer, bool) {
+	v, ok, _ := ParseSemVerAt(data)
+	return v, ok
+}
+
+// ParseSemVerAt is like ParseSemVer but also returns the offset of the
+// first byte that makes data an invalid version, or -1 if it is valid.
+func ParseSemVerAt(data []byte) (SemVer, bool, int) {
+
+%% machine semver;
+%% write data;
+
+	var v SemVer
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	overflow := -1
+
+	%%{
+	    action mark  { mark = p }
+	    action pre   { v.PreRelease = data[mark:p] }
+	    action build { v.BuildMetadata = data[mark:p] }
+
+	    action major {
+	        if v.Major > (math.MaxUint64-uint64(fc-'0'))/10 {
+	            overflow = p
+	            fbreak;
+	        }
+	        v.Major = v.Major*10 + uint64(fc-'0')
+	    }
+
+	    action minor {
+	        if v.Minor > (math.MaxUint64-uint64(fc-'0'))/10 {
+	            overflow = p
+	            fbreak;
+	        }
+	        v.Minor = v.Minor*10 + uint64(fc-'0')
+	    }
+
+	    action patch {
+	        if v.Patch > (math.MaxUint64-uint64(fc-'0'))/10 {
+	            overflow = p
+	            fbreak;
+	        }
+	        v.Patch = v.Patch*10 + uint64(fc-'0')
+	    }
+
+	    ident_char = alnum | '-' ;
+
+	    # numeric identifiers can't have leading zeros
+	    numeric = '0' | '1'..'9' digit* ;
+
+	    # an alphanumeric identifier needs at least one non-digit
+	    alphanumeric = ident_char* ( alpha | '-' ) ident_char* ;
+
+	    pre_release_ident = numeric | alphanumeric ;
+	    pre_release = pre_release_ident ( '.' pre_release_ident )* ;
+
+	    build_ident = ident_char+ ;
+	    build = build_ident ( '.' build_ident )* ;
+
+	    main := numeric $major '.' numeric $minor '.' numeric $patch
+	            ( '-' pre_release >mark %pre )?
+	            ( '+' build >mark %build )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	switch {
+	case overflow >= 0:
+		return SemVer{}, false, overflow
+	case cs < semver_first_final:
+		return SemVer{}, false, p
+	}
+
+	return v, true, -1
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+var data = []byte("1.0.0-alpha.1+001")
+
+var hits int
+
+func TestParseSemVer(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SemVer
+		ok   bool
+	}{
+		{"0.0.0", SemVer{}, true},
+		{"1.0.0-alpha.1+001", SemVer{1, 0, 0, []byte("alpha.1"), []byte("001")}, true},
+		{"1.2.3", SemVer{1, 2, 3, nil, nil}, true},
+		{"10.20.30", SemVer{10, 20, 30, nil, nil}, true},
+		{"1.0.0-alpha", SemVer{1, 0, 0, []byte("alpha"), nil}, true},
+		{"1.0.0-0.3.7", SemVer{1, 0, 0, []byte("0.3.7"), nil}, true},
+		{"1.0.0-x.7.z.92", SemVer{1, 0, 0, []byte("x.7.z.92"), nil}, true},
+		{"1.0.0-x-y-z.--", SemVer{1, 0, 0, []byte("x-y-z.--"), nil}, true},
+		{"1.0.0-0alpha.00a", SemVer{1, 0, 0, []byte("0alpha.00a"), nil}, true},
+		{"1.0.0+20130313144700", SemVer{1, 0, 0, nil, []byte("20130313144700")}, true},
+		{"1.0.0-beta+exp.sha.5114f85", SemVer{1, 0, 0, []byte("beta"), []byte("exp.sha.5114f85")}, true},
+		{"1.0.0+21AF26D3----117B344092BD", SemVer{1, 0, 0, nil, []byte("21AF26D3----117B344092BD")}, true},
+		{"18446744073709551615.0.0", SemVer{18446744073709551615, 0, 0, nil, nil}, true},
+
+		{in: "1.02.3"},
+		{in: "01.2.3"},
+		{in: "1.2.03"},
+		{in: "1.0.0-"},
+		{in: "1.0.0+"},
+		{in: "1.0.0-01"},
+		{in: "1.0.0-alpha..1"},
+		{in: "1.0.0-alpha."},
+		{in: "1.0.0-alpha_beta"},
+		{in: "1.0.0+a+b"},
+		{in: "1.2"},
+		{in: "1.2.3.4"},
+		{in: "v1.2.3"},
+		{in: "1.2.-3"},
+		{in: "18446744073709551616.0.0"},
+		{in: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseSemVer([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseSemVer(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseSemVer(%q)=%+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseSemVerAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"1.01.0", 3},
+		{"1.0", 3},
+		{"1.0.0-alpha..1", 12},
+		{"18446744073709551616.0.0", 19},
+		{"1.18446744073709551620.0", 21},
+		{"1.0.184467440737095516150", 24},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseSemVerAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseSemVerAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// the regexp suggested on semver.org
+var reSemVer = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+func regexSemVer(b []byte) (SemVer, bool) {
+	m := reSemVer.FindSubmatch(b)
+	if m == nil {
+		return SemVer{}, false
+	}
+	var v SemVer
+	var err error
+	if v.Major, err = strconv.ParseUint(string(m[1]), 10, 64); err != nil {
+		return SemVer{}, false
+	}
+	if v.Minor, err = strconv.ParseUint(string(m[2]), 10, 64); err != nil {
+		return SemVer{}, false
+	}
+	if v.Patch, err = strconv.ParseUint(string(m[3]), 10, 64); err != nil {
+		return SemVer{}, false
+	}
+	v.PreRelease, v.BuildMetadata = m[4], m[5]
+	return v, true
+}
+
+func TestSemVerAlternatives(t *testing.T) {
+	want, _ := ParseSemVer(data)
+	if got, ok := regexSemVer(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexSemVer=%+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexSemVer(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseSemVer(data); ok {
+			hits++
+		}
+	}
+}
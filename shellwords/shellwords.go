@@ -0,0 +1,254 @@
+
+//line shellwords.rl:1
+package main
+
+// ScanShellWords splits data into words the way a POSIX shell would,
+// removing the quoting.  Inside single quotes every character is literal.
+// Inside double quotes a backslash only escapes '"', '\\', '`', '$', and
+// newline, and is kept before anything else.  Outside quotes a backslash
+// escapes any character.  In both of the latter, a backslash-newline is
+// removed to join the lines.  A '#' at the start of a word begins a
+// comment that runs to the end of the line.  Quoted and unquoted parts with
+// nothing between them make up a single word, so '' is an empty word.
+//
+// No expansion is done, and operators such as '|' and ';' are treated as
+// ordinary characters.  The words share a single newly allocated buffer.
+func ScanShellWords(data []byte) ([][]byte, bool) {
+	words, ok, _ := ScanShellWordsAt(data)
+	return words, ok
+}
+
+// ScanShellWordsAt is like ScanShellWords but also returns the offset of
+// the first byte it couldn't make sense of, or -1.  A quote left open
+// fails at len(data).
+func ScanShellWordsAt(data []byte) ([][]byte, bool, int) {
+
+
+//line shellwords.rl:25
+
+//line shellwords.go:30
+const shellwords_start int = 5
+const shellwords_first_final int = 5
+const shellwords_error int = -1
+
+const shellwords_en_main int = 5
+
+
+//line shellwords.rl:26
+
+	var words [][]byte
+	buf := make([]byte, 0, len(data))
+	start := 0
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	
+//line shellwords.go:47
+	{
+	cs = shellwords_start
+	}
+
+//line shellwords.go:52
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 0:
+		goto st_case_0
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 7:
+		goto st_case_7
+	case 4:
+		goto st_case_4
+	}
+	goto st_out
+tr13:
+//line shellwords.rl:37
+ words = append(words, buf[start:len(buf):len(buf)]) 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line shellwords.go:85
+		switch data[p] {
+		case 32:
+			goto st5
+		case 34:
+			goto tr9
+		case 35:
+			goto st7
+		case 39:
+			goto tr11
+		case 92:
+			goto tr12
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st5
+		}
+		goto tr8
+tr6:
+//line shellwords.rl:35
+ buf = append(buf, (data[p])) 
+	goto st6
+tr8:
+//line shellwords.rl:34
+ start = len(buf) 
+//line shellwords.rl:35
+ buf = append(buf, (data[p])) 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line shellwords.go:117
+		switch data[p] {
+		case 32:
+			goto tr13
+		case 34:
+			goto st0
+		case 39:
+			goto st2
+		case 92:
+			goto st3
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto tr13
+		}
+		goto tr6
+tr0:
+//line shellwords.rl:35
+ buf = append(buf, (data[p])) 
+	goto st0
+tr3:
+//line shellwords.rl:36
+ buf = append(buf, '\\', (data[p])) 
+	goto st0
+tr9:
+//line shellwords.rl:34
+ start = len(buf) 
+	goto st0
+	st0:
+		if p++; p == pe {
+			goto _test_eof0
+		}
+	st_case_0:
+//line shellwords.go:149
+		switch data[p] {
+		case 34:
+			goto st6
+		case 92:
+			goto st1
+		}
+		goto tr0
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 10:
+			goto st0
+		case 34:
+			goto tr0
+		case 36:
+			goto tr0
+		case 92:
+			goto tr0
+		case 96:
+			goto tr0
+		}
+		goto tr3
+tr5:
+//line shellwords.rl:35
+ buf = append(buf, (data[p])) 
+	goto st2
+tr11:
+//line shellwords.rl:34
+ start = len(buf) 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line shellwords.go:188
+		if data[p] == 39 {
+			goto st6
+		}
+		goto tr5
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 10 {
+			goto st6
+		}
+		goto tr6
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if data[p] == 10 {
+			goto st5
+		}
+		goto st7
+tr12:
+//line shellwords.rl:34
+ start = len(buf) 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line shellwords.go:220
+		if data[p] == 10 {
+			goto st5
+		}
+		goto tr6
+	st_out:
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof0: cs = 0; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 6:
+//line shellwords.rl:37
+ words = append(words, buf[start:len(buf):len(buf)]) 
+//line shellwords.go:241
+		}
+	}
+
+	}
+
+//line shellwords.rl:68
+
+
+	if cs < shellwords_first_final {
+		return nil, false, p
+	}
+
+	return words, true, -1
+}
@@ -0,0 +1,75 @@
+package main
+
+// ScanShellWords splits data into words the way a POSIX shell would,
+// removing the quoting.  Inside single quotes every character is literal.
+// Inside double quotes a backslash only escapes '"', '\\', '`', '$', and
+// newline, and is kept before anything else.  Outside quotes a backslash
+// escapes any character.  In both of the latter, a backslash-newline is
+// removed to join the lines.  A '#' at the start of a word begins a
+// comment that runs to the end of the line.  Quoted and unquoted parts with
+// nothing between them make up a single word, so '' is an empty word.
+//
+// No expansion is done, and operators such as '|' and ';' are treated as
+// ordinary characters.  The words share a single newly allocated buffer.
+func ScanShellWords(data []byte) ([][]byte, bool) {
+	words, ok, _ := ScanShellWordsAt(data)
+	return words, ok
+}
+
+// ScanShellWordsAt is like ScanShellWords but also returns the offset of
+// the first byte it couldn't make sense of, or -1.  A quote left open
+// fails at len(data).
+func ScanShellWordsAt(data []byte) ([][]byte, bool, int) {
+
+%% machine shellwords;
+%% write data;
+
+	var words [][]byte
+	buf := make([]byte, 0, len(data))
+	start := 0
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	%%{
+	    action start { start = len(buf) }
+	    action char  { buf = append(buf, fc) }
+	    action keep  { buf = append(buf, '\\', fc) }
+	    action word  { words = append(words, buf[start:len(buf):len(buf)]) }
+
+	    blank = [ \t\n] ;
+	    continuation = '\\\n' ;
+
+	    unquoted = ( any - ( blank | ['"\\] ) ) @char ;
+	    escape = '\\' ( any - '\n' ) @char ;
+
+	    single = "'" ( [^'] @char )* "'" ;
+
+	    double = '"' ( ( any - ["\\] ) @char
+	                 | '\\' ( ["\\`$] @char | '\n' | ( any - ["\\`$\n] ) @keep )
+	                 )* '"' ;
+
+	    part = unquoted | escape | single | double ;
+
+	    first = ( unquoted - '#' ) | escape | single | double ;
+	    word = first ( part | continuation )* ;
+
+	    # A comment has to run to the end of the line, so only the last one
+	    # in data may stop short of a newline.
+	    comment = '#' [^\n]* ;
+
+	    # a continuation between words is just more blank space
+	    gap = ( blank | continuation | comment '\n' )* ;
+	    sep = blank gap ;
+
+	    main := gap ( comment | word >start %word ( sep word >start %word )* ( sep comment? )? )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < shellwords_first_final {
+		return nil, false, p
+	}
+
+	return words, true, -1
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/shlex"
+)
+
+var data = []byte(`docker run --rm -e 'GREETING=hello world' -v "$HOME/src:/src" -w /src golang:1.22 sh -c "go test ./... && echo \"done\"" # run the tests`)
+
+var hits int
+
+func TestScanShellWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+		ok   bool
+	}{
+		{
+			in:   string(data),
+			want: []string{"docker", "run", "--rm", "-e", "GREETING=hello world", "-v", "$HOME/src:/src", "-w", "/src", "golang:1.22", "sh", "-c", `go test ./... && echo "done"`},
+			ok:   true,
+		},
+		{in: "", want: nil, ok: true},
+		{in: " \t\n", want: nil, ok: true},
+		{in: "a  b\tc\nd", want: []string{"a", "b", "c", "d"}, ok: true},
+		// empty quotes are still a word
+		{in: `'' ""`, want: []string{"", ""}, ok: true},
+		{in: `a '' b`, want: []string{"a", "", "b"}, ok: true},
+		// adjacent parts join up
+		{in: `'foo'"bar"baz`, want: []string{"foobarbaz"}, ok: true},
+		{in: `a'b c'd`, want: []string{"ab cd"}, ok: true},
+		// single quotes keep everything, including backslashes and newlines
+		{in: `'a\nb' 'c` + "\n" + `d'`, want: []string{`a\nb`, "c\nd"}, ok: true},
+		{in: `'\'`, want: []string{`\`}, ok: true},
+		// double quotes only give backslash a meaning before a few characters
+		{in: `"\"\\\$\` + "`" + `\a"`, want: []string{`"\$` + "`" + `\a`}, ok: true},
+		{in: "\"a\nb\"", want: []string{"a\nb"}, ok: true},
+		{in: "\"a\\\nb\"", want: []string{"ab"}, ok: true},
+		// unquoted backslash escapes anything
+		{in: `a\ b \'c\"`, want: []string{"a b", `'c"`}, ok: true},
+		{in: `\#not-a-comment`, want: []string{"#not-a-comment"}, ok: true},
+		// line continuations
+		{in: "a\\\nb", want: []string{"ab"}, ok: true},
+		{in: "a \\\n b", want: []string{"a", "b"}, ok: true},
+		{in: "\\\na", want: []string{"a"}, ok: true},
+		// comments only start at the beginning of a word
+		{in: "# comment", want: nil, ok: true},
+		{in: "a # comment\nb", want: []string{"a", "b"}, ok: true},
+		{in: "a#b", want: []string{"a#b"}, ok: true},
+		{in: "# it's fine\na", want: []string{"a"}, ok: true},
+		{in: "a \"#b\"", want: []string{"a", "#b"}, ok: true},
+		// unterminated
+		{in: `'abc`},
+		{in: `"abc`},
+		{in: `"abc\"`},
+		{in: `abc\`},
+		{in: `a "b`},
+	}
+
+	for _, tt := range tests {
+		got, ok := ScanShellWords([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ScanShellWords(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var words []string
+		for _, w := range got {
+			words = append(words, string(w))
+		}
+		if !reflect.DeepEqual(words, tt.want) {
+			t.Errorf("ScanShellWords(%q)=%q, want %q", tt.in, words, tt.want)
+		}
+	}
+}
+
+func TestScanShellWordsAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{`echo 'unterminated`, 18},
+		{`echo "also`, 10},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ScanShellWordsAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ScanShellWordsAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+func TestShellWordsAlternatives(t *testing.T) {
+	words, _ := ScanShellWords(data)
+	var want []string
+	for _, w := range words {
+		want = append(want, string(w))
+	}
+	if got, err := shlex.Split(string(data)); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("shlex.Split=%q, %v, want %q", got, err, want)
+	}
+}
+
+func BenchmarkShlex(b *testing.B) {
+	line := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, err := shlex.Split(line); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ScanShellWords(data); ok {
+			hits++
+		}
+	}
+}
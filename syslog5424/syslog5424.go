@@ -0,0 +1,9927 @@
+
+//line syslog5424.rl:1
+package main
+
+import "strconv"
+
+// Syslog5424Message is a message in the RFC 5424 syslog format.  The NILVALUE
+// "-" is reported as a nil slice.  All byte slices point into the parsed
+// input except for structured data values containing escapes.
+type Syslog5424Message struct {
+	Priority       int
+	Version        int
+	Timestamp      []byte
+	Hostname       []byte
+	AppName        []byte
+	ProcID         []byte
+	MsgID          []byte
+	StructuredData []SDElement
+	Message        []byte
+}
+
+// Facility returns the facility encoded in the message priority.
+func (m *Syslog5424Message) Facility() int { return m.Priority / 8 }
+
+// Severity returns the severity encoded in the message priority.
+func (m *Syslog5424Message) Severity() int { return m.Priority % 8 }
+
+// SDElement is a single [id name="value" ...] block of structured data.
+type SDElement struct {
+	ID     []byte
+	Params []SDParam
+}
+
+// SDParam is a structured data parameter.  Value has had its \" \\ and \]
+// escapes removed.
+type SDParam struct {
+	Name  []byte
+	Value []byte
+}
+
+// A SyslogSyntaxError reports where ParseSyslog5424 stopped.
+type SyslogSyntaxError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *SyslogSyntaxError) Error() string {
+	return "syslog5424: " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+func unescapeSDValue(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) && (v[i+1] == '"' || v[i+1] == '\\' || v[i+1] == ']') {
+			i++
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseSyslog5424 parses an RFC 5424 syslog message.
+func ParseSyslog5424(data []byte) (Syslog5424Message, error) {
+
+
+//line syslog5424.rl:64
+
+//line syslog5424.go:69
+const syslog5424_start int = 1
+const syslog5424_first_final int = 599
+const syslog5424_error int = 0
+
+const syslog5424_en_main int = 1
+
+
+//line syslog5424.rl:65
+
+	var m Syslog5424Message
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name []byte
+	var sd *SDElement
+	escaped := false
+
+	
+//line syslog5424.go:89
+	{
+	cs = syslog5424_start
+	}
+
+//line syslog5424.go:94
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 599:
+		goto st_case_599
+	case 600:
+		goto st_case_600
+	case 601:
+		goto st_case_601
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 602:
+		goto st_case_602
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 153:
+		goto st_case_153
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 180:
+		goto st_case_180
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 185:
+		goto st_case_185
+	case 186:
+		goto st_case_186
+	case 187:
+		goto st_case_187
+	case 188:
+		goto st_case_188
+	case 189:
+		goto st_case_189
+	case 190:
+		goto st_case_190
+	case 191:
+		goto st_case_191
+	case 192:
+		goto st_case_192
+	case 193:
+		goto st_case_193
+	case 194:
+		goto st_case_194
+	case 195:
+		goto st_case_195
+	case 196:
+		goto st_case_196
+	case 197:
+		goto st_case_197
+	case 198:
+		goto st_case_198
+	case 199:
+		goto st_case_199
+	case 200:
+		goto st_case_200
+	case 201:
+		goto st_case_201
+	case 202:
+		goto st_case_202
+	case 203:
+		goto st_case_203
+	case 204:
+		goto st_case_204
+	case 205:
+		goto st_case_205
+	case 206:
+		goto st_case_206
+	case 207:
+		goto st_case_207
+	case 208:
+		goto st_case_208
+	case 209:
+		goto st_case_209
+	case 210:
+		goto st_case_210
+	case 211:
+		goto st_case_211
+	case 212:
+		goto st_case_212
+	case 213:
+		goto st_case_213
+	case 214:
+		goto st_case_214
+	case 215:
+		goto st_case_215
+	case 216:
+		goto st_case_216
+	case 217:
+		goto st_case_217
+	case 218:
+		goto st_case_218
+	case 219:
+		goto st_case_219
+	case 220:
+		goto st_case_220
+	case 221:
+		goto st_case_221
+	case 222:
+		goto st_case_222
+	case 223:
+		goto st_case_223
+	case 224:
+		goto st_case_224
+	case 225:
+		goto st_case_225
+	case 226:
+		goto st_case_226
+	case 227:
+		goto st_case_227
+	case 228:
+		goto st_case_228
+	case 229:
+		goto st_case_229
+	case 230:
+		goto st_case_230
+	case 231:
+		goto st_case_231
+	case 232:
+		goto st_case_232
+	case 233:
+		goto st_case_233
+	case 234:
+		goto st_case_234
+	case 235:
+		goto st_case_235
+	case 236:
+		goto st_case_236
+	case 237:
+		goto st_case_237
+	case 238:
+		goto st_case_238
+	case 239:
+		goto st_case_239
+	case 240:
+		goto st_case_240
+	case 241:
+		goto st_case_241
+	case 242:
+		goto st_case_242
+	case 243:
+		goto st_case_243
+	case 244:
+		goto st_case_244
+	case 245:
+		goto st_case_245
+	case 246:
+		goto st_case_246
+	case 247:
+		goto st_case_247
+	case 248:
+		goto st_case_248
+	case 249:
+		goto st_case_249
+	case 250:
+		goto st_case_250
+	case 251:
+		goto st_case_251
+	case 252:
+		goto st_case_252
+	case 253:
+		goto st_case_253
+	case 254:
+		goto st_case_254
+	case 255:
+		goto st_case_255
+	case 256:
+		goto st_case_256
+	case 257:
+		goto st_case_257
+	case 258:
+		goto st_case_258
+	case 259:
+		goto st_case_259
+	case 260:
+		goto st_case_260
+	case 261:
+		goto st_case_261
+	case 262:
+		goto st_case_262
+	case 263:
+		goto st_case_263
+	case 264:
+		goto st_case_264
+	case 265:
+		goto st_case_265
+	case 266:
+		goto st_case_266
+	case 267:
+		goto st_case_267
+	case 268:
+		goto st_case_268
+	case 269:
+		goto st_case_269
+	case 270:
+		goto st_case_270
+	case 271:
+		goto st_case_271
+	case 272:
+		goto st_case_272
+	case 273:
+		goto st_case_273
+	case 274:
+		goto st_case_274
+	case 275:
+		goto st_case_275
+	case 276:
+		goto st_case_276
+	case 277:
+		goto st_case_277
+	case 278:
+		goto st_case_278
+	case 279:
+		goto st_case_279
+	case 280:
+		goto st_case_280
+	case 281:
+		goto st_case_281
+	case 282:
+		goto st_case_282
+	case 283:
+		goto st_case_283
+	case 284:
+		goto st_case_284
+	case 285:
+		goto st_case_285
+	case 286:
+		goto st_case_286
+	case 287:
+		goto st_case_287
+	case 288:
+		goto st_case_288
+	case 289:
+		goto st_case_289
+	case 290:
+		goto st_case_290
+	case 291:
+		goto st_case_291
+	case 292:
+		goto st_case_292
+	case 293:
+		goto st_case_293
+	case 294:
+		goto st_case_294
+	case 295:
+		goto st_case_295
+	case 296:
+		goto st_case_296
+	case 297:
+		goto st_case_297
+	case 298:
+		goto st_case_298
+	case 299:
+		goto st_case_299
+	case 300:
+		goto st_case_300
+	case 301:
+		goto st_case_301
+	case 302:
+		goto st_case_302
+	case 303:
+		goto st_case_303
+	case 304:
+		goto st_case_304
+	case 305:
+		goto st_case_305
+	case 306:
+		goto st_case_306
+	case 307:
+		goto st_case_307
+	case 308:
+		goto st_case_308
+	case 309:
+		goto st_case_309
+	case 310:
+		goto st_case_310
+	case 311:
+		goto st_case_311
+	case 312:
+		goto st_case_312
+	case 313:
+		goto st_case_313
+	case 314:
+		goto st_case_314
+	case 315:
+		goto st_case_315
+	case 316:
+		goto st_case_316
+	case 317:
+		goto st_case_317
+	case 318:
+		goto st_case_318
+	case 319:
+		goto st_case_319
+	case 320:
+		goto st_case_320
+	case 321:
+		goto st_case_321
+	case 322:
+		goto st_case_322
+	case 323:
+		goto st_case_323
+	case 324:
+		goto st_case_324
+	case 325:
+		goto st_case_325
+	case 326:
+		goto st_case_326
+	case 327:
+		goto st_case_327
+	case 328:
+		goto st_case_328
+	case 329:
+		goto st_case_329
+	case 330:
+		goto st_case_330
+	case 331:
+		goto st_case_331
+	case 332:
+		goto st_case_332
+	case 333:
+		goto st_case_333
+	case 334:
+		goto st_case_334
+	case 335:
+		goto st_case_335
+	case 336:
+		goto st_case_336
+	case 337:
+		goto st_case_337
+	case 338:
+		goto st_case_338
+	case 339:
+		goto st_case_339
+	case 340:
+		goto st_case_340
+	case 341:
+		goto st_case_341
+	case 342:
+		goto st_case_342
+	case 343:
+		goto st_case_343
+	case 344:
+		goto st_case_344
+	case 345:
+		goto st_case_345
+	case 346:
+		goto st_case_346
+	case 347:
+		goto st_case_347
+	case 348:
+		goto st_case_348
+	case 349:
+		goto st_case_349
+	case 350:
+		goto st_case_350
+	case 351:
+		goto st_case_351
+	case 352:
+		goto st_case_352
+	case 353:
+		goto st_case_353
+	case 354:
+		goto st_case_354
+	case 355:
+		goto st_case_355
+	case 356:
+		goto st_case_356
+	case 357:
+		goto st_case_357
+	case 358:
+		goto st_case_358
+	case 359:
+		goto st_case_359
+	case 360:
+		goto st_case_360
+	case 361:
+		goto st_case_361
+	case 362:
+		goto st_case_362
+	case 363:
+		goto st_case_363
+	case 364:
+		goto st_case_364
+	case 365:
+		goto st_case_365
+	case 366:
+		goto st_case_366
+	case 367:
+		goto st_case_367
+	case 368:
+		goto st_case_368
+	case 369:
+		goto st_case_369
+	case 370:
+		goto st_case_370
+	case 371:
+		goto st_case_371
+	case 372:
+		goto st_case_372
+	case 373:
+		goto st_case_373
+	case 374:
+		goto st_case_374
+	case 375:
+		goto st_case_375
+	case 376:
+		goto st_case_376
+	case 377:
+		goto st_case_377
+	case 378:
+		goto st_case_378
+	case 379:
+		goto st_case_379
+	case 380:
+		goto st_case_380
+	case 381:
+		goto st_case_381
+	case 382:
+		goto st_case_382
+	case 383:
+		goto st_case_383
+	case 384:
+		goto st_case_384
+	case 385:
+		goto st_case_385
+	case 386:
+		goto st_case_386
+	case 387:
+		goto st_case_387
+	case 388:
+		goto st_case_388
+	case 389:
+		goto st_case_389
+	case 390:
+		goto st_case_390
+	case 391:
+		goto st_case_391
+	case 392:
+		goto st_case_392
+	case 393:
+		goto st_case_393
+	case 394:
+		goto st_case_394
+	case 395:
+		goto st_case_395
+	case 396:
+		goto st_case_396
+	case 397:
+		goto st_case_397
+	case 398:
+		goto st_case_398
+	case 399:
+		goto st_case_399
+	case 400:
+		goto st_case_400
+	case 401:
+		goto st_case_401
+	case 402:
+		goto st_case_402
+	case 403:
+		goto st_case_403
+	case 404:
+		goto st_case_404
+	case 405:
+		goto st_case_405
+	case 406:
+		goto st_case_406
+	case 407:
+		goto st_case_407
+	case 408:
+		goto st_case_408
+	case 409:
+		goto st_case_409
+	case 410:
+		goto st_case_410
+	case 411:
+		goto st_case_411
+	case 412:
+		goto st_case_412
+	case 413:
+		goto st_case_413
+	case 414:
+		goto st_case_414
+	case 415:
+		goto st_case_415
+	case 416:
+		goto st_case_416
+	case 417:
+		goto st_case_417
+	case 418:
+		goto st_case_418
+	case 419:
+		goto st_case_419
+	case 420:
+		goto st_case_420
+	case 421:
+		goto st_case_421
+	case 422:
+		goto st_case_422
+	case 423:
+		goto st_case_423
+	case 424:
+		goto st_case_424
+	case 425:
+		goto st_case_425
+	case 426:
+		goto st_case_426
+	case 427:
+		goto st_case_427
+	case 428:
+		goto st_case_428
+	case 429:
+		goto st_case_429
+	case 430:
+		goto st_case_430
+	case 431:
+		goto st_case_431
+	case 432:
+		goto st_case_432
+	case 433:
+		goto st_case_433
+	case 434:
+		goto st_case_434
+	case 435:
+		goto st_case_435
+	case 436:
+		goto st_case_436
+	case 437:
+		goto st_case_437
+	case 438:
+		goto st_case_438
+	case 439:
+		goto st_case_439
+	case 440:
+		goto st_case_440
+	case 441:
+		goto st_case_441
+	case 442:
+		goto st_case_442
+	case 443:
+		goto st_case_443
+	case 444:
+		goto st_case_444
+	case 445:
+		goto st_case_445
+	case 446:
+		goto st_case_446
+	case 447:
+		goto st_case_447
+	case 448:
+		goto st_case_448
+	case 449:
+		goto st_case_449
+	case 450:
+		goto st_case_450
+	case 451:
+		goto st_case_451
+	case 452:
+		goto st_case_452
+	case 453:
+		goto st_case_453
+	case 454:
+		goto st_case_454
+	case 455:
+		goto st_case_455
+	case 456:
+		goto st_case_456
+	case 457:
+		goto st_case_457
+	case 458:
+		goto st_case_458
+	case 459:
+		goto st_case_459
+	case 460:
+		goto st_case_460
+	case 461:
+		goto st_case_461
+	case 462:
+		goto st_case_462
+	case 463:
+		goto st_case_463
+	case 464:
+		goto st_case_464
+	case 465:
+		goto st_case_465
+	case 466:
+		goto st_case_466
+	case 467:
+		goto st_case_467
+	case 468:
+		goto st_case_468
+	case 469:
+		goto st_case_469
+	case 470:
+		goto st_case_470
+	case 471:
+		goto st_case_471
+	case 472:
+		goto st_case_472
+	case 473:
+		goto st_case_473
+	case 474:
+		goto st_case_474
+	case 475:
+		goto st_case_475
+	case 476:
+		goto st_case_476
+	case 477:
+		goto st_case_477
+	case 478:
+		goto st_case_478
+	case 479:
+		goto st_case_479
+	case 480:
+		goto st_case_480
+	case 481:
+		goto st_case_481
+	case 482:
+		goto st_case_482
+	case 483:
+		goto st_case_483
+	case 484:
+		goto st_case_484
+	case 485:
+		goto st_case_485
+	case 486:
+		goto st_case_486
+	case 487:
+		goto st_case_487
+	case 488:
+		goto st_case_488
+	case 489:
+		goto st_case_489
+	case 490:
+		goto st_case_490
+	case 491:
+		goto st_case_491
+	case 492:
+		goto st_case_492
+	case 493:
+		goto st_case_493
+	case 494:
+		goto st_case_494
+	case 495:
+		goto st_case_495
+	case 496:
+		goto st_case_496
+	case 497:
+		goto st_case_497
+	case 498:
+		goto st_case_498
+	case 499:
+		goto st_case_499
+	case 500:
+		goto st_case_500
+	case 501:
+		goto st_case_501
+	case 502:
+		goto st_case_502
+	case 503:
+		goto st_case_503
+	case 504:
+		goto st_case_504
+	case 505:
+		goto st_case_505
+	case 506:
+		goto st_case_506
+	case 507:
+		goto st_case_507
+	case 508:
+		goto st_case_508
+	case 509:
+		goto st_case_509
+	case 510:
+		goto st_case_510
+	case 511:
+		goto st_case_511
+	case 512:
+		goto st_case_512
+	case 513:
+		goto st_case_513
+	case 514:
+		goto st_case_514
+	case 515:
+		goto st_case_515
+	case 516:
+		goto st_case_516
+	case 517:
+		goto st_case_517
+	case 518:
+		goto st_case_518
+	case 519:
+		goto st_case_519
+	case 520:
+		goto st_case_520
+	case 521:
+		goto st_case_521
+	case 522:
+		goto st_case_522
+	case 523:
+		goto st_case_523
+	case 524:
+		goto st_case_524
+	case 525:
+		goto st_case_525
+	case 526:
+		goto st_case_526
+	case 527:
+		goto st_case_527
+	case 528:
+		goto st_case_528
+	case 529:
+		goto st_case_529
+	case 530:
+		goto st_case_530
+	case 531:
+		goto st_case_531
+	case 532:
+		goto st_case_532
+	case 533:
+		goto st_case_533
+	case 534:
+		goto st_case_534
+	case 535:
+		goto st_case_535
+	case 536:
+		goto st_case_536
+	case 537:
+		goto st_case_537
+	case 538:
+		goto st_case_538
+	case 539:
+		goto st_case_539
+	case 540:
+		goto st_case_540
+	case 541:
+		goto st_case_541
+	case 542:
+		goto st_case_542
+	case 543:
+		goto st_case_543
+	case 544:
+		goto st_case_544
+	case 545:
+		goto st_case_545
+	case 546:
+		goto st_case_546
+	case 547:
+		goto st_case_547
+	case 548:
+		goto st_case_548
+	case 549:
+		goto st_case_549
+	case 550:
+		goto st_case_550
+	case 551:
+		goto st_case_551
+	case 552:
+		goto st_case_552
+	case 553:
+		goto st_case_553
+	case 554:
+		goto st_case_554
+	case 555:
+		goto st_case_555
+	case 556:
+		goto st_case_556
+	case 557:
+		goto st_case_557
+	case 558:
+		goto st_case_558
+	case 559:
+		goto st_case_559
+	case 560:
+		goto st_case_560
+	case 561:
+		goto st_case_561
+	case 562:
+		goto st_case_562
+	case 563:
+		goto st_case_563
+	case 564:
+		goto st_case_564
+	case 565:
+		goto st_case_565
+	case 566:
+		goto st_case_566
+	case 567:
+		goto st_case_567
+	case 568:
+		goto st_case_568
+	case 569:
+		goto st_case_569
+	case 570:
+		goto st_case_570
+	case 571:
+		goto st_case_571
+	case 572:
+		goto st_case_572
+	case 573:
+		goto st_case_573
+	case 574:
+		goto st_case_574
+	case 575:
+		goto st_case_575
+	case 576:
+		goto st_case_576
+	case 577:
+		goto st_case_577
+	case 578:
+		goto st_case_578
+	case 579:
+		goto st_case_579
+	case 580:
+		goto st_case_580
+	case 581:
+		goto st_case_581
+	case 582:
+		goto st_case_582
+	case 583:
+		goto st_case_583
+	case 584:
+		goto st_case_584
+	case 585:
+		goto st_case_585
+	case 586:
+		goto st_case_586
+	case 587:
+		goto st_case_587
+	case 588:
+		goto st_case_588
+	case 589:
+		goto st_case_589
+	case 590:
+		goto st_case_590
+	case 591:
+		goto st_case_591
+	case 592:
+		goto st_case_592
+	case 593:
+		goto st_case_593
+	case 594:
+		goto st_case_594
+	case 595:
+		goto st_case_595
+	case 596:
+		goto st_case_596
+	case 597:
+		goto st_case_597
+	case 598:
+		goto st_case_598
+	}
+	goto st_out
+	st_case_1:
+		if data[p] == 60 {
+			goto st2
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr2
+		}
+		goto st0
+tr2:
+//line syslog5424.rl:77
+ m.Priority = m.Priority*10 + int((data[p])-'0') 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line syslog5424.go:1335
+		if data[p] == 62 {
+			goto st6
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr3
+		}
+		goto st0
+tr3:
+//line syslog5424.rl:77
+ m.Priority = m.Priority*10 + int((data[p])-'0') 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line syslog5424.go:1352
+		if data[p] == 62 {
+			goto st6
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr5
+		}
+		goto st0
+tr5:
+//line syslog5424.rl:77
+ m.Priority = m.Priority*10 + int((data[p])-'0') 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line syslog5424.go:1369
+		if data[p] == 62 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr6
+		}
+		goto st0
+tr6:
+//line syslog5424.rl:78
+ m.Version = m.Version*10 + int((data[p])-'0') 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line syslog5424.go:1392
+		if data[p] == 32 {
+			goto st8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if data[p] == 45 {
+			goto st9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr10
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if data[p] == 32 {
+			goto st10
+		}
+		goto st0
+tr609:
+//line syslog5424.rl:79
+ m.Timestamp = data[mark:p] 
+	goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+//line syslog5424.go:1430
+		if data[p] == 45 {
+			goto tr13
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto tr12
+		}
+		goto st0
+tr12:
+//line syslog5424.rl:76
+ mark = p 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line syslog5424.go:1447
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st305
+		}
+		goto st0
+tr14:
+//line syslog5424.rl:80
+ m.Hostname = data[mark:p] 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line syslog5424.go:1464
+		if data[p] == 45 {
+			goto tr17
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto tr16
+		}
+		goto st0
+tr16:
+//line syslog5424.rl:76
+ mark = p 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line syslog5424.go:1481
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st257
+		}
+		goto st0
+tr18:
+//line syslog5424.rl:81
+ m.AppName = data[mark:p] 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line syslog5424.go:1498
+		if data[p] == 45 {
+			goto tr21
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto tr20
+		}
+		goto st0
+tr20:
+//line syslog5424.rl:76
+ mark = p 
+	goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+//line syslog5424.go:1515
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st129
+		}
+		goto st0
+tr22:
+//line syslog5424.rl:82
+ m.ProcID = data[mark:p] 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line syslog5424.go:1532
+		if data[p] == 45 {
+			goto tr25
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto tr24
+		}
+		goto st0
+tr24:
+//line syslog5424.rl:76
+ mark = p 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line syslog5424.go:1549
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st97
+		}
+		goto st0
+tr26:
+//line syslog5424.rl:83
+ m.MsgID = data[mark:p] 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line syslog5424.go:1566
+		switch data[p] {
+		case 45:
+			goto st599
+		case 91:
+			goto st19
+		}
+		goto st0
+	st599:
+		if p++; p == pe {
+			goto _test_eof599
+		}
+	st_case_599:
+		if data[p] == 32 {
+			goto st600
+		}
+		goto st0
+	st600:
+		if p++; p == pe {
+			goto _test_eof600
+		}
+	st_case_600:
+		goto tr618
+tr618:
+//line syslog5424.rl:76
+ mark = p 
+	goto st601
+	st601:
+		if p++; p == pe {
+			goto _test_eof601
+		}
+	st_case_601:
+//line syslog5424.go:1598
+		goto st601
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 33 {
+			goto tr30
+		}
+		switch {
+		case data[p] < 62:
+			if 35 <= data[p] && data[p] <= 60 {
+				goto tr30
+			}
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto tr30
+			}
+		default:
+			goto tr30
+		}
+		goto st0
+tr30:
+//line syslog5424.rl:76
+ mark = p 
+	goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+//line syslog5424.go:1630
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st66
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st66
+			}
+		case data[p] >= 35:
+			goto st66
+		}
+		goto st0
+tr31:
+//line syslog5424.rl:86
+
+	        m.StructuredData = append(m.StructuredData, SDElement{ID: data[mark:p]})
+	        sd = &m.StructuredData[len(m.StructuredData)-1]
+	    
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line syslog5424.go:1660
+		if data[p] == 33 {
+			goto tr34
+		}
+		switch {
+		case data[p] < 62:
+			if 35 <= data[p] && data[p] <= 60 {
+				goto tr34
+			}
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto tr34
+			}
+		default:
+			goto tr34
+		}
+		goto st0
+tr34:
+//line syslog5424.rl:76
+ mark = p 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line syslog5424.go:1686
+		switch data[p] {
+		case 33:
+			goto st23
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st23
+			}
+		case data[p] >= 35:
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 33:
+			goto st24
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st24
+			}
+		case data[p] >= 35:
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch data[p] {
+		case 33:
+			goto st25
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st25
+			}
+		case data[p] >= 35:
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 33:
+			goto st26
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st26
+			}
+		case data[p] >= 35:
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch data[p] {
+		case 33:
+			goto st27
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st27
+			}
+		case data[p] >= 35:
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 33:
+			goto st28
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st28
+			}
+		case data[p] >= 35:
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 33:
+			goto st29
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st29
+			}
+		case data[p] >= 35:
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 33:
+			goto st30
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st30
+			}
+		case data[p] >= 35:
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 33:
+			goto st31
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st31
+			}
+		case data[p] >= 35:
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 33:
+			goto st32
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st32
+			}
+		case data[p] >= 35:
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 33:
+			goto st33
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st33
+			}
+		case data[p] >= 35:
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 33:
+			goto st34
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st34
+			}
+		case data[p] >= 35:
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 33:
+			goto st35
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st35
+			}
+		case data[p] >= 35:
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 33:
+			goto st36
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st36
+			}
+		case data[p] >= 35:
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 33:
+			goto st37
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st37
+			}
+		case data[p] >= 35:
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 33:
+			goto st38
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st38
+			}
+		case data[p] >= 35:
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 33:
+			goto st39
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st39
+			}
+		case data[p] >= 35:
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 33:
+			goto st40
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st40
+			}
+		case data[p] >= 35:
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 33:
+			goto st41
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st41
+			}
+		case data[p] >= 35:
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 33:
+			goto st42
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st42
+			}
+		case data[p] >= 35:
+			goto st42
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 33:
+			goto st43
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st43
+			}
+		case data[p] >= 35:
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 33:
+			goto st44
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st44
+			}
+		case data[p] >= 35:
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 33:
+			goto st45
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st45
+			}
+		case data[p] >= 35:
+			goto st45
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch data[p] {
+		case 33:
+			goto st46
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st46
+			}
+		case data[p] >= 35:
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 33:
+			goto st47
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st47
+			}
+		case data[p] >= 35:
+			goto st47
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 33:
+			goto st48
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st48
+			}
+		case data[p] >= 35:
+			goto st48
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 33:
+			goto st49
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st49
+			}
+		case data[p] >= 35:
+			goto st49
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch data[p] {
+		case 33:
+			goto st50
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st50
+			}
+		case data[p] >= 35:
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 33:
+			goto st51
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st51
+			}
+		case data[p] >= 35:
+			goto st51
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 33:
+			goto st52
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st52
+			}
+		case data[p] >= 35:
+			goto st52
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 33:
+			goto st53
+		case 61:
+			goto tr36
+		}
+		switch {
+		case data[p] > 92:
+			if 94 <= data[p] && data[p] <= 126 {
+				goto st53
+			}
+		case data[p] >= 35:
+			goto st53
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 61 {
+			goto tr36
+		}
+		goto st0
+tr36:
+//line syslog5424.rl:91
+ name = data[mark:p]; escaped = false 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line syslog5424.go:2320
+		if data[p] == 34 {
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 34:
+			goto tr69
+		case 92:
+			goto tr70
+		case 93:
+			goto st0
+		case 224:
+			goto tr72
+		case 237:
+			goto tr74
+		case 240:
+			goto tr75
+		case 244:
+			goto tr77
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto tr71
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto tr76
+			}
+		default:
+			goto tr73
+		}
+		goto tr68
+tr68:
+//line syslog5424.rl:76
+ mark = p 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line syslog5424.go:2378
+		switch data[p] {
+		case 34:
+			goto tr79
+		case 92:
+			goto tr80
+		case 93:
+			goto st0
+		case 224:
+			goto st60
+		case 237:
+			goto st62
+		case 240:
+			goto st63
+		case 244:
+			goto st65
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto st59
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto st64
+			}
+		default:
+			goto st61
+		}
+		goto st56
+tr69:
+//line syslog5424.rl:76
+ mark = p 
+//line syslog5424.rl:94
+
+	        if escaped {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: unescapeSDValue(data[mark:p])})
+	        } else {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: data[mark:p]})
+	        }
+	    
+	goto st57
+tr79:
+//line syslog5424.rl:94
+
+	        if escaped {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: unescapeSDValue(data[mark:p])})
+	        } else {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: data[mark:p]})
+	        }
+	    
+	goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+//line syslog5424.go:2445
+		switch data[p] {
+		case 32:
+			goto st21
+		case 93:
+			goto st602
+		}
+		goto st0
+tr33:
+//line syslog5424.rl:86
+
+	        m.StructuredData = append(m.StructuredData, SDElement{ID: data[mark:p]})
+	        sd = &m.StructuredData[len(m.StructuredData)-1]
+	    
+	goto st602
+	st602:
+		if p++; p == pe {
+			goto _test_eof602
+		}
+	st_case_602:
+//line syslog5424.go:2465
+		switch data[p] {
+		case 32:
+			goto st600
+		case 91:
+			goto st19
+		}
+		goto st0
+tr70:
+//line syslog5424.rl:76
+ mark = p 
+//line syslog5424.rl:92
+ escaped = true 
+	goto st58
+tr80:
+//line syslog5424.rl:92
+ escaped = true 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line syslog5424.go:2488
+		switch data[p] {
+		case 224:
+			goto st60
+		case 237:
+			goto st62
+		case 240:
+			goto st63
+		case 244:
+			goto st65
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 193:
+				if data[p] <= 223 {
+					goto st59
+				}
+			case data[p] >= 128:
+				goto st0
+			}
+		case data[p] > 239:
+			switch {
+			case data[p] > 243:
+				if 245 <= data[p] {
+					goto st0
+				}
+			case data[p] >= 241:
+				goto st64
+			}
+		default:
+			goto st61
+		}
+		goto st56
+tr71:
+//line syslog5424.rl:76
+ mark = p 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line syslog5424.go:2531
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st56
+		}
+		goto st0
+tr72:
+//line syslog5424.rl:76
+ mark = p 
+	goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+//line syslog5424.go:2545
+		if 160 <= data[p] && data[p] <= 191 {
+			goto st59
+		}
+		goto st0
+tr73:
+//line syslog5424.rl:76
+ mark = p 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line syslog5424.go:2559
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st59
+		}
+		goto st0
+tr74:
+//line syslog5424.rl:76
+ mark = p 
+	goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+//line syslog5424.go:2573
+		if 128 <= data[p] && data[p] <= 159 {
+			goto st59
+		}
+		goto st0
+tr75:
+//line syslog5424.rl:76
+ mark = p 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line syslog5424.go:2587
+		if 144 <= data[p] && data[p] <= 191 {
+			goto st61
+		}
+		goto st0
+tr76:
+//line syslog5424.rl:76
+ mark = p 
+	goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+//line syslog5424.go:2601
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st61
+		}
+		goto st0
+tr77:
+//line syslog5424.rl:76
+ mark = p 
+	goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+//line syslog5424.go:2615
+		if 128 <= data[p] && data[p] <= 143 {
+			goto st61
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st67
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st67
+			}
+		case data[p] >= 35:
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st68
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st68
+			}
+		case data[p] >= 35:
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st69
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st69
+			}
+		case data[p] >= 35:
+			goto st69
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st70
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st70
+			}
+		case data[p] >= 35:
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st71
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st71
+			}
+		case data[p] >= 35:
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st72
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st72
+			}
+		case data[p] >= 35:
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st73
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st73
+			}
+		case data[p] >= 35:
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st74
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st74
+			}
+		case data[p] >= 35:
+			goto st74
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st75
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st75
+			}
+		case data[p] >= 35:
+			goto st75
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st76
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st76
+			}
+		case data[p] >= 35:
+			goto st76
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st77
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st77
+			}
+		case data[p] >= 35:
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st78
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st78
+			}
+		case data[p] >= 35:
+			goto st78
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st79
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st79
+			}
+		case data[p] >= 35:
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st80
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st80
+			}
+		case data[p] >= 35:
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st81
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st81
+			}
+		case data[p] >= 35:
+			goto st81
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st82
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st82
+			}
+		case data[p] >= 35:
+			goto st82
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st83
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st83
+			}
+		case data[p] >= 35:
+			goto st83
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st84
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st84
+			}
+		case data[p] >= 35:
+			goto st84
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st85
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st85
+			}
+		case data[p] >= 35:
+			goto st85
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st86
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st86
+			}
+		case data[p] >= 35:
+			goto st86
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st87
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st87
+			}
+		case data[p] >= 35:
+			goto st87
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st88
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st88
+			}
+		case data[p] >= 35:
+			goto st88
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st89
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st89
+			}
+		case data[p] >= 35:
+			goto st89
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st90
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st90
+			}
+		case data[p] >= 35:
+			goto st90
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st91
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st91
+			}
+		case data[p] >= 35:
+			goto st91
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st92
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st92
+			}
+		case data[p] >= 35:
+			goto st92
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st93
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st93
+			}
+		case data[p] >= 35:
+			goto st93
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st94
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st94
+			}
+		case data[p] >= 35:
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st95
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st95
+			}
+		case data[p] >= 35:
+			goto st95
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 33:
+			goto st96
+		case 93:
+			goto tr33
+		}
+		switch {
+		case data[p] > 60:
+			if 62 <= data[p] && data[p] <= 126 {
+				goto st96
+			}
+		case data[p] >= 35:
+			goto st96
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch data[p] {
+		case 32:
+			goto tr31
+		case 93:
+			goto tr33
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st99
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st100
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st101
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st102
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st103
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st104
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st105
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st106
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st107
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st108
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st109
+		}
+		goto st0
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st110
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st111
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st112
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st113
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st114
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st115
+		}
+		goto st0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st116
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st117
+		}
+		goto st0
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st118
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st119
+		}
+		goto st0
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st120
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st121
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st122
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st123
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st124
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st125
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st126
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		if data[p] == 32 {
+			goto tr26
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st127
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 32 {
+			goto tr26
+		}
+		goto st0
+tr25:
+//line syslog5424.rl:76
+ mark = p 
+	goto st128
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+//line syslog5424.go:3670
+		if data[p] == 32 {
+			goto st18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st97
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st130
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st131
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st132
+		}
+		goto st0
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st133
+		}
+		goto st0
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st134
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st135
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st136
+		}
+		goto st0
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st137
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st138
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st139
+		}
+		goto st0
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st140
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st141
+		}
+		goto st0
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st142
+		}
+		goto st0
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st143
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st144
+		}
+		goto st0
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st145
+		}
+		goto st0
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st146
+		}
+		goto st0
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st147
+		}
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st148
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st149
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st150
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st151
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st152
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st153
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st154
+		}
+		goto st0
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st155
+		}
+		goto st0
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st156
+		}
+		goto st0
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st157
+		}
+		goto st0
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st158
+		}
+		goto st0
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st159
+		}
+		goto st0
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st160
+		}
+		goto st0
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st161
+		}
+		goto st0
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st162
+		}
+		goto st0
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st163
+		}
+		goto st0
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st164
+		}
+		goto st0
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st165
+		}
+		goto st0
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st166
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st167
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st168
+		}
+		goto st0
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st169
+		}
+		goto st0
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st170
+		}
+		goto st0
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st171
+		}
+		goto st0
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st172
+		}
+		goto st0
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st173
+		}
+		goto st0
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st174
+		}
+		goto st0
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st175
+		}
+		goto st0
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st176
+		}
+		goto st0
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st177
+		}
+		goto st0
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st178
+		}
+		goto st0
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st179
+		}
+		goto st0
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st180
+		}
+		goto st0
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st181
+		}
+		goto st0
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st182
+		}
+		goto st0
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st183
+		}
+		goto st0
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st184
+		}
+		goto st0
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st185
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st186
+		}
+		goto st0
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st187
+		}
+		goto st0
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st188
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st189
+		}
+		goto st0
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st190
+		}
+		goto st0
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st191
+		}
+		goto st0
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st192
+		}
+		goto st0
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st193
+		}
+		goto st0
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st194
+		}
+		goto st0
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st195
+		}
+		goto st0
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st196
+		}
+		goto st0
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st197
+		}
+		goto st0
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st198
+		}
+		goto st0
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st199
+		}
+		goto st0
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st200
+		}
+		goto st0
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st201
+		}
+		goto st0
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st202
+		}
+		goto st0
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st203
+		}
+		goto st0
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st204
+		}
+		goto st0
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st205
+		}
+		goto st0
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st206
+		}
+		goto st0
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st207
+		}
+		goto st0
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st208
+		}
+		goto st0
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st209
+		}
+		goto st0
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st210
+		}
+		goto st0
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st211
+		}
+		goto st0
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st212
+		}
+		goto st0
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st213
+		}
+		goto st0
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st214
+		}
+		goto st0
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st215
+		}
+		goto st0
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st216
+		}
+		goto st0
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st217
+		}
+		goto st0
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st218
+		}
+		goto st0
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st219
+		}
+		goto st0
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st220
+		}
+		goto st0
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st221
+		}
+		goto st0
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st222
+		}
+		goto st0
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st223
+		}
+		goto st0
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st224
+		}
+		goto st0
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st225
+		}
+		goto st0
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st226
+		}
+		goto st0
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st227
+		}
+		goto st0
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st228
+		}
+		goto st0
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st229
+		}
+		goto st0
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st230
+		}
+		goto st0
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st231
+		}
+		goto st0
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st232
+		}
+		goto st0
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st233
+		}
+		goto st0
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st234
+		}
+		goto st0
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st235
+		}
+		goto st0
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st236
+		}
+		goto st0
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st237
+		}
+		goto st0
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st238
+		}
+		goto st0
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st239
+		}
+		goto st0
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st240
+		}
+		goto st0
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st241
+		}
+		goto st0
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st242
+		}
+		goto st0
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st243
+		}
+		goto st0
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st244
+		}
+		goto st0
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st245
+		}
+		goto st0
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st246
+		}
+		goto st0
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st247
+		}
+		goto st0
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st248
+		}
+		goto st0
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st249
+		}
+		goto st0
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st250
+		}
+		goto st0
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st251
+		}
+		goto st0
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st252
+		}
+		goto st0
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st253
+		}
+		goto st0
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st254
+		}
+		goto st0
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		if data[p] == 32 {
+			goto tr22
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st255
+		}
+		goto st0
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		if data[p] == 32 {
+			goto tr22
+		}
+		goto st0
+tr21:
+//line syslog5424.rl:76
+ mark = p 
+	goto st256
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+//line syslog5424.go:5208
+		if data[p] == 32 {
+			goto st16
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st129
+		}
+		goto st0
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st258
+		}
+		goto st0
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st259
+		}
+		goto st0
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st260
+		}
+		goto st0
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st261
+		}
+		goto st0
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st262
+		}
+		goto st0
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st263
+		}
+		goto st0
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st264
+		}
+		goto st0
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st265
+		}
+		goto st0
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st266
+		}
+		goto st0
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st267
+		}
+		goto st0
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st268
+		}
+		goto st0
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st269
+		}
+		goto st0
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st270
+		}
+		goto st0
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st271
+		}
+		goto st0
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st272
+		}
+		goto st0
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st273
+		}
+		goto st0
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st274
+		}
+		goto st0
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st275
+		}
+		goto st0
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st276
+		}
+		goto st0
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st277
+		}
+		goto st0
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st278
+		}
+		goto st0
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st279
+		}
+		goto st0
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st280
+		}
+		goto st0
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st281
+		}
+		goto st0
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st282
+		}
+		goto st0
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st283
+		}
+		goto st0
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st284
+		}
+		goto st0
+	st284:
+		if p++; p == pe {
+			goto _test_eof284
+		}
+	st_case_284:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st285
+		}
+		goto st0
+	st285:
+		if p++; p == pe {
+			goto _test_eof285
+		}
+	st_case_285:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st286
+		}
+		goto st0
+	st286:
+		if p++; p == pe {
+			goto _test_eof286
+		}
+	st_case_286:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st287
+		}
+		goto st0
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st288
+		}
+		goto st0
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st289
+		}
+		goto st0
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st290
+		}
+		goto st0
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st291
+		}
+		goto st0
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st292
+		}
+		goto st0
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st293
+		}
+		goto st0
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st294
+		}
+		goto st0
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st295
+		}
+		goto st0
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st296
+		}
+		goto st0
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st297
+		}
+		goto st0
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st298
+		}
+		goto st0
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st299
+		}
+		goto st0
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st300
+		}
+		goto st0
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st301
+		}
+		goto st0
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st302
+		}
+		goto st0
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		if data[p] == 32 {
+			goto tr18
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st303
+		}
+		goto st0
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		if data[p] == 32 {
+			goto tr18
+		}
+		goto st0
+tr17:
+//line syslog5424.rl:76
+ mark = p 
+	goto st304
+	st304:
+		if p++; p == pe {
+			goto _test_eof304
+		}
+	st_case_304:
+//line syslog5424.go:5786
+		if data[p] == 32 {
+			goto st14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st257
+		}
+		goto st0
+	st305:
+		if p++; p == pe {
+			goto _test_eof305
+		}
+	st_case_305:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st306
+		}
+		goto st0
+	st306:
+		if p++; p == pe {
+			goto _test_eof306
+		}
+	st_case_306:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st307
+		}
+		goto st0
+	st307:
+		if p++; p == pe {
+			goto _test_eof307
+		}
+	st_case_307:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st308
+		}
+		goto st0
+	st308:
+		if p++; p == pe {
+			goto _test_eof308
+		}
+	st_case_308:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st309
+		}
+		goto st0
+	st309:
+		if p++; p == pe {
+			goto _test_eof309
+		}
+	st_case_309:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st310
+		}
+		goto st0
+	st310:
+		if p++; p == pe {
+			goto _test_eof310
+		}
+	st_case_310:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st311
+		}
+		goto st0
+	st311:
+		if p++; p == pe {
+			goto _test_eof311
+		}
+	st_case_311:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st312
+		}
+		goto st0
+	st312:
+		if p++; p == pe {
+			goto _test_eof312
+		}
+	st_case_312:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st313
+		}
+		goto st0
+	st313:
+		if p++; p == pe {
+			goto _test_eof313
+		}
+	st_case_313:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st314
+		}
+		goto st0
+	st314:
+		if p++; p == pe {
+			goto _test_eof314
+		}
+	st_case_314:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st315
+		}
+		goto st0
+	st315:
+		if p++; p == pe {
+			goto _test_eof315
+		}
+	st_case_315:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st316
+		}
+		goto st0
+	st316:
+		if p++; p == pe {
+			goto _test_eof316
+		}
+	st_case_316:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st317
+		}
+		goto st0
+	st317:
+		if p++; p == pe {
+			goto _test_eof317
+		}
+	st_case_317:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st318
+		}
+		goto st0
+	st318:
+		if p++; p == pe {
+			goto _test_eof318
+		}
+	st_case_318:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st319
+		}
+		goto st0
+	st319:
+		if p++; p == pe {
+			goto _test_eof319
+		}
+	st_case_319:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st320
+		}
+		goto st0
+	st320:
+		if p++; p == pe {
+			goto _test_eof320
+		}
+	st_case_320:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st321
+		}
+		goto st0
+	st321:
+		if p++; p == pe {
+			goto _test_eof321
+		}
+	st_case_321:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st322
+		}
+		goto st0
+	st322:
+		if p++; p == pe {
+			goto _test_eof322
+		}
+	st_case_322:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st323
+		}
+		goto st0
+	st323:
+		if p++; p == pe {
+			goto _test_eof323
+		}
+	st_case_323:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st324
+		}
+		goto st0
+	st324:
+		if p++; p == pe {
+			goto _test_eof324
+		}
+	st_case_324:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st325
+		}
+		goto st0
+	st325:
+		if p++; p == pe {
+			goto _test_eof325
+		}
+	st_case_325:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st326
+		}
+		goto st0
+	st326:
+		if p++; p == pe {
+			goto _test_eof326
+		}
+	st_case_326:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st327
+		}
+		goto st0
+	st327:
+		if p++; p == pe {
+			goto _test_eof327
+		}
+	st_case_327:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st328
+		}
+		goto st0
+	st328:
+		if p++; p == pe {
+			goto _test_eof328
+		}
+	st_case_328:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st329
+		}
+		goto st0
+	st329:
+		if p++; p == pe {
+			goto _test_eof329
+		}
+	st_case_329:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st330
+		}
+		goto st0
+	st330:
+		if p++; p == pe {
+			goto _test_eof330
+		}
+	st_case_330:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st331
+		}
+		goto st0
+	st331:
+		if p++; p == pe {
+			goto _test_eof331
+		}
+	st_case_331:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st332
+		}
+		goto st0
+	st332:
+		if p++; p == pe {
+			goto _test_eof332
+		}
+	st_case_332:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st333
+		}
+		goto st0
+	st333:
+		if p++; p == pe {
+			goto _test_eof333
+		}
+	st_case_333:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st334
+		}
+		goto st0
+	st334:
+		if p++; p == pe {
+			goto _test_eof334
+		}
+	st_case_334:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st335
+		}
+		goto st0
+	st335:
+		if p++; p == pe {
+			goto _test_eof335
+		}
+	st_case_335:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st336
+		}
+		goto st0
+	st336:
+		if p++; p == pe {
+			goto _test_eof336
+		}
+	st_case_336:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st337
+		}
+		goto st0
+	st337:
+		if p++; p == pe {
+			goto _test_eof337
+		}
+	st_case_337:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st338
+		}
+		goto st0
+	st338:
+		if p++; p == pe {
+			goto _test_eof338
+		}
+	st_case_338:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st339
+		}
+		goto st0
+	st339:
+		if p++; p == pe {
+			goto _test_eof339
+		}
+	st_case_339:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st340
+		}
+		goto st0
+	st340:
+		if p++; p == pe {
+			goto _test_eof340
+		}
+	st_case_340:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st341
+		}
+		goto st0
+	st341:
+		if p++; p == pe {
+			goto _test_eof341
+		}
+	st_case_341:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st342
+		}
+		goto st0
+	st342:
+		if p++; p == pe {
+			goto _test_eof342
+		}
+	st_case_342:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st343
+		}
+		goto st0
+	st343:
+		if p++; p == pe {
+			goto _test_eof343
+		}
+	st_case_343:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st344
+		}
+		goto st0
+	st344:
+		if p++; p == pe {
+			goto _test_eof344
+		}
+	st_case_344:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st345
+		}
+		goto st0
+	st345:
+		if p++; p == pe {
+			goto _test_eof345
+		}
+	st_case_345:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st346
+		}
+		goto st0
+	st346:
+		if p++; p == pe {
+			goto _test_eof346
+		}
+	st_case_346:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st347
+		}
+		goto st0
+	st347:
+		if p++; p == pe {
+			goto _test_eof347
+		}
+	st_case_347:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st348
+		}
+		goto st0
+	st348:
+		if p++; p == pe {
+			goto _test_eof348
+		}
+	st_case_348:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st349
+		}
+		goto st0
+	st349:
+		if p++; p == pe {
+			goto _test_eof349
+		}
+	st_case_349:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st350
+		}
+		goto st0
+	st350:
+		if p++; p == pe {
+			goto _test_eof350
+		}
+	st_case_350:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st351
+		}
+		goto st0
+	st351:
+		if p++; p == pe {
+			goto _test_eof351
+		}
+	st_case_351:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st352
+		}
+		goto st0
+	st352:
+		if p++; p == pe {
+			goto _test_eof352
+		}
+	st_case_352:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st353
+		}
+		goto st0
+	st353:
+		if p++; p == pe {
+			goto _test_eof353
+		}
+	st_case_353:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st354
+		}
+		goto st0
+	st354:
+		if p++; p == pe {
+			goto _test_eof354
+		}
+	st_case_354:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st355
+		}
+		goto st0
+	st355:
+		if p++; p == pe {
+			goto _test_eof355
+		}
+	st_case_355:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st356
+		}
+		goto st0
+	st356:
+		if p++; p == pe {
+			goto _test_eof356
+		}
+	st_case_356:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st357
+		}
+		goto st0
+	st357:
+		if p++; p == pe {
+			goto _test_eof357
+		}
+	st_case_357:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st358
+		}
+		goto st0
+	st358:
+		if p++; p == pe {
+			goto _test_eof358
+		}
+	st_case_358:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st359
+		}
+		goto st0
+	st359:
+		if p++; p == pe {
+			goto _test_eof359
+		}
+	st_case_359:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st360
+		}
+		goto st0
+	st360:
+		if p++; p == pe {
+			goto _test_eof360
+		}
+	st_case_360:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st361
+		}
+		goto st0
+	st361:
+		if p++; p == pe {
+			goto _test_eof361
+		}
+	st_case_361:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st362
+		}
+		goto st0
+	st362:
+		if p++; p == pe {
+			goto _test_eof362
+		}
+	st_case_362:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st363
+		}
+		goto st0
+	st363:
+		if p++; p == pe {
+			goto _test_eof363
+		}
+	st_case_363:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st364
+		}
+		goto st0
+	st364:
+		if p++; p == pe {
+			goto _test_eof364
+		}
+	st_case_364:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st365
+		}
+		goto st0
+	st365:
+		if p++; p == pe {
+			goto _test_eof365
+		}
+	st_case_365:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st366
+		}
+		goto st0
+	st366:
+		if p++; p == pe {
+			goto _test_eof366
+		}
+	st_case_366:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st367
+		}
+		goto st0
+	st367:
+		if p++; p == pe {
+			goto _test_eof367
+		}
+	st_case_367:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st368
+		}
+		goto st0
+	st368:
+		if p++; p == pe {
+			goto _test_eof368
+		}
+	st_case_368:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st369
+		}
+		goto st0
+	st369:
+		if p++; p == pe {
+			goto _test_eof369
+		}
+	st_case_369:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st370
+		}
+		goto st0
+	st370:
+		if p++; p == pe {
+			goto _test_eof370
+		}
+	st_case_370:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st371
+		}
+		goto st0
+	st371:
+		if p++; p == pe {
+			goto _test_eof371
+		}
+	st_case_371:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st372
+		}
+		goto st0
+	st372:
+		if p++; p == pe {
+			goto _test_eof372
+		}
+	st_case_372:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st373
+		}
+		goto st0
+	st373:
+		if p++; p == pe {
+			goto _test_eof373
+		}
+	st_case_373:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st374
+		}
+		goto st0
+	st374:
+		if p++; p == pe {
+			goto _test_eof374
+		}
+	st_case_374:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st375
+		}
+		goto st0
+	st375:
+		if p++; p == pe {
+			goto _test_eof375
+		}
+	st_case_375:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st376
+		}
+		goto st0
+	st376:
+		if p++; p == pe {
+			goto _test_eof376
+		}
+	st_case_376:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st377
+		}
+		goto st0
+	st377:
+		if p++; p == pe {
+			goto _test_eof377
+		}
+	st_case_377:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st378
+		}
+		goto st0
+	st378:
+		if p++; p == pe {
+			goto _test_eof378
+		}
+	st_case_378:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st379
+		}
+		goto st0
+	st379:
+		if p++; p == pe {
+			goto _test_eof379
+		}
+	st_case_379:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st380
+		}
+		goto st0
+	st380:
+		if p++; p == pe {
+			goto _test_eof380
+		}
+	st_case_380:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st381
+		}
+		goto st0
+	st381:
+		if p++; p == pe {
+			goto _test_eof381
+		}
+	st_case_381:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st382
+		}
+		goto st0
+	st382:
+		if p++; p == pe {
+			goto _test_eof382
+		}
+	st_case_382:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st383
+		}
+		goto st0
+	st383:
+		if p++; p == pe {
+			goto _test_eof383
+		}
+	st_case_383:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st384
+		}
+		goto st0
+	st384:
+		if p++; p == pe {
+			goto _test_eof384
+		}
+	st_case_384:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st385
+		}
+		goto st0
+	st385:
+		if p++; p == pe {
+			goto _test_eof385
+		}
+	st_case_385:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st386
+		}
+		goto st0
+	st386:
+		if p++; p == pe {
+			goto _test_eof386
+		}
+	st_case_386:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st387
+		}
+		goto st0
+	st387:
+		if p++; p == pe {
+			goto _test_eof387
+		}
+	st_case_387:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st388
+		}
+		goto st0
+	st388:
+		if p++; p == pe {
+			goto _test_eof388
+		}
+	st_case_388:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st389
+		}
+		goto st0
+	st389:
+		if p++; p == pe {
+			goto _test_eof389
+		}
+	st_case_389:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st390
+		}
+		goto st0
+	st390:
+		if p++; p == pe {
+			goto _test_eof390
+		}
+	st_case_390:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st391
+		}
+		goto st0
+	st391:
+		if p++; p == pe {
+			goto _test_eof391
+		}
+	st_case_391:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st392
+		}
+		goto st0
+	st392:
+		if p++; p == pe {
+			goto _test_eof392
+		}
+	st_case_392:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st393
+		}
+		goto st0
+	st393:
+		if p++; p == pe {
+			goto _test_eof393
+		}
+	st_case_393:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st394
+		}
+		goto st0
+	st394:
+		if p++; p == pe {
+			goto _test_eof394
+		}
+	st_case_394:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st395
+		}
+		goto st0
+	st395:
+		if p++; p == pe {
+			goto _test_eof395
+		}
+	st_case_395:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st396
+		}
+		goto st0
+	st396:
+		if p++; p == pe {
+			goto _test_eof396
+		}
+	st_case_396:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st397
+		}
+		goto st0
+	st397:
+		if p++; p == pe {
+			goto _test_eof397
+		}
+	st_case_397:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st398
+		}
+		goto st0
+	st398:
+		if p++; p == pe {
+			goto _test_eof398
+		}
+	st_case_398:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st399
+		}
+		goto st0
+	st399:
+		if p++; p == pe {
+			goto _test_eof399
+		}
+	st_case_399:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st400
+		}
+		goto st0
+	st400:
+		if p++; p == pe {
+			goto _test_eof400
+		}
+	st_case_400:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st401
+		}
+		goto st0
+	st401:
+		if p++; p == pe {
+			goto _test_eof401
+		}
+	st_case_401:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st402
+		}
+		goto st0
+	st402:
+		if p++; p == pe {
+			goto _test_eof402
+		}
+	st_case_402:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st403
+		}
+		goto st0
+	st403:
+		if p++; p == pe {
+			goto _test_eof403
+		}
+	st_case_403:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st404
+		}
+		goto st0
+	st404:
+		if p++; p == pe {
+			goto _test_eof404
+		}
+	st_case_404:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st405
+		}
+		goto st0
+	st405:
+		if p++; p == pe {
+			goto _test_eof405
+		}
+	st_case_405:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st406
+		}
+		goto st0
+	st406:
+		if p++; p == pe {
+			goto _test_eof406
+		}
+	st_case_406:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st407
+		}
+		goto st0
+	st407:
+		if p++; p == pe {
+			goto _test_eof407
+		}
+	st_case_407:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st408
+		}
+		goto st0
+	st408:
+		if p++; p == pe {
+			goto _test_eof408
+		}
+	st_case_408:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st409
+		}
+		goto st0
+	st409:
+		if p++; p == pe {
+			goto _test_eof409
+		}
+	st_case_409:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st410
+		}
+		goto st0
+	st410:
+		if p++; p == pe {
+			goto _test_eof410
+		}
+	st_case_410:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st411
+		}
+		goto st0
+	st411:
+		if p++; p == pe {
+			goto _test_eof411
+		}
+	st_case_411:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st412
+		}
+		goto st0
+	st412:
+		if p++; p == pe {
+			goto _test_eof412
+		}
+	st_case_412:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st413
+		}
+		goto st0
+	st413:
+		if p++; p == pe {
+			goto _test_eof413
+		}
+	st_case_413:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st414
+		}
+		goto st0
+	st414:
+		if p++; p == pe {
+			goto _test_eof414
+		}
+	st_case_414:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st415
+		}
+		goto st0
+	st415:
+		if p++; p == pe {
+			goto _test_eof415
+		}
+	st_case_415:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st416
+		}
+		goto st0
+	st416:
+		if p++; p == pe {
+			goto _test_eof416
+		}
+	st_case_416:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st417
+		}
+		goto st0
+	st417:
+		if p++; p == pe {
+			goto _test_eof417
+		}
+	st_case_417:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st418
+		}
+		goto st0
+	st418:
+		if p++; p == pe {
+			goto _test_eof418
+		}
+	st_case_418:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st419
+		}
+		goto st0
+	st419:
+		if p++; p == pe {
+			goto _test_eof419
+		}
+	st_case_419:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st420
+		}
+		goto st0
+	st420:
+		if p++; p == pe {
+			goto _test_eof420
+		}
+	st_case_420:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st421
+		}
+		goto st0
+	st421:
+		if p++; p == pe {
+			goto _test_eof421
+		}
+	st_case_421:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st422
+		}
+		goto st0
+	st422:
+		if p++; p == pe {
+			goto _test_eof422
+		}
+	st_case_422:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st423
+		}
+		goto st0
+	st423:
+		if p++; p == pe {
+			goto _test_eof423
+		}
+	st_case_423:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st424
+		}
+		goto st0
+	st424:
+		if p++; p == pe {
+			goto _test_eof424
+		}
+	st_case_424:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st425
+		}
+		goto st0
+	st425:
+		if p++; p == pe {
+			goto _test_eof425
+		}
+	st_case_425:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st426
+		}
+		goto st0
+	st426:
+		if p++; p == pe {
+			goto _test_eof426
+		}
+	st_case_426:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st427
+		}
+		goto st0
+	st427:
+		if p++; p == pe {
+			goto _test_eof427
+		}
+	st_case_427:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st428
+		}
+		goto st0
+	st428:
+		if p++; p == pe {
+			goto _test_eof428
+		}
+	st_case_428:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st429
+		}
+		goto st0
+	st429:
+		if p++; p == pe {
+			goto _test_eof429
+		}
+	st_case_429:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st430
+		}
+		goto st0
+	st430:
+		if p++; p == pe {
+			goto _test_eof430
+		}
+	st_case_430:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st431
+		}
+		goto st0
+	st431:
+		if p++; p == pe {
+			goto _test_eof431
+		}
+	st_case_431:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st432
+		}
+		goto st0
+	st432:
+		if p++; p == pe {
+			goto _test_eof432
+		}
+	st_case_432:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st433
+		}
+		goto st0
+	st433:
+		if p++; p == pe {
+			goto _test_eof433
+		}
+	st_case_433:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st434
+		}
+		goto st0
+	st434:
+		if p++; p == pe {
+			goto _test_eof434
+		}
+	st_case_434:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st435
+		}
+		goto st0
+	st435:
+		if p++; p == pe {
+			goto _test_eof435
+		}
+	st_case_435:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st436
+		}
+		goto st0
+	st436:
+		if p++; p == pe {
+			goto _test_eof436
+		}
+	st_case_436:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st437
+		}
+		goto st0
+	st437:
+		if p++; p == pe {
+			goto _test_eof437
+		}
+	st_case_437:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st438
+		}
+		goto st0
+	st438:
+		if p++; p == pe {
+			goto _test_eof438
+		}
+	st_case_438:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st439
+		}
+		goto st0
+	st439:
+		if p++; p == pe {
+			goto _test_eof439
+		}
+	st_case_439:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st440
+		}
+		goto st0
+	st440:
+		if p++; p == pe {
+			goto _test_eof440
+		}
+	st_case_440:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st441
+		}
+		goto st0
+	st441:
+		if p++; p == pe {
+			goto _test_eof441
+		}
+	st_case_441:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st442
+		}
+		goto st0
+	st442:
+		if p++; p == pe {
+			goto _test_eof442
+		}
+	st_case_442:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st443
+		}
+		goto st0
+	st443:
+		if p++; p == pe {
+			goto _test_eof443
+		}
+	st_case_443:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st444
+		}
+		goto st0
+	st444:
+		if p++; p == pe {
+			goto _test_eof444
+		}
+	st_case_444:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st445
+		}
+		goto st0
+	st445:
+		if p++; p == pe {
+			goto _test_eof445
+		}
+	st_case_445:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st446
+		}
+		goto st0
+	st446:
+		if p++; p == pe {
+			goto _test_eof446
+		}
+	st_case_446:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st447
+		}
+		goto st0
+	st447:
+		if p++; p == pe {
+			goto _test_eof447
+		}
+	st_case_447:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st448
+		}
+		goto st0
+	st448:
+		if p++; p == pe {
+			goto _test_eof448
+		}
+	st_case_448:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st449
+		}
+		goto st0
+	st449:
+		if p++; p == pe {
+			goto _test_eof449
+		}
+	st_case_449:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st450
+		}
+		goto st0
+	st450:
+		if p++; p == pe {
+			goto _test_eof450
+		}
+	st_case_450:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st451
+		}
+		goto st0
+	st451:
+		if p++; p == pe {
+			goto _test_eof451
+		}
+	st_case_451:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st452
+		}
+		goto st0
+	st452:
+		if p++; p == pe {
+			goto _test_eof452
+		}
+	st_case_452:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st453
+		}
+		goto st0
+	st453:
+		if p++; p == pe {
+			goto _test_eof453
+		}
+	st_case_453:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st454
+		}
+		goto st0
+	st454:
+		if p++; p == pe {
+			goto _test_eof454
+		}
+	st_case_454:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st455
+		}
+		goto st0
+	st455:
+		if p++; p == pe {
+			goto _test_eof455
+		}
+	st_case_455:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st456
+		}
+		goto st0
+	st456:
+		if p++; p == pe {
+			goto _test_eof456
+		}
+	st_case_456:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st457
+		}
+		goto st0
+	st457:
+		if p++; p == pe {
+			goto _test_eof457
+		}
+	st_case_457:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st458
+		}
+		goto st0
+	st458:
+		if p++; p == pe {
+			goto _test_eof458
+		}
+	st_case_458:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st459
+		}
+		goto st0
+	st459:
+		if p++; p == pe {
+			goto _test_eof459
+		}
+	st_case_459:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st460
+		}
+		goto st0
+	st460:
+		if p++; p == pe {
+			goto _test_eof460
+		}
+	st_case_460:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st461
+		}
+		goto st0
+	st461:
+		if p++; p == pe {
+			goto _test_eof461
+		}
+	st_case_461:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st462
+		}
+		goto st0
+	st462:
+		if p++; p == pe {
+			goto _test_eof462
+		}
+	st_case_462:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st463
+		}
+		goto st0
+	st463:
+		if p++; p == pe {
+			goto _test_eof463
+		}
+	st_case_463:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st464
+		}
+		goto st0
+	st464:
+		if p++; p == pe {
+			goto _test_eof464
+		}
+	st_case_464:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st465
+		}
+		goto st0
+	st465:
+		if p++; p == pe {
+			goto _test_eof465
+		}
+	st_case_465:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st466
+		}
+		goto st0
+	st466:
+		if p++; p == pe {
+			goto _test_eof466
+		}
+	st_case_466:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st467
+		}
+		goto st0
+	st467:
+		if p++; p == pe {
+			goto _test_eof467
+		}
+	st_case_467:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st468
+		}
+		goto st0
+	st468:
+		if p++; p == pe {
+			goto _test_eof468
+		}
+	st_case_468:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st469
+		}
+		goto st0
+	st469:
+		if p++; p == pe {
+			goto _test_eof469
+		}
+	st_case_469:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st470
+		}
+		goto st0
+	st470:
+		if p++; p == pe {
+			goto _test_eof470
+		}
+	st_case_470:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st471
+		}
+		goto st0
+	st471:
+		if p++; p == pe {
+			goto _test_eof471
+		}
+	st_case_471:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st472
+		}
+		goto st0
+	st472:
+		if p++; p == pe {
+			goto _test_eof472
+		}
+	st_case_472:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st473
+		}
+		goto st0
+	st473:
+		if p++; p == pe {
+			goto _test_eof473
+		}
+	st_case_473:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st474
+		}
+		goto st0
+	st474:
+		if p++; p == pe {
+			goto _test_eof474
+		}
+	st_case_474:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st475
+		}
+		goto st0
+	st475:
+		if p++; p == pe {
+			goto _test_eof475
+		}
+	st_case_475:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st476
+		}
+		goto st0
+	st476:
+		if p++; p == pe {
+			goto _test_eof476
+		}
+	st_case_476:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st477
+		}
+		goto st0
+	st477:
+		if p++; p == pe {
+			goto _test_eof477
+		}
+	st_case_477:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st478
+		}
+		goto st0
+	st478:
+		if p++; p == pe {
+			goto _test_eof478
+		}
+	st_case_478:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st479
+		}
+		goto st0
+	st479:
+		if p++; p == pe {
+			goto _test_eof479
+		}
+	st_case_479:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st480
+		}
+		goto st0
+	st480:
+		if p++; p == pe {
+			goto _test_eof480
+		}
+	st_case_480:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st481
+		}
+		goto st0
+	st481:
+		if p++; p == pe {
+			goto _test_eof481
+		}
+	st_case_481:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st482
+		}
+		goto st0
+	st482:
+		if p++; p == pe {
+			goto _test_eof482
+		}
+	st_case_482:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st483
+		}
+		goto st0
+	st483:
+		if p++; p == pe {
+			goto _test_eof483
+		}
+	st_case_483:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st484
+		}
+		goto st0
+	st484:
+		if p++; p == pe {
+			goto _test_eof484
+		}
+	st_case_484:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st485
+		}
+		goto st0
+	st485:
+		if p++; p == pe {
+			goto _test_eof485
+		}
+	st_case_485:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st486
+		}
+		goto st0
+	st486:
+		if p++; p == pe {
+			goto _test_eof486
+		}
+	st_case_486:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st487
+		}
+		goto st0
+	st487:
+		if p++; p == pe {
+			goto _test_eof487
+		}
+	st_case_487:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st488
+		}
+		goto st0
+	st488:
+		if p++; p == pe {
+			goto _test_eof488
+		}
+	st_case_488:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st489
+		}
+		goto st0
+	st489:
+		if p++; p == pe {
+			goto _test_eof489
+		}
+	st_case_489:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st490
+		}
+		goto st0
+	st490:
+		if p++; p == pe {
+			goto _test_eof490
+		}
+	st_case_490:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st491
+		}
+		goto st0
+	st491:
+		if p++; p == pe {
+			goto _test_eof491
+		}
+	st_case_491:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st492
+		}
+		goto st0
+	st492:
+		if p++; p == pe {
+			goto _test_eof492
+		}
+	st_case_492:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st493
+		}
+		goto st0
+	st493:
+		if p++; p == pe {
+			goto _test_eof493
+		}
+	st_case_493:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st494
+		}
+		goto st0
+	st494:
+		if p++; p == pe {
+			goto _test_eof494
+		}
+	st_case_494:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st495
+		}
+		goto st0
+	st495:
+		if p++; p == pe {
+			goto _test_eof495
+		}
+	st_case_495:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st496
+		}
+		goto st0
+	st496:
+		if p++; p == pe {
+			goto _test_eof496
+		}
+	st_case_496:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st497
+		}
+		goto st0
+	st497:
+		if p++; p == pe {
+			goto _test_eof497
+		}
+	st_case_497:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st498
+		}
+		goto st0
+	st498:
+		if p++; p == pe {
+			goto _test_eof498
+		}
+	st_case_498:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st499
+		}
+		goto st0
+	st499:
+		if p++; p == pe {
+			goto _test_eof499
+		}
+	st_case_499:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st500
+		}
+		goto st0
+	st500:
+		if p++; p == pe {
+			goto _test_eof500
+		}
+	st_case_500:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st501
+		}
+		goto st0
+	st501:
+		if p++; p == pe {
+			goto _test_eof501
+		}
+	st_case_501:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st502
+		}
+		goto st0
+	st502:
+		if p++; p == pe {
+			goto _test_eof502
+		}
+	st_case_502:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st503
+		}
+		goto st0
+	st503:
+		if p++; p == pe {
+			goto _test_eof503
+		}
+	st_case_503:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st504
+		}
+		goto st0
+	st504:
+		if p++; p == pe {
+			goto _test_eof504
+		}
+	st_case_504:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st505
+		}
+		goto st0
+	st505:
+		if p++; p == pe {
+			goto _test_eof505
+		}
+	st_case_505:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st506
+		}
+		goto st0
+	st506:
+		if p++; p == pe {
+			goto _test_eof506
+		}
+	st_case_506:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st507
+		}
+		goto st0
+	st507:
+		if p++; p == pe {
+			goto _test_eof507
+		}
+	st_case_507:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st508
+		}
+		goto st0
+	st508:
+		if p++; p == pe {
+			goto _test_eof508
+		}
+	st_case_508:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st509
+		}
+		goto st0
+	st509:
+		if p++; p == pe {
+			goto _test_eof509
+		}
+	st_case_509:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st510
+		}
+		goto st0
+	st510:
+		if p++; p == pe {
+			goto _test_eof510
+		}
+	st_case_510:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st511
+		}
+		goto st0
+	st511:
+		if p++; p == pe {
+			goto _test_eof511
+		}
+	st_case_511:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st512
+		}
+		goto st0
+	st512:
+		if p++; p == pe {
+			goto _test_eof512
+		}
+	st_case_512:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st513
+		}
+		goto st0
+	st513:
+		if p++; p == pe {
+			goto _test_eof513
+		}
+	st_case_513:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st514
+		}
+		goto st0
+	st514:
+		if p++; p == pe {
+			goto _test_eof514
+		}
+	st_case_514:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st515
+		}
+		goto st0
+	st515:
+		if p++; p == pe {
+			goto _test_eof515
+		}
+	st_case_515:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st516
+		}
+		goto st0
+	st516:
+		if p++; p == pe {
+			goto _test_eof516
+		}
+	st_case_516:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st517
+		}
+		goto st0
+	st517:
+		if p++; p == pe {
+			goto _test_eof517
+		}
+	st_case_517:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st518
+		}
+		goto st0
+	st518:
+		if p++; p == pe {
+			goto _test_eof518
+		}
+	st_case_518:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st519
+		}
+		goto st0
+	st519:
+		if p++; p == pe {
+			goto _test_eof519
+		}
+	st_case_519:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st520
+		}
+		goto st0
+	st520:
+		if p++; p == pe {
+			goto _test_eof520
+		}
+	st_case_520:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st521
+		}
+		goto st0
+	st521:
+		if p++; p == pe {
+			goto _test_eof521
+		}
+	st_case_521:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st522
+		}
+		goto st0
+	st522:
+		if p++; p == pe {
+			goto _test_eof522
+		}
+	st_case_522:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st523
+		}
+		goto st0
+	st523:
+		if p++; p == pe {
+			goto _test_eof523
+		}
+	st_case_523:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st524
+		}
+		goto st0
+	st524:
+		if p++; p == pe {
+			goto _test_eof524
+		}
+	st_case_524:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st525
+		}
+		goto st0
+	st525:
+		if p++; p == pe {
+			goto _test_eof525
+		}
+	st_case_525:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st526
+		}
+		goto st0
+	st526:
+		if p++; p == pe {
+			goto _test_eof526
+		}
+	st_case_526:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st527
+		}
+		goto st0
+	st527:
+		if p++; p == pe {
+			goto _test_eof527
+		}
+	st_case_527:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st528
+		}
+		goto st0
+	st528:
+		if p++; p == pe {
+			goto _test_eof528
+		}
+	st_case_528:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st529
+		}
+		goto st0
+	st529:
+		if p++; p == pe {
+			goto _test_eof529
+		}
+	st_case_529:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st530
+		}
+		goto st0
+	st530:
+		if p++; p == pe {
+			goto _test_eof530
+		}
+	st_case_530:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st531
+		}
+		goto st0
+	st531:
+		if p++; p == pe {
+			goto _test_eof531
+		}
+	st_case_531:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st532
+		}
+		goto st0
+	st532:
+		if p++; p == pe {
+			goto _test_eof532
+		}
+	st_case_532:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st533
+		}
+		goto st0
+	st533:
+		if p++; p == pe {
+			goto _test_eof533
+		}
+	st_case_533:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st534
+		}
+		goto st0
+	st534:
+		if p++; p == pe {
+			goto _test_eof534
+		}
+	st_case_534:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st535
+		}
+		goto st0
+	st535:
+		if p++; p == pe {
+			goto _test_eof535
+		}
+	st_case_535:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st536
+		}
+		goto st0
+	st536:
+		if p++; p == pe {
+			goto _test_eof536
+		}
+	st_case_536:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st537
+		}
+		goto st0
+	st537:
+		if p++; p == pe {
+			goto _test_eof537
+		}
+	st_case_537:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st538
+		}
+		goto st0
+	st538:
+		if p++; p == pe {
+			goto _test_eof538
+		}
+	st_case_538:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st539
+		}
+		goto st0
+	st539:
+		if p++; p == pe {
+			goto _test_eof539
+		}
+	st_case_539:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st540
+		}
+		goto st0
+	st540:
+		if p++; p == pe {
+			goto _test_eof540
+		}
+	st_case_540:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st541
+		}
+		goto st0
+	st541:
+		if p++; p == pe {
+			goto _test_eof541
+		}
+	st_case_541:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st542
+		}
+		goto st0
+	st542:
+		if p++; p == pe {
+			goto _test_eof542
+		}
+	st_case_542:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st543
+		}
+		goto st0
+	st543:
+		if p++; p == pe {
+			goto _test_eof543
+		}
+	st_case_543:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st544
+		}
+		goto st0
+	st544:
+		if p++; p == pe {
+			goto _test_eof544
+		}
+	st_case_544:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st545
+		}
+		goto st0
+	st545:
+		if p++; p == pe {
+			goto _test_eof545
+		}
+	st_case_545:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st546
+		}
+		goto st0
+	st546:
+		if p++; p == pe {
+			goto _test_eof546
+		}
+	st_case_546:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st547
+		}
+		goto st0
+	st547:
+		if p++; p == pe {
+			goto _test_eof547
+		}
+	st_case_547:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st548
+		}
+		goto st0
+	st548:
+		if p++; p == pe {
+			goto _test_eof548
+		}
+	st_case_548:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st549
+		}
+		goto st0
+	st549:
+		if p++; p == pe {
+			goto _test_eof549
+		}
+	st_case_549:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st550
+		}
+		goto st0
+	st550:
+		if p++; p == pe {
+			goto _test_eof550
+		}
+	st_case_550:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st551
+		}
+		goto st0
+	st551:
+		if p++; p == pe {
+			goto _test_eof551
+		}
+	st_case_551:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st552
+		}
+		goto st0
+	st552:
+		if p++; p == pe {
+			goto _test_eof552
+		}
+	st_case_552:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st553
+		}
+		goto st0
+	st553:
+		if p++; p == pe {
+			goto _test_eof553
+		}
+	st_case_553:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st554
+		}
+		goto st0
+	st554:
+		if p++; p == pe {
+			goto _test_eof554
+		}
+	st_case_554:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st555
+		}
+		goto st0
+	st555:
+		if p++; p == pe {
+			goto _test_eof555
+		}
+	st_case_555:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st556
+		}
+		goto st0
+	st556:
+		if p++; p == pe {
+			goto _test_eof556
+		}
+	st_case_556:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st557
+		}
+		goto st0
+	st557:
+		if p++; p == pe {
+			goto _test_eof557
+		}
+	st_case_557:
+		if data[p] == 32 {
+			goto tr14
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st558
+		}
+		goto st0
+	st558:
+		if p++; p == pe {
+			goto _test_eof558
+		}
+	st_case_558:
+		if data[p] == 32 {
+			goto tr14
+		}
+		goto st0
+tr13:
+//line syslog5424.rl:76
+ mark = p 
+	goto st559
+	st559:
+		if p++; p == pe {
+			goto _test_eof559
+		}
+	st_case_559:
+//line syslog5424.go:8848
+		if data[p] == 32 {
+			goto st12
+		}
+		if 33 <= data[p] && data[p] <= 126 {
+			goto st305
+		}
+		goto st0
+tr10:
+//line syslog5424.rl:76
+ mark = p 
+	goto st560
+	st560:
+		if p++; p == pe {
+			goto _test_eof560
+		}
+	st_case_560:
+//line syslog5424.go:8865
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st561
+		}
+		goto st0
+	st561:
+		if p++; p == pe {
+			goto _test_eof561
+		}
+	st_case_561:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st562
+		}
+		goto st0
+	st562:
+		if p++; p == pe {
+			goto _test_eof562
+		}
+	st_case_562:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st563
+		}
+		goto st0
+	st563:
+		if p++; p == pe {
+			goto _test_eof563
+		}
+	st_case_563:
+		if data[p] == 45 {
+			goto st564
+		}
+		goto st0
+	st564:
+		if p++; p == pe {
+			goto _test_eof564
+		}
+	st_case_564:
+		switch data[p] {
+		case 48:
+			goto st565
+		case 49:
+			goto st596
+		}
+		goto st0
+	st565:
+		if p++; p == pe {
+			goto _test_eof565
+		}
+	st_case_565:
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st566
+		}
+		goto st0
+	st566:
+		if p++; p == pe {
+			goto _test_eof566
+		}
+	st_case_566:
+		if data[p] == 45 {
+			goto st567
+		}
+		goto st0
+	st567:
+		if p++; p == pe {
+			goto _test_eof567
+		}
+	st_case_567:
+		switch data[p] {
+		case 48:
+			goto st568
+		case 51:
+			goto st595
+		}
+		if 49 <= data[p] && data[p] <= 50 {
+			goto st594
+		}
+		goto st0
+	st568:
+		if p++; p == pe {
+			goto _test_eof568
+		}
+	st_case_568:
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st569
+		}
+		goto st0
+	st569:
+		if p++; p == pe {
+			goto _test_eof569
+		}
+	st_case_569:
+		if data[p] == 84 {
+			goto st570
+		}
+		goto st0
+	st570:
+		if p++; p == pe {
+			goto _test_eof570
+		}
+	st_case_570:
+		if data[p] == 50 {
+			goto st593
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st571
+		}
+		goto st0
+	st571:
+		if p++; p == pe {
+			goto _test_eof571
+		}
+	st_case_571:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st572
+		}
+		goto st0
+	st572:
+		if p++; p == pe {
+			goto _test_eof572
+		}
+	st_case_572:
+		if data[p] == 58 {
+			goto st573
+		}
+		goto st0
+	st573:
+		if p++; p == pe {
+			goto _test_eof573
+		}
+	st_case_573:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st574
+		}
+		goto st0
+	st574:
+		if p++; p == pe {
+			goto _test_eof574
+		}
+	st_case_574:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st575
+		}
+		goto st0
+	st575:
+		if p++; p == pe {
+			goto _test_eof575
+		}
+	st_case_575:
+		if data[p] == 58 {
+			goto st576
+		}
+		goto st0
+	st576:
+		if p++; p == pe {
+			goto _test_eof576
+		}
+	st_case_576:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st577
+		}
+		goto st0
+	st577:
+		if p++; p == pe {
+			goto _test_eof577
+		}
+	st_case_577:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st578
+		}
+		goto st0
+	st578:
+		if p++; p == pe {
+			goto _test_eof578
+		}
+	st_case_578:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 46:
+			goto st586
+		case 90:
+			goto st584
+		}
+		goto st0
+	st579:
+		if p++; p == pe {
+			goto _test_eof579
+		}
+	st_case_579:
+		if data[p] == 50 {
+			goto st585
+		}
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st580
+		}
+		goto st0
+	st580:
+		if p++; p == pe {
+			goto _test_eof580
+		}
+	st_case_580:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st581
+		}
+		goto st0
+	st581:
+		if p++; p == pe {
+			goto _test_eof581
+		}
+	st_case_581:
+		if data[p] == 58 {
+			goto st582
+		}
+		goto st0
+	st582:
+		if p++; p == pe {
+			goto _test_eof582
+		}
+	st_case_582:
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st583
+		}
+		goto st0
+	st583:
+		if p++; p == pe {
+			goto _test_eof583
+		}
+	st_case_583:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st584
+		}
+		goto st0
+	st584:
+		if p++; p == pe {
+			goto _test_eof584
+		}
+	st_case_584:
+		if data[p] == 32 {
+			goto tr609
+		}
+		goto st0
+	st585:
+		if p++; p == pe {
+			goto _test_eof585
+		}
+	st_case_585:
+		if 48 <= data[p] && data[p] <= 51 {
+			goto st581
+		}
+		goto st0
+	st586:
+		if p++; p == pe {
+			goto _test_eof586
+		}
+	st_case_586:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st587
+		}
+		goto st0
+	st587:
+		if p++; p == pe {
+			goto _test_eof587
+		}
+	st_case_587:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st588
+		}
+		goto st0
+	st588:
+		if p++; p == pe {
+			goto _test_eof588
+		}
+	st_case_588:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st589
+		}
+		goto st0
+	st589:
+		if p++; p == pe {
+			goto _test_eof589
+		}
+	st_case_589:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st590
+		}
+		goto st0
+	st590:
+		if p++; p == pe {
+			goto _test_eof590
+		}
+	st_case_590:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st591
+		}
+		goto st0
+	st591:
+		if p++; p == pe {
+			goto _test_eof591
+		}
+	st_case_591:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st592
+		}
+		goto st0
+	st592:
+		if p++; p == pe {
+			goto _test_eof592
+		}
+	st_case_592:
+		switch data[p] {
+		case 43:
+			goto st579
+		case 45:
+			goto st579
+		case 90:
+			goto st584
+		}
+		goto st0
+	st593:
+		if p++; p == pe {
+			goto _test_eof593
+		}
+	st_case_593:
+		if 48 <= data[p] && data[p] <= 51 {
+			goto st572
+		}
+		goto st0
+	st594:
+		if p++; p == pe {
+			goto _test_eof594
+		}
+	st_case_594:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st569
+		}
+		goto st0
+	st595:
+		if p++; p == pe {
+			goto _test_eof595
+		}
+	st_case_595:
+		if 48 <= data[p] && data[p] <= 49 {
+			goto st569
+		}
+		goto st0
+	st596:
+		if p++; p == pe {
+			goto _test_eof596
+		}
+	st_case_596:
+		if 48 <= data[p] && data[p] <= 50 {
+			goto st566
+		}
+		goto st0
+tr8:
+//line syslog5424.rl:78
+ m.Version = m.Version*10 + int((data[p])-'0') 
+	goto st597
+	st597:
+		if p++; p == pe {
+			goto _test_eof597
+		}
+	st_case_597:
+//line syslog5424.go:9270
+		if data[p] == 32 {
+			goto st8
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr616
+		}
+		goto st0
+tr616:
+//line syslog5424.rl:78
+ m.Version = m.Version*10 + int((data[p])-'0') 
+	goto st598
+	st598:
+		if p++; p == pe {
+			goto _test_eof598
+		}
+	st_case_598:
+//line syslog5424.go:9287
+		if data[p] == 32 {
+			goto st8
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof599: cs = 599; goto _test_eof
+	_test_eof600: cs = 600; goto _test_eof
+	_test_eof601: cs = 601; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof602: cs = 602; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof132: cs = 132; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+	_test_eof187: cs = 187; goto _test_eof
+	_test_eof188: cs = 188; goto _test_eof
+	_test_eof189: cs = 189; goto _test_eof
+	_test_eof190: cs = 190; goto _test_eof
+	_test_eof191: cs = 191; goto _test_eof
+	_test_eof192: cs = 192; goto _test_eof
+	_test_eof193: cs = 193; goto _test_eof
+	_test_eof194: cs = 194; goto _test_eof
+	_test_eof195: cs = 195; goto _test_eof
+	_test_eof196: cs = 196; goto _test_eof
+	_test_eof197: cs = 197; goto _test_eof
+	_test_eof198: cs = 198; goto _test_eof
+	_test_eof199: cs = 199; goto _test_eof
+	_test_eof200: cs = 200; goto _test_eof
+	_test_eof201: cs = 201; goto _test_eof
+	_test_eof202: cs = 202; goto _test_eof
+	_test_eof203: cs = 203; goto _test_eof
+	_test_eof204: cs = 204; goto _test_eof
+	_test_eof205: cs = 205; goto _test_eof
+	_test_eof206: cs = 206; goto _test_eof
+	_test_eof207: cs = 207; goto _test_eof
+	_test_eof208: cs = 208; goto _test_eof
+	_test_eof209: cs = 209; goto _test_eof
+	_test_eof210: cs = 210; goto _test_eof
+	_test_eof211: cs = 211; goto _test_eof
+	_test_eof212: cs = 212; goto _test_eof
+	_test_eof213: cs = 213; goto _test_eof
+	_test_eof214: cs = 214; goto _test_eof
+	_test_eof215: cs = 215; goto _test_eof
+	_test_eof216: cs = 216; goto _test_eof
+	_test_eof217: cs = 217; goto _test_eof
+	_test_eof218: cs = 218; goto _test_eof
+	_test_eof219: cs = 219; goto _test_eof
+	_test_eof220: cs = 220; goto _test_eof
+	_test_eof221: cs = 221; goto _test_eof
+	_test_eof222: cs = 222; goto _test_eof
+	_test_eof223: cs = 223; goto _test_eof
+	_test_eof224: cs = 224; goto _test_eof
+	_test_eof225: cs = 225; goto _test_eof
+	_test_eof226: cs = 226; goto _test_eof
+	_test_eof227: cs = 227; goto _test_eof
+	_test_eof228: cs = 228; goto _test_eof
+	_test_eof229: cs = 229; goto _test_eof
+	_test_eof230: cs = 230; goto _test_eof
+	_test_eof231: cs = 231; goto _test_eof
+	_test_eof232: cs = 232; goto _test_eof
+	_test_eof233: cs = 233; goto _test_eof
+	_test_eof234: cs = 234; goto _test_eof
+	_test_eof235: cs = 235; goto _test_eof
+	_test_eof236: cs = 236; goto _test_eof
+	_test_eof237: cs = 237; goto _test_eof
+	_test_eof238: cs = 238; goto _test_eof
+	_test_eof239: cs = 239; goto _test_eof
+	_test_eof240: cs = 240; goto _test_eof
+	_test_eof241: cs = 241; goto _test_eof
+	_test_eof242: cs = 242; goto _test_eof
+	_test_eof243: cs = 243; goto _test_eof
+	_test_eof244: cs = 244; goto _test_eof
+	_test_eof245: cs = 245; goto _test_eof
+	_test_eof246: cs = 246; goto _test_eof
+	_test_eof247: cs = 247; goto _test_eof
+	_test_eof248: cs = 248; goto _test_eof
+	_test_eof249: cs = 249; goto _test_eof
+	_test_eof250: cs = 250; goto _test_eof
+	_test_eof251: cs = 251; goto _test_eof
+	_test_eof252: cs = 252; goto _test_eof
+	_test_eof253: cs = 253; goto _test_eof
+	_test_eof254: cs = 254; goto _test_eof
+	_test_eof255: cs = 255; goto _test_eof
+	_test_eof256: cs = 256; goto _test_eof
+	_test_eof257: cs = 257; goto _test_eof
+	_test_eof258: cs = 258; goto _test_eof
+	_test_eof259: cs = 259; goto _test_eof
+	_test_eof260: cs = 260; goto _test_eof
+	_test_eof261: cs = 261; goto _test_eof
+	_test_eof262: cs = 262; goto _test_eof
+	_test_eof263: cs = 263; goto _test_eof
+	_test_eof264: cs = 264; goto _test_eof
+	_test_eof265: cs = 265; goto _test_eof
+	_test_eof266: cs = 266; goto _test_eof
+	_test_eof267: cs = 267; goto _test_eof
+	_test_eof268: cs = 268; goto _test_eof
+	_test_eof269: cs = 269; goto _test_eof
+	_test_eof270: cs = 270; goto _test_eof
+	_test_eof271: cs = 271; goto _test_eof
+	_test_eof272: cs = 272; goto _test_eof
+	_test_eof273: cs = 273; goto _test_eof
+	_test_eof274: cs = 274; goto _test_eof
+	_test_eof275: cs = 275; goto _test_eof
+	_test_eof276: cs = 276; goto _test_eof
+	_test_eof277: cs = 277; goto _test_eof
+	_test_eof278: cs = 278; goto _test_eof
+	_test_eof279: cs = 279; goto _test_eof
+	_test_eof280: cs = 280; goto _test_eof
+	_test_eof281: cs = 281; goto _test_eof
+	_test_eof282: cs = 282; goto _test_eof
+	_test_eof283: cs = 283; goto _test_eof
+	_test_eof284: cs = 284; goto _test_eof
+	_test_eof285: cs = 285; goto _test_eof
+	_test_eof286: cs = 286; goto _test_eof
+	_test_eof287: cs = 287; goto _test_eof
+	_test_eof288: cs = 288; goto _test_eof
+	_test_eof289: cs = 289; goto _test_eof
+	_test_eof290: cs = 290; goto _test_eof
+	_test_eof291: cs = 291; goto _test_eof
+	_test_eof292: cs = 292; goto _test_eof
+	_test_eof293: cs = 293; goto _test_eof
+	_test_eof294: cs = 294; goto _test_eof
+	_test_eof295: cs = 295; goto _test_eof
+	_test_eof296: cs = 296; goto _test_eof
+	_test_eof297: cs = 297; goto _test_eof
+	_test_eof298: cs = 298; goto _test_eof
+	_test_eof299: cs = 299; goto _test_eof
+	_test_eof300: cs = 300; goto _test_eof
+	_test_eof301: cs = 301; goto _test_eof
+	_test_eof302: cs = 302; goto _test_eof
+	_test_eof303: cs = 303; goto _test_eof
+	_test_eof304: cs = 304; goto _test_eof
+	_test_eof305: cs = 305; goto _test_eof
+	_test_eof306: cs = 306; goto _test_eof
+	_test_eof307: cs = 307; goto _test_eof
+	_test_eof308: cs = 308; goto _test_eof
+	_test_eof309: cs = 309; goto _test_eof
+	_test_eof310: cs = 310; goto _test_eof
+	_test_eof311: cs = 311; goto _test_eof
+	_test_eof312: cs = 312; goto _test_eof
+	_test_eof313: cs = 313; goto _test_eof
+	_test_eof314: cs = 314; goto _test_eof
+	_test_eof315: cs = 315; goto _test_eof
+	_test_eof316: cs = 316; goto _test_eof
+	_test_eof317: cs = 317; goto _test_eof
+	_test_eof318: cs = 318; goto _test_eof
+	_test_eof319: cs = 319; goto _test_eof
+	_test_eof320: cs = 320; goto _test_eof
+	_test_eof321: cs = 321; goto _test_eof
+	_test_eof322: cs = 322; goto _test_eof
+	_test_eof323: cs = 323; goto _test_eof
+	_test_eof324: cs = 324; goto _test_eof
+	_test_eof325: cs = 325; goto _test_eof
+	_test_eof326: cs = 326; goto _test_eof
+	_test_eof327: cs = 327; goto _test_eof
+	_test_eof328: cs = 328; goto _test_eof
+	_test_eof329: cs = 329; goto _test_eof
+	_test_eof330: cs = 330; goto _test_eof
+	_test_eof331: cs = 331; goto _test_eof
+	_test_eof332: cs = 332; goto _test_eof
+	_test_eof333: cs = 333; goto _test_eof
+	_test_eof334: cs = 334; goto _test_eof
+	_test_eof335: cs = 335; goto _test_eof
+	_test_eof336: cs = 336; goto _test_eof
+	_test_eof337: cs = 337; goto _test_eof
+	_test_eof338: cs = 338; goto _test_eof
+	_test_eof339: cs = 339; goto _test_eof
+	_test_eof340: cs = 340; goto _test_eof
+	_test_eof341: cs = 341; goto _test_eof
+	_test_eof342: cs = 342; goto _test_eof
+	_test_eof343: cs = 343; goto _test_eof
+	_test_eof344: cs = 344; goto _test_eof
+	_test_eof345: cs = 345; goto _test_eof
+	_test_eof346: cs = 346; goto _test_eof
+	_test_eof347: cs = 347; goto _test_eof
+	_test_eof348: cs = 348; goto _test_eof
+	_test_eof349: cs = 349; goto _test_eof
+	_test_eof350: cs = 350; goto _test_eof
+	_test_eof351: cs = 351; goto _test_eof
+	_test_eof352: cs = 352; goto _test_eof
+	_test_eof353: cs = 353; goto _test_eof
+	_test_eof354: cs = 354; goto _test_eof
+	_test_eof355: cs = 355; goto _test_eof
+	_test_eof356: cs = 356; goto _test_eof
+	_test_eof357: cs = 357; goto _test_eof
+	_test_eof358: cs = 358; goto _test_eof
+	_test_eof359: cs = 359; goto _test_eof
+	_test_eof360: cs = 360; goto _test_eof
+	_test_eof361: cs = 361; goto _test_eof
+	_test_eof362: cs = 362; goto _test_eof
+	_test_eof363: cs = 363; goto _test_eof
+	_test_eof364: cs = 364; goto _test_eof
+	_test_eof365: cs = 365; goto _test_eof
+	_test_eof366: cs = 366; goto _test_eof
+	_test_eof367: cs = 367; goto _test_eof
+	_test_eof368: cs = 368; goto _test_eof
+	_test_eof369: cs = 369; goto _test_eof
+	_test_eof370: cs = 370; goto _test_eof
+	_test_eof371: cs = 371; goto _test_eof
+	_test_eof372: cs = 372; goto _test_eof
+	_test_eof373: cs = 373; goto _test_eof
+	_test_eof374: cs = 374; goto _test_eof
+	_test_eof375: cs = 375; goto _test_eof
+	_test_eof376: cs = 376; goto _test_eof
+	_test_eof377: cs = 377; goto _test_eof
+	_test_eof378: cs = 378; goto _test_eof
+	_test_eof379: cs = 379; goto _test_eof
+	_test_eof380: cs = 380; goto _test_eof
+	_test_eof381: cs = 381; goto _test_eof
+	_test_eof382: cs = 382; goto _test_eof
+	_test_eof383: cs = 383; goto _test_eof
+	_test_eof384: cs = 384; goto _test_eof
+	_test_eof385: cs = 385; goto _test_eof
+	_test_eof386: cs = 386; goto _test_eof
+	_test_eof387: cs = 387; goto _test_eof
+	_test_eof388: cs = 388; goto _test_eof
+	_test_eof389: cs = 389; goto _test_eof
+	_test_eof390: cs = 390; goto _test_eof
+	_test_eof391: cs = 391; goto _test_eof
+	_test_eof392: cs = 392; goto _test_eof
+	_test_eof393: cs = 393; goto _test_eof
+	_test_eof394: cs = 394; goto _test_eof
+	_test_eof395: cs = 395; goto _test_eof
+	_test_eof396: cs = 396; goto _test_eof
+	_test_eof397: cs = 397; goto _test_eof
+	_test_eof398: cs = 398; goto _test_eof
+	_test_eof399: cs = 399; goto _test_eof
+	_test_eof400: cs = 400; goto _test_eof
+	_test_eof401: cs = 401; goto _test_eof
+	_test_eof402: cs = 402; goto _test_eof
+	_test_eof403: cs = 403; goto _test_eof
+	_test_eof404: cs = 404; goto _test_eof
+	_test_eof405: cs = 405; goto _test_eof
+	_test_eof406: cs = 406; goto _test_eof
+	_test_eof407: cs = 407; goto _test_eof
+	_test_eof408: cs = 408; goto _test_eof
+	_test_eof409: cs = 409; goto _test_eof
+	_test_eof410: cs = 410; goto _test_eof
+	_test_eof411: cs = 411; goto _test_eof
+	_test_eof412: cs = 412; goto _test_eof
+	_test_eof413: cs = 413; goto _test_eof
+	_test_eof414: cs = 414; goto _test_eof
+	_test_eof415: cs = 415; goto _test_eof
+	_test_eof416: cs = 416; goto _test_eof
+	_test_eof417: cs = 417; goto _test_eof
+	_test_eof418: cs = 418; goto _test_eof
+	_test_eof419: cs = 419; goto _test_eof
+	_test_eof420: cs = 420; goto _test_eof
+	_test_eof421: cs = 421; goto _test_eof
+	_test_eof422: cs = 422; goto _test_eof
+	_test_eof423: cs = 423; goto _test_eof
+	_test_eof424: cs = 424; goto _test_eof
+	_test_eof425: cs = 425; goto _test_eof
+	_test_eof426: cs = 426; goto _test_eof
+	_test_eof427: cs = 427; goto _test_eof
+	_test_eof428: cs = 428; goto _test_eof
+	_test_eof429: cs = 429; goto _test_eof
+	_test_eof430: cs = 430; goto _test_eof
+	_test_eof431: cs = 431; goto _test_eof
+	_test_eof432: cs = 432; goto _test_eof
+	_test_eof433: cs = 433; goto _test_eof
+	_test_eof434: cs = 434; goto _test_eof
+	_test_eof435: cs = 435; goto _test_eof
+	_test_eof436: cs = 436; goto _test_eof
+	_test_eof437: cs = 437; goto _test_eof
+	_test_eof438: cs = 438; goto _test_eof
+	_test_eof439: cs = 439; goto _test_eof
+	_test_eof440: cs = 440; goto _test_eof
+	_test_eof441: cs = 441; goto _test_eof
+	_test_eof442: cs = 442; goto _test_eof
+	_test_eof443: cs = 443; goto _test_eof
+	_test_eof444: cs = 444; goto _test_eof
+	_test_eof445: cs = 445; goto _test_eof
+	_test_eof446: cs = 446; goto _test_eof
+	_test_eof447: cs = 447; goto _test_eof
+	_test_eof448: cs = 448; goto _test_eof
+	_test_eof449: cs = 449; goto _test_eof
+	_test_eof450: cs = 450; goto _test_eof
+	_test_eof451: cs = 451; goto _test_eof
+	_test_eof452: cs = 452; goto _test_eof
+	_test_eof453: cs = 453; goto _test_eof
+	_test_eof454: cs = 454; goto _test_eof
+	_test_eof455: cs = 455; goto _test_eof
+	_test_eof456: cs = 456; goto _test_eof
+	_test_eof457: cs = 457; goto _test_eof
+	_test_eof458: cs = 458; goto _test_eof
+	_test_eof459: cs = 459; goto _test_eof
+	_test_eof460: cs = 460; goto _test_eof
+	_test_eof461: cs = 461; goto _test_eof
+	_test_eof462: cs = 462; goto _test_eof
+	_test_eof463: cs = 463; goto _test_eof
+	_test_eof464: cs = 464; goto _test_eof
+	_test_eof465: cs = 465; goto _test_eof
+	_test_eof466: cs = 466; goto _test_eof
+	_test_eof467: cs = 467; goto _test_eof
+	_test_eof468: cs = 468; goto _test_eof
+	_test_eof469: cs = 469; goto _test_eof
+	_test_eof470: cs = 470; goto _test_eof
+	_test_eof471: cs = 471; goto _test_eof
+	_test_eof472: cs = 472; goto _test_eof
+	_test_eof473: cs = 473; goto _test_eof
+	_test_eof474: cs = 474; goto _test_eof
+	_test_eof475: cs = 475; goto _test_eof
+	_test_eof476: cs = 476; goto _test_eof
+	_test_eof477: cs = 477; goto _test_eof
+	_test_eof478: cs = 478; goto _test_eof
+	_test_eof479: cs = 479; goto _test_eof
+	_test_eof480: cs = 480; goto _test_eof
+	_test_eof481: cs = 481; goto _test_eof
+	_test_eof482: cs = 482; goto _test_eof
+	_test_eof483: cs = 483; goto _test_eof
+	_test_eof484: cs = 484; goto _test_eof
+	_test_eof485: cs = 485; goto _test_eof
+	_test_eof486: cs = 486; goto _test_eof
+	_test_eof487: cs = 487; goto _test_eof
+	_test_eof488: cs = 488; goto _test_eof
+	_test_eof489: cs = 489; goto _test_eof
+	_test_eof490: cs = 490; goto _test_eof
+	_test_eof491: cs = 491; goto _test_eof
+	_test_eof492: cs = 492; goto _test_eof
+	_test_eof493: cs = 493; goto _test_eof
+	_test_eof494: cs = 494; goto _test_eof
+	_test_eof495: cs = 495; goto _test_eof
+	_test_eof496: cs = 496; goto _test_eof
+	_test_eof497: cs = 497; goto _test_eof
+	_test_eof498: cs = 498; goto _test_eof
+	_test_eof499: cs = 499; goto _test_eof
+	_test_eof500: cs = 500; goto _test_eof
+	_test_eof501: cs = 501; goto _test_eof
+	_test_eof502: cs = 502; goto _test_eof
+	_test_eof503: cs = 503; goto _test_eof
+	_test_eof504: cs = 504; goto _test_eof
+	_test_eof505: cs = 505; goto _test_eof
+	_test_eof506: cs = 506; goto _test_eof
+	_test_eof507: cs = 507; goto _test_eof
+	_test_eof508: cs = 508; goto _test_eof
+	_test_eof509: cs = 509; goto _test_eof
+	_test_eof510: cs = 510; goto _test_eof
+	_test_eof511: cs = 511; goto _test_eof
+	_test_eof512: cs = 512; goto _test_eof
+	_test_eof513: cs = 513; goto _test_eof
+	_test_eof514: cs = 514; goto _test_eof
+	_test_eof515: cs = 515; goto _test_eof
+	_test_eof516: cs = 516; goto _test_eof
+	_test_eof517: cs = 517; goto _test_eof
+	_test_eof518: cs = 518; goto _test_eof
+	_test_eof519: cs = 519; goto _test_eof
+	_test_eof520: cs = 520; goto _test_eof
+	_test_eof521: cs = 521; goto _test_eof
+	_test_eof522: cs = 522; goto _test_eof
+	_test_eof523: cs = 523; goto _test_eof
+	_test_eof524: cs = 524; goto _test_eof
+	_test_eof525: cs = 525; goto _test_eof
+	_test_eof526: cs = 526; goto _test_eof
+	_test_eof527: cs = 527; goto _test_eof
+	_test_eof528: cs = 528; goto _test_eof
+	_test_eof529: cs = 529; goto _test_eof
+	_test_eof530: cs = 530; goto _test_eof
+	_test_eof531: cs = 531; goto _test_eof
+	_test_eof532: cs = 532; goto _test_eof
+	_test_eof533: cs = 533; goto _test_eof
+	_test_eof534: cs = 534; goto _test_eof
+	_test_eof535: cs = 535; goto _test_eof
+	_test_eof536: cs = 536; goto _test_eof
+	_test_eof537: cs = 537; goto _test_eof
+	_test_eof538: cs = 538; goto _test_eof
+	_test_eof539: cs = 539; goto _test_eof
+	_test_eof540: cs = 540; goto _test_eof
+	_test_eof541: cs = 541; goto _test_eof
+	_test_eof542: cs = 542; goto _test_eof
+	_test_eof543: cs = 543; goto _test_eof
+	_test_eof544: cs = 544; goto _test_eof
+	_test_eof545: cs = 545; goto _test_eof
+	_test_eof546: cs = 546; goto _test_eof
+	_test_eof547: cs = 547; goto _test_eof
+	_test_eof548: cs = 548; goto _test_eof
+	_test_eof549: cs = 549; goto _test_eof
+	_test_eof550: cs = 550; goto _test_eof
+	_test_eof551: cs = 551; goto _test_eof
+	_test_eof552: cs = 552; goto _test_eof
+	_test_eof553: cs = 553; goto _test_eof
+	_test_eof554: cs = 554; goto _test_eof
+	_test_eof555: cs = 555; goto _test_eof
+	_test_eof556: cs = 556; goto _test_eof
+	_test_eof557: cs = 557; goto _test_eof
+	_test_eof558: cs = 558; goto _test_eof
+	_test_eof559: cs = 559; goto _test_eof
+	_test_eof560: cs = 560; goto _test_eof
+	_test_eof561: cs = 561; goto _test_eof
+	_test_eof562: cs = 562; goto _test_eof
+	_test_eof563: cs = 563; goto _test_eof
+	_test_eof564: cs = 564; goto _test_eof
+	_test_eof565: cs = 565; goto _test_eof
+	_test_eof566: cs = 566; goto _test_eof
+	_test_eof567: cs = 567; goto _test_eof
+	_test_eof568: cs = 568; goto _test_eof
+	_test_eof569: cs = 569; goto _test_eof
+	_test_eof570: cs = 570; goto _test_eof
+	_test_eof571: cs = 571; goto _test_eof
+	_test_eof572: cs = 572; goto _test_eof
+	_test_eof573: cs = 573; goto _test_eof
+	_test_eof574: cs = 574; goto _test_eof
+	_test_eof575: cs = 575; goto _test_eof
+	_test_eof576: cs = 576; goto _test_eof
+	_test_eof577: cs = 577; goto _test_eof
+	_test_eof578: cs = 578; goto _test_eof
+	_test_eof579: cs = 579; goto _test_eof
+	_test_eof580: cs = 580; goto _test_eof
+	_test_eof581: cs = 581; goto _test_eof
+	_test_eof582: cs = 582; goto _test_eof
+	_test_eof583: cs = 583; goto _test_eof
+	_test_eof584: cs = 584; goto _test_eof
+	_test_eof585: cs = 585; goto _test_eof
+	_test_eof586: cs = 586; goto _test_eof
+	_test_eof587: cs = 587; goto _test_eof
+	_test_eof588: cs = 588; goto _test_eof
+	_test_eof589: cs = 589; goto _test_eof
+	_test_eof590: cs = 590; goto _test_eof
+	_test_eof591: cs = 591; goto _test_eof
+	_test_eof592: cs = 592; goto _test_eof
+	_test_eof593: cs = 593; goto _test_eof
+	_test_eof594: cs = 594; goto _test_eof
+	_test_eof595: cs = 595; goto _test_eof
+	_test_eof596: cs = 596; goto _test_eof
+	_test_eof597: cs = 597; goto _test_eof
+	_test_eof598: cs = 598; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 600:
+//line syslog5424.rl:76
+ mark = p 
+//line syslog5424.rl:84
+ m.Message = data[mark:p] 
+		case 601:
+//line syslog5424.rl:84
+ m.Message = data[mark:p] 
+//line syslog5424.go:9906
+		}
+	}
+
+	_out: {}
+	}
+
+//line syslog5424.rl:157
+
+
+	if cs < syslog5424_first_final {
+		if p == pe {
+			return Syslog5424Message{}, &SyslogSyntaxError{Offset: p, Msg: "unexpected end of message"}
+		}
+		return Syslog5424Message{}, &SyslogSyntaxError{Offset: p, Msg: "unexpected " + strconv.QuoteRune(rune(data[p]))}
+	}
+
+	if m.Priority > 191 {
+		return Syslog5424Message{}, &SyslogSyntaxError{Offset: 1, Msg: "priority out of range"}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import "strconv"
+
+// Syslog5424Message is a message in the RFC 5424 syslog format.  The NILVALUE
+// "-" is reported as a nil slice.  All byte slices point into the parsed
+// input except for structured data values containing escapes.
+type Syslog5424Message struct {
+	Priority       int
+	Version        int
+	Timestamp      []byte
+	Hostname       []byte
+	AppName        []byte
+	ProcID         []byte
+	MsgID          []byte
+	StructuredData []SDElement
+	Message        []byte
+}
+
+// Facility returns the facility encoded in the message priority.
+func (m *Syslog5424Message) Facility() int { return m.Priority / 8 }
+
+// Severity returns the severity encoded in the message priority.
+func (m *Syslog5424Message) Severity() int { return m.Priority % 8 }
+
+// SDElement is a single [id name="value" ...] block of structured data.
+type SDElement struct {
+	ID     []byte
+	Params []SDParam
+}
+
+// SDParam is a structured data parameter.  Value has had its \" \\ and \]
+// escapes removed.
+type SDParam struct {
+	Name  []byte
+	Value []byte
+}
+
+// A SyslogSyntaxError reports where ParseSyslog5424 stopped.
+type SyslogSyntaxError struct {
+	Offset int
+	Msg    string
+}
+
+func (e *SyslogSyntaxError) Error() string {
+	return "syslog5424: " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+func unescapeSDValue(v []byte) []byte {
+	out := make([]byte, 0, len(v))
+	for i := 0; i < len(v); i++ {
+		if v[i] == '\\' && i+1 < len(v) && (v[i+1] == '"' || v[i+1] == '\\' || v[i+1] == ']') {
+			i++
+		}
+		out = append(out, v[i])
+	}
+	return out
+}
+
+// ParseSyslog5424 parses an RFC 5424 syslog message.
+func ParseSyslog5424(data []byte) (Syslog5424Message, error) {
+
+%% machine syslog5424;
+%% write data;
+
+	var m Syslog5424Message
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	var name []byte
+	var sd *SDElement
+	escaped := false
+
+	%%{
+	    action mark      { mark = p }
+	    action prival    { m.Priority = m.Priority*10 + int(fc-'0') }
+	    action version   { m.Version = m.Version*10 + int(fc-'0') }
+	    action timestamp { m.Timestamp = data[mark:p] }
+	    action hostname  { m.Hostname = data[mark:p] }
+	    action app_name  { m.AppName = data[mark:p] }
+	    action procid    { m.ProcID = data[mark:p] }
+	    action msgid     { m.MsgID = data[mark:p] }
+	    action message   { m.Message = data[mark:p] }
+
+	    action sd_id {
+	        m.StructuredData = append(m.StructuredData, SDElement{ID: data[mark:p]})
+	        sd = &m.StructuredData[len(m.StructuredData)-1]
+	    }
+
+	    action param_name { name = data[mark:p]; escaped = false }
+	    action escape     { escaped = true }
+
+	    action param_value {
+	        if escaped {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: unescapeSDValue(data[mark:p])})
+	        } else {
+	            sd.Params = append(sd.Params, SDParam{Name: name, Value: data[mark:p]})
+	        }
+	    }
+
+	    nil = '-' ;
+
+	    printusascii = 33..126 ;
+
+	    utf8 = 0x00..0x7f
+	         | 0xc2..0xdf 0x80..0xbf
+	         | 0xe0 0xa0..0xbf 0x80..0xbf
+	         | ( 0xe1..0xec | 0xee..0xef ) 0x80..0xbf 0x80..0xbf
+	         | 0xed 0x80..0x9f 0x80..0xbf
+	         | 0xf0 0x90..0xbf 0x80..0xbf 0x80..0xbf
+	         | 0xf1..0xf3 0x80..0xbf 0x80..0xbf 0x80..0xbf
+	         | 0xf4 0x80..0x8f 0x80..0xbf 0x80..0xbf ;
+
+	    pri = '<' digit{1,3} $prival '>' ;
+	    version = ( '1'..'9' digit{0,2} ) $version ;
+
+	    date_fullyear = digit{4} ;
+	    date_month = '0' '1'..'9' | '1' '0'..'2' ;
+	    date_mday = '0' '1'..'9' | '1'..'2' digit | '3' '0'..'1' ;
+	    full_date = date_fullyear '-' date_month '-' date_mday ;
+
+	    time_hour = '0'..'1' digit | '2' '0'..'3' ;
+	    time_minute = '0'..'5' digit ;
+	    time_second = '0'..'5' digit ;
+	    time_secfrac = '.' digit{1,6} ;
+	    time_numoffset = ( '+' | '-' ) time_hour ':' time_minute ;
+	    time_offset = 'Z' | time_numoffset ;
+	    partial_time = time_hour ':' time_minute ':' time_second time_secfrac? ;
+	    full_time = partial_time time_offset ;
+
+	    timestamp = nil | ( full_date 'T' full_time ) >mark %timestamp ;
+
+	    hostname = nil | ( printusascii{1,255} - nil ) >mark %hostname ;
+	    app_name = nil | ( printusascii{1,48} - nil ) >mark %app_name ;
+	    procid = nil | ( printusascii{1,128} - nil ) >mark %procid ;
+	    msgid = nil | ( printusascii{1,32} - nil ) >mark %msgid ;
+
+	    header = pri version ' ' timestamp ' ' hostname ' ' app_name ' ' procid ' ' msgid ;
+
+	    sd_name = ( printusascii - ( '=' | ']' | '"' ) ){1,32} ;
+
+	    param_value = ( ( utf8 - ( '"' | '\\' | ']' ) ) | ( '\\' >escape utf8 ) )* ;
+
+	    sd_param = sd_name >mark %param_name '="' param_value >mark %param_value '"' ;
+
+	    sd_element = '[' sd_name >mark %sd_id ( ' ' sd_param )* ']' ;
+
+	    structured_data = nil | sd_element+ ;
+
+	    msg = any* >mark %message ;
+
+	    main := header ' ' structured_data ( ' ' msg )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < syslog5424_first_final {
+		if p == pe {
+			return Syslog5424Message{}, &SyslogSyntaxError{Offset: p, Msg: "unexpected end of message"}
+		}
+		return Syslog5424Message{}, &SyslogSyntaxError{Offset: p, Msg: "unexpected " + strconv.QuoteRune(rune(data[p]))}
+	}
+
+	if m.Priority > 191 {
+		return Syslog5424Message{}, &SyslogSyntaxError{Offset: 1, Msg: "priority out of range"}
+	}
+
+	return m, nil
+}
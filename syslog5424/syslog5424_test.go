@@ -0,0 +1,241 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+// example from RFC 5424 section 6.5
+var data = []byte(`<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"] An application event log entry...`)
+
+var corpus = [][]byte{
+	data,
+	[]byte(`<34>1 2003-10-11T22:14:15.003Z mymachine.example.com su - ID47 - 'su root' failed for lonvick on /dev/pts/8`),
+	[]byte(`<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - %% It's time to make the do-nuts.`),
+	[]byte(`<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high"]`),
+	[]byte(`<13>1 2019-02-13T19:48:34+00:00 host01 sshd 1234 auth - Accepted publickey for git from 203.0.113.9 port 50122 ssh2`),
+	[]byte(`<86>1 2021-06-01T12:00:00.123456+02:00 web-1.example.org nginx 977 access [meta@41058 sequenceId="1" requestId="a1b2"] GET /healthz 200`),
+	[]byte(`<0>1 1970-01-01T00:00:00Z - - - - -`),
+	[]byte(`<191>1 2022-12-31T23:59:59.9-05:00 edge kernel - - - [UFW BLOCK] IN=eth0 OUT= SRC=198.51.100.4`),
+	[]byte(`<30>1 2020-03-15T08:30:00.5Z db01 postgres 4411 - [origin@32473 ip="10.0.0.5" software="postgres" swVersion="13.2"] checkpoint starting: time`),
+	[]byte(`<14>1 2023-01-07T10:20:30.040Z app01 payments 55 TXN [txn@32473 id="7f3c" amount="12.50" currency="EUR"] payment accepted`),
+	[]byte(`<11>1 2023-01-07T10:20:31Z app01 payments 55 TXN [err@32473 msg="card \"declined\" by issuer"] payment failed`),
+	[]byte(`<46>1 2018-05-05T05:05:05.555+05:30 logger rsyslogd - - [origin software="rsyslogd" swVersion="8.24.0" x-pid="871" x-info="https://www.rsyslog.com"] start`),
+	[]byte(`<78>1 2017-07-04T14:00:00-04:00 cron01 CRON 2211 - - (root) CMD (run-parts /etc/cron.hourly)`),
+	[]byte(`<27>1 2024-02-29T00:00:00.000Z nas smartd 600 - [disk@32473 dev="/dev/sda" path="C:\\Disks\\0"] temperature critical`),
+	[]byte(`<133>1 2016-11-11T11:11:11.111Z fw01 filterlog 3001 - [rule@32473 match="[a-z\]+"] block in on em0`),
+	[]byte(`<38>1 2015-08-09T10:11:12Z auth01 sudo - - - alice : TTY=pts/0 ; PWD=/home/alice ; USER=root ; COMMAND=/bin/ls`),
+	[]byte(`<22>1 2014-04-04T04:04:04.4Z mx01 postfix/smtp 9001 - - 4F2A1C: to=<bob@example.com>, status=sent`),
+	[]byte(`<9>1 2013-03-03T03:03:03Z lb haproxy 120 - [stats@32473 conn="42" queue="0"][timing@32473 tq="1" tw="0" tc="2"]`),
+	[]byte(`<142>1 2012-12-12T12:12:12.121212Z k8s-node-3 kubelet 1500 - [k8s@32473 pod="api-7d9f" ns="default"] Started container`),
+	[]byte(`<5>1 2011-01-01T01:01:01Z router - - - - ` + "\xef\xbb\xbf" + `Link up on ge-0/0/1`),
+	[]byte(`<174>1 2010-10-10T10:10:10.01+01:00 printer cupsd 88 job [job@32473 id="17" user="carol" title="Quarterly \\ Report"] job completed`),
+	[]byte(`<101>1 2009-09-09T09:09:09Z - app - - [empty@32473]`),
+}
+
+var hits int
+
+func TestParseSyslog5424(t *testing.T) {
+	tests := []struct {
+		line string
+		want Syslog5424Message
+		ok   bool
+	}{
+		{
+			line: string(data),
+			want: Syslog5424Message{
+				Priority:  165,
+				Version:   1,
+				Timestamp: []byte("2003-10-11T22:14:15.003Z"),
+				Hostname:  []byte("mymachine.example.com"),
+				AppName:   []byte("evntslog"),
+				MsgID:     []byte("ID47"),
+				StructuredData: []SDElement{
+					{
+						ID: []byte("exampleSDID@32473"),
+						Params: []SDParam{
+							{Name: []byte("iut"), Value: []byte("3")},
+							{Name: []byte("eventSource"), Value: []byte("Application")},
+							{Name: []byte("eventID"), Value: []byte("1011")},
+						},
+					},
+				},
+				Message: []byte("An application event log entry..."),
+			},
+			ok: true,
+		},
+		{
+			// all NILVALUEs and no MSG
+			line: `<0>1 - - - - - -`,
+			want: Syslog5424Message{Version: 1},
+			ok:   true,
+		},
+		{
+			line: `<165>1 2003-08-24T05:14:15.000003-07:00 192.0.2.1 myproc 8710 - - %% It's time to make the do-nuts.`,
+			want: Syslog5424Message{
+				Priority:  165,
+				Version:   1,
+				Timestamp: []byte("2003-08-24T05:14:15.000003-07:00"),
+				Hostname:  []byte("192.0.2.1"),
+				AppName:   []byte("myproc"),
+				ProcID:    []byte("8710"),
+				Message:   []byte("%% It's time to make the do-nuts."),
+			},
+			ok: true,
+		},
+		{
+			// multiple elements, escapes, and an element with no params
+			line: `<11>1 2023-01-07T10:20:31Z app01 payments 55 TXN [err@32473 msg="card \"declined\" \\ [x\]" raw="a\b"][empty@32473]`,
+			want: Syslog5424Message{
+				Priority:  11,
+				Version:   1,
+				Timestamp: []byte("2023-01-07T10:20:31Z"),
+				Hostname:  []byte("app01"),
+				AppName:   []byte("payments"),
+				ProcID:    []byte("55"),
+				MsgID:     []byte("TXN"),
+				StructuredData: []SDElement{
+					{
+						ID: []byte("err@32473"),
+						Params: []SDParam{
+							{Name: []byte("msg"), Value: []byte(`card "declined" \ [x]`)},
+							{Name: []byte("raw"), Value: []byte(`a\b`)},
+						},
+					},
+					{ID: []byte("empty@32473")},
+				},
+			},
+			ok: true,
+		},
+		{
+			// empty MSG after the separating space
+			line: `<13>12 - host - - - - `,
+			want: Syslog5424Message{
+				Priority: 13,
+				Version:  12,
+				Hostname: []byte("host"),
+				Message:  []byte(""),
+			},
+			ok: true,
+		},
+		// priority out of range
+		{line: `<192>1 - - - - - -`},
+		// version can't start with zero
+		{line: `<13>0 - - - - - -`},
+		// month 13
+		{line: `<13>1 2003-13-11T22:14:15Z - - - - -`},
+		// missing time offset
+		{line: `<13>1 2003-10-11T22:14:15.003 - - - - -`},
+		// seven digits of fractional seconds
+		{line: `<13>1 2003-10-11T22:14:15.0000003Z - - - - -`},
+		// MSGID is limited to 32 characters
+		{line: `<13>1 - - - - 0123456789abcdef0123456789abcdefX -`},
+		// unescaped ] in a param value
+		{line: `<13>1 - - - - - [id a="]"]`},
+		// space in an SD-ID
+		{line: `<13>1 - - - - - [id x a="b"`},
+		// param value must be valid UTF-8
+		{line: "<13>1 - - - - - [id a=\"\xff\"]"},
+		// missing structured data
+		{line: `<13>1 - - - - -`},
+		{line: ``},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSyslog5424([]byte(tt.line))
+		if ok := err == nil; ok != tt.ok {
+			t.Errorf("ParseSyslog5424(%q) err=%v, want ok=%v", tt.line, err, tt.ok)
+			continue
+		}
+		if err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseSyslog5424(%q)=%+v, want %+v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestSyslog5424Errors(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{`<13>1 - - - - -`, "syslog5424: unexpected end of message at offset 15"},
+		{`<13>1 2003-13-11T22:14:15Z - - - - -`, "syslog5424: unexpected '3' at offset 12"},
+		{`<192>1 - - - - - -`, "syslog5424: priority out of range at offset 1"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseSyslog5424([]byte(tt.line))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("ParseSyslog5424(%q) err=%v, want %q", tt.line, err, tt.want)
+		}
+	}
+}
+
+func TestSyslog5424Corpus(t *testing.T) {
+	for _, line := range corpus {
+		if _, err := ParseSyslog5424(line); err != nil {
+			t.Errorf("ParseSyslog5424(%q): %v", line, err)
+		}
+	}
+}
+
+// reSyslog only pulls apart the header; the structured data is taken as an
+// opaque blob since a regular expression can't return repeated groups.
+var reSyslog = regexp.MustCompile(`^<(\d{1,3})>([1-9]\d{0,2}) (\S+) (\S{1,255}) (\S{1,48}) (\S{1,128}) (\S{1,32}) (-|(?:\[(?:[^"\]]|"(?:[^"\\]|\\.)*")*\])+)(?: (.*))?$`)
+
+func regexSyslog5424(line []byte) (Syslog5424Message, bool) {
+	m := reSyslog.FindSubmatch(line)
+	if m == nil {
+		return Syslog5424Message{}, false
+	}
+	nilvalue := func(b []byte) []byte {
+		if string(b) == "-" {
+			return nil
+		}
+		return b
+	}
+	pri, _ := strconv.Atoi(string(m[1]))
+	version, _ := strconv.Atoi(string(m[2]))
+	return Syslog5424Message{
+		Priority:  pri,
+		Version:   version,
+		Timestamp: nilvalue(m[3]),
+		Hostname:  nilvalue(m[4]),
+		AppName:   nilvalue(m[5]),
+		ProcID:    nilvalue(m[6]),
+		MsgID:     nilvalue(m[7]),
+		Message:   m[9],
+	}, true
+}
+
+func TestSyslog5424Alternatives(t *testing.T) {
+	for _, line := range corpus {
+		want, _ := ParseSyslog5424(line)
+		want.StructuredData = nil
+		if got, ok := regexSyslog5424(line); !ok || !reflect.DeepEqual(got, want) {
+			t.Errorf("regexSyslog5424(%q)=%+v, want %+v", line, got, want)
+		}
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, line := range corpus {
+			if _, ok := regexSyslog5424(line); ok {
+				hits++
+			}
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, line := range corpus {
+			if _, err := ParseSyslog5424(line); err == nil {
+				hits++
+			}
+		}
+	}
+}
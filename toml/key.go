@@ -0,0 +1,647 @@
+
+//line key.rl:1
+package main
+
+import "unicode/utf8"
+
+
+//line key.rl:20
+
+
+func unhex(c byte) rune {
+	switch {
+	case c <= '9':
+		return rune(c - '0')
+	case c <= 'F':
+		return rune(c - 'A' + 10)
+	}
+	return rune(c - 'a' + 10)
+}
+
+// unescapeTOML expands the escape sequences in the body of a basic
+// string, which the toml_string grammar has already checked the shape of.
+// It fails if a \u or \U escape is not a Unicode scalar value.
+func unescapeTOML(b []byte) ([]byte, bool) {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] != '\\' {
+			out = append(out, b[i])
+			continue
+		}
+		i++
+		switch b[i] {
+		case 'b':
+			out = append(out, '\b')
+		case 't':
+			out = append(out, '\t')
+		case 'n':
+			out = append(out, '\n')
+		case 'f':
+			out = append(out, '\f')
+		case 'r':
+			out = append(out, '\r')
+		case 'u', 'U':
+			n := 4
+			if b[i] == 'U' {
+				n = 8
+			}
+			var r rune
+			for _, c := range b[i+1 : i+1+n] {
+				r = r<<4 | unhex(c)
+			}
+			if r < 0 || !utf8.ValidRune(r) {
+				return nil, false
+			}
+			out = utf8.AppendRune(out, r)
+			i += n
+		default:
+			out = append(out, b[i])
+		}
+	}
+	return out, true
+}
+
+// ParseTOMLKey parses a TOML key, which may be dotted, into its parts.
+// Bare and literal parts point into data; double-quoted parts with escapes
+// are unescaped into new slices.  Whitespace is allowed around the dots.
+func ParseTOMLKey(data []byte) (parts [][]byte, ok bool) {
+	parts, ok, _ = ParseTOMLKeyAt(data)
+	return parts, ok
+}
+
+// ParseTOMLKeyAt is like ParseTOMLKey but also returns the offset of the
+// first byte that is out of place, or -1 if data is a valid key.  A quoted
+// part with an escape that is not a Unicode scalar value fails at its
+// opening quote.
+func ParseTOMLKeyAt(data []byte) (parts [][]byte, ok bool, errPos int) {
+
+
+//line key.rl:90
+
+//line key.go:82
+const toml_key_start int = 1
+const toml_key_first_final int = 17
+const toml_key_error int = 0
+
+const toml_key_en_main int = 1
+
+
+//line key.rl:91
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+	invalid := -1
+
+	
+//line key.go:99
+	{
+	cs = toml_key_start
+	}
+
+//line key.go:104
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 17:
+		goto st_case_17
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 18:
+		goto st_case_18
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 34:
+			goto tr1
+		case 39:
+			goto st6
+		case 45:
+			goto tr3
+		case 95:
+			goto tr3
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr3
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr3
+			}
+		default:
+			goto tr3
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line key.rl:102
+ escaped = false 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line key.go:187
+		switch data[p] {
+		case 34:
+			goto tr5
+		case 92:
+			goto tr6
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto tr4
+tr4:
+//line key.rl:101
+ mark = p 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line key.go:214
+		switch data[p] {
+		case 34:
+			goto tr8
+		case 92:
+			goto tr9
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st3
+tr5:
+//line key.rl:101
+ mark = p 
+//line key.rl:105
+
+	        if escaped {
+	            part, valid := unescapeTOML(data[mark:p])
+	            if !valid && invalid < 0 {
+	                invalid = mark - 1
+	            }
+	            parts = append(parts, part)
+	        } else {
+	            parts = append(parts, data[mark:p])
+	        }
+	    
+	goto st17
+tr8:
+//line key.rl:105
+
+	        if escaped {
+	            part, valid := unescapeTOML(data[mark:p])
+	            if !valid && invalid < 0 {
+	                invalid = mark - 1
+	            }
+	            parts = append(parts, part)
+	        } else {
+	            parts = append(parts, data[mark:p])
+	        }
+	    
+	goto st17
+tr13:
+//line key.rl:101
+ mark = p 
+//line key.rl:103
+ parts = append(parts, data[mark:p]) 
+	goto st17
+tr15:
+//line key.rl:103
+ parts = append(parts, data[mark:p]) 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line key.go:277
+		switch data[p] {
+		case 9:
+			goto st4
+		case 32:
+			goto st4
+		case 46:
+			goto st5
+		}
+		goto st0
+tr24:
+//line key.rl:103
+ parts = append(parts, data[mark:p]) 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line key.go:296
+		switch data[p] {
+		case 9:
+			goto st4
+		case 32:
+			goto st4
+		case 46:
+			goto st5
+		}
+		goto st0
+tr26:
+//line key.rl:103
+ parts = append(parts, data[mark:p]) 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line key.go:315
+		switch data[p] {
+		case 9:
+			goto st5
+		case 32:
+			goto st5
+		case 34:
+			goto tr1
+		case 39:
+			goto st6
+		case 45:
+			goto tr3
+		case 95:
+			goto tr3
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr3
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr3
+			}
+		default:
+			goto tr3
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 39:
+			goto tr13
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto tr12
+tr12:
+//line key.rl:101
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line key.go:372
+		switch data[p] {
+		case 39:
+			goto tr15
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st7
+tr3:
+//line key.rl:101
+ mark = p 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line key.go:397
+		switch data[p] {
+		case 9:
+			goto tr24
+		case 32:
+			goto tr24
+		case 45:
+			goto st18
+		case 46:
+			goto tr26
+		case 95:
+			goto st18
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st18
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+tr6:
+//line key.rl:101
+ mark = p 
+//line key.rl:11
+ escaped = true 
+	goto st8
+tr9:
+//line key.rl:11
+ escaped = true 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line key.go:438
+		switch data[p] {
+		case 34:
+			goto st3
+		case 85:
+			goto st9
+		case 92:
+			goto st3
+		case 98:
+			goto st3
+		case 102:
+			goto st3
+		case 110:
+			goto st3
+		case 114:
+			goto st3
+		case 116:
+			goto st3
+		case 117:
+			goto st13
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st11
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st12
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st12
+			}
+		default:
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st13
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st13
+			}
+		default:
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st14
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st14
+			}
+		default:
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st16
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st16
+			}
+		default:
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st3
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st3
+			}
+		default:
+			goto st3
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 18:
+//line key.rl:103
+ parts = append(parts, data[mark:p]) 
+//line key.go:629
+		}
+	}
+
+	_out: {}
+	}
+
+//line key.rl:129
+
+
+	if cs < toml_key_first_final {
+		return nil, false, p
+	}
+
+	if invalid >= 0 {
+		return nil, false, invalid
+	}
+
+	return parts, true, -1
+}
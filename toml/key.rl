@@ -0,0 +1,140 @@
+package main
+
+import "unicode/utf8"
+
+%%{
+    machine toml_string;
+
+    # These expect escaped to be in scope.  It is set by any escape in a
+    # basic string and should be cleared at the opening quote.
+
+    action escaped { escaped = true }
+
+    # tabs are the only control characters allowed in strings
+    basic_char = ( any - ( cntrl | 0x7f | '"' | '\\' ) ) | '\t' ;
+    escape_seq = '\\' ( [btnfr"\\] | 'u' xdigit{4} | 'U' xdigit{8} ) ;
+    basic_body = ( basic_char | escape_seq >escaped )* ;
+
+    literal_char = ( any - ( cntrl | 0x7f | "'" ) ) | '\t' ;
+    literal_body = literal_char* ;
+}%%
+
+func unhex(c byte) rune {
+	switch {
+	case c <= '9':
+		return rune(c - '0')
+	case c <= 'F':
+		return rune(c - 'A' + 10)
+	}
+	return rune(c - 'a' + 10)
+}
+
+// unescapeTOML expands the escape sequences in the body of a basic
+// string, which the toml_string grammar has already checked the shape of.
+// It fails if a \u or \U escape is not a Unicode scalar value.
+func unescapeTOML(b []byte) ([]byte, bool) {
+	out := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		if b[i] != '\\' {
+			out = append(out, b[i])
+			continue
+		}
+		i++
+		switch b[i] {
+		case 'b':
+			out = append(out, '\b')
+		case 't':
+			out = append(out, '\t')
+		case 'n':
+			out = append(out, '\n')
+		case 'f':
+			out = append(out, '\f')
+		case 'r':
+			out = append(out, '\r')
+		case 'u', 'U':
+			n := 4
+			if b[i] == 'U' {
+				n = 8
+			}
+			var r rune
+			for _, c := range b[i+1 : i+1+n] {
+				r = r<<4 | unhex(c)
+			}
+			if r < 0 || !utf8.ValidRune(r) {
+				return nil, false
+			}
+			out = utf8.AppendRune(out, r)
+			i += n
+		default:
+			out = append(out, b[i])
+		}
+	}
+	return out, true
+}
+
+// ParseTOMLKey parses a TOML key, which may be dotted, into its parts.
+// Bare and literal parts point into data; double-quoted parts with escapes
+// are unescaped into new slices.  Whitespace is allowed around the dots.
+func ParseTOMLKey(data []byte) (parts [][]byte, ok bool) {
+	parts, ok, _ = ParseTOMLKeyAt(data)
+	return parts, ok
+}
+
+// ParseTOMLKeyAt is like ParseTOMLKey but also returns the offset of the
+// first byte that is out of place, or -1 if data is a valid key.  A quoted
+// part with an escape that is not a Unicode scalar value fails at its
+// opening quote.
+func ParseTOMLKeyAt(data []byte) (parts [][]byte, ok bool, errPos int) {
+
+%% machine toml_key;
+%% write data;
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	escaped := false
+	invalid := -1
+
+	%%{
+	    include toml_string;
+
+	    action mark  { mark = p }
+	    action reset { escaped = false }
+	    action part  { parts = append(parts, data[mark:p]) }
+
+	    action basic {
+	        if escaped {
+	            part, valid := unescapeTOML(data[mark:p])
+	            if !valid && invalid < 0 {
+	                invalid = mark - 1
+	            }
+	            parts = append(parts, part)
+	        } else {
+	            parts = append(parts, data[mark:p])
+	        }
+	    }
+
+	    bare_key = [A-Za-z0-9_\-]+ >mark %part ;
+	    quoted_key = '"' @reset basic_body >mark %basic '"'
+	               | "'" literal_body >mark %part "'" ;
+
+	    simple_key = bare_key | quoted_key ;
+
+	    ws = [ \t]* ;
+
+	    main := simple_key ( ws '.' ws simple_key )* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < toml_key_first_final {
+		return nil, false, p
+	}
+
+	if invalid >= 0 {
+		return nil, false, invalid
+	}
+
+	return parts, true, -1
+}
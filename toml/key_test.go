@@ -0,0 +1,124 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+)
+
+var data = []byte(`site."google.com".'raw\path'.last-seen`)
+
+var hits int
+
+func TestParseTOMLKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want []string
+		ok   bool
+	}{
+		{key: string(data), want: []string{"site", "google.com", `raw\path`, "last-seen"}, ok: true},
+		{key: "bare_key-1", want: []string{"bare_key-1"}, ok: true},
+		{key: "1234", want: []string{"1234"}, ok: true},
+		{key: `"basic\nkey"`, want: []string{"basic\nkey"}, ok: true},
+		{key: `"\b\t\f\r\"\\"`, want: []string{"\b\t\f\r\"\\"}, ok: true},
+		{key: `"étÉ" . '' . "\U0001F600"`, want: []string{"étÉ", "", "😀"}, ok: true},
+		{key: `"ʎǝʞ"`, want: []string{"ʎǝʞ"}, ok: true},
+		{key: "fruit. color", want: []string{"fruit", "color"}, ok: true},
+		{key: "3.14159", want: []string{"3", "14159"}, ok: true},
+
+		{key: `"\ud800"`},
+		{key: `"\U00110000"`},
+		{key: `"\FFFFFFFF"`},
+		{key: `"\x41"`},
+		{key: `"\u12"`},
+		{key: `"unterminated`},
+		{key: `'it''s'`},
+		{key: "\"new\nline\""},
+		{key: "a..b"},
+		{key: "a."},
+		{key: ".a"},
+		{key: " a"},
+		{key: "a b"},
+		{key: "ключ"},
+		{key: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseTOMLKey([]byte(tt.key))
+		if ok != tt.ok {
+			t.Errorf("ParseTOMLKey(%q) ok=%v, want %v", tt.key, ok, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		var parts []string
+		for _, p := range got {
+			parts = append(parts, string(p))
+		}
+		if !reflect.DeepEqual(parts, tt.want) {
+			t.Errorf("ParseTOMLKey(%q)=%q, want %q", tt.key, parts, tt.want)
+		}
+	}
+}
+
+func TestParseTOMLKeyAt(t *testing.T) {
+	tests := []struct {
+		key    string
+		errPos int
+	}{
+		{string(data), -1},
+		{`site."bad\q"`, 10},
+		{`site..last`, 5},
+		{`site.`, 5},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseTOMLKeyAt([]byte(tt.key))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseTOMLKeyAt(%q)=%v, %d, want errPos %d", tt.key, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// burntSushiKey has the toml package parse the key by decoding a document
+// that assigns to it.
+func burntSushiKey(key string) ([]string, bool) {
+	var v map[string]interface{}
+	md, err := toml.Decode(key+" = 1", &v)
+	if err != nil {
+		return nil, false
+	}
+	keys := md.Keys()
+	return keys[len(keys)-1], true
+}
+
+func TestTOMLKeyAlternatives(t *testing.T) {
+	got, ok := burntSushiKey(string(data))
+	parts, _ := ParseTOMLKey(data)
+	var want []string
+	for _, p := range parts {
+		want = append(want, string(p))
+	}
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("burntSushiKey=%q, want %q", got, want)
+	}
+}
+
+func BenchmarkBurntSushi(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, ok := burntSushiKey(s); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseTOMLKey(data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,5244 @@
+
+//line uri.rl:1
+package main
+
+// URI holds the components of a URI.  Each field is a sub-slice of the
+// parsed input, or nil if the component was absent.  Host and Path may be
+// present but empty, as in "file:///etc/hosts" or "http://example.com".
+type URI struct {
+	Scheme   []byte
+	Userinfo []byte
+	Host     []byte
+	Port     []byte
+	Path     []byte
+	Query    []byte
+	Fragment []byte
+}
+
+// ParseURI parses an absolute URI following the grammar in RFC 3986
+// section 3.  Relative references are rejected.
+func ParseURI(data []byte) (URI, bool) {
+	u, ok, _ := ParseURIAt(data)
+	return u, ok
+}
+
+// ParseURIAt is like ParseURI but also returns the offset of the first byte
+// that can't appear where it does, or -1 if data is a valid URI.
+func ParseURIAt(data []byte) (URI, bool, int) {
+
+
+//line uri.rl:28
+
+//line uri.go:33
+const uri_start int = 1
+const uri_first_final int = 171
+const uri_error int = 0
+
+const uri_en_main int = 1
+
+
+//line uri.rl:29
+
+	var u URI
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	// the port needs its own mark: "user:pass@" looks like host:port
+	// until the '@' arrives
+	mark, portMark := 0, 0
+
+	
+//line uri.go:52
+	{
+	cs = uri_start
+	}
+
+//line uri.go:57
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 180:
+		goto st_case_180
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 185:
+		goto st_case_185
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 153:
+		goto st_case_153
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 186:
+		goto st_case_186
+	}
+	goto st_out
+	st_case_1:
+		switch {
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr1
+			}
+		case data[p] >= 65:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line uri.rl:39
+ mark = p 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line uri.go:462
+		switch data[p] {
+		case 43:
+			goto st2
+		case 58:
+			goto tr3
+		}
+		switch {
+		case data[p] < 48:
+			if 45 <= data[p] && data[p] <= 46 {
+				goto st2
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st2
+				}
+			case data[p] >= 65:
+				goto st2
+			}
+		default:
+			goto st2
+		}
+		goto st0
+tr3:
+//line uri.rl:41
+ u.Scheme = data[mark:p] 
+	goto st171
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+//line uri.go:496
+		switch data[p] {
+		case 33:
+			goto tr173
+		case 35:
+			goto tr174
+		case 37:
+			goto tr175
+		case 47:
+			goto tr176
+		case 61:
+			goto tr173
+		case 63:
+			goto tr177
+		case 95:
+			goto tr173
+		case 126:
+			goto tr173
+		}
+		switch {
+		case data[p] < 64:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto tr173
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr173
+			}
+		default:
+			goto tr173
+		}
+		goto st0
+tr173:
+//line uri.rl:39
+ mark = p 
+	goto st172
+tr193:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+	goto st172
+tr200:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:39
+ mark = p 
+	goto st172
+tr204:
+//line uri.rl:40
+ portMark = p 
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+	goto st172
+tr214:
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+	goto st172
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+//line uri.go:563
+		switch data[p] {
+		case 33:
+			goto st172
+		case 35:
+			goto tr178
+		case 37:
+			goto st5
+		case 61:
+			goto st172
+		case 63:
+			goto tr180
+		case 95:
+			goto st172
+		case 126:
+			goto st172
+		}
+		switch {
+		case data[p] < 64:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st172
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st172
+			}
+		default:
+			goto st172
+		}
+		goto st0
+tr174:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+tr178:
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+tr185:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:46
+ u.Query = data[mark:p] 
+	goto st173
+tr187:
+//line uri.rl:46
+ u.Query = data[mark:p] 
+	goto st173
+tr191:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+tr198:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+tr203:
+//line uri.rl:40
+ portMark = p 
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+tr213:
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st173
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+//line uri.go:652
+		switch data[p] {
+		case 33:
+			goto tr181
+		case 37:
+			goto tr182
+		case 61:
+			goto tr181
+		case 95:
+			goto tr181
+		case 126:
+			goto tr181
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto tr181
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr181
+			}
+		default:
+			goto tr181
+		}
+		goto st0
+tr181:
+//line uri.rl:39
+ mark = p 
+	goto st174
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+//line uri.go:687
+		switch data[p] {
+		case 33:
+			goto st174
+		case 37:
+			goto st3
+		case 61:
+			goto st174
+		case 95:
+			goto st174
+		case 126:
+			goto st174
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st174
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st174
+			}
+		default:
+			goto st174
+		}
+		goto st0
+tr182:
+//line uri.rl:39
+ mark = p 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line uri.go:722
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st174
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st174
+			}
+		default:
+			goto st174
+		}
+		goto st0
+tr175:
+//line uri.rl:39
+ mark = p 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line uri.go:763
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st172
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st172
+			}
+		default:
+			goto st172
+		}
+		goto st0
+tr177:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+tr180:
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+tr195:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+tr202:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+tr206:
+//line uri.rl:40
+ portMark = p 
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+tr216:
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+	goto st175
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+//line uri.go:844
+		switch data[p] {
+		case 33:
+			goto tr184
+		case 35:
+			goto tr185
+		case 37:
+			goto tr186
+		case 61:
+			goto tr184
+		case 95:
+			goto tr184
+		case 126:
+			goto tr184
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto tr184
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr184
+			}
+		default:
+			goto tr184
+		}
+		goto st0
+tr184:
+//line uri.rl:39
+ mark = p 
+	goto st176
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+//line uri.go:881
+		switch data[p] {
+		case 33:
+			goto st176
+		case 35:
+			goto tr187
+		case 37:
+			goto st7
+		case 61:
+			goto st176
+		case 95:
+			goto st176
+		case 126:
+			goto st176
+		}
+		switch {
+		case data[p] < 63:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st176
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+tr186:
+//line uri.rl:39
+ mark = p 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line uri.go:918
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st8
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st176
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto st0
+tr176:
+//line uri.rl:39
+ mark = p 
+	goto st177
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+//line uri.go:959
+		switch data[p] {
+		case 33:
+			goto st172
+		case 35:
+			goto tr178
+		case 37:
+			goto st5
+		case 47:
+			goto st178
+		case 61:
+			goto st172
+		case 63:
+			goto tr180
+		case 95:
+			goto st172
+		case 126:
+			goto st172
+		}
+		switch {
+		case data[p] < 64:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st172
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st172
+			}
+		default:
+			goto st172
+		}
+		goto st0
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch data[p] {
+		case 33:
+			goto tr190
+		case 35:
+			goto tr191
+		case 37:
+			goto tr192
+		case 47:
+			goto tr193
+		case 58:
+			goto tr194
+		case 61:
+			goto tr190
+		case 63:
+			goto tr195
+		case 64:
+			goto tr196
+		case 91:
+			goto tr197
+		case 95:
+			goto tr190
+		case 126:
+			goto tr190
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto tr190
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr190
+			}
+		default:
+			goto tr190
+		}
+		goto st0
+tr190:
+//line uri.rl:39
+ mark = p 
+	goto st179
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+//line uri.go:1042
+		switch data[p] {
+		case 33:
+			goto st179
+		case 35:
+			goto tr198
+		case 37:
+			goto st9
+		case 47:
+			goto tr200
+		case 58:
+			goto tr201
+		case 61:
+			goto st179
+		case 63:
+			goto tr202
+		case 64:
+			goto tr14
+		case 95:
+			goto st179
+		case 126:
+			goto st179
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st179
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st179
+			}
+		default:
+			goto st179
+		}
+		goto st0
+tr192:
+//line uri.rl:39
+ mark = p 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line uri.go:1087
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st179
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st179
+			}
+		default:
+			goto st179
+		}
+		goto st0
+tr194:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+	goto st180
+tr201:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+	goto st180
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+//line uri.go:1134
+		switch data[p] {
+		case 33:
+			goto st11
+		case 35:
+			goto tr203
+		case 37:
+			goto st12
+		case 47:
+			goto tr204
+		case 61:
+			goto st11
+		case 63:
+			goto tr206
+		case 64:
+			goto tr14
+		case 95:
+			goto st11
+		case 126:
+			goto st11
+		}
+		switch {
+		case data[p] < 58:
+			switch {
+			case data[p] > 46:
+				if 48 <= data[p] {
+					goto tr205
+				}
+			case data[p] >= 36:
+				goto st11
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st11
+				}
+			case data[p] >= 65:
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 33:
+			goto st11
+		case 37:
+			goto st12
+		case 61:
+			goto st11
+		case 64:
+			goto tr14
+		case 95:
+			goto st11
+		case 126:
+			goto st11
+		}
+		switch {
+		case data[p] < 48:
+			if 36 <= data[p] && data[p] <= 46 {
+				goto st11
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st11
+				}
+			case data[p] >= 65:
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st13
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st13
+			}
+		default:
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st11
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+tr14:
+//line uri.rl:42
+ u.Userinfo = data[mark:p] 
+	goto st181
+tr196:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:42
+ u.Userinfo = data[mark:p] 
+	goto st181
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+//line uri.go:1266
+		switch data[p] {
+		case 33:
+			goto tr207
+		case 35:
+			goto tr191
+		case 37:
+			goto tr208
+		case 47:
+			goto tr193
+		case 58:
+			goto tr209
+		case 61:
+			goto tr207
+		case 63:
+			goto tr195
+		case 91:
+			goto tr197
+		case 95:
+			goto tr207
+		case 126:
+			goto tr207
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto tr207
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr207
+			}
+		default:
+			goto tr207
+		}
+		goto st0
+tr207:
+//line uri.rl:39
+ mark = p 
+	goto st182
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+//line uri.go:1311
+		switch data[p] {
+		case 33:
+			goto st182
+		case 35:
+			goto tr198
+		case 37:
+			goto st14
+		case 47:
+			goto tr200
+		case 58:
+			goto tr211
+		case 61:
+			goto st182
+		case 63:
+			goto tr202
+		case 95:
+			goto st182
+		case 126:
+			goto st182
+		}
+		switch {
+		case data[p] < 65:
+			if 36 <= data[p] && data[p] <= 59 {
+				goto st182
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st182
+			}
+		default:
+			goto st182
+		}
+		goto st0
+tr208:
+//line uri.rl:39
+ mark = p 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line uri.go:1354
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st182
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st182
+			}
+		default:
+			goto st182
+		}
+		goto st0
+tr209:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+	goto st183
+tr211:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+	goto st183
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+//line uri.go:1401
+		switch data[p] {
+		case 35:
+			goto tr203
+		case 47:
+			goto tr204
+		case 63:
+			goto tr206
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr212
+		}
+		goto st0
+tr212:
+//line uri.rl:40
+ portMark = p 
+	goto st184
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+//line uri.go:1423
+		switch data[p] {
+		case 35:
+			goto tr213
+		case 47:
+			goto tr214
+		case 63:
+			goto tr216
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st184
+		}
+		goto st0
+tr197:
+//line uri.rl:39
+ mark = p 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line uri.go:1445
+		switch data[p] {
+		case 58:
+			goto st152
+		case 118:
+			goto st167
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st17
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st17
+			}
+		default:
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 58 {
+			goto st21
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st18
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st18
+			}
+		default:
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 58 {
+			goto st21
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st19
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st19
+			}
+		default:
+			goto st19
+		}
+		goto st0
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 58 {
+			goto st21
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st20
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st20
+			}
+		default:
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 58 {
+			goto st21
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 58 {
+			goto st138
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st22
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st22
+			}
+		default:
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 58 {
+			goto st26
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st23
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st23
+			}
+		default:
+			goto st23
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 58 {
+			goto st26
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st24
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st24
+			}
+		default:
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 58 {
+			goto st26
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st25
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st25
+			}
+		default:
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 58 {
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 58 {
+			goto st124
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st27
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st27
+			}
+		default:
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 58 {
+			goto st31
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st28
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st28
+			}
+		default:
+			goto st28
+		}
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 58 {
+			goto st31
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st29
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st29
+			}
+		default:
+			goto st29
+		}
+		goto st0
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 58 {
+			goto st31
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st30
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st30
+			}
+		default:
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 58 {
+			goto st31
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		if data[p] == 58 {
+			goto st110
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st32
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st32
+			}
+		default:
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 58 {
+			goto st36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st33
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st33
+			}
+		default:
+			goto st33
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 58 {
+			goto st36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st34
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st34
+			}
+		default:
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 58 {
+			goto st36
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st35
+			}
+		default:
+			goto st35
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 58 {
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 58 {
+			goto st96
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st37
+			}
+		default:
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 58 {
+			goto st41
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 58 {
+			goto st41
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st39
+			}
+		default:
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 58 {
+			goto st41
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st40
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st40
+			}
+		default:
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 58 {
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 58 {
+			goto st82
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st42
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st42
+			}
+		default:
+			goto st42
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		if data[p] == 58 {
+			goto st46
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st43
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st43
+			}
+		default:
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if data[p] == 58 {
+			goto st46
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st44
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st44
+			}
+		default:
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 58 {
+			goto st46
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st45
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st45
+			}
+		default:
+			goto st45
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 58 {
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 48:
+			goto st47
+		case 49:
+			goto st73
+		case 50:
+			goto st76
+		case 58:
+			goto st80
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st79
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st81
+			}
+		default:
+			goto st81
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st66
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 48:
+			goto st49
+		case 49:
+			goto st62
+		case 50:
+			goto st64
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto st63
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 46 {
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 48:
+			goto st51
+		case 49:
+			goto st58
+		case 50:
+			goto st60
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto st59
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 46 {
+			goto st52
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 48:
+			goto st53
+		case 49:
+			goto st54
+		case 50:
+			goto st56
+		}
+		if 51 <= data[p] && data[p] <= 57 {
+			goto st55
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 93 {
+			goto st185
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		switch data[p] {
+		case 35:
+			goto tr198
+		case 47:
+			goto tr200
+		case 58:
+			goto tr211
+		case 63:
+			goto tr202
+		}
+		goto st0
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		if data[p] == 93 {
+			goto st185
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 93 {
+			goto st185
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st53
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 53:
+			goto st57
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st53
+			}
+		case data[p] >= 48:
+			goto st55
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 93 {
+			goto st185
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st53
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 46 {
+			goto st52
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st59
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 46 {
+			goto st52
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st51
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 46:
+			goto st52
+		case 53:
+			goto st61
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st51
+			}
+		case data[p] >= 48:
+			goto st59
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 46 {
+			goto st52
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st51
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 46 {
+			goto st50
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 46 {
+			goto st50
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st49
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 46:
+			goto st50
+		case 53:
+			goto st65
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto st49
+			}
+		case data[p] >= 48:
+			goto st63
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 46 {
+			goto st50
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto st49
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 58 {
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 58 {
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st68
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 58 {
+			goto st69
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 58 {
+			goto st53
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st70
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st70
+			}
+		default:
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 93 {
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st71
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st71
+			}
+		default:
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 93 {
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st72
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st72
+			}
+		default:
+			goto st72
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 93 {
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st53
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st53
+			}
+		default:
+			goto st53
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st74
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st75
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st68
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st77
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st74
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st66
+				}
+			case data[p] >= 65:
+				goto st66
+			}
+		default:
+			goto st78
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st75
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st67
+				}
+			case data[p] >= 65:
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st78
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 93 {
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st70
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st70
+			}
+		default:
+			goto st70
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 58 {
+			goto st69
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st66
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 48:
+			goto st83
+		case 49:
+			goto st88
+		case 50:
+			goto st91
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st95
+			}
+		default:
+			goto st95
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st84
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st84
+			}
+		default:
+			goto st84
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch data[p] {
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch data[p] {
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st86
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st86
+			}
+		default:
+			goto st86
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch data[p] {
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st70
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st70
+			}
+		default:
+			goto st70
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st89
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st84
+			}
+		default:
+			goto st84
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st90
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st86
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st86
+			}
+		default:
+			goto st86
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st92
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st89
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st84
+				}
+			case data[p] >= 65:
+				goto st84
+			}
+		default:
+			goto st93
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st90
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st85
+				}
+			case data[p] >= 65:
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st93
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st84
+			}
+		default:
+			goto st84
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch data[p] {
+		case 58:
+			goto st87
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st84
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st84
+			}
+		default:
+			goto st84
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch data[p] {
+		case 48:
+			goto st97
+		case 49:
+			goto st102
+		case 50:
+			goto st105
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st108
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st109
+			}
+		default:
+			goto st109
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st98
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		switch data[p] {
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st99
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st99
+			}
+		default:
+			goto st99
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		switch data[p] {
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st100
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st100
+			}
+		default:
+			goto st100
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		switch data[p] {
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		switch data[p] {
+		case 48:
+			goto st83
+		case 49:
+			goto st88
+		case 50:
+			goto st91
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st95
+			}
+		default:
+			goto st95
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st103
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st104
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st99
+			}
+		default:
+			goto st99
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st100
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st100
+			}
+		default:
+			goto st100
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st106
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st103
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st98
+				}
+			case data[p] >= 65:
+				goto st98
+			}
+		default:
+			goto st107
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st104
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st99
+				}
+			case data[p] >= 65:
+				goto st99
+			}
+		default:
+			goto st99
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st99
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st99
+			}
+		default:
+			goto st99
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st107
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		switch data[p] {
+		case 58:
+			goto st101
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st98
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		switch data[p] {
+		case 48:
+			goto st111
+		case 49:
+			goto st116
+		case 50:
+			goto st119
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st122
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st123
+			}
+		default:
+			goto st123
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st112
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st112
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		switch data[p] {
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st113
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st113
+			}
+		default:
+			goto st113
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		switch data[p] {
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st114
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st114
+			}
+		default:
+			goto st114
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		switch data[p] {
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		goto st0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		switch data[p] {
+		case 48:
+			goto st97
+		case 49:
+			goto st102
+		case 50:
+			goto st105
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st108
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st109
+			}
+		default:
+			goto st109
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st117
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st112
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st118
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st113
+			}
+		default:
+			goto st113
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st114
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st114
+			}
+		default:
+			goto st114
+		}
+		goto st0
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st120
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st117
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st112
+				}
+			case data[p] >= 65:
+				goto st112
+			}
+		default:
+			goto st121
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st118
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st113
+				}
+			case data[p] >= 65:
+				goto st113
+			}
+		default:
+			goto st113
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st113
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st113
+			}
+		default:
+			goto st113
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st121
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st112
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		switch data[p] {
+		case 58:
+			goto st115
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st112
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st112
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		switch data[p] {
+		case 48:
+			goto st125
+		case 49:
+			goto st130
+		case 50:
+			goto st133
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st136
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st137
+			}
+		default:
+			goto st137
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st126
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		switch data[p] {
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st127
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st127
+			}
+		default:
+			goto st127
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		switch data[p] {
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st128
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st128
+			}
+		default:
+			goto st128
+		}
+		goto st0
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		switch data[p] {
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		switch data[p] {
+		case 48:
+			goto st111
+		case 49:
+			goto st116
+		case 50:
+			goto st119
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st122
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st123
+			}
+		default:
+			goto st123
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st131
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st132
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st127
+			}
+		default:
+			goto st127
+		}
+		goto st0
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st128
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st128
+			}
+		default:
+			goto st128
+		}
+		goto st0
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st134
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st131
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st126
+				}
+			case data[p] >= 65:
+				goto st126
+			}
+		default:
+			goto st135
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st132
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st127
+				}
+			case data[p] >= 65:
+				goto st127
+			}
+		default:
+			goto st127
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st127
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st127
+			}
+		default:
+			goto st127
+		}
+		goto st0
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st135
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		switch data[p] {
+		case 58:
+			goto st129
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st126
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		switch data[p] {
+		case 48:
+			goto st139
+		case 49:
+			goto st144
+		case 50:
+			goto st147
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st150
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st151
+			}
+		default:
+			goto st151
+		}
+		goto st0
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st140
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st140
+			}
+		default:
+			goto st140
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch data[p] {
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st141
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st141
+			}
+		default:
+			goto st141
+		}
+		goto st0
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch data[p] {
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st142
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st142
+			}
+		default:
+			goto st142
+		}
+		goto st0
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		switch data[p] {
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		switch data[p] {
+		case 48:
+			goto st125
+		case 49:
+			goto st130
+		case 50:
+			goto st133
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st136
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st137
+			}
+		default:
+			goto st137
+		}
+		goto st0
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st145
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st140
+			}
+		default:
+			goto st140
+		}
+		goto st0
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st146
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st141
+			}
+		default:
+			goto st141
+		}
+		goto st0
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st142
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st142
+			}
+		default:
+			goto st142
+		}
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st148
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st145
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st140
+				}
+			case data[p] >= 65:
+				goto st140
+			}
+		default:
+			goto st149
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st146
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st141
+				}
+			case data[p] >= 65:
+				goto st141
+			}
+		default:
+			goto st141
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st141
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st141
+			}
+		default:
+			goto st141
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st149
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st140
+			}
+		default:
+			goto st140
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		switch data[p] {
+		case 58:
+			goto st143
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st140
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st140
+			}
+		default:
+			goto st140
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if data[p] == 58 {
+			goto st153
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch data[p] {
+		case 48:
+			goto st154
+		case 49:
+			goto st159
+		case 50:
+			goto st162
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st165
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st166
+			}
+		default:
+			goto st166
+		}
+		goto st0
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st155
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st155
+			}
+		default:
+			goto st155
+		}
+		goto st0
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		switch data[p] {
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st156
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st156
+			}
+		default:
+			goto st156
+		}
+		goto st0
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		switch data[p] {
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st157
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st157
+			}
+		default:
+			goto st157
+		}
+		goto st0
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		switch data[p] {
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		goto st0
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		switch data[p] {
+		case 48:
+			goto st139
+		case 49:
+			goto st144
+		case 50:
+			goto st147
+		}
+		switch {
+		case data[p] < 65:
+			if 51 <= data[p] && data[p] <= 57 {
+				goto st150
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st151
+			}
+		default:
+			goto st151
+		}
+		goto st0
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st160
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st155
+			}
+		default:
+			goto st155
+		}
+		goto st0
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st161
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st156
+			}
+		default:
+			goto st156
+		}
+		goto st0
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st157
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st157
+			}
+		default:
+			goto st157
+		}
+		goto st0
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 53:
+			goto st163
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] && data[p] <= 52 {
+				goto st160
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st155
+				}
+			case data[p] >= 65:
+				goto st155
+			}
+		default:
+			goto st164
+		}
+		goto st0
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 54:
+			if 48 <= data[p] {
+				goto st161
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 70:
+				if 97 <= data[p] && data[p] <= 102 {
+					goto st156
+				}
+			case data[p] >= 65:
+				goto st156
+			}
+		default:
+			goto st156
+		}
+		goto st0
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st156
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st156
+			}
+		default:
+			goto st156
+		}
+		goto st0
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		switch data[p] {
+		case 46:
+			goto st48
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st164
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st155
+			}
+		default:
+			goto st155
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch data[p] {
+		case 58:
+			goto st158
+		case 93:
+			goto st185
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st155
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st155
+			}
+		default:
+			goto st155
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st168
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st168
+			}
+		default:
+			goto st168
+		}
+		goto st0
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 46 {
+			goto st169
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st168
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st168
+			}
+		default:
+			goto st168
+		}
+		goto st0
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		switch data[p] {
+		case 33:
+			goto st170
+		case 36:
+			goto st170
+		case 61:
+			goto st170
+		case 95:
+			goto st170
+		case 126:
+			goto st170
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st170
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st170
+				}
+			case data[p] >= 65:
+				goto st170
+			}
+		default:
+			goto st170
+		}
+		goto st0
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 33:
+			goto st170
+		case 36:
+			goto st170
+		case 61:
+			goto st170
+		case 93:
+			goto st185
+		case 95:
+			goto st170
+		case 126:
+			goto st170
+		}
+		switch {
+		case data[p] < 48:
+			if 38 <= data[p] && data[p] <= 46 {
+				goto st170
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st170
+				}
+			case data[p] >= 65:
+				goto st170
+			}
+		default:
+			goto st170
+		}
+		goto st0
+tr205:
+//line uri.rl:40
+ portMark = p 
+	goto st186
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+//line uri.go:4942
+		switch data[p] {
+		case 33:
+			goto st11
+		case 35:
+			goto tr213
+		case 37:
+			goto st12
+		case 47:
+			goto tr214
+		case 61:
+			goto st11
+		case 63:
+			goto tr216
+		case 64:
+			goto tr14
+		case 95:
+			goto st11
+		case 126:
+			goto st11
+		}
+		switch {
+		case data[p] < 58:
+			switch {
+			case data[p] > 46:
+				if 48 <= data[p] {
+					goto st186
+				}
+			case data[p] >= 36:
+				goto st11
+			}
+		case data[p] > 59:
+			switch {
+			case data[p] > 90:
+				if 97 <= data[p] && data[p] <= 122 {
+					goto st11
+				}
+			case data[p] >= 65:
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof132: cs = 132; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 171:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+		case 172, 177:
+//line uri.rl:45
+ u.Path = data[mark:p] 
+		case 173:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:47
+ u.Fragment = data[mark:p] 
+		case 174:
+//line uri.rl:47
+ u.Fragment = data[mark:p] 
+		case 175:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:46
+ u.Query = data[mark:p] 
+		case 176:
+//line uri.rl:46
+ u.Query = data[mark:p] 
+		case 178, 181:
+//line uri.rl:39
+ mark = p 
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+		case 179, 182, 185:
+//line uri.rl:43
+ u.Host = data[mark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+		case 180, 183:
+//line uri.rl:40
+ portMark = p 
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+		case 184, 186:
+//line uri.rl:44
+ u.Port = data[portMark:p] 
+//line uri.rl:39
+ mark = p 
+//line uri.rl:45
+ u.Path = data[mark:p] 
+//line uri.go:5230
+		}
+	}
+
+	_out: {}
+	}
+
+//line uri.rl:105
+
+
+	if cs < uri_first_final {
+		return URI{}, false, p
+	}
+
+	return u, true, -1
+}
@@ -0,0 +1,112 @@
+package main
+
+// URI holds the components of a URI.  Each field is a sub-slice of the
+// parsed input, or nil if the component was absent.  Host and Path may be
+// present but empty, as in "file:///etc/hosts" or "http://example.com".
+type URI struct {
+	Scheme   []byte
+	Userinfo []byte
+	Host     []byte
+	Port     []byte
+	Path     []byte
+	Query    []byte
+	Fragment []byte
+}
+
+// ParseURI parses an absolute URI following the grammar in RFC 3986
+// section 3.  Relative references are rejected.
+func ParseURI(data []byte) (URI, bool) {
+	u, ok, _ := ParseURIAt(data)
+	return u, ok
+}
+
+// ParseURIAt is like ParseURI but also returns the offset of the first byte
+// that can't appear where it does, or -1 if data is a valid URI.
+func ParseURIAt(data []byte) (URI, bool, int) {
+
+%% machine uri;
+%% write data;
+
+	var u URI
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	// the port needs its own mark: "user:pass@" looks like host:port
+	// until the '@' arrives
+	mark, portMark := 0, 0
+
+	%%{
+	    action mark      { mark = p }
+	    action port_mark { portMark = p }
+	    action scheme    { u.Scheme = data[mark:p] }
+	    action userinfo  { u.Userinfo = data[mark:p] }
+	    action host      { u.Host = data[mark:p] }
+	    action port      { u.Port = data[portMark:p] }
+	    action path      { u.Path = data[mark:p] }
+	    action query     { u.Query = data[mark:p] }
+	    action fragment  { u.Fragment = data[mark:p] }
+
+	    unreserved = alnum | [\-._~] ;
+	    pct_encoded = '%' xdigit xdigit ;
+	    sub_delims = [!$&'()*+,;=] ;
+	    pchar = unreserved | pct_encoded | sub_delims | [:@] ;
+
+	    dec_octet = digit
+	              | '1'..'9' digit
+	              | '1' digit{2}
+	              | '2' '0'..'4' digit
+	              | '25' '0'..'5' ;
+	    ipv4address = dec_octet '.' dec_octet '.' dec_octet '.' dec_octet ;
+
+	    h16 = xdigit{1,4} ;
+	    ls32 = h16 ':' h16 | ipv4address ;
+	    ipv6address =                            ( h16 ':' ){6} ls32
+	                |                       '::' ( h16 ':' ){5} ls32
+	                | (                h16 )? '::' ( h16 ':' ){4} ls32
+	                | ( ( h16 ':' ){,1} h16 )? '::' ( h16 ':' ){3} ls32
+	                | ( ( h16 ':' ){,2} h16 )? '::' ( h16 ':' ){2} ls32
+	                | ( ( h16 ':' ){,3} h16 )? '::'   h16 ':'      ls32
+	                | ( ( h16 ':' ){,4} h16 )? '::'                ls32
+	                | ( ( h16 ':' ){,5} h16 )? '::'                h16
+	                | ( ( h16 ':' ){,6} h16 )? '::' ;
+	    ipvfuture = 'v' xdigit+ '.' ( unreserved | sub_delims | ':' )+ ;
+	    ip_literal = '[' ( ipv6address | ipvfuture ) ']' ;
+
+	    reg_name = ( unreserved | pct_encoded | sub_delims )* ;
+	    host = ip_literal | ipv4address | reg_name ;
+	    port = digit* ;
+	    userinfo = ( unreserved | pct_encoded | sub_delims | ':' )* ;
+
+	    authority = ( userinfo >mark %userinfo '@' )?
+	                host >mark %host
+	                ( ':' port >port_mark %port )? ;
+
+	    segment = pchar* ;
+	    segment_nz = pchar+ ;
+
+	    path_abempty = ( '/' segment )* ;
+	    path_absolute = '/' ( segment_nz ( '/' segment )* )? ;
+	    path_rootless = segment_nz ( '/' segment )* ;
+	    path_empty = zlen ;
+
+	    hier_part = '//' authority path_abempty >mark %path
+	              | ( path_absolute | path_rootless | path_empty ) >mark %path ;
+
+	    scheme = alpha ( alpha | digit | [+\-.] )* ;
+	    query = ( pchar | [/?] )* ;
+	    fragment = ( pchar | [/?] )* ;
+
+	    main := scheme >mark %scheme ':' hier_part
+	            ( '?' query >mark %query )?
+	            ( '#' fragment >mark %fragment )? ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < uri_first_final {
+		return URI{}, false, p
+	}
+
+	return u, true, -1
+}
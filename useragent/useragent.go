@@ -0,0 +1,493 @@
+
+//line useragent.rl:1
+package main
+
+// UAProduct is one product token of a User-Agent header, along with the
+// comment that follows it, if any.  Comment excludes the enclosing
+// parentheses.  All fields point into the parsed header.
+type UAProduct struct {
+	Name    []byte
+	Version []byte
+	Comment []byte
+}
+
+// ParseUserAgent splits a User-Agent header into its products, following
+// the grammar from RFC 7231 section 5.5.3.  Comments may nest, as browsers
+// emit, up to a depth of 8.  A product followed by several comments keeps
+// the first.
+func ParseUserAgent(data []byte) ([]UAProduct, bool) {
+	products, ok, _ := ParseUserAgentAt(data)
+	return products, ok
+}
+
+// ParseUserAgentAt is like ParseUserAgent but also returns the offset of
+// the first unexpected byte, or -1 if the header parsed.
+func ParseUserAgentAt(data []byte) ([]UAProduct, bool, int) {
+
+
+//line useragent.rl:26
+
+//line useragent.go:31
+const useragent_start int = 1
+const useragent_first_final int = 6
+const useragent_error int = 0
+
+const useragent_en_comment int = 4
+const useragent_en_main int = 1
+
+
+//line useragent.rl:27
+
+	var products []UAProduct
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	var stack [8]int
+	top := 0
+
+	
+//line useragent.go:53
+	{
+	cs = useragent_start
+	top = 0
+	}
+
+//line useragent.go:59
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	goto _resume
+
+_again:
+	switch cs {
+	case 1:
+		goto st1
+	case 0:
+		goto st0
+	case 6:
+		goto st6
+	case 2:
+		goto st2
+	case 7:
+		goto st7
+	case 3:
+		goto st3
+	case 8:
+		goto st8
+	case 4:
+		goto st4
+	case 9:
+		goto st9
+	case 5:
+		goto st5
+	}
+
+	if p++; p == pe {
+		goto _test_eof
+	}
+_resume:
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 6:
+		goto st_case_6
+	case 2:
+		goto st_case_2
+	case 7:
+		goto st_case_7
+	case 3:
+		goto st_case_3
+	case 8:
+		goto st_case_8
+	case 4:
+		goto st_case_4
+	case 9:
+		goto st_case_9
+	case 5:
+		goto st_case_5
+	}
+	goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 33:
+			goto tr1
+		case 124:
+			goto tr1
+		case 126:
+			goto tr1
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr1
+				}
+			case data[p] >= 35:
+				goto tr1
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr1
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr1
+				}
+			default:
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line useragent.rl:39
+ mark = p 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line useragent.go:170
+		switch data[p] {
+		case 9:
+			goto tr9
+		case 32:
+			goto tr9
+		case 33:
+			goto st6
+		case 47:
+			goto tr11
+		case 124:
+			goto st6
+		case 126:
+			goto st6
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st6
+				}
+			case data[p] >= 35:
+				goto st6
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st6
+				}
+			case data[p] >= 65:
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+tr9:
+//line useragent.rl:40
+ products = append(products, UAProduct{Name: data[mark:p]}) 
+	goto st2
+tr12:
+//line useragent.rl:41
+ products[len(products)-1].Version = data[mark:p] 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line useragent.go:221
+		switch data[p] {
+		case 9:
+			goto st2
+		case 32:
+			goto st2
+		case 33:
+			goto tr1
+		case 40:
+			goto tr3
+		case 124:
+			goto tr1
+		case 126:
+			goto tr1
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr1
+				}
+			case data[p] >= 35:
+				goto tr1
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr1
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr1
+				}
+			default:
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+tr3:
+//line useragent.rl:43
+
+	        if top == len(stack) {
+	            p++; cs = 7; goto _out
+
+	        }
+	        if top == 0 {
+	            mark = p + 1
+	        }
+	        stack[top] = 7; top++; goto st4
+
+	    
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line useragent.go:282
+		switch data[p] {
+		case 9:
+			goto st2
+		case 32:
+			goto st2
+		}
+		goto st0
+tr11:
+//line useragent.rl:40
+ products = append(products, UAProduct{Name: data[mark:p]}) 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line useragent.go:299
+		switch data[p] {
+		case 33:
+			goto tr4
+		case 124:
+			goto tr4
+		case 126:
+			goto tr4
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto tr4
+				}
+			case data[p] >= 35:
+				goto tr4
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto tr4
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto tr4
+				}
+			default:
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+tr4:
+//line useragent.rl:39
+ mark = p 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line useragent.go:344
+		switch data[p] {
+		case 9:
+			goto tr12
+		case 32:
+			goto tr12
+		case 33:
+			goto st8
+		case 124:
+			goto st8
+		case 126:
+			goto st8
+		}
+		switch {
+		case data[p] < 45:
+			switch {
+			case data[p] > 39:
+				if 42 <= data[p] && data[p] <= 43 {
+					goto st8
+				}
+			case data[p] >= 35:
+				goto st8
+			}
+		case data[p] > 46:
+			switch {
+			case data[p] < 65:
+				if 48 <= data[p] && data[p] <= 57 {
+					goto st8
+				}
+			case data[p] > 90:
+				if 94 <= data[p] && data[p] <= 122 {
+					goto st8
+				}
+			default:
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto st0
+tr6:
+//line useragent.rl:43
+
+	        if top == len(stack) {
+	            p++; cs = 4; goto _out
+
+	        }
+	        if top == 0 {
+	            mark = p + 1
+	        }
+	        stack[top] = 4; top++; goto st4
+
+	    
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line useragent.go:403
+		switch data[p] {
+		case 40:
+			goto tr6
+		case 41:
+			goto tr7
+		case 92:
+			goto st5
+		case 127:
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st4
+tr7:
+	cs = 9
+//line useragent.rl:53
+
+	        if top == 1 && products[len(products)-1].Comment == nil {
+	            products[len(products)-1].Comment = data[mark:p]
+	        }
+	        top--; cs = stack[top]
+goto _again
+
+	    
+	goto _again
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line useragent.go:440
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 127 {
+			goto st0
+		}
+		switch {
+		case data[p] > 8:
+			if 10 <= data[p] && data[p] <= 31 {
+				goto st0
+			}
+		default:
+			goto st0
+		}
+		goto st4
+	st_out:
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 6:
+//line useragent.rl:40
+ products = append(products, UAProduct{Name: data[mark:p]}) 
+		case 8:
+//line useragent.rl:41
+ products[len(products)-1].Version = data[mark:p] 
+//line useragent.go:479
+		}
+	}
+
+	_out: {}
+	}
+
+//line useragent.rl:76
+
+
+	if cs < useragent_first_final || top != 0 {
+		return nil, false, p
+	}
+
+	return products, true, -1
+}
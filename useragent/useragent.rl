@@ -0,0 +1,83 @@
+package main
+
+// UAProduct is one product token of a User-Agent header, along with the
+// comment that follows it, if any.  Comment excludes the enclosing
+// parentheses.  All fields point into the parsed header.
+type UAProduct struct {
+	Name    []byte
+	Version []byte
+	Comment []byte
+}
+
+// ParseUserAgent splits a User-Agent header into its products, following
+// the grammar from RFC 7231 section 5.5.3.  Comments may nest, as browsers
+// emit, up to a depth of 8.  A product followed by several comments keeps
+// the first.
+func ParseUserAgent(data []byte) ([]UAProduct, bool) {
+	products, ok, _ := ParseUserAgentAt(data)
+	return products, ok
+}
+
+// ParseUserAgentAt is like ParseUserAgent but also returns the offset of
+// the first unexpected byte, or -1 if the header parsed.
+func ParseUserAgentAt(data []byte) ([]UAProduct, bool, int) {
+
+%% machine useragent;
+%% write data;
+
+	var products []UAProduct
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	_ = eof
+
+	mark := 0
+	var stack [8]int
+	top := 0
+
+	%%{
+	    action mark    { mark = p }
+	    action name    { products = append(products, UAProduct{Name: data[mark:p]}) }
+	    action version { products[len(products)-1].Version = data[mark:p] }
+
+	    action open {
+	        if top == len(stack) {
+	            fbreak;
+	        }
+	        if top == 0 {
+	            mark = p + 1
+	        }
+	        fcall comment;
+	    }
+
+	    action close {
+	        if top == 1 && products[len(products)-1].Comment == nil {
+	            products[len(products)-1].Comment = data[mark:p]
+	        }
+	        fret;
+	    }
+
+	    tchar = alnum | [!#$%&'*+\-.\^_`|~] ;
+	    token = tchar+ ;
+
+	    ctext = ( '\t' | 0x20..0x7e | 0x80..0xff ) - [()\\] ;
+	    quoted_pair = '\\' ( '\t' | 0x20..0x7e | 0x80..0xff ) ;
+
+	    comment := ( ctext | quoted_pair | '(' @open )* ')' @close ;
+
+	    product = token >mark %name ( '/' token >mark %version )? ;
+
+	    rws = [ \t]+ ;
+
+	    main := product ( rws ( product | '(' @open ) )* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < useragent_first_final || top != 0 {
+		return nil, false, p
+	}
+
+	return products, true, -1
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/mssola/useragent"
+)
+
+var data = []byte(`Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36`)
+
+var hits int
+
+func TestParseUserAgent(t *testing.T) {
+	tests := []struct {
+		ua   string
+		want []UAProduct
+		ok   bool
+	}{
+		{
+			ua: string(data),
+			want: []UAProduct{
+				{Name: []byte("Mozilla"), Version: []byte("5.0"), Comment: []byte("Windows NT 10.0; Win64; x64")},
+				{Name: []byte("AppleWebKit"), Version: []byte("537.36"), Comment: []byte("KHTML, like Gecko")},
+				{Name: []byte("Chrome"), Version: []byte("120.0.0.0")},
+				{Name: []byte("Safari"), Version: []byte("537.36")},
+			},
+			ok: true,
+		},
+		{
+			ua: `Mozilla/5.0 (Linux; Android 10; K) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/114.0.0.0 Mobile Safari/537.36`,
+			want: []UAProduct{
+				{Name: []byte("Mozilla"), Version: []byte("5.0"), Comment: []byte("Linux; Android 10; K")},
+				{Name: []byte("AppleWebKit"), Version: []byte("537.36"), Comment: []byte("KHTML, like Gecko")},
+				{Name: []byte("Chrome"), Version: []byte("114.0.0.0")},
+				{Name: []byte("Mobile")},
+				{Name: []byte("Safari"), Version: []byte("537.36")},
+			},
+			ok: true,
+		},
+		{
+			ua: `Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)`,
+			want: []UAProduct{
+				{Name: []byte("Mozilla"), Version: []byte("5.0"), Comment: []byte("compatible; Googlebot/2.1; +http://www.google.com/bot.html")},
+			},
+			ok: true,
+		},
+		{
+			ua:   `curl/8.4.0`,
+			want: []UAProduct{{Name: []byte("curl"), Version: []byte("8.4.0")}},
+			ok:   true,
+		},
+		{
+			// nested comments, as sent by some Samsung and Dalvik builds
+			ua: `Dalvik/2.1.0 (Linux; U; Android 11; SM-A515F Build/RP1A.200720.012) (Samsung (SM-A515F)) okhttp/4.9.2`,
+			want: []UAProduct{
+				{Name: []byte("Dalvik"), Version: []byte("2.1.0"), Comment: []byte("Linux; U; Android 11; SM-A515F Build/RP1A.200720.012")},
+				{Name: []byte("okhttp"), Version: []byte("4.9.2")},
+			},
+			ok: true,
+		},
+		{
+			ua: `App/1.0 (a (b (c)) \) d)`,
+			want: []UAProduct{
+				{Name: []byte("App"), Version: []byte("1.0"), Comment: []byte(`a (b (c)) \) d`)},
+			},
+			ok: true,
+		},
+		{
+			ua:   `App/1.0 ()`,
+			want: []UAProduct{{Name: []byte("App"), Version: []byte("1.0"), Comment: []byte("")}},
+			ok:   true,
+		},
+		// comments nested too deeply
+		{ua: `App/1.0 (((((((((x)))))))))`},
+		{ua: `App/1.0 (unbalanced`},
+		{ua: `App/1.0 (one) two)`},
+		// a comment can't come first
+		{ua: `(compatible) App/1.0`},
+		{ua: `App/`},
+		{ua: `App/1.0 `},
+		{ua: `App/1.0(x)`},
+		{ua: `App@home/1.0`},
+		{ua: ``},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseUserAgent([]byte(tt.ua))
+		if ok != tt.ok {
+			t.Errorf("ParseUserAgent(%q) ok=%v, want %v", tt.ua, ok, tt.ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseUserAgent(%q)=%q, want %q", tt.ua, got, tt.want)
+		}
+	}
+}
+
+func TestParseUserAgentAt(t *testing.T) {
+	tests := []struct {
+		ua     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"Mozilla/5.0 (Windows", 20},
+		{"Mozilla/", 8},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseUserAgentAt([]byte(tt.ua))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseUserAgentAt(%q)=%v, %d, want errPos %d", tt.ua, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+// reUA only copes with a single level of nesting in comments.
+var reUA = regexp.MustCompile("([!#$%&'*+\\-.^_`|~0-9A-Za-z]+)(?:/([!#$%&'*+\\-.^_`|~0-9A-Za-z]+))?|\\(((?:[^()]|\\([^()]*\\))*)\\)")
+
+func regexUA(ua []byte) ([]UAProduct, bool) {
+	var products []UAProduct
+	for _, m := range reUA.FindAllSubmatch(ua, -1) {
+		switch {
+		case m[1] != nil:
+			products = append(products, UAProduct{Name: m[1], Version: m[2]})
+		case len(products) == 0:
+			return nil, false
+		case products[len(products)-1].Comment == nil:
+			products[len(products)-1].Comment = m[3]
+		}
+	}
+	return products, products != nil
+}
+
+func TestUserAgentAlternatives(t *testing.T) {
+	want, _ := ParseUserAgent(data)
+	if got, ok := regexUA(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("regexUA=%q, want %q", got, want)
+	}
+
+	// useragent interprets rather than tokenizes, so only compare the
+	// products it reports as-is
+	ua := useragent.New(string(data))
+	if got := ua.Mozilla(); got != string(want[0].Version) {
+		t.Errorf("useragent Mozilla()=%q, want %q", got, want[0].Version)
+	}
+	if name, version := ua.Engine(); name != string(want[1].Name) || version != string(want[1].Version) {
+		t.Errorf("useragent Engine()=%q %q, want %q %q", name, version, want[1].Name, want[1].Version)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := regexUA(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkUserAgent(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if ua := useragent.New(s); ua.Mozilla() != "" {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseUserAgent(data); ok {
+			hits++
+		}
+	}
+}
@@ -0,0 +1,2000 @@
+
+//line uuid.rl:1
+package main
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+// ParseUUID parses a UUID in the RFC 4122 string representation, either bare
+// (f81d4fae-7dec-11d0-a765-00a0c91e6bf6), as a URN
+// (urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6), or in braces
+// ({f81d4fae-7dec-11d0-a765-00a0c91e6bf6}).  Hex digits may be in either
+// case.
+func ParseUUID(data []byte) ([16]byte, bool) {
+	u, ok, _ := ParseUUIDAt(data)
+	return u, ok
+}
+
+// ParseUUIDAt is like ParseUUID but also returns the offset of the first
+// byte that isn't where a UUID would have it, or -1.
+func ParseUUIDAt(data []byte) ([16]byte, bool, int) {
+
+
+//line uuid.rl:28
+
+//line uuid.go:33
+const uuid_start int = 1
+const uuid_first_final int = 83
+const uuid_error int = 0
+
+const uuid_en_main int = 1
+
+
+//line uuid.rl:29
+
+	var u [16]byte
+	n := 0
+
+	cs, p, pe := 0, 0, len(data)
+
+	
+//line uuid.go:49
+	{
+	cs = uuid_start
+	}
+
+//line uuid.go:54
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 1:
+		goto st_case_1
+	case 0:
+		goto st_case_0
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 83:
+		goto st_case_83
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	}
+	goto st_out
+	st_case_1:
+		switch data[p] {
+		case 85:
+			goto st37
+		case 117:
+			goto st37
+		case 123:
+			goto st46
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+tr1:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+//line uuid.go:265
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr4
+			}
+		default:
+			goto tr4
+		}
+		goto st0
+tr4:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+//line uuid.go:288
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr5
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr5
+			}
+		default:
+			goto tr5
+		}
+		goto st0
+tr5:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+//line uuid.go:311
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr6
+			}
+		default:
+			goto tr6
+		}
+		goto st0
+tr6:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+//line uuid.go:334
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr7
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr7
+			}
+		default:
+			goto tr7
+		}
+		goto st0
+tr7:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+//line uuid.go:357
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr8
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr8
+			}
+		default:
+			goto tr8
+		}
+		goto st0
+tr8:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+//line uuid.go:380
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr9
+			}
+		default:
+			goto tr9
+		}
+		goto st0
+tr9:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+//line uuid.go:403
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr10
+			}
+		default:
+			goto tr10
+		}
+		goto st0
+tr10:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+//line uuid.go:426
+		if data[p] == 45 {
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr12
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr12
+			}
+		default:
+			goto tr12
+		}
+		goto st0
+tr12:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+//line uuid.go:458
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr13
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr13
+			}
+		default:
+			goto tr13
+		}
+		goto st0
+tr13:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+//line uuid.go:481
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr14
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr14
+			}
+		default:
+			goto tr14
+		}
+		goto st0
+tr14:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+//line uuid.go:504
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr15
+			}
+		default:
+			goto tr15
+		}
+		goto st0
+tr15:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+//line uuid.go:527
+		if data[p] == 45 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr17
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr17
+			}
+		default:
+			goto tr17
+		}
+		goto st0
+tr17:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+//line uuid.go:559
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr18
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr18
+			}
+		default:
+			goto tr18
+		}
+		goto st0
+tr18:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+//line uuid.go:582
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr19
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr19
+			}
+		default:
+			goto tr19
+		}
+		goto st0
+tr19:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line uuid.go:605
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr20
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr20
+			}
+		default:
+			goto tr20
+		}
+		goto st0
+tr20:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line uuid.go:628
+		if data[p] == 45 {
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr22
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr22
+			}
+		default:
+			goto tr22
+		}
+		goto st0
+tr22:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+//line uuid.go:660
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr23
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr23
+			}
+		default:
+			goto tr23
+		}
+		goto st0
+tr23:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+//line uuid.go:683
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr24
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr24
+			}
+		default:
+			goto tr24
+		}
+		goto st0
+tr24:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+//line uuid.go:706
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr25
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr25
+			}
+		default:
+			goto tr25
+		}
+		goto st0
+tr25:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+//line uuid.go:729
+		if data[p] == 45 {
+			goto st25
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr27
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr27
+			}
+		default:
+			goto tr27
+		}
+		goto st0
+tr27:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+//line uuid.go:761
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr28
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr28
+			}
+		default:
+			goto tr28
+		}
+		goto st0
+tr28:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st27
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+//line uuid.go:784
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr29
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr29
+			}
+		default:
+			goto tr29
+		}
+		goto st0
+tr29:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line uuid.go:807
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr30
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr30
+			}
+		default:
+			goto tr30
+		}
+		goto st0
+tr30:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line uuid.go:830
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr31
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr31
+			}
+		default:
+			goto tr31
+		}
+		goto st0
+tr31:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+//line uuid.go:853
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr32
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr32
+			}
+		default:
+			goto tr32
+		}
+		goto st0
+tr32:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+//line uuid.go:876
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr33
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr33
+			}
+		default:
+			goto tr33
+		}
+		goto st0
+tr33:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+//line uuid.go:899
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr34
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr34
+			}
+		default:
+			goto tr34
+		}
+		goto st0
+tr34:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+//line uuid.go:922
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr35
+			}
+		default:
+			goto tr35
+		}
+		goto st0
+tr35:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+//line uuid.go:945
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr36
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr36
+			}
+		default:
+			goto tr36
+		}
+		goto st0
+tr36:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+//line uuid.go:968
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr37
+			}
+		default:
+			goto tr37
+		}
+		goto st0
+tr37:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st36
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+//line uuid.go:991
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr38
+			}
+		default:
+			goto tr38
+		}
+		goto st0
+tr38:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st83
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+//line uuid.go:1014
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 82:
+			goto st38
+		case 114:
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 78:
+			goto st39
+		case 110:
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 58 {
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 85:
+			goto st41
+		case 117:
+			goto st41
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 85:
+			goto st42
+		case 117:
+			goto st42
+		}
+		goto st0
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 73:
+			goto st43
+		case 105:
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 68:
+			goto st44
+		case 100:
+			goto st44
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 58 {
+			goto st45
+		}
+		goto st0
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr1
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr1
+			}
+		default:
+			goto tr1
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr47
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr47
+			}
+		default:
+			goto tr47
+		}
+		goto st0
+tr47:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+//line uuid.go:1151
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr48
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr48
+			}
+		default:
+			goto tr48
+		}
+		goto st0
+tr48:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+//line uuid.go:1174
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr49
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr49
+			}
+		default:
+			goto tr49
+		}
+		goto st0
+tr49:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+//line uuid.go:1197
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr50
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr50
+			}
+		default:
+			goto tr50
+		}
+		goto st0
+tr50:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+//line uuid.go:1220
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr51
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr51
+			}
+		default:
+			goto tr51
+		}
+		goto st0
+tr51:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+//line uuid.go:1243
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr52
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr52
+			}
+		default:
+			goto tr52
+		}
+		goto st0
+tr52:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st52
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+//line uuid.go:1266
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr53
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr53
+			}
+		default:
+			goto tr53
+		}
+		goto st0
+tr53:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+//line uuid.go:1289
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr54
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr54
+			}
+		default:
+			goto tr54
+		}
+		goto st0
+tr54:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st54
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+//line uuid.go:1312
+		if data[p] == 45 {
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr56
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr56
+			}
+		default:
+			goto tr56
+		}
+		goto st0
+tr56:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+//line uuid.go:1344
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr57
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr57
+			}
+		default:
+			goto tr57
+		}
+		goto st0
+tr57:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+//line uuid.go:1367
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr58
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr58
+			}
+		default:
+			goto tr58
+		}
+		goto st0
+tr58:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+//line uuid.go:1390
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr59
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr59
+			}
+		default:
+			goto tr59
+		}
+		goto st0
+tr59:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+//line uuid.go:1413
+		if data[p] == 45 {
+			goto st60
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr61
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr61
+			}
+		default:
+			goto tr61
+		}
+		goto st0
+tr61:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+//line uuid.go:1445
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr62
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr62
+			}
+		default:
+			goto tr62
+		}
+		goto st0
+tr62:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+//line uuid.go:1468
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr63
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr63
+			}
+		default:
+			goto tr63
+		}
+		goto st0
+tr63:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st63
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+//line uuid.go:1491
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr64
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr64
+			}
+		default:
+			goto tr64
+		}
+		goto st0
+tr64:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+//line uuid.go:1514
+		if data[p] == 45 {
+			goto st65
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr66
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr66
+			}
+		default:
+			goto tr66
+		}
+		goto st0
+tr66:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st66
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+//line uuid.go:1546
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr67
+			}
+		default:
+			goto tr67
+		}
+		goto st0
+tr67:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st67
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+//line uuid.go:1569
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr68
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr68
+			}
+		default:
+			goto tr68
+		}
+		goto st0
+tr68:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st68
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+//line uuid.go:1592
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr69
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr69
+			}
+		default:
+			goto tr69
+		}
+		goto st0
+tr69:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st69
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+//line uuid.go:1615
+		if data[p] == 45 {
+			goto st70
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr71
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr71
+			}
+		default:
+			goto tr71
+		}
+		goto st0
+tr71:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+//line uuid.go:1647
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr72
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr72
+			}
+		default:
+			goto tr72
+		}
+		goto st0
+tr72:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+//line uuid.go:1670
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr73
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr73
+			}
+		default:
+			goto tr73
+		}
+		goto st0
+tr73:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st73
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+//line uuid.go:1693
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr74
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr74
+			}
+		default:
+			goto tr74
+		}
+		goto st0
+tr74:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st74
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+//line uuid.go:1716
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr75
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr75
+			}
+		default:
+			goto tr75
+		}
+		goto st0
+tr75:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+//line uuid.go:1739
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr76
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr76
+			}
+		default:
+			goto tr76
+		}
+		goto st0
+tr76:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+//line uuid.go:1762
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr77
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr77
+			}
+		default:
+			goto tr77
+		}
+		goto st0
+tr77:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st77
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+//line uuid.go:1785
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr78
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr78
+			}
+		default:
+			goto tr78
+		}
+		goto st0
+tr78:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st78
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+//line uuid.go:1808
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr79
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr79
+			}
+		default:
+			goto tr79
+		}
+		goto st0
+tr79:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st79
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+//line uuid.go:1831
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr80
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr80
+			}
+		default:
+			goto tr80
+		}
+		goto st0
+tr80:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st80
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+//line uuid.go:1854
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr81
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr81
+			}
+		default:
+			goto tr81
+		}
+		goto st0
+tr81:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st81
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+//line uuid.go:1877
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr82
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr82
+			}
+		default:
+			goto tr82
+		}
+		goto st0
+tr82:
+//line uuid.rl:36
+ u[n>>1] = u[n>>1]<<4 | unhex((data[p])); n++ 
+	goto st82
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+//line uuid.go:1900
+		if data[p] == 125 {
+			goto st83
+		}
+		goto st0
+	st_out:
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+
+	_test_eof: {}
+	_out: {}
+	}
+
+//line uuid.rl:46
+
+
+	if cs < uuid_first_final {
+		return [16]byte{}, false, p
+	}
+
+	return u, true, -1
+}
@@ -0,0 +1,53 @@
+package main
+
+func unhex(c byte) byte {
+	switch {
+	case c <= '9':
+		return c - '0'
+	case c <= 'F':
+		return c - 'A' + 10
+	}
+	return c - 'a' + 10
+}
+
+// ParseUUID parses a UUID in the RFC 4122 string representation, either bare
+// (f81d4fae-7dec-11d0-a765-00a0c91e6bf6), as a URN
+// (urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6), or in braces
+// ({f81d4fae-7dec-11d0-a765-00a0c91e6bf6}).  Hex digits may be in either
+// case.
+func ParseUUID(data []byte) ([16]byte, bool) {
+	u, ok, _ := ParseUUIDAt(data)
+	return u, ok
+}
+
+// ParseUUIDAt is like ParseUUID but also returns the offset of the first
+// byte that isn't where a UUID would have it, or -1.
+func ParseUUIDAt(data []byte) ([16]byte, bool, int) {
+
+%% machine uuid;
+%% write data;
+
+	var u [16]byte
+	n := 0
+
+	cs, p, pe := 0, 0, len(data)
+
+	%%{
+	    action nibble { u[n>>1] = u[n>>1]<<4 | unhex(fc); n++ }
+
+	    hex = xdigit $nibble ;
+
+	    uuid = hex{8} '-' hex{4} '-' hex{4} '-' hex{4} '-' hex{12} ;
+
+	    main := uuid | 'urn:uuid:'i uuid | '{' uuid '}' ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < uuid_first_final {
+		return [16]byte{}, false, p
+	}
+
+	return u, true, -1
+}
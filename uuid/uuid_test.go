@@ -0,0 +1,114 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var data = []byte("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+
+var hits int
+
+func TestParseUUID(t *testing.T) {
+	want := [16]byte{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+
+	tests := []struct {
+		in string
+		ok bool
+	}{
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", true},
+		{"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", true},
+		{"f81D4fAE-7dEc-11d0-A765-00a0C91e6bF6", true},
+		{"urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", true},
+		{"URN:UUID:F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", true},
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", true},
+
+		// right length, hyphens in the wrong places
+		{"f81d4fa-e7dec-11d0-a765-00a0c91e6bf6", false},
+		{"f81d4fae-7dec11-d0-a765-00a0c91e6bf6", false},
+		{"f81d4fae-7dec-11d0-a76500-a0c91e6bf6", false},
+		{"f81d4fae7-dec-11d0-a765-00a0c91e6bf6", false},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf-", false},
+		// not hex
+		{"g81d4fae-7dec-11d0-a765-00a0c91e6bf6", false},
+		// wrong length
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf", false},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf66", false},
+		{"f81d4fae7dec11d0a76500a0c91e6bf6", false},
+		// mismatched wrappers
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6", false},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", false},
+		{"{urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", false},
+		{"uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseUUID([]byte(tt.in))
+		if ok != tt.ok {
+			t.Errorf("ParseUUID(%q) ok=%v, want %v", tt.in, ok, tt.ok)
+			continue
+		}
+		if ok && got != want {
+			t.Errorf("ParseUUID(%q)=%x, want %x", tt.in, got, want)
+		}
+	}
+}
+
+func TestParseUUIDAt(t *testing.T) {
+	tests := []struct {
+		in     string
+		errPos int
+	}{
+		{string(data), -1},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bfg", 35},
+		{"f81d4fae7dec-11d0-a765-00a0c91e6bf6", 8},
+		{"f81d4fae-7dec-11d0-a765", 23},
+	}
+
+	for _, tt := range tests {
+		_, ok, errPos := ParseUUIDAt([]byte(tt.in))
+		if errPos != tt.errPos || ok != (tt.errPos == -1) {
+			t.Errorf("ParseUUIDAt(%q)=%v, %d, want errPos %d", tt.in, ok, errPos, tt.errPos)
+		}
+	}
+}
+
+var reUUID = regexp.MustCompile(`^(?i:urn:uuid:)?([0-9a-fA-F]{8})-([0-9a-fA-F]{4})-([0-9a-fA-F]{4})-([0-9a-fA-F]{4})-([0-9a-fA-F]{12})$`)
+
+func TestUUIDAlternatives(t *testing.T) {
+	want, _ := ParseUUID(data)
+	if got, err := uuid.Parse(string(data)); err != nil || [16]byte(got) != want {
+		t.Errorf("uuid.Parse=%x, want %x", got, want)
+	}
+	if !reUUID.Match(data) {
+		t.Errorf("reUUID doesn't match %q", data)
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if reUUID.Match(data) {
+			hits++
+		}
+	}
+}
+
+func BenchmarkGoogleUUID(b *testing.B) {
+	s := string(data)
+	for i := 0; i < b.N; i++ {
+		if _, err := uuid.Parse(s); err == nil {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := ParseUUID(data); ok {
+			hits++
+		}
+	}
+}
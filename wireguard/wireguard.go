@@ -0,0 +1,7904 @@
+
+//line wireguard.rl:1
+package main
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// WGConfig is a WireGuard configuration file as read by wg(8) and
+// wg-quick(8).  The byte slices point into the parsed file.
+type WGConfig struct {
+	Interface WGInterface
+	Peers     []WGPeer
+}
+
+// WGInterface holds the [Interface] section.  Address, DNS, and MTU are
+// only used by wg-quick.
+type WGInterface struct {
+	PrivateKey []byte
+	ListenPort int
+	FwMark     []byte
+	Address    [][]byte
+	DNS        [][]byte
+	MTU        int
+}
+
+// WGPeer holds a [Peer] section.  A PersistentKeepalive of zero is off.
+type WGPeer struct {
+	PublicKey           []byte
+	PresharedKey        []byte
+	AllowedIPs          [][]byte
+	Endpoint            []byte
+	PersistentKeepalive int
+}
+
+// A WGSyntaxError reports where ParseWireGuardConfig stopped.
+type WGSyntaxError struct {
+	Line   int
+	Offset int
+	Msg    string
+}
+
+func (e *WGSyntaxError) Error() string {
+	return "wireguard: " + e.Msg + " on line " + strconv.Itoa(e.Line)
+}
+
+// ParseWireGuardConfig parses a WireGuard configuration file.  Section
+// names and keys are case-insensitive, and each key is only accepted in its
+// own section.  Keys must be in base64 and ports and the like in range.
+// Comma separated values are split apart, and repeating a key adds to its
+// list instead of replacing it.  A backslash at the end of a line continues
+// the line, which is allowed wherever whitespace is, so a long list can be
+// written as
+//
+//	AllowedIPs = 10.0.0.0/8, \
+//	             192.168.0.0/16
+//
+// The script hooks and other wg-quick settings not in WGInterface are
+// rejected.
+func ParseWireGuardConfig(data []byte) (WGConfig, error) {
+
+
+//line wireguard.rl:62
+
+//line wireguard.go:67
+const wireguard_start int = 309
+const wireguard_first_final int = 309
+const wireguard_error int = 0
+
+const wireguard_en_main int = 309
+
+
+//line wireguard.rl:63
+
+	var c WGConfig
+	var peer *WGPeer
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	n := 0
+
+	
+//line wireguard.go:86
+	{
+	cs = wireguard_start
+	}
+
+//line wireguard.go:91
+	{
+	if p == pe {
+		goto _test_eof
+	}
+	switch cs {
+	case 309:
+		goto st_case_309
+	case 0:
+		goto st_case_0
+	case 310:
+		goto st_case_310
+	case 311:
+		goto st_case_311
+	case 1:
+		goto st_case_1
+	case 2:
+		goto st_case_2
+	case 3:
+		goto st_case_3
+	case 4:
+		goto st_case_4
+	case 5:
+		goto st_case_5
+	case 6:
+		goto st_case_6
+	case 7:
+		goto st_case_7
+	case 8:
+		goto st_case_8
+	case 9:
+		goto st_case_9
+	case 10:
+		goto st_case_10
+	case 312:
+		goto st_case_312
+	case 313:
+		goto st_case_313
+	case 314:
+		goto st_case_314
+	case 315:
+		goto st_case_315
+	case 11:
+		goto st_case_11
+	case 12:
+		goto st_case_12
+	case 13:
+		goto st_case_13
+	case 14:
+		goto st_case_14
+	case 15:
+		goto st_case_15
+	case 16:
+		goto st_case_16
+	case 17:
+		goto st_case_17
+	case 18:
+		goto st_case_18
+	case 316:
+		goto st_case_316
+	case 317:
+		goto st_case_317
+	case 19:
+		goto st_case_19
+	case 20:
+		goto st_case_20
+	case 21:
+		goto st_case_21
+	case 22:
+		goto st_case_22
+	case 23:
+		goto st_case_23
+	case 24:
+		goto st_case_24
+	case 25:
+		goto st_case_25
+	case 26:
+		goto st_case_26
+	case 27:
+		goto st_case_27
+	case 28:
+		goto st_case_28
+	case 318:
+		goto st_case_318
+	case 319:
+		goto st_case_319
+	case 29:
+		goto st_case_29
+	case 30:
+		goto st_case_30
+	case 31:
+		goto st_case_31
+	case 32:
+		goto st_case_32
+	case 33:
+		goto st_case_33
+	case 34:
+		goto st_case_34
+	case 35:
+		goto st_case_35
+	case 36:
+		goto st_case_36
+	case 37:
+		goto st_case_37
+	case 38:
+		goto st_case_38
+	case 39:
+		goto st_case_39
+	case 40:
+		goto st_case_40
+	case 41:
+		goto st_case_41
+	case 320:
+		goto st_case_320
+	case 321:
+		goto st_case_321
+	case 42:
+		goto st_case_42
+	case 43:
+		goto st_case_43
+	case 44:
+		goto st_case_44
+	case 322:
+		goto st_case_322
+	case 45:
+		goto st_case_45
+	case 46:
+		goto st_case_46
+	case 323:
+		goto st_case_323
+	case 47:
+		goto st_case_47
+	case 48:
+		goto st_case_48
+	case 49:
+		goto st_case_49
+	case 50:
+		goto st_case_50
+	case 51:
+		goto st_case_51
+	case 52:
+		goto st_case_52
+	case 53:
+		goto st_case_53
+	case 54:
+		goto st_case_54
+	case 55:
+		goto st_case_55
+	case 56:
+		goto st_case_56
+	case 57:
+		goto st_case_57
+	case 58:
+		goto st_case_58
+	case 59:
+		goto st_case_59
+	case 60:
+		goto st_case_60
+	case 61:
+		goto st_case_61
+	case 324:
+		goto st_case_324
+	case 325:
+		goto st_case_325
+	case 326:
+		goto st_case_326
+	case 327:
+		goto st_case_327
+	case 328:
+		goto st_case_328
+	case 329:
+		goto st_case_329
+	case 330:
+		goto st_case_330
+	case 331:
+		goto st_case_331
+	case 332:
+		goto st_case_332
+	case 62:
+		goto st_case_62
+	case 63:
+		goto st_case_63
+	case 64:
+		goto st_case_64
+	case 65:
+		goto st_case_65
+	case 66:
+		goto st_case_66
+	case 67:
+		goto st_case_67
+	case 68:
+		goto st_case_68
+	case 69:
+		goto st_case_69
+	case 333:
+		goto st_case_333
+	case 334:
+		goto st_case_334
+	case 335:
+		goto st_case_335
+	case 336:
+		goto st_case_336
+	case 337:
+		goto st_case_337
+	case 338:
+		goto st_case_338
+	case 339:
+		goto st_case_339
+	case 340:
+		goto st_case_340
+	case 341:
+		goto st_case_341
+	case 70:
+		goto st_case_70
+	case 71:
+		goto st_case_71
+	case 72:
+		goto st_case_72
+	case 73:
+		goto st_case_73
+	case 74:
+		goto st_case_74
+	case 75:
+		goto st_case_75
+	case 76:
+		goto st_case_76
+	case 77:
+		goto st_case_77
+	case 78:
+		goto st_case_78
+	case 79:
+		goto st_case_79
+	case 80:
+		goto st_case_80
+	case 81:
+		goto st_case_81
+	case 82:
+		goto st_case_82
+	case 83:
+		goto st_case_83
+	case 84:
+		goto st_case_84
+	case 85:
+		goto st_case_85
+	case 86:
+		goto st_case_86
+	case 87:
+		goto st_case_87
+	case 88:
+		goto st_case_88
+	case 89:
+		goto st_case_89
+	case 90:
+		goto st_case_90
+	case 91:
+		goto st_case_91
+	case 92:
+		goto st_case_92
+	case 93:
+		goto st_case_93
+	case 94:
+		goto st_case_94
+	case 95:
+		goto st_case_95
+	case 96:
+		goto st_case_96
+	case 97:
+		goto st_case_97
+	case 98:
+		goto st_case_98
+	case 99:
+		goto st_case_99
+	case 100:
+		goto st_case_100
+	case 101:
+		goto st_case_101
+	case 102:
+		goto st_case_102
+	case 103:
+		goto st_case_103
+	case 104:
+		goto st_case_104
+	case 105:
+		goto st_case_105
+	case 106:
+		goto st_case_106
+	case 107:
+		goto st_case_107
+	case 108:
+		goto st_case_108
+	case 109:
+		goto st_case_109
+	case 110:
+		goto st_case_110
+	case 111:
+		goto st_case_111
+	case 112:
+		goto st_case_112
+	case 113:
+		goto st_case_113
+	case 114:
+		goto st_case_114
+	case 115:
+		goto st_case_115
+	case 116:
+		goto st_case_116
+	case 117:
+		goto st_case_117
+	case 118:
+		goto st_case_118
+	case 119:
+		goto st_case_119
+	case 120:
+		goto st_case_120
+	case 121:
+		goto st_case_121
+	case 122:
+		goto st_case_122
+	case 123:
+		goto st_case_123
+	case 124:
+		goto st_case_124
+	case 125:
+		goto st_case_125
+	case 126:
+		goto st_case_126
+	case 127:
+		goto st_case_127
+	case 342:
+		goto st_case_342
+	case 128:
+		goto st_case_128
+	case 129:
+		goto st_case_129
+	case 130:
+		goto st_case_130
+	case 131:
+		goto st_case_131
+	case 132:
+		goto st_case_132
+	case 133:
+		goto st_case_133
+	case 134:
+		goto st_case_134
+	case 135:
+		goto st_case_135
+	case 343:
+		goto st_case_343
+	case 344:
+		goto st_case_344
+	case 345:
+		goto st_case_345
+	case 346:
+		goto st_case_346
+	case 136:
+		goto st_case_136
+	case 137:
+		goto st_case_137
+	case 138:
+		goto st_case_138
+	case 139:
+		goto st_case_139
+	case 140:
+		goto st_case_140
+	case 141:
+		goto st_case_141
+	case 142:
+		goto st_case_142
+	case 143:
+		goto st_case_143
+	case 144:
+		goto st_case_144
+	case 145:
+		goto st_case_145
+	case 146:
+		goto st_case_146
+	case 347:
+		goto st_case_347
+	case 348:
+		goto st_case_348
+	case 147:
+		goto st_case_147
+	case 148:
+		goto st_case_148
+	case 149:
+		goto st_case_149
+	case 150:
+		goto st_case_150
+	case 151:
+		goto st_case_151
+	case 152:
+		goto st_case_152
+	case 153:
+		goto st_case_153
+	case 154:
+		goto st_case_154
+	case 155:
+		goto st_case_155
+	case 156:
+		goto st_case_156
+	case 157:
+		goto st_case_157
+	case 158:
+		goto st_case_158
+	case 159:
+		goto st_case_159
+	case 160:
+		goto st_case_160
+	case 161:
+		goto st_case_161
+	case 349:
+		goto st_case_349
+	case 162:
+		goto st_case_162
+	case 163:
+		goto st_case_163
+	case 164:
+		goto st_case_164
+	case 165:
+		goto st_case_165
+	case 166:
+		goto st_case_166
+	case 167:
+		goto st_case_167
+	case 168:
+		goto st_case_168
+	case 169:
+		goto st_case_169
+	case 170:
+		goto st_case_170
+	case 171:
+		goto st_case_171
+	case 172:
+		goto st_case_172
+	case 173:
+		goto st_case_173
+	case 174:
+		goto st_case_174
+	case 175:
+		goto st_case_175
+	case 176:
+		goto st_case_176
+	case 177:
+		goto st_case_177
+	case 178:
+		goto st_case_178
+	case 179:
+		goto st_case_179
+	case 180:
+		goto st_case_180
+	case 181:
+		goto st_case_181
+	case 182:
+		goto st_case_182
+	case 183:
+		goto st_case_183
+	case 184:
+		goto st_case_184
+	case 185:
+		goto st_case_185
+	case 186:
+		goto st_case_186
+	case 187:
+		goto st_case_187
+	case 350:
+		goto st_case_350
+	case 351:
+		goto st_case_351
+	case 352:
+		goto st_case_352
+	case 353:
+		goto st_case_353
+	case 354:
+		goto st_case_354
+	case 355:
+		goto st_case_355
+	case 356:
+		goto st_case_356
+	case 357:
+		goto st_case_357
+	case 358:
+		goto st_case_358
+	case 188:
+		goto st_case_188
+	case 189:
+		goto st_case_189
+	case 190:
+		goto st_case_190
+	case 191:
+		goto st_case_191
+	case 192:
+		goto st_case_192
+	case 193:
+		goto st_case_193
+	case 194:
+		goto st_case_194
+	case 195:
+		goto st_case_195
+	case 196:
+		goto st_case_196
+	case 197:
+		goto st_case_197
+	case 198:
+		goto st_case_198
+	case 199:
+		goto st_case_199
+	case 200:
+		goto st_case_200
+	case 201:
+		goto st_case_201
+	case 202:
+		goto st_case_202
+	case 203:
+		goto st_case_203
+	case 204:
+		goto st_case_204
+	case 205:
+		goto st_case_205
+	case 206:
+		goto st_case_206
+	case 207:
+		goto st_case_207
+	case 208:
+		goto st_case_208
+	case 209:
+		goto st_case_209
+	case 210:
+		goto st_case_210
+	case 211:
+		goto st_case_211
+	case 212:
+		goto st_case_212
+	case 213:
+		goto st_case_213
+	case 214:
+		goto st_case_214
+	case 215:
+		goto st_case_215
+	case 216:
+		goto st_case_216
+	case 217:
+		goto st_case_217
+	case 218:
+		goto st_case_218
+	case 219:
+		goto st_case_219
+	case 220:
+		goto st_case_220
+	case 221:
+		goto st_case_221
+	case 222:
+		goto st_case_222
+	case 223:
+		goto st_case_223
+	case 224:
+		goto st_case_224
+	case 225:
+		goto st_case_225
+	case 226:
+		goto st_case_226
+	case 227:
+		goto st_case_227
+	case 228:
+		goto st_case_228
+	case 229:
+		goto st_case_229
+	case 230:
+		goto st_case_230
+	case 231:
+		goto st_case_231
+	case 232:
+		goto st_case_232
+	case 233:
+		goto st_case_233
+	case 234:
+		goto st_case_234
+	case 235:
+		goto st_case_235
+	case 236:
+		goto st_case_236
+	case 237:
+		goto st_case_237
+	case 238:
+		goto st_case_238
+	case 239:
+		goto st_case_239
+	case 240:
+		goto st_case_240
+	case 241:
+		goto st_case_241
+	case 242:
+		goto st_case_242
+	case 243:
+		goto st_case_243
+	case 244:
+		goto st_case_244
+	case 245:
+		goto st_case_245
+	case 246:
+		goto st_case_246
+	case 247:
+		goto st_case_247
+	case 248:
+		goto st_case_248
+	case 359:
+		goto st_case_359
+	case 249:
+		goto st_case_249
+	case 250:
+		goto st_case_250
+	case 251:
+		goto st_case_251
+	case 252:
+		goto st_case_252
+	case 253:
+		goto st_case_253
+	case 254:
+		goto st_case_254
+	case 255:
+		goto st_case_255
+	case 256:
+		goto st_case_256
+	case 257:
+		goto st_case_257
+	case 258:
+		goto st_case_258
+	case 259:
+		goto st_case_259
+	case 260:
+		goto st_case_260
+	case 261:
+		goto st_case_261
+	case 262:
+		goto st_case_262
+	case 263:
+		goto st_case_263
+	case 264:
+		goto st_case_264
+	case 265:
+		goto st_case_265
+	case 266:
+		goto st_case_266
+	case 267:
+		goto st_case_267
+	case 268:
+		goto st_case_268
+	case 269:
+		goto st_case_269
+	case 270:
+		goto st_case_270
+	case 271:
+		goto st_case_271
+	case 272:
+		goto st_case_272
+	case 273:
+		goto st_case_273
+	case 274:
+		goto st_case_274
+	case 275:
+		goto st_case_275
+	case 276:
+		goto st_case_276
+	case 277:
+		goto st_case_277
+	case 278:
+		goto st_case_278
+	case 279:
+		goto st_case_279
+	case 280:
+		goto st_case_280
+	case 281:
+		goto st_case_281
+	case 282:
+		goto st_case_282
+	case 283:
+		goto st_case_283
+	case 284:
+		goto st_case_284
+	case 285:
+		goto st_case_285
+	case 286:
+		goto st_case_286
+	case 287:
+		goto st_case_287
+	case 288:
+		goto st_case_288
+	case 289:
+		goto st_case_289
+	case 290:
+		goto st_case_290
+	case 291:
+		goto st_case_291
+	case 292:
+		goto st_case_292
+	case 293:
+		goto st_case_293
+	case 294:
+		goto st_case_294
+	case 295:
+		goto st_case_295
+	case 296:
+		goto st_case_296
+	case 297:
+		goto st_case_297
+	case 298:
+		goto st_case_298
+	case 299:
+		goto st_case_299
+	case 300:
+		goto st_case_300
+	case 301:
+		goto st_case_301
+	case 302:
+		goto st_case_302
+	case 303:
+		goto st_case_303
+	case 304:
+		goto st_case_304
+	case 360:
+		goto st_case_360
+	case 305:
+		goto st_case_305
+	case 306:
+		goto st_case_306
+	case 307:
+		goto st_case_307
+	case 308:
+		goto st_case_308
+	}
+	goto st_out
+	st309:
+		if p++; p == pe {
+			goto _test_eof309
+		}
+	st_case_309:
+		switch data[p] {
+		case 13:
+			goto st310
+		case 32:
+			goto st309
+		case 35:
+			goto st311
+		case 91:
+			goto st1
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st309
+		}
+		goto st0
+st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st310:
+		if p++; p == pe {
+			goto _test_eof310
+		}
+	st_case_310:
+		if data[p] == 10 {
+			goto st309
+		}
+		goto st0
+	st311:
+		if p++; p == pe {
+			goto _test_eof311
+		}
+	st_case_311:
+		if data[p] == 10 {
+			goto st309
+		}
+		goto st311
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 73:
+			goto st2
+		case 80:
+			goto st132
+		case 105:
+			goto st2
+		case 112:
+			goto st132
+		}
+		goto st0
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 78:
+			goto st3
+		case 110:
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 84:
+			goto st4
+		case 116:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 69:
+			goto st5
+		case 101:
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 82:
+			goto st6
+		case 114:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 70:
+			goto st7
+		case 102:
+			goto st7
+		}
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 65:
+			goto st8
+		case 97:
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 67:
+			goto st9
+		case 99:
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 69:
+			goto st10
+		case 101:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 93 {
+			goto st312
+		}
+		goto st0
+tr350:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+	goto st312
+tr357:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+	goto st312
+tr369:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+	goto st312
+tr381:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+	goto st312
+	st312:
+		if p++; p == pe {
+			goto _test_eof312
+		}
+	st_case_312:
+//line wireguard.go:1004
+		switch data[p] {
+		case 9:
+			goto st312
+		case 10:
+			goto st313
+		case 13:
+			goto st314
+		case 32:
+			goto st312
+		case 35:
+			goto st315
+		case 92:
+			goto st42
+		}
+		goto st0
+tr336:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st313
+tr344:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st313
+tr351:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+	goto st313
+tr358:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+	goto st313
+tr370:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+	goto st313
+tr382:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+	goto st313
+	st313:
+		if p++; p == pe {
+			goto _test_eof313
+		}
+	st_case_313:
+//line wireguard.go:1049
+		switch data[p] {
+		case 13:
+			goto st314
+		case 32:
+			goto st313
+		case 35:
+			goto st315
+		case 65:
+			goto st11
+		case 68:
+			goto st25
+		case 70:
+			goto st35
+		case 76:
+			goto st51
+		case 77:
+			goto st66
+		case 80:
+			goto st74
+		case 91:
+			goto st1
+		case 97:
+			goto st11
+		case 100:
+			goto st25
+		case 102:
+			goto st35
+		case 108:
+			goto st51
+		case 109:
+			goto st66
+		case 112:
+			goto st74
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st313
+		}
+		goto st0
+tr337:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st314
+tr345:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st314
+tr352:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+	goto st314
+tr359:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+	goto st314
+tr371:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+	goto st314
+tr383:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+	goto st314
+	st314:
+		if p++; p == pe {
+			goto _test_eof314
+		}
+	st_case_314:
+//line wireguard.go:1117
+		if data[p] == 10 {
+			goto st313
+		}
+		goto st0
+tr338:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st315
+tr346:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st315
+tr353:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+	goto st315
+tr360:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+	goto st315
+tr372:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+	goto st315
+tr384:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+	goto st315
+	st315:
+		if p++; p == pe {
+			goto _test_eof315
+		}
+	st_case_315:
+//line wireguard.go:1151
+		if data[p] == 10 {
+			goto st313
+		}
+		goto st315
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 68:
+			goto st12
+		case 100:
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 68:
+			goto st13
+		case 100:
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch data[p] {
+		case 82:
+			goto st14
+		case 114:
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch data[p] {
+		case 69:
+			goto st15
+		case 101:
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 83:
+			goto st16
+		case 115:
+			goto st16
+		}
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 83:
+			goto st17
+		case 115:
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		switch data[p] {
+		case 9:
+			goto st17
+		case 32:
+			goto st17
+		case 61:
+			goto st18
+		case 92:
+			goto st23
+		}
+		goto st0
+tr339:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+//line wireguard.go:1253
+		switch data[p] {
+		case 9:
+			goto st18
+		case 32:
+			goto st18
+		case 35:
+			goto st0
+		case 44:
+			goto st0
+		case 92:
+			goto st21
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr20
+tr20:
+//line wireguard.rl:73
+ mark = p 
+	goto st316
+	st316:
+		if p++; p == pe {
+			goto _test_eof316
+		}
+	st_case_316:
+//line wireguard.go:1279
+		switch data[p] {
+		case 9:
+			goto tr335
+		case 10:
+			goto tr336
+		case 13:
+			goto tr337
+		case 32:
+			goto tr335
+		case 35:
+			goto tr338
+		case 44:
+			goto tr339
+		case 92:
+			goto tr340
+		}
+		if 11 <= data[p] && data[p] <= 12 {
+			goto st0
+		}
+		goto st316
+tr335:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st317
+	st317:
+		if p++; p == pe {
+			goto _test_eof317
+		}
+	st_case_317:
+//line wireguard.go:1309
+		switch data[p] {
+		case 9:
+			goto st317
+		case 10:
+			goto st313
+		case 13:
+			goto st314
+		case 32:
+			goto st317
+		case 35:
+			goto st315
+		case 44:
+			goto st18
+		case 92:
+			goto st19
+		}
+		goto st0
+tr340:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+	goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+//line wireguard.go:1336
+		switch data[p] {
+		case 10:
+			goto st317
+		case 13:
+			goto st20
+		}
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 10 {
+			goto st317
+		}
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch data[p] {
+		case 10:
+			goto st18
+		case 13:
+			goto st22
+		}
+		goto st0
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 10 {
+			goto st18
+		}
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 10:
+			goto st17
+		case 13:
+			goto st24
+		}
+		goto st0
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 10 {
+			goto st17
+		}
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 78:
+			goto st26
+		case 110:
+			goto st26
+		}
+		goto st0
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch data[p] {
+		case 83:
+			goto st27
+		case 115:
+			goto st27
+		}
+		goto st0
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 9:
+			goto st27
+		case 32:
+			goto st27
+		case 61:
+			goto st28
+		case 92:
+			goto st33
+		}
+		goto st0
+tr347:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+//line wireguard.go:1444
+		switch data[p] {
+		case 9:
+			goto st28
+		case 32:
+			goto st28
+		case 35:
+			goto st0
+		case 44:
+			goto st0
+		case 92:
+			goto st31
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr30
+tr30:
+//line wireguard.rl:73
+ mark = p 
+	goto st318
+	st318:
+		if p++; p == pe {
+			goto _test_eof318
+		}
+	st_case_318:
+//line wireguard.go:1470
+		switch data[p] {
+		case 9:
+			goto tr343
+		case 10:
+			goto tr344
+		case 13:
+			goto tr345
+		case 32:
+			goto tr343
+		case 35:
+			goto tr346
+		case 44:
+			goto tr347
+		case 92:
+			goto tr348
+		}
+		if 11 <= data[p] && data[p] <= 12 {
+			goto st0
+		}
+		goto st318
+tr343:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st319
+	st319:
+		if p++; p == pe {
+			goto _test_eof319
+		}
+	st_case_319:
+//line wireguard.go:1500
+		switch data[p] {
+		case 9:
+			goto st319
+		case 10:
+			goto st313
+		case 13:
+			goto st314
+		case 32:
+			goto st319
+		case 35:
+			goto st315
+		case 44:
+			goto st28
+		case 92:
+			goto st29
+		}
+		goto st0
+tr348:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+	goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+//line wireguard.go:1527
+		switch data[p] {
+		case 10:
+			goto st319
+		case 13:
+			goto st30
+		}
+		goto st0
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 10 {
+			goto st319
+		}
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 10:
+			goto st28
+		case 13:
+			goto st32
+		}
+		goto st0
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		if data[p] == 10 {
+			goto st28
+		}
+		goto st0
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 10:
+			goto st27
+		case 13:
+			goto st34
+		}
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 10 {
+			goto st27
+		}
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 87:
+			goto st36
+		case 119:
+			goto st36
+		}
+		goto st0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 77:
+			goto st37
+		case 109:
+			goto st37
+		}
+		goto st0
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 65:
+			goto st38
+		case 97:
+			goto st38
+		}
+		goto st0
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 82:
+			goto st39
+		case 114:
+			goto st39
+		}
+		goto st0
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch data[p] {
+		case 75:
+			goto st40
+		case 107:
+			goto st40
+		}
+		goto st0
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 9:
+			goto st40
+		case 32:
+			goto st40
+		case 61:
+			goto st41
+		case 92:
+			goto st49
+		}
+		goto st0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch data[p] {
+		case 9:
+			goto st41
+		case 32:
+			goto st41
+		case 48:
+			goto tr43
+		case 79:
+			goto tr45
+		case 92:
+			goto st47
+		case 111:
+			goto tr45
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto tr44
+		}
+		goto st0
+tr43:
+//line wireguard.rl:73
+ mark = p 
+	goto st320
+	st320:
+		if p++; p == pe {
+			goto _test_eof320
+		}
+	st_case_320:
+//line wireguard.go:1694
+		switch data[p] {
+		case 9:
+			goto tr350
+		case 10:
+			goto tr351
+		case 13:
+			goto tr352
+		case 32:
+			goto tr350
+		case 35:
+			goto tr353
+		case 88:
+			goto st44
+		case 92:
+			goto tr356
+		case 120:
+			goto st44
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st321
+		}
+		goto st0
+tr44:
+//line wireguard.rl:73
+ mark = p 
+	goto st321
+	st321:
+		if p++; p == pe {
+			goto _test_eof321
+		}
+	st_case_321:
+//line wireguard.go:1726
+		switch data[p] {
+		case 9:
+			goto tr350
+		case 10:
+			goto tr351
+		case 13:
+			goto tr352
+		case 32:
+			goto tr350
+		case 35:
+			goto tr353
+		case 92:
+			goto tr356
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st321
+		}
+		goto st0
+tr356:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+	goto st42
+tr362:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+	goto st42
+tr374:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+	goto st42
+tr385:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+	goto st42
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+//line wireguard.go:1766
+		switch data[p] {
+		case 10:
+			goto st312
+		case 13:
+			goto st43
+		}
+		goto st0
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		if data[p] == 10 {
+			goto st312
+		}
+		goto st0
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st322
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st322
+			}
+		default:
+			goto st322
+		}
+		goto st0
+	st322:
+		if p++; p == pe {
+			goto _test_eof322
+		}
+	st_case_322:
+		switch data[p] {
+		case 9:
+			goto tr350
+		case 10:
+			goto tr351
+		case 13:
+			goto tr352
+		case 32:
+			goto tr350
+		case 35:
+			goto tr353
+		case 92:
+			goto tr356
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st322
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st322
+			}
+		default:
+			goto st322
+		}
+		goto st0
+tr45:
+//line wireguard.rl:73
+ mark = p 
+	goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+//line wireguard.go:1842
+		switch data[p] {
+		case 70:
+			goto st46
+		case 102:
+			goto st46
+		}
+		goto st0
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 70:
+			goto st323
+		case 102:
+			goto st323
+		}
+		goto st0
+	st323:
+		if p++; p == pe {
+			goto _test_eof323
+		}
+	st_case_323:
+		switch data[p] {
+		case 9:
+			goto tr350
+		case 10:
+			goto tr351
+		case 13:
+			goto tr352
+		case 32:
+			goto tr350
+		case 35:
+			goto tr353
+		case 92:
+			goto tr356
+		}
+		goto st0
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 10:
+			goto st41
+		case 13:
+			goto st48
+		}
+		goto st0
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 10 {
+			goto st41
+		}
+		goto st0
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch data[p] {
+		case 10:
+			goto st40
+		case 13:
+			goto st50
+		}
+		goto st0
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 10 {
+			goto st40
+		}
+		goto st0
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch data[p] {
+		case 73:
+			goto st52
+		case 105:
+			goto st52
+		}
+		goto st0
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 83:
+			goto st53
+		case 115:
+			goto st53
+		}
+		goto st0
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch data[p] {
+		case 84:
+			goto st54
+		case 116:
+			goto st54
+		}
+		goto st0
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		switch data[p] {
+		case 69:
+			goto st55
+		case 101:
+			goto st55
+		}
+		goto st0
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 78:
+			goto st56
+		case 110:
+			goto st56
+		}
+		goto st0
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 80:
+			goto st57
+		case 112:
+			goto st57
+		}
+		goto st0
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		switch data[p] {
+		case 79:
+			goto st58
+		case 111:
+			goto st58
+		}
+		goto st0
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch data[p] {
+		case 82:
+			goto st59
+		case 114:
+			goto st59
+		}
+		goto st0
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		switch data[p] {
+		case 84:
+			goto st60
+		case 116:
+			goto st60
+		}
+		goto st0
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 9:
+			goto st60
+		case 32:
+			goto st60
+		case 61:
+			goto st61
+		case 92:
+			goto st64
+		}
+		goto st0
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch data[p] {
+		case 9:
+			goto st61
+		case 32:
+			goto st61
+		case 48:
+			goto tr64
+		case 54:
+			goto tr66
+		case 92:
+			goto st62
+		}
+		switch {
+		case data[p] > 53:
+			if 55 <= data[p] && data[p] <= 57 {
+				goto tr64
+			}
+		case data[p] >= 49:
+			goto tr65
+		}
+		goto st0
+tr64:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st324
+tr365:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st324
+	st324:
+		if p++; p == pe {
+			goto _test_eof324
+		}
+	st_case_324:
+//line wireguard.go:2089
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr361
+		}
+		goto st0
+tr361:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st325
+	st325:
+		if p++; p == pe {
+			goto _test_eof325
+		}
+	st_case_325:
+//line wireguard.go:2117
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr363
+		}
+		goto st0
+tr363:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st326
+	st326:
+		if p++; p == pe {
+			goto _test_eof326
+		}
+	st_case_326:
+//line wireguard.go:2145
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr364
+		}
+		goto st0
+tr364:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st327
+	st327:
+		if p++; p == pe {
+			goto _test_eof327
+		}
+	st_case_327:
+//line wireguard.go:2173
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		goto st0
+tr65:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st328
+	st328:
+		if p++; p == pe {
+			goto _test_eof328
+		}
+	st_case_328:
+//line wireguard.go:2200
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr365
+		}
+		goto st0
+tr66:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st329
+	st329:
+		if p++; p == pe {
+			goto _test_eof329
+		}
+	st_case_329:
+//line wireguard.go:2230
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 53:
+			goto tr366
+		case 92:
+			goto tr362
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr361
+			}
+		case data[p] >= 48:
+			goto tr365
+		}
+		goto st0
+tr366:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st330
+	st330:
+		if p++; p == pe {
+			goto _test_eof330
+		}
+	st_case_330:
+//line wireguard.go:2265
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 53:
+			goto tr367
+		case 92:
+			goto tr362
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr363
+			}
+		case data[p] >= 48:
+			goto tr361
+		}
+		goto st0
+tr367:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st331
+	st331:
+		if p++; p == pe {
+			goto _test_eof331
+		}
+	st_case_331:
+//line wireguard.go:2300
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 51:
+			goto tr368
+		case 92:
+			goto tr362
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr364
+			}
+		case data[p] >= 48:
+			goto tr363
+		}
+		goto st0
+tr368:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st332
+	st332:
+		if p++; p == pe {
+			goto _test_eof332
+		}
+	st_case_332:
+//line wireguard.go:2335
+		switch data[p] {
+		case 9:
+			goto tr357
+		case 10:
+			goto tr358
+		case 13:
+			goto tr359
+		case 32:
+			goto tr357
+		case 35:
+			goto tr360
+		case 92:
+			goto tr362
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr364
+		}
+		goto st0
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 10:
+			goto st61
+		case 13:
+			goto st63
+		}
+		goto st0
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 10 {
+			goto st61
+		}
+		goto st0
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 10:
+			goto st60
+		case 13:
+			goto st65
+		}
+		goto st0
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 10 {
+			goto st60
+		}
+		goto st0
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch data[p] {
+		case 84:
+			goto st67
+		case 116:
+			goto st67
+		}
+		goto st0
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch data[p] {
+		case 85:
+			goto st68
+		case 117:
+			goto st68
+		}
+		goto st0
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 9:
+			goto st68
+		case 32:
+			goto st68
+		case 61:
+			goto st69
+		case 92:
+			goto st72
+		}
+		goto st0
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch data[p] {
+		case 9:
+			goto st69
+		case 32:
+			goto st69
+		case 48:
+			goto tr74
+		case 54:
+			goto tr76
+		case 92:
+			goto st70
+		}
+		switch {
+		case data[p] > 53:
+			if 55 <= data[p] && data[p] <= 57 {
+				goto tr74
+			}
+		case data[p] >= 49:
+			goto tr75
+		}
+		goto st0
+tr74:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st333
+tr377:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st333
+	st333:
+		if p++; p == pe {
+			goto _test_eof333
+		}
+	st_case_333:
+//line wireguard.go:2477
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr373
+		}
+		goto st0
+tr373:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st334
+	st334:
+		if p++; p == pe {
+			goto _test_eof334
+		}
+	st_case_334:
+//line wireguard.go:2505
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr375
+		}
+		goto st0
+tr375:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st335
+	st335:
+		if p++; p == pe {
+			goto _test_eof335
+		}
+	st_case_335:
+//line wireguard.go:2533
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr376
+		}
+		goto st0
+tr376:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st336
+	st336:
+		if p++; p == pe {
+			goto _test_eof336
+		}
+	st_case_336:
+//line wireguard.go:2561
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		goto st0
+tr75:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st337
+	st337:
+		if p++; p == pe {
+			goto _test_eof337
+		}
+	st_case_337:
+//line wireguard.go:2588
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr377
+		}
+		goto st0
+tr76:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st338
+	st338:
+		if p++; p == pe {
+			goto _test_eof338
+		}
+	st_case_338:
+//line wireguard.go:2618
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 53:
+			goto tr378
+		case 92:
+			goto tr374
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr373
+			}
+		case data[p] >= 48:
+			goto tr377
+		}
+		goto st0
+tr378:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st339
+	st339:
+		if p++; p == pe {
+			goto _test_eof339
+		}
+	st_case_339:
+//line wireguard.go:2653
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 53:
+			goto tr379
+		case 92:
+			goto tr374
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr375
+			}
+		case data[p] >= 48:
+			goto tr373
+		}
+		goto st0
+tr379:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st340
+	st340:
+		if p++; p == pe {
+			goto _test_eof340
+		}
+	st_case_340:
+//line wireguard.go:2688
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 51:
+			goto tr380
+		case 92:
+			goto tr374
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr376
+			}
+		case data[p] >= 48:
+			goto tr375
+		}
+		goto st0
+tr380:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st341
+	st341:
+		if p++; p == pe {
+			goto _test_eof341
+		}
+	st_case_341:
+//line wireguard.go:2723
+		switch data[p] {
+		case 9:
+			goto tr369
+		case 10:
+			goto tr370
+		case 13:
+			goto tr371
+		case 32:
+			goto tr369
+		case 35:
+			goto tr372
+		case 92:
+			goto tr374
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr376
+		}
+		goto st0
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 10:
+			goto st69
+		case 13:
+			goto st71
+		}
+		goto st0
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 10 {
+			goto st69
+		}
+		goto st0
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 10:
+			goto st68
+		case 13:
+			goto st73
+		}
+		goto st0
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 10 {
+			goto st68
+		}
+		goto st0
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 82:
+			goto st75
+		case 114:
+			goto st75
+		}
+		goto st0
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 73:
+			goto st76
+		case 105:
+			goto st76
+		}
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 86:
+			goto st77
+		case 118:
+			goto st77
+		}
+		goto st0
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		switch data[p] {
+		case 65:
+			goto st78
+		case 97:
+			goto st78
+		}
+		goto st0
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 84:
+			goto st79
+		case 116:
+			goto st79
+		}
+		goto st0
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		switch data[p] {
+		case 69:
+			goto st80
+		case 101:
+			goto st80
+		}
+		goto st0
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch data[p] {
+		case 75:
+			goto st81
+		case 107:
+			goto st81
+		}
+		goto st0
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		switch data[p] {
+		case 69:
+			goto st82
+		case 101:
+			goto st82
+		}
+		goto st0
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 89:
+			goto st83
+		case 121:
+			goto st83
+		}
+		goto st0
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch data[p] {
+		case 9:
+			goto st83
+		case 32:
+			goto st83
+		case 61:
+			goto st84
+		case 92:
+			goto st130
+		}
+		goto st0
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch data[p] {
+		case 9:
+			goto st84
+		case 32:
+			goto st84
+		case 43:
+			goto tr91
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto tr91
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr91
+			}
+		default:
+			goto tr91
+		}
+		goto st0
+tr91:
+//line wireguard.rl:73
+ mark = p 
+	goto st85
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+//line wireguard.go:2945
+		if data[p] == 43 {
+			goto st86
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st86
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st86
+			}
+		default:
+			goto st86
+		}
+		goto st0
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 43 {
+			goto st87
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st87
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st87
+			}
+		default:
+			goto st87
+		}
+		goto st0
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 43 {
+			goto st88
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st88
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st88
+			}
+		default:
+			goto st88
+		}
+		goto st0
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 43 {
+			goto st89
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st89
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st89
+			}
+		default:
+			goto st89
+		}
+		goto st0
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		if data[p] == 43 {
+			goto st90
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st90
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st90
+			}
+		default:
+			goto st90
+		}
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		if data[p] == 43 {
+			goto st91
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st91
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st91
+			}
+		default:
+			goto st91
+		}
+		goto st0
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 43 {
+			goto st92
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st92
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st92
+			}
+		default:
+			goto st92
+		}
+		goto st0
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 43 {
+			goto st93
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st93
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st93
+			}
+		default:
+			goto st93
+		}
+		goto st0
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		if data[p] == 43 {
+			goto st94
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st94
+			}
+		default:
+			goto st94
+		}
+		goto st0
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		if data[p] == 43 {
+			goto st95
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st95
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st95
+			}
+		default:
+			goto st95
+		}
+		goto st0
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		if data[p] == 43 {
+			goto st96
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st96
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto st0
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		if data[p] == 43 {
+			goto st97
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st97
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st97
+			}
+		default:
+			goto st97
+		}
+		goto st0
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 43 {
+			goto st98
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st98
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st98
+			}
+		default:
+			goto st98
+		}
+		goto st0
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 43 {
+			goto st99
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st99
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st99
+			}
+		default:
+			goto st99
+		}
+		goto st0
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		if data[p] == 43 {
+			goto st100
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st100
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st100
+			}
+		default:
+			goto st100
+		}
+		goto st0
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if data[p] == 43 {
+			goto st101
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st101
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st101
+			}
+		default:
+			goto st101
+		}
+		goto st0
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 43 {
+			goto st102
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st102
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st102
+			}
+		default:
+			goto st102
+		}
+		goto st0
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 43 {
+			goto st103
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st103
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st103
+			}
+		default:
+			goto st103
+		}
+		goto st0
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		if data[p] == 43 {
+			goto st104
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st104
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st104
+			}
+		default:
+			goto st104
+		}
+		goto st0
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 43 {
+			goto st105
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st105
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st105
+			}
+		default:
+			goto st105
+		}
+		goto st0
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		if data[p] == 43 {
+			goto st106
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st106
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st106
+			}
+		default:
+			goto st106
+		}
+		goto st0
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 43 {
+			goto st107
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st107
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st107
+			}
+		default:
+			goto st107
+		}
+		goto st0
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 43 {
+			goto st108
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st108
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st108
+			}
+		default:
+			goto st108
+		}
+		goto st0
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 43 {
+			goto st109
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st109
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st109
+			}
+		default:
+			goto st109
+		}
+		goto st0
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		if data[p] == 43 {
+			goto st110
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st110
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st110
+			}
+		default:
+			goto st110
+		}
+		goto st0
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 43 {
+			goto st111
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st111
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st111
+			}
+		default:
+			goto st111
+		}
+		goto st0
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 43 {
+			goto st112
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st112
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st112
+			}
+		default:
+			goto st112
+		}
+		goto st0
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 43 {
+			goto st113
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st113
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st113
+			}
+		default:
+			goto st113
+		}
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 43 {
+			goto st114
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st114
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st114
+			}
+		default:
+			goto st114
+		}
+		goto st0
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 43 {
+			goto st115
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st115
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st115
+			}
+		default:
+			goto st115
+		}
+		goto st0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 43 {
+			goto st116
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st116
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st116
+			}
+		default:
+			goto st116
+		}
+		goto st0
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		if data[p] == 43 {
+			goto st117
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st117
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st117
+			}
+		default:
+			goto st117
+		}
+		goto st0
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		if data[p] == 43 {
+			goto st118
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st118
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st118
+			}
+		default:
+			goto st118
+		}
+		goto st0
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 43 {
+			goto st119
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st119
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st119
+			}
+		default:
+			goto st119
+		}
+		goto st0
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		if data[p] == 43 {
+			goto st120
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st120
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st120
+			}
+		default:
+			goto st120
+		}
+		goto st0
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 43 {
+			goto st121
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st121
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st121
+			}
+		default:
+			goto st121
+		}
+		goto st0
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 43 {
+			goto st122
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st122
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st122
+			}
+		default:
+			goto st122
+		}
+		goto st0
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 43 {
+			goto st123
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st123
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st123
+			}
+		default:
+			goto st123
+		}
+		goto st0
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 43 {
+			goto st124
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st124
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st124
+			}
+		default:
+			goto st124
+		}
+		goto st0
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 43 {
+			goto st125
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st125
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st125
+			}
+		default:
+			goto st125
+		}
+		goto st0
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if data[p] == 43 {
+			goto st126
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st126
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st126
+			}
+		default:
+			goto st126
+		}
+		goto st0
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		switch data[p] {
+		case 48:
+			goto st127
+		case 52:
+			goto st127
+		case 56:
+			goto st127
+		case 65:
+			goto st127
+		case 69:
+			goto st127
+		case 73:
+			goto st127
+		case 77:
+			goto st127
+		case 81:
+			goto st127
+		case 85:
+			goto st127
+		case 89:
+			goto st127
+		case 99:
+			goto st127
+		case 103:
+			goto st127
+		case 107:
+			goto st127
+		case 111:
+			goto st127
+		case 115:
+			goto st127
+		case 119:
+			goto st127
+		}
+		goto st0
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 61 {
+			goto st342
+		}
+		goto st0
+	st342:
+		if p++; p == pe {
+			goto _test_eof342
+		}
+	st_case_342:
+		switch data[p] {
+		case 9:
+			goto tr381
+		case 10:
+			goto tr382
+		case 13:
+			goto tr383
+		case 32:
+			goto tr381
+		case 35:
+			goto tr384
+		case 92:
+			goto tr385
+		}
+		goto st0
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		switch data[p] {
+		case 10:
+			goto st84
+		case 13:
+			goto st129
+		}
+		goto st0
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 10 {
+			goto st84
+		}
+		goto st0
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		switch data[p] {
+		case 10:
+			goto st83
+		case 13:
+			goto st131
+		}
+		goto st0
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		if data[p] == 10 {
+			goto st83
+		}
+		goto st0
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		switch data[p] {
+		case 69:
+			goto st133
+		case 101:
+			goto st133
+		}
+		goto st0
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		switch data[p] {
+		case 69:
+			goto st134
+		case 101:
+			goto st134
+		}
+		goto st0
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		switch data[p] {
+		case 82:
+			goto st135
+		case 114:
+			goto st135
+		}
+		goto st0
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if data[p] == 93 {
+			goto tr141
+		}
+		goto st0
+tr141:
+//line wireguard.rl:77
+
+	        c.Peers = append(c.Peers, WGPeer{})
+	        peer = &c.Peers[len(c.Peers)-1]
+	    
+	goto st343
+tr402:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+	goto st343
+tr407:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+	goto st343
+tr419:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+	goto st343
+tr424:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+	goto st343
+	st343:
+		if p++; p == pe {
+			goto _test_eof343
+		}
+	st_case_343:
+//line wireguard.go:3986
+		switch data[p] {
+		case 9:
+			goto st343
+		case 10:
+			goto st344
+		case 13:
+			goto st345
+		case 32:
+			goto st343
+		case 35:
+			goto st346
+		case 92:
+			goto st162
+		}
+		goto st0
+tr395:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st344
+tr403:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+	goto st344
+tr408:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+	goto st344
+tr420:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+	goto st344
+tr425:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+	goto st344
+	st344:
+		if p++; p == pe {
+			goto _test_eof344
+		}
+	st_case_344:
+//line wireguard.go:4027
+		switch data[p] {
+		case 13:
+			goto st345
+		case 32:
+			goto st344
+		case 35:
+			goto st346
+		case 65:
+			goto st136
+		case 69:
+			goto st153
+		case 80:
+			goto st168
+		case 91:
+			goto st1
+		case 97:
+			goto st136
+		case 101:
+			goto st153
+		case 112:
+			goto st168
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st344
+		}
+		goto st0
+tr396:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st345
+tr404:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+	goto st345
+tr409:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+	goto st345
+tr421:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+	goto st345
+tr426:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+	goto st345
+	st345:
+		if p++; p == pe {
+			goto _test_eof345
+		}
+	st_case_345:
+//line wireguard.go:4079
+		if data[p] == 10 {
+			goto st344
+		}
+		goto st0
+tr397:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st346
+tr405:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+	goto st346
+tr410:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+	goto st346
+tr422:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+	goto st346
+tr427:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+	goto st346
+	st346:
+		if p++; p == pe {
+			goto _test_eof346
+		}
+	st_case_346:
+//line wireguard.go:4109
+		if data[p] == 10 {
+			goto st344
+		}
+		goto st346
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		switch data[p] {
+		case 76:
+			goto st137
+		case 108:
+			goto st137
+		}
+		goto st0
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		switch data[p] {
+		case 76:
+			goto st138
+		case 108:
+			goto st138
+		}
+		goto st0
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		switch data[p] {
+		case 79:
+			goto st139
+		case 111:
+			goto st139
+		}
+		goto st0
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		switch data[p] {
+		case 87:
+			goto st140
+		case 119:
+			goto st140
+		}
+		goto st0
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch data[p] {
+		case 69:
+			goto st141
+		case 101:
+			goto st141
+		}
+		goto st0
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch data[p] {
+		case 68:
+			goto st142
+		case 100:
+			goto st142
+		}
+		goto st0
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		switch data[p] {
+		case 73:
+			goto st143
+		case 105:
+			goto st143
+		}
+		goto st0
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		switch data[p] {
+		case 80:
+			goto st144
+		case 112:
+			goto st144
+		}
+		goto st0
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		switch data[p] {
+		case 83:
+			goto st145
+		case 115:
+			goto st145
+		}
+		goto st0
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		switch data[p] {
+		case 9:
+			goto st145
+		case 32:
+			goto st145
+		case 61:
+			goto st146
+		case 92:
+			goto st151
+		}
+		goto st0
+tr398:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st146
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+//line wireguard.go:4247
+		switch data[p] {
+		case 9:
+			goto st146
+		case 32:
+			goto st146
+		case 35:
+			goto st0
+		case 44:
+			goto st0
+		case 92:
+			goto st149
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr153
+tr153:
+//line wireguard.rl:73
+ mark = p 
+	goto st347
+	st347:
+		if p++; p == pe {
+			goto _test_eof347
+		}
+	st_case_347:
+//line wireguard.go:4273
+		switch data[p] {
+		case 9:
+			goto tr394
+		case 10:
+			goto tr395
+		case 13:
+			goto tr396
+		case 32:
+			goto tr394
+		case 35:
+			goto tr397
+		case 44:
+			goto tr398
+		case 92:
+			goto tr399
+		}
+		if 11 <= data[p] && data[p] <= 12 {
+			goto st0
+		}
+		goto st347
+tr394:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st348
+	st348:
+		if p++; p == pe {
+			goto _test_eof348
+		}
+	st_case_348:
+//line wireguard.go:4303
+		switch data[p] {
+		case 9:
+			goto st348
+		case 10:
+			goto st344
+		case 13:
+			goto st345
+		case 32:
+			goto st348
+		case 35:
+			goto st346
+		case 44:
+			goto st146
+		case 92:
+			goto st147
+		}
+		goto st0
+tr399:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+	goto st147
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+//line wireguard.go:4330
+		switch data[p] {
+		case 10:
+			goto st348
+		case 13:
+			goto st148
+		}
+		goto st0
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 10 {
+			goto st348
+		}
+		goto st0
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch data[p] {
+		case 10:
+			goto st146
+		case 13:
+			goto st150
+		}
+		goto st0
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		if data[p] == 10 {
+			goto st146
+		}
+		goto st0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		switch data[p] {
+		case 10:
+			goto st145
+		case 13:
+			goto st152
+		}
+		goto st0
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if data[p] == 10 {
+			goto st145
+		}
+		goto st0
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch data[p] {
+		case 78:
+			goto st154
+		case 110:
+			goto st154
+		}
+		goto st0
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		switch data[p] {
+		case 68:
+			goto st155
+		case 100:
+			goto st155
+		}
+		goto st0
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		switch data[p] {
+		case 80:
+			goto st156
+		case 112:
+			goto st156
+		}
+		goto st0
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		switch data[p] {
+		case 79:
+			goto st157
+		case 111:
+			goto st157
+		}
+		goto st0
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		switch data[p] {
+		case 73:
+			goto st158
+		case 105:
+			goto st158
+		}
+		goto st0
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		switch data[p] {
+		case 78:
+			goto st159
+		case 110:
+			goto st159
+		}
+		goto st0
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 84:
+			goto st160
+		case 116:
+			goto st160
+		}
+		goto st0
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch data[p] {
+		case 9:
+			goto st160
+		case 32:
+			goto st160
+		case 61:
+			goto st161
+		case 92:
+			goto st166
+		}
+		goto st0
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		switch data[p] {
+		case 9:
+			goto st161
+		case 32:
+			goto st161
+		case 35:
+			goto st0
+		case 44:
+			goto st0
+		case 92:
+			goto st164
+		}
+		if 10 <= data[p] && data[p] <= 13 {
+			goto st0
+		}
+		goto tr168
+tr168:
+//line wireguard.rl:73
+ mark = p 
+	goto st349
+	st349:
+		if p++; p == pe {
+			goto _test_eof349
+		}
+	st_case_349:
+//line wireguard.go:4519
+		switch data[p] {
+		case 9:
+			goto tr402
+		case 10:
+			goto tr403
+		case 13:
+			goto tr404
+		case 32:
+			goto tr402
+		case 35:
+			goto tr405
+		case 44:
+			goto st0
+		case 92:
+			goto tr406
+		}
+		if 11 <= data[p] && data[p] <= 12 {
+			goto st0
+		}
+		goto st349
+tr406:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+	goto st162
+tr412:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+	goto st162
+tr423:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+	goto st162
+tr428:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+	goto st162
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+//line wireguard.go:4561
+		switch data[p] {
+		case 10:
+			goto st343
+		case 13:
+			goto st163
+		}
+		goto st0
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		if data[p] == 10 {
+			goto st343
+		}
+		goto st0
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		switch data[p] {
+		case 10:
+			goto st161
+		case 13:
+			goto st165
+		}
+		goto st0
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		if data[p] == 10 {
+			goto st161
+		}
+		goto st0
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch data[p] {
+		case 10:
+			goto st160
+		case 13:
+			goto st167
+		}
+		goto st0
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 10 {
+			goto st160
+		}
+		goto st0
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		switch data[p] {
+		case 69:
+			goto st169
+		case 82:
+			goto st194
+		case 85:
+			goto st253
+		case 101:
+			goto st169
+		case 114:
+			goto st194
+		case 117:
+			goto st253
+		}
+		goto st0
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		switch data[p] {
+		case 82:
+			goto st170
+		case 114:
+			goto st170
+		}
+		goto st0
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 83:
+			goto st171
+		case 115:
+			goto st171
+		}
+		goto st0
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		switch data[p] {
+		case 73:
+			goto st172
+		case 105:
+			goto st172
+		}
+		goto st0
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		switch data[p] {
+		case 83:
+			goto st173
+		case 115:
+			goto st173
+		}
+		goto st0
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		switch data[p] {
+		case 84:
+			goto st174
+		case 116:
+			goto st174
+		}
+		goto st0
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		switch data[p] {
+		case 69:
+			goto st175
+		case 101:
+			goto st175
+		}
+		goto st0
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		switch data[p] {
+		case 78:
+			goto st176
+		case 110:
+			goto st176
+		}
+		goto st0
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		switch data[p] {
+		case 84:
+			goto st177
+		case 116:
+			goto st177
+		}
+		goto st0
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		switch data[p] {
+		case 75:
+			goto st178
+		case 107:
+			goto st178
+		}
+		goto st0
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch data[p] {
+		case 69:
+			goto st179
+		case 101:
+			goto st179
+		}
+		goto st0
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		switch data[p] {
+		case 69:
+			goto st180
+		case 101:
+			goto st180
+		}
+		goto st0
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		switch data[p] {
+		case 80:
+			goto st181
+		case 112:
+			goto st181
+		}
+		goto st0
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		switch data[p] {
+		case 65:
+			goto st182
+		case 97:
+			goto st182
+		}
+		goto st0
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		switch data[p] {
+		case 76:
+			goto st183
+		case 108:
+			goto st183
+		}
+		goto st0
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		switch data[p] {
+		case 73:
+			goto st184
+		case 105:
+			goto st184
+		}
+		goto st0
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		switch data[p] {
+		case 86:
+			goto st185
+		case 118:
+			goto st185
+		}
+		goto st0
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		switch data[p] {
+		case 69:
+			goto st186
+		case 101:
+			goto st186
+		}
+		goto st0
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		switch data[p] {
+		case 9:
+			goto st186
+		case 32:
+			goto st186
+		case 61:
+			goto st187
+		case 92:
+			goto st192
+		}
+		goto st0
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		switch data[p] {
+		case 9:
+			goto st187
+		case 32:
+			goto st187
+		case 48:
+			goto tr196
+		case 54:
+			goto tr198
+		case 79:
+			goto st188
+		case 92:
+			goto st190
+		case 111:
+			goto st188
+		}
+		switch {
+		case data[p] > 53:
+			if 55 <= data[p] && data[p] <= 57 {
+				goto tr196
+			}
+		case data[p] >= 49:
+			goto tr197
+		}
+		goto st0
+tr196:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st350
+tr415:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st350
+	st350:
+		if p++; p == pe {
+			goto _test_eof350
+		}
+	st_case_350:
+//line wireguard.go:4905
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr411
+		}
+		goto st0
+tr411:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st351
+	st351:
+		if p++; p == pe {
+			goto _test_eof351
+		}
+	st_case_351:
+//line wireguard.go:4933
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr413
+		}
+		goto st0
+tr413:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st352
+	st352:
+		if p++; p == pe {
+			goto _test_eof352
+		}
+	st_case_352:
+//line wireguard.go:4961
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr414
+		}
+		goto st0
+tr202:
+//line wireguard.rl:75
+ n = 0 
+	goto st353
+tr414:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st353
+	st353:
+		if p++; p == pe {
+			goto _test_eof353
+		}
+	st_case_353:
+//line wireguard.go:4993
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		goto st0
+tr197:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st354
+	st354:
+		if p++; p == pe {
+			goto _test_eof354
+		}
+	st_case_354:
+//line wireguard.go:5020
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto tr415
+		}
+		goto st0
+tr198:
+//line wireguard.rl:75
+ n = 0 
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st355
+	st355:
+		if p++; p == pe {
+			goto _test_eof355
+		}
+	st_case_355:
+//line wireguard.go:5050
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 53:
+			goto tr416
+		case 92:
+			goto tr412
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr411
+			}
+		case data[p] >= 48:
+			goto tr415
+		}
+		goto st0
+tr416:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st356
+	st356:
+		if p++; p == pe {
+			goto _test_eof356
+		}
+	st_case_356:
+//line wireguard.go:5085
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 53:
+			goto tr417
+		case 92:
+			goto tr412
+		}
+		switch {
+		case data[p] > 52:
+			if 54 <= data[p] && data[p] <= 57 {
+				goto tr413
+			}
+		case data[p] >= 48:
+			goto tr411
+		}
+		goto st0
+tr417:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st357
+	st357:
+		if p++; p == pe {
+			goto _test_eof357
+		}
+	st_case_357:
+//line wireguard.go:5120
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 51:
+			goto tr418
+		case 92:
+			goto tr412
+		}
+		switch {
+		case data[p] > 50:
+			if 52 <= data[p] && data[p] <= 57 {
+				goto tr414
+			}
+		case data[p] >= 48:
+			goto tr413
+		}
+		goto st0
+tr418:
+//line wireguard.rl:74
+ n = n*10 + int((data[p])-'0') 
+	goto st358
+	st358:
+		if p++; p == pe {
+			goto _test_eof358
+		}
+	st_case_358:
+//line wireguard.go:5155
+		switch data[p] {
+		case 9:
+			goto tr407
+		case 10:
+			goto tr408
+		case 13:
+			goto tr409
+		case 32:
+			goto tr407
+		case 35:
+			goto tr410
+		case 92:
+			goto tr412
+		}
+		if 48 <= data[p] && data[p] <= 53 {
+			goto tr414
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		switch data[p] {
+		case 70:
+			goto st189
+		case 102:
+			goto st189
+		}
+		goto st0
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		switch data[p] {
+		case 70:
+			goto tr202
+		case 102:
+			goto tr202
+		}
+		goto st0
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		switch data[p] {
+		case 10:
+			goto st187
+		case 13:
+			goto st191
+		}
+		goto st0
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		if data[p] == 10 {
+			goto st187
+		}
+		goto st0
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		switch data[p] {
+		case 10:
+			goto st186
+		case 13:
+			goto st193
+		}
+		goto st0
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		if data[p] == 10 {
+			goto st186
+		}
+		goto st0
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		switch data[p] {
+		case 69:
+			goto st195
+		case 101:
+			goto st195
+		}
+		goto st0
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		switch data[p] {
+		case 83:
+			goto st196
+		case 115:
+			goto st196
+		}
+		goto st0
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		switch data[p] {
+		case 72:
+			goto st197
+		case 104:
+			goto st197
+		}
+		goto st0
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		switch data[p] {
+		case 65:
+			goto st198
+		case 97:
+			goto st198
+		}
+		goto st0
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		switch data[p] {
+		case 82:
+			goto st199
+		case 114:
+			goto st199
+		}
+		goto st0
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		switch data[p] {
+		case 69:
+			goto st200
+		case 101:
+			goto st200
+		}
+		goto st0
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		switch data[p] {
+		case 68:
+			goto st201
+		case 100:
+			goto st201
+		}
+		goto st0
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch data[p] {
+		case 75:
+			goto st202
+		case 107:
+			goto st202
+		}
+		goto st0
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		switch data[p] {
+		case 69:
+			goto st203
+		case 101:
+			goto st203
+		}
+		goto st0
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		switch data[p] {
+		case 89:
+			goto st204
+		case 121:
+			goto st204
+		}
+		goto st0
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		switch data[p] {
+		case 9:
+			goto st204
+		case 32:
+			goto st204
+		case 61:
+			goto st205
+		case 92:
+			goto st251
+		}
+		goto st0
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		switch data[p] {
+		case 9:
+			goto st205
+		case 32:
+			goto st205
+		case 43:
+			goto tr217
+		case 92:
+			goto st249
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto tr217
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr217
+			}
+		default:
+			goto tr217
+		}
+		goto st0
+tr217:
+//line wireguard.rl:73
+ mark = p 
+	goto st206
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+//line wireguard.go:5413
+		if data[p] == 43 {
+			goto st207
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st207
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st207
+			}
+		default:
+			goto st207
+		}
+		goto st0
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		if data[p] == 43 {
+			goto st208
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st208
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st208
+			}
+		default:
+			goto st208
+		}
+		goto st0
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		if data[p] == 43 {
+			goto st209
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st209
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st209
+			}
+		default:
+			goto st209
+		}
+		goto st0
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		if data[p] == 43 {
+			goto st210
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st210
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st210
+			}
+		default:
+			goto st210
+		}
+		goto st0
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		if data[p] == 43 {
+			goto st211
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st211
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st211
+			}
+		default:
+			goto st211
+		}
+		goto st0
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		if data[p] == 43 {
+			goto st212
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st212
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st212
+			}
+		default:
+			goto st212
+		}
+		goto st0
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		if data[p] == 43 {
+			goto st213
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st213
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st213
+			}
+		default:
+			goto st213
+		}
+		goto st0
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		if data[p] == 43 {
+			goto st214
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st214
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st214
+			}
+		default:
+			goto st214
+		}
+		goto st0
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		if data[p] == 43 {
+			goto st215
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st215
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st215
+			}
+		default:
+			goto st215
+		}
+		goto st0
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		if data[p] == 43 {
+			goto st216
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st216
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st216
+			}
+		default:
+			goto st216
+		}
+		goto st0
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		if data[p] == 43 {
+			goto st217
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st217
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st217
+			}
+		default:
+			goto st217
+		}
+		goto st0
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		if data[p] == 43 {
+			goto st218
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st218
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st218
+			}
+		default:
+			goto st218
+		}
+		goto st0
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		if data[p] == 43 {
+			goto st219
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st219
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st219
+			}
+		default:
+			goto st219
+		}
+		goto st0
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		if data[p] == 43 {
+			goto st220
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st220
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st220
+			}
+		default:
+			goto st220
+		}
+		goto st0
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		if data[p] == 43 {
+			goto st221
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st221
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st221
+			}
+		default:
+			goto st221
+		}
+		goto st0
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		if data[p] == 43 {
+			goto st222
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st222
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st222
+			}
+		default:
+			goto st222
+		}
+		goto st0
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		if data[p] == 43 {
+			goto st223
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st223
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st223
+			}
+		default:
+			goto st223
+		}
+		goto st0
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		if data[p] == 43 {
+			goto st224
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st224
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st224
+			}
+		default:
+			goto st224
+		}
+		goto st0
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		if data[p] == 43 {
+			goto st225
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st225
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st225
+			}
+		default:
+			goto st225
+		}
+		goto st0
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 43 {
+			goto st226
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st226
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st226
+			}
+		default:
+			goto st226
+		}
+		goto st0
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 43 {
+			goto st227
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st227
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st227
+			}
+		default:
+			goto st227
+		}
+		goto st0
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		if data[p] == 43 {
+			goto st228
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st228
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st228
+			}
+		default:
+			goto st228
+		}
+		goto st0
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if data[p] == 43 {
+			goto st229
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st229
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st229
+			}
+		default:
+			goto st229
+		}
+		goto st0
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 43 {
+			goto st230
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st230
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st230
+			}
+		default:
+			goto st230
+		}
+		goto st0
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		if data[p] == 43 {
+			goto st231
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st231
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st231
+			}
+		default:
+			goto st231
+		}
+		goto st0
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 43 {
+			goto st232
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st232
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st232
+			}
+		default:
+			goto st232
+		}
+		goto st0
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		if data[p] == 43 {
+			goto st233
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st233
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st233
+			}
+		default:
+			goto st233
+		}
+		goto st0
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		if data[p] == 43 {
+			goto st234
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st234
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st234
+			}
+		default:
+			goto st234
+		}
+		goto st0
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 43 {
+			goto st235
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st235
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st235
+			}
+		default:
+			goto st235
+		}
+		goto st0
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if data[p] == 43 {
+			goto st236
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st236
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st236
+			}
+		default:
+			goto st236
+		}
+		goto st0
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		if data[p] == 43 {
+			goto st237
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st237
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st237
+			}
+		default:
+			goto st237
+		}
+		goto st0
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+		if data[p] == 43 {
+			goto st238
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st238
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st238
+			}
+		default:
+			goto st238
+		}
+		goto st0
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		if data[p] == 43 {
+			goto st239
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st239
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st239
+			}
+		default:
+			goto st239
+		}
+		goto st0
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		if data[p] == 43 {
+			goto st240
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st240
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st240
+			}
+		default:
+			goto st240
+		}
+		goto st0
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		if data[p] == 43 {
+			goto st241
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st241
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st241
+			}
+		default:
+			goto st241
+		}
+		goto st0
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		if data[p] == 43 {
+			goto st242
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st242
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st242
+			}
+		default:
+			goto st242
+		}
+		goto st0
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		if data[p] == 43 {
+			goto st243
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st243
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st243
+			}
+		default:
+			goto st243
+		}
+		goto st0
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		if data[p] == 43 {
+			goto st244
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st244
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st244
+			}
+		default:
+			goto st244
+		}
+		goto st0
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		if data[p] == 43 {
+			goto st245
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st245
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st245
+			}
+		default:
+			goto st245
+		}
+		goto st0
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 43 {
+			goto st246
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st246
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st246
+			}
+		default:
+			goto st246
+		}
+		goto st0
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		if data[p] == 43 {
+			goto st247
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st247
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st247
+			}
+		default:
+			goto st247
+		}
+		goto st0
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		switch data[p] {
+		case 48:
+			goto st248
+		case 52:
+			goto st248
+		case 56:
+			goto st248
+		case 65:
+			goto st248
+		case 69:
+			goto st248
+		case 73:
+			goto st248
+		case 77:
+			goto st248
+		case 81:
+			goto st248
+		case 85:
+			goto st248
+		case 89:
+			goto st248
+		case 99:
+			goto st248
+		case 103:
+			goto st248
+		case 107:
+			goto st248
+		case 111:
+			goto st248
+		case 115:
+			goto st248
+		case 119:
+			goto st248
+		}
+		goto st0
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		if data[p] == 61 {
+			goto st359
+		}
+		goto st0
+	st359:
+		if p++; p == pe {
+			goto _test_eof359
+		}
+	st_case_359:
+		switch data[p] {
+		case 9:
+			goto tr419
+		case 10:
+			goto tr420
+		case 13:
+			goto tr421
+		case 32:
+			goto tr419
+		case 35:
+			goto tr422
+		case 92:
+			goto tr423
+		}
+		goto st0
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		switch data[p] {
+		case 10:
+			goto st205
+		case 13:
+			goto st250
+		}
+		goto st0
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		if data[p] == 10 {
+			goto st205
+		}
+		goto st0
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		switch data[p] {
+		case 10:
+			goto st204
+		case 13:
+			goto st252
+		}
+		goto st0
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		if data[p] == 10 {
+			goto st204
+		}
+		goto st0
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		switch data[p] {
+		case 66:
+			goto st254
+		case 98:
+			goto st254
+		}
+		goto st0
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		switch data[p] {
+		case 76:
+			goto st255
+		case 108:
+			goto st255
+		}
+		goto st0
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		switch data[p] {
+		case 73:
+			goto st256
+		case 105:
+			goto st256
+		}
+		goto st0
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		switch data[p] {
+		case 67:
+			goto st257
+		case 99:
+			goto st257
+		}
+		goto st0
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+		switch data[p] {
+		case 75:
+			goto st258
+		case 107:
+			goto st258
+		}
+		goto st0
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		switch data[p] {
+		case 69:
+			goto st259
+		case 101:
+			goto st259
+		}
+		goto st0
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		switch data[p] {
+		case 89:
+			goto st260
+		case 121:
+			goto st260
+		}
+		goto st0
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		switch data[p] {
+		case 9:
+			goto st260
+		case 32:
+			goto st260
+		case 61:
+			goto st261
+		case 92:
+			goto st307
+		}
+		goto st0
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		switch data[p] {
+		case 9:
+			goto st261
+		case 32:
+			goto st261
+		case 43:
+			goto tr273
+		case 92:
+			goto st305
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto tr273
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto tr273
+			}
+		default:
+			goto tr273
+		}
+		goto st0
+tr273:
+//line wireguard.rl:73
+ mark = p 
+	goto st262
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+//line wireguard.go:6518
+		if data[p] == 43 {
+			goto st263
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st263
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st263
+			}
+		default:
+			goto st263
+		}
+		goto st0
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		if data[p] == 43 {
+			goto st264
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st264
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st264
+			}
+		default:
+			goto st264
+		}
+		goto st0
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		if data[p] == 43 {
+			goto st265
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st265
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st265
+			}
+		default:
+			goto st265
+		}
+		goto st0
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		if data[p] == 43 {
+			goto st266
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st266
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st266
+			}
+		default:
+			goto st266
+		}
+		goto st0
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+		if data[p] == 43 {
+			goto st267
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st267
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st267
+			}
+		default:
+			goto st267
+		}
+		goto st0
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+		if data[p] == 43 {
+			goto st268
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st268
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st268
+			}
+		default:
+			goto st268
+		}
+		goto st0
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		if data[p] == 43 {
+			goto st269
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st269
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st269
+			}
+		default:
+			goto st269
+		}
+		goto st0
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		if data[p] == 43 {
+			goto st270
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st270
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st270
+			}
+		default:
+			goto st270
+		}
+		goto st0
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		if data[p] == 43 {
+			goto st271
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st271
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st271
+			}
+		default:
+			goto st271
+		}
+		goto st0
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		if data[p] == 43 {
+			goto st272
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st272
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st272
+			}
+		default:
+			goto st272
+		}
+		goto st0
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		if data[p] == 43 {
+			goto st273
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st273
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st273
+			}
+		default:
+			goto st273
+		}
+		goto st0
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		if data[p] == 43 {
+			goto st274
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st274
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st274
+			}
+		default:
+			goto st274
+		}
+		goto st0
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		if data[p] == 43 {
+			goto st275
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st275
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st275
+			}
+		default:
+			goto st275
+		}
+		goto st0
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		if data[p] == 43 {
+			goto st276
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st276
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st276
+			}
+		default:
+			goto st276
+		}
+		goto st0
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		if data[p] == 43 {
+			goto st277
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st277
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st277
+			}
+		default:
+			goto st277
+		}
+		goto st0
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		if data[p] == 43 {
+			goto st278
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st278
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st278
+			}
+		default:
+			goto st278
+		}
+		goto st0
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		if data[p] == 43 {
+			goto st279
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st279
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st279
+			}
+		default:
+			goto st279
+		}
+		goto st0
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		if data[p] == 43 {
+			goto st280
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st280
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st280
+			}
+		default:
+			goto st280
+		}
+		goto st0
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		if data[p] == 43 {
+			goto st281
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st281
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st281
+			}
+		default:
+			goto st281
+		}
+		goto st0
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		if data[p] == 43 {
+			goto st282
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st282
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st282
+			}
+		default:
+			goto st282
+		}
+		goto st0
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		if data[p] == 43 {
+			goto st283
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st283
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st283
+			}
+		default:
+			goto st283
+		}
+		goto st0
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		if data[p] == 43 {
+			goto st284
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st284
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st284
+			}
+		default:
+			goto st284
+		}
+		goto st0
+	st284:
+		if p++; p == pe {
+			goto _test_eof284
+		}
+	st_case_284:
+		if data[p] == 43 {
+			goto st285
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st285
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st285
+			}
+		default:
+			goto st285
+		}
+		goto st0
+	st285:
+		if p++; p == pe {
+			goto _test_eof285
+		}
+	st_case_285:
+		if data[p] == 43 {
+			goto st286
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st286
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st286
+			}
+		default:
+			goto st286
+		}
+		goto st0
+	st286:
+		if p++; p == pe {
+			goto _test_eof286
+		}
+	st_case_286:
+		if data[p] == 43 {
+			goto st287
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st287
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st287
+			}
+		default:
+			goto st287
+		}
+		goto st0
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
+		if data[p] == 43 {
+			goto st288
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st288
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st288
+			}
+		default:
+			goto st288
+		}
+		goto st0
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+		if data[p] == 43 {
+			goto st289
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st289
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st289
+			}
+		default:
+			goto st289
+		}
+		goto st0
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+		if data[p] == 43 {
+			goto st290
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st290
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st290
+			}
+		default:
+			goto st290
+		}
+		goto st0
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		if data[p] == 43 {
+			goto st291
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st291
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st291
+			}
+		default:
+			goto st291
+		}
+		goto st0
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+		if data[p] == 43 {
+			goto st292
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st292
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st292
+			}
+		default:
+			goto st292
+		}
+		goto st0
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+		if data[p] == 43 {
+			goto st293
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st293
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st293
+			}
+		default:
+			goto st293
+		}
+		goto st0
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		if data[p] == 43 {
+			goto st294
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st294
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st294
+			}
+		default:
+			goto st294
+		}
+		goto st0
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+		if data[p] == 43 {
+			goto st295
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st295
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st295
+			}
+		default:
+			goto st295
+		}
+		goto st0
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+		if data[p] == 43 {
+			goto st296
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st296
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto st0
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+		if data[p] == 43 {
+			goto st297
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st297
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st297
+			}
+		default:
+			goto st297
+		}
+		goto st0
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+		if data[p] == 43 {
+			goto st298
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st298
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st298
+			}
+		default:
+			goto st298
+		}
+		goto st0
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		if data[p] == 43 {
+			goto st299
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st299
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st299
+			}
+		default:
+			goto st299
+		}
+		goto st0
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		if data[p] == 43 {
+			goto st300
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st300
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st300
+			}
+		default:
+			goto st300
+		}
+		goto st0
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		if data[p] == 43 {
+			goto st301
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st301
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st301
+			}
+		default:
+			goto st301
+		}
+		goto st0
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		if data[p] == 43 {
+			goto st302
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st302
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st302
+			}
+		default:
+			goto st302
+		}
+		goto st0
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		if data[p] == 43 {
+			goto st303
+		}
+		switch {
+		case data[p] < 65:
+			if 47 <= data[p] && data[p] <= 57 {
+				goto st303
+			}
+		case data[p] > 90:
+			if 97 <= data[p] && data[p] <= 122 {
+				goto st303
+			}
+		default:
+			goto st303
+		}
+		goto st0
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		switch data[p] {
+		case 48:
+			goto st304
+		case 52:
+			goto st304
+		case 56:
+			goto st304
+		case 65:
+			goto st304
+		case 69:
+			goto st304
+		case 73:
+			goto st304
+		case 77:
+			goto st304
+		case 81:
+			goto st304
+		case 85:
+			goto st304
+		case 89:
+			goto st304
+		case 99:
+			goto st304
+		case 103:
+			goto st304
+		case 107:
+			goto st304
+		case 111:
+			goto st304
+		case 115:
+			goto st304
+		case 119:
+			goto st304
+		}
+		goto st0
+	st304:
+		if p++; p == pe {
+			goto _test_eof304
+		}
+	st_case_304:
+		if data[p] == 61 {
+			goto st360
+		}
+		goto st0
+	st360:
+		if p++; p == pe {
+			goto _test_eof360
+		}
+	st_case_360:
+		switch data[p] {
+		case 9:
+			goto tr424
+		case 10:
+			goto tr425
+		case 13:
+			goto tr426
+		case 32:
+			goto tr424
+		case 35:
+			goto tr427
+		case 92:
+			goto tr428
+		}
+		goto st0
+	st305:
+		if p++; p == pe {
+			goto _test_eof305
+		}
+	st_case_305:
+		switch data[p] {
+		case 10:
+			goto st261
+		case 13:
+			goto st306
+		}
+		goto st0
+	st306:
+		if p++; p == pe {
+			goto _test_eof306
+		}
+	st_case_306:
+		if data[p] == 10 {
+			goto st261
+		}
+		goto st0
+	st307:
+		if p++; p == pe {
+			goto _test_eof307
+		}
+	st_case_307:
+		switch data[p] {
+		case 10:
+			goto st260
+		case 13:
+			goto st308
+		}
+		goto st0
+	st308:
+		if p++; p == pe {
+			goto _test_eof308
+		}
+	st_case_308:
+		if data[p] == 10 {
+			goto st260
+		}
+		goto st0
+	st_out:
+	_test_eof309: cs = 309; goto _test_eof
+	_test_eof310: cs = 310; goto _test_eof
+	_test_eof311: cs = 311; goto _test_eof
+	_test_eof1: cs = 1; goto _test_eof
+	_test_eof2: cs = 2; goto _test_eof
+	_test_eof3: cs = 3; goto _test_eof
+	_test_eof4: cs = 4; goto _test_eof
+	_test_eof5: cs = 5; goto _test_eof
+	_test_eof6: cs = 6; goto _test_eof
+	_test_eof7: cs = 7; goto _test_eof
+	_test_eof8: cs = 8; goto _test_eof
+	_test_eof9: cs = 9; goto _test_eof
+	_test_eof10: cs = 10; goto _test_eof
+	_test_eof312: cs = 312; goto _test_eof
+	_test_eof313: cs = 313; goto _test_eof
+	_test_eof314: cs = 314; goto _test_eof
+	_test_eof315: cs = 315; goto _test_eof
+	_test_eof11: cs = 11; goto _test_eof
+	_test_eof12: cs = 12; goto _test_eof
+	_test_eof13: cs = 13; goto _test_eof
+	_test_eof14: cs = 14; goto _test_eof
+	_test_eof15: cs = 15; goto _test_eof
+	_test_eof16: cs = 16; goto _test_eof
+	_test_eof17: cs = 17; goto _test_eof
+	_test_eof18: cs = 18; goto _test_eof
+	_test_eof316: cs = 316; goto _test_eof
+	_test_eof317: cs = 317; goto _test_eof
+	_test_eof19: cs = 19; goto _test_eof
+	_test_eof20: cs = 20; goto _test_eof
+	_test_eof21: cs = 21; goto _test_eof
+	_test_eof22: cs = 22; goto _test_eof
+	_test_eof23: cs = 23; goto _test_eof
+	_test_eof24: cs = 24; goto _test_eof
+	_test_eof25: cs = 25; goto _test_eof
+	_test_eof26: cs = 26; goto _test_eof
+	_test_eof27: cs = 27; goto _test_eof
+	_test_eof28: cs = 28; goto _test_eof
+	_test_eof318: cs = 318; goto _test_eof
+	_test_eof319: cs = 319; goto _test_eof
+	_test_eof29: cs = 29; goto _test_eof
+	_test_eof30: cs = 30; goto _test_eof
+	_test_eof31: cs = 31; goto _test_eof
+	_test_eof32: cs = 32; goto _test_eof
+	_test_eof33: cs = 33; goto _test_eof
+	_test_eof34: cs = 34; goto _test_eof
+	_test_eof35: cs = 35; goto _test_eof
+	_test_eof36: cs = 36; goto _test_eof
+	_test_eof37: cs = 37; goto _test_eof
+	_test_eof38: cs = 38; goto _test_eof
+	_test_eof39: cs = 39; goto _test_eof
+	_test_eof40: cs = 40; goto _test_eof
+	_test_eof41: cs = 41; goto _test_eof
+	_test_eof320: cs = 320; goto _test_eof
+	_test_eof321: cs = 321; goto _test_eof
+	_test_eof42: cs = 42; goto _test_eof
+	_test_eof43: cs = 43; goto _test_eof
+	_test_eof44: cs = 44; goto _test_eof
+	_test_eof322: cs = 322; goto _test_eof
+	_test_eof45: cs = 45; goto _test_eof
+	_test_eof46: cs = 46; goto _test_eof
+	_test_eof323: cs = 323; goto _test_eof
+	_test_eof47: cs = 47; goto _test_eof
+	_test_eof48: cs = 48; goto _test_eof
+	_test_eof49: cs = 49; goto _test_eof
+	_test_eof50: cs = 50; goto _test_eof
+	_test_eof51: cs = 51; goto _test_eof
+	_test_eof52: cs = 52; goto _test_eof
+	_test_eof53: cs = 53; goto _test_eof
+	_test_eof54: cs = 54; goto _test_eof
+	_test_eof55: cs = 55; goto _test_eof
+	_test_eof56: cs = 56; goto _test_eof
+	_test_eof57: cs = 57; goto _test_eof
+	_test_eof58: cs = 58; goto _test_eof
+	_test_eof59: cs = 59; goto _test_eof
+	_test_eof60: cs = 60; goto _test_eof
+	_test_eof61: cs = 61; goto _test_eof
+	_test_eof324: cs = 324; goto _test_eof
+	_test_eof325: cs = 325; goto _test_eof
+	_test_eof326: cs = 326; goto _test_eof
+	_test_eof327: cs = 327; goto _test_eof
+	_test_eof328: cs = 328; goto _test_eof
+	_test_eof329: cs = 329; goto _test_eof
+	_test_eof330: cs = 330; goto _test_eof
+	_test_eof331: cs = 331; goto _test_eof
+	_test_eof332: cs = 332; goto _test_eof
+	_test_eof62: cs = 62; goto _test_eof
+	_test_eof63: cs = 63; goto _test_eof
+	_test_eof64: cs = 64; goto _test_eof
+	_test_eof65: cs = 65; goto _test_eof
+	_test_eof66: cs = 66; goto _test_eof
+	_test_eof67: cs = 67; goto _test_eof
+	_test_eof68: cs = 68; goto _test_eof
+	_test_eof69: cs = 69; goto _test_eof
+	_test_eof333: cs = 333; goto _test_eof
+	_test_eof334: cs = 334; goto _test_eof
+	_test_eof335: cs = 335; goto _test_eof
+	_test_eof336: cs = 336; goto _test_eof
+	_test_eof337: cs = 337; goto _test_eof
+	_test_eof338: cs = 338; goto _test_eof
+	_test_eof339: cs = 339; goto _test_eof
+	_test_eof340: cs = 340; goto _test_eof
+	_test_eof341: cs = 341; goto _test_eof
+	_test_eof70: cs = 70; goto _test_eof
+	_test_eof71: cs = 71; goto _test_eof
+	_test_eof72: cs = 72; goto _test_eof
+	_test_eof73: cs = 73; goto _test_eof
+	_test_eof74: cs = 74; goto _test_eof
+	_test_eof75: cs = 75; goto _test_eof
+	_test_eof76: cs = 76; goto _test_eof
+	_test_eof77: cs = 77; goto _test_eof
+	_test_eof78: cs = 78; goto _test_eof
+	_test_eof79: cs = 79; goto _test_eof
+	_test_eof80: cs = 80; goto _test_eof
+	_test_eof81: cs = 81; goto _test_eof
+	_test_eof82: cs = 82; goto _test_eof
+	_test_eof83: cs = 83; goto _test_eof
+	_test_eof84: cs = 84; goto _test_eof
+	_test_eof85: cs = 85; goto _test_eof
+	_test_eof86: cs = 86; goto _test_eof
+	_test_eof87: cs = 87; goto _test_eof
+	_test_eof88: cs = 88; goto _test_eof
+	_test_eof89: cs = 89; goto _test_eof
+	_test_eof90: cs = 90; goto _test_eof
+	_test_eof91: cs = 91; goto _test_eof
+	_test_eof92: cs = 92; goto _test_eof
+	_test_eof93: cs = 93; goto _test_eof
+	_test_eof94: cs = 94; goto _test_eof
+	_test_eof95: cs = 95; goto _test_eof
+	_test_eof96: cs = 96; goto _test_eof
+	_test_eof97: cs = 97; goto _test_eof
+	_test_eof98: cs = 98; goto _test_eof
+	_test_eof99: cs = 99; goto _test_eof
+	_test_eof100: cs = 100; goto _test_eof
+	_test_eof101: cs = 101; goto _test_eof
+	_test_eof102: cs = 102; goto _test_eof
+	_test_eof103: cs = 103; goto _test_eof
+	_test_eof104: cs = 104; goto _test_eof
+	_test_eof105: cs = 105; goto _test_eof
+	_test_eof106: cs = 106; goto _test_eof
+	_test_eof107: cs = 107; goto _test_eof
+	_test_eof108: cs = 108; goto _test_eof
+	_test_eof109: cs = 109; goto _test_eof
+	_test_eof110: cs = 110; goto _test_eof
+	_test_eof111: cs = 111; goto _test_eof
+	_test_eof112: cs = 112; goto _test_eof
+	_test_eof113: cs = 113; goto _test_eof
+	_test_eof114: cs = 114; goto _test_eof
+	_test_eof115: cs = 115; goto _test_eof
+	_test_eof116: cs = 116; goto _test_eof
+	_test_eof117: cs = 117; goto _test_eof
+	_test_eof118: cs = 118; goto _test_eof
+	_test_eof119: cs = 119; goto _test_eof
+	_test_eof120: cs = 120; goto _test_eof
+	_test_eof121: cs = 121; goto _test_eof
+	_test_eof122: cs = 122; goto _test_eof
+	_test_eof123: cs = 123; goto _test_eof
+	_test_eof124: cs = 124; goto _test_eof
+	_test_eof125: cs = 125; goto _test_eof
+	_test_eof126: cs = 126; goto _test_eof
+	_test_eof127: cs = 127; goto _test_eof
+	_test_eof342: cs = 342; goto _test_eof
+	_test_eof128: cs = 128; goto _test_eof
+	_test_eof129: cs = 129; goto _test_eof
+	_test_eof130: cs = 130; goto _test_eof
+	_test_eof131: cs = 131; goto _test_eof
+	_test_eof132: cs = 132; goto _test_eof
+	_test_eof133: cs = 133; goto _test_eof
+	_test_eof134: cs = 134; goto _test_eof
+	_test_eof135: cs = 135; goto _test_eof
+	_test_eof343: cs = 343; goto _test_eof
+	_test_eof344: cs = 344; goto _test_eof
+	_test_eof345: cs = 345; goto _test_eof
+	_test_eof346: cs = 346; goto _test_eof
+	_test_eof136: cs = 136; goto _test_eof
+	_test_eof137: cs = 137; goto _test_eof
+	_test_eof138: cs = 138; goto _test_eof
+	_test_eof139: cs = 139; goto _test_eof
+	_test_eof140: cs = 140; goto _test_eof
+	_test_eof141: cs = 141; goto _test_eof
+	_test_eof142: cs = 142; goto _test_eof
+	_test_eof143: cs = 143; goto _test_eof
+	_test_eof144: cs = 144; goto _test_eof
+	_test_eof145: cs = 145; goto _test_eof
+	_test_eof146: cs = 146; goto _test_eof
+	_test_eof347: cs = 347; goto _test_eof
+	_test_eof348: cs = 348; goto _test_eof
+	_test_eof147: cs = 147; goto _test_eof
+	_test_eof148: cs = 148; goto _test_eof
+	_test_eof149: cs = 149; goto _test_eof
+	_test_eof150: cs = 150; goto _test_eof
+	_test_eof151: cs = 151; goto _test_eof
+	_test_eof152: cs = 152; goto _test_eof
+	_test_eof153: cs = 153; goto _test_eof
+	_test_eof154: cs = 154; goto _test_eof
+	_test_eof155: cs = 155; goto _test_eof
+	_test_eof156: cs = 156; goto _test_eof
+	_test_eof157: cs = 157; goto _test_eof
+	_test_eof158: cs = 158; goto _test_eof
+	_test_eof159: cs = 159; goto _test_eof
+	_test_eof160: cs = 160; goto _test_eof
+	_test_eof161: cs = 161; goto _test_eof
+	_test_eof349: cs = 349; goto _test_eof
+	_test_eof162: cs = 162; goto _test_eof
+	_test_eof163: cs = 163; goto _test_eof
+	_test_eof164: cs = 164; goto _test_eof
+	_test_eof165: cs = 165; goto _test_eof
+	_test_eof166: cs = 166; goto _test_eof
+	_test_eof167: cs = 167; goto _test_eof
+	_test_eof168: cs = 168; goto _test_eof
+	_test_eof169: cs = 169; goto _test_eof
+	_test_eof170: cs = 170; goto _test_eof
+	_test_eof171: cs = 171; goto _test_eof
+	_test_eof172: cs = 172; goto _test_eof
+	_test_eof173: cs = 173; goto _test_eof
+	_test_eof174: cs = 174; goto _test_eof
+	_test_eof175: cs = 175; goto _test_eof
+	_test_eof176: cs = 176; goto _test_eof
+	_test_eof177: cs = 177; goto _test_eof
+	_test_eof178: cs = 178; goto _test_eof
+	_test_eof179: cs = 179; goto _test_eof
+	_test_eof180: cs = 180; goto _test_eof
+	_test_eof181: cs = 181; goto _test_eof
+	_test_eof182: cs = 182; goto _test_eof
+	_test_eof183: cs = 183; goto _test_eof
+	_test_eof184: cs = 184; goto _test_eof
+	_test_eof185: cs = 185; goto _test_eof
+	_test_eof186: cs = 186; goto _test_eof
+	_test_eof187: cs = 187; goto _test_eof
+	_test_eof350: cs = 350; goto _test_eof
+	_test_eof351: cs = 351; goto _test_eof
+	_test_eof352: cs = 352; goto _test_eof
+	_test_eof353: cs = 353; goto _test_eof
+	_test_eof354: cs = 354; goto _test_eof
+	_test_eof355: cs = 355; goto _test_eof
+	_test_eof356: cs = 356; goto _test_eof
+	_test_eof357: cs = 357; goto _test_eof
+	_test_eof358: cs = 358; goto _test_eof
+	_test_eof188: cs = 188; goto _test_eof
+	_test_eof189: cs = 189; goto _test_eof
+	_test_eof190: cs = 190; goto _test_eof
+	_test_eof191: cs = 191; goto _test_eof
+	_test_eof192: cs = 192; goto _test_eof
+	_test_eof193: cs = 193; goto _test_eof
+	_test_eof194: cs = 194; goto _test_eof
+	_test_eof195: cs = 195; goto _test_eof
+	_test_eof196: cs = 196; goto _test_eof
+	_test_eof197: cs = 197; goto _test_eof
+	_test_eof198: cs = 198; goto _test_eof
+	_test_eof199: cs = 199; goto _test_eof
+	_test_eof200: cs = 200; goto _test_eof
+	_test_eof201: cs = 201; goto _test_eof
+	_test_eof202: cs = 202; goto _test_eof
+	_test_eof203: cs = 203; goto _test_eof
+	_test_eof204: cs = 204; goto _test_eof
+	_test_eof205: cs = 205; goto _test_eof
+	_test_eof206: cs = 206; goto _test_eof
+	_test_eof207: cs = 207; goto _test_eof
+	_test_eof208: cs = 208; goto _test_eof
+	_test_eof209: cs = 209; goto _test_eof
+	_test_eof210: cs = 210; goto _test_eof
+	_test_eof211: cs = 211; goto _test_eof
+	_test_eof212: cs = 212; goto _test_eof
+	_test_eof213: cs = 213; goto _test_eof
+	_test_eof214: cs = 214; goto _test_eof
+	_test_eof215: cs = 215; goto _test_eof
+	_test_eof216: cs = 216; goto _test_eof
+	_test_eof217: cs = 217; goto _test_eof
+	_test_eof218: cs = 218; goto _test_eof
+	_test_eof219: cs = 219; goto _test_eof
+	_test_eof220: cs = 220; goto _test_eof
+	_test_eof221: cs = 221; goto _test_eof
+	_test_eof222: cs = 222; goto _test_eof
+	_test_eof223: cs = 223; goto _test_eof
+	_test_eof224: cs = 224; goto _test_eof
+	_test_eof225: cs = 225; goto _test_eof
+	_test_eof226: cs = 226; goto _test_eof
+	_test_eof227: cs = 227; goto _test_eof
+	_test_eof228: cs = 228; goto _test_eof
+	_test_eof229: cs = 229; goto _test_eof
+	_test_eof230: cs = 230; goto _test_eof
+	_test_eof231: cs = 231; goto _test_eof
+	_test_eof232: cs = 232; goto _test_eof
+	_test_eof233: cs = 233; goto _test_eof
+	_test_eof234: cs = 234; goto _test_eof
+	_test_eof235: cs = 235; goto _test_eof
+	_test_eof236: cs = 236; goto _test_eof
+	_test_eof237: cs = 237; goto _test_eof
+	_test_eof238: cs = 238; goto _test_eof
+	_test_eof239: cs = 239; goto _test_eof
+	_test_eof240: cs = 240; goto _test_eof
+	_test_eof241: cs = 241; goto _test_eof
+	_test_eof242: cs = 242; goto _test_eof
+	_test_eof243: cs = 243; goto _test_eof
+	_test_eof244: cs = 244; goto _test_eof
+	_test_eof245: cs = 245; goto _test_eof
+	_test_eof246: cs = 246; goto _test_eof
+	_test_eof247: cs = 247; goto _test_eof
+	_test_eof248: cs = 248; goto _test_eof
+	_test_eof359: cs = 359; goto _test_eof
+	_test_eof249: cs = 249; goto _test_eof
+	_test_eof250: cs = 250; goto _test_eof
+	_test_eof251: cs = 251; goto _test_eof
+	_test_eof252: cs = 252; goto _test_eof
+	_test_eof253: cs = 253; goto _test_eof
+	_test_eof254: cs = 254; goto _test_eof
+	_test_eof255: cs = 255; goto _test_eof
+	_test_eof256: cs = 256; goto _test_eof
+	_test_eof257: cs = 257; goto _test_eof
+	_test_eof258: cs = 258; goto _test_eof
+	_test_eof259: cs = 259; goto _test_eof
+	_test_eof260: cs = 260; goto _test_eof
+	_test_eof261: cs = 261; goto _test_eof
+	_test_eof262: cs = 262; goto _test_eof
+	_test_eof263: cs = 263; goto _test_eof
+	_test_eof264: cs = 264; goto _test_eof
+	_test_eof265: cs = 265; goto _test_eof
+	_test_eof266: cs = 266; goto _test_eof
+	_test_eof267: cs = 267; goto _test_eof
+	_test_eof268: cs = 268; goto _test_eof
+	_test_eof269: cs = 269; goto _test_eof
+	_test_eof270: cs = 270; goto _test_eof
+	_test_eof271: cs = 271; goto _test_eof
+	_test_eof272: cs = 272; goto _test_eof
+	_test_eof273: cs = 273; goto _test_eof
+	_test_eof274: cs = 274; goto _test_eof
+	_test_eof275: cs = 275; goto _test_eof
+	_test_eof276: cs = 276; goto _test_eof
+	_test_eof277: cs = 277; goto _test_eof
+	_test_eof278: cs = 278; goto _test_eof
+	_test_eof279: cs = 279; goto _test_eof
+	_test_eof280: cs = 280; goto _test_eof
+	_test_eof281: cs = 281; goto _test_eof
+	_test_eof282: cs = 282; goto _test_eof
+	_test_eof283: cs = 283; goto _test_eof
+	_test_eof284: cs = 284; goto _test_eof
+	_test_eof285: cs = 285; goto _test_eof
+	_test_eof286: cs = 286; goto _test_eof
+	_test_eof287: cs = 287; goto _test_eof
+	_test_eof288: cs = 288; goto _test_eof
+	_test_eof289: cs = 289; goto _test_eof
+	_test_eof290: cs = 290; goto _test_eof
+	_test_eof291: cs = 291; goto _test_eof
+	_test_eof292: cs = 292; goto _test_eof
+	_test_eof293: cs = 293; goto _test_eof
+	_test_eof294: cs = 294; goto _test_eof
+	_test_eof295: cs = 295; goto _test_eof
+	_test_eof296: cs = 296; goto _test_eof
+	_test_eof297: cs = 297; goto _test_eof
+	_test_eof298: cs = 298; goto _test_eof
+	_test_eof299: cs = 299; goto _test_eof
+	_test_eof300: cs = 300; goto _test_eof
+	_test_eof301: cs = 301; goto _test_eof
+	_test_eof302: cs = 302; goto _test_eof
+	_test_eof303: cs = 303; goto _test_eof
+	_test_eof304: cs = 304; goto _test_eof
+	_test_eof360: cs = 360; goto _test_eof
+	_test_eof305: cs = 305; goto _test_eof
+	_test_eof306: cs = 306; goto _test_eof
+	_test_eof307: cs = 307; goto _test_eof
+	_test_eof308: cs = 308; goto _test_eof
+
+	_test_eof: {}
+	if p == eof {
+		switch cs {
+		case 316:
+//line wireguard.rl:85
+ c.Interface.Address = append(c.Interface.Address, data[mark:p]) 
+		case 318:
+//line wireguard.rl:86
+ c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) 
+		case 320, 321, 322, 323:
+//line wireguard.rl:84
+ c.Interface.FwMark = data[mark:p] 
+		case 324, 325, 326, 327, 328, 329, 330, 331, 332:
+//line wireguard.rl:83
+ c.Interface.ListenPort = n 
+		case 333, 334, 335, 336, 337, 338, 339, 340, 341:
+//line wireguard.rl:87
+ c.Interface.MTU = n 
+		case 342:
+//line wireguard.rl:82
+ c.Interface.PrivateKey = data[mark:p] 
+		case 347:
+//line wireguard.rl:91
+ peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) 
+		case 349:
+//line wireguard.rl:92
+ peer.Endpoint = data[mark:p] 
+		case 350, 351, 352, 353, 354, 355, 356, 357, 358:
+//line wireguard.rl:93
+ peer.PersistentKeepalive = n 
+		case 359:
+//line wireguard.rl:90
+ peer.PresharedKey = data[mark:p] 
+		case 360:
+//line wireguard.rl:89
+ peer.PublicKey = data[mark:p] 
+//line wireguard.go:7884
+		}
+	}
+
+	_out: {}
+	}
+
+//line wireguard.rl:149
+
+
+	if cs < wireguard_first_final {
+		e := &WGSyntaxError{Line: bytes.Count(data[:p], []byte("\n")) + 1, Offset: p}
+		if p == pe {
+			e.Msg = "unexpected end of file"
+		} else {
+			e.Msg = "unexpected " + strconv.QuoteRune(rune(data[p]))
+		}
+		return WGConfig{}, e
+	}
+
+	return c, nil
+}
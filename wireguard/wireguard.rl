@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// WGConfig is a WireGuard configuration file as read by wg(8) and
+// wg-quick(8).  The byte slices point into the parsed file.
+type WGConfig struct {
+	Interface WGInterface
+	Peers     []WGPeer
+}
+
+// WGInterface holds the [Interface] section.  Address, DNS, and MTU are
+// only used by wg-quick.
+type WGInterface struct {
+	PrivateKey []byte
+	ListenPort int
+	FwMark     []byte
+	Address    [][]byte
+	DNS        [][]byte
+	MTU        int
+}
+
+// WGPeer holds a [Peer] section.  A PersistentKeepalive of zero is off.
+type WGPeer struct {
+	PublicKey           []byte
+	PresharedKey        []byte
+	AllowedIPs          [][]byte
+	Endpoint            []byte
+	PersistentKeepalive int
+}
+
+// A WGSyntaxError reports where ParseWireGuardConfig stopped.
+type WGSyntaxError struct {
+	Line   int
+	Offset int
+	Msg    string
+}
+
+func (e *WGSyntaxError) Error() string {
+	return "wireguard: " + e.Msg + " on line " + strconv.Itoa(e.Line)
+}
+
+// ParseWireGuardConfig parses a WireGuard configuration file.  Section
+// names and keys are case-insensitive, and each key is only accepted in its
+// own section.  Keys must be in base64 and ports and the like in range.
+// Comma separated values are split apart, and repeating a key adds to its
+// list instead of replacing it.  A backslash at the end of a line continues
+// the line, which is allowed wherever whitespace is, so a long list can be
+// written as
+//
+//	AllowedIPs = 10.0.0.0/8, \
+//	             192.168.0.0/16
+//
+// The script hooks and other wg-quick settings not in WGInterface are
+// rejected.
+func ParseWireGuardConfig(data []byte) (WGConfig, error) {
+
+%% machine wireguard;
+%% write data;
+
+	var c WGConfig
+	var peer *WGPeer
+
+	cs, p, pe, eof := 0, 0, len(data), len(data)
+
+	mark := 0
+	n := 0
+
+	%%{
+	    action mark     { mark = p }
+	    action num      { n = n*10 + int(fc-'0') }
+	    action num_init { n = 0 }
+
+	    action peer {
+	        c.Peers = append(c.Peers, WGPeer{})
+	        peer = &c.Peers[len(c.Peers)-1]
+	    }
+
+	    action private_key { c.Interface.PrivateKey = data[mark:p] }
+	    action listen_port { c.Interface.ListenPort = n }
+	    action fwmark      { c.Interface.FwMark = data[mark:p] }
+	    action address     { c.Interface.Address = append(c.Interface.Address, data[mark:p]) }
+	    action dns         { c.Interface.DNS = append(c.Interface.DNS, data[mark:p]) }
+	    action mtu         { c.Interface.MTU = n }
+
+	    action public_key    { peer.PublicKey = data[mark:p] }
+	    action preshared_key { peer.PresharedKey = data[mark:p] }
+	    action allowed_ips   { peer.AllowedIPs = append(peer.AllowedIPs, data[mark:p]) }
+	    action endpoint      { peer.Endpoint = data[mark:p] }
+	    action keepalive     { peer.PersistentKeepalive = n }
+
+	    ws = [ \t] ;
+	    continuation = '\\' '\r'? '\n' ;
+	    sp = ( ws | continuation )* ;
+
+	    comment = '#' [^\n]* ;
+	    blank = ws* comment? '\r'? ;
+	    tail = sp comment? '\r'? ;
+
+	    # 32 bytes of base64, so the last character only has 4 bits set
+	    key = [A-Za-z0-9+/]{42} [AEIMQUYcgkosw048] '=' ;
+
+	    # 0-65535
+	    uint16 = ( digit{1,4}
+	             | '1'..'5' digit{4}
+	             | '6' '0'..'4' digit{3}
+	             | '65' '0'..'4' digit{2}
+	             | '655' '0'..'2' digit
+	             | '6553' '0'..'5' ) >num_init $num ;
+
+	    item = ( any - ( space | [,#\\] ) )+ ;
+
+	    fwmark = 'off'i | digit+ | '0x'i xdigit+ ;
+	    keepalive = 'off'i @num_init | uint16 ;
+
+	    private_key = 'PrivateKey'i sp '=' sp key >mark %private_key ;
+	    listen_port = 'ListenPort'i sp '=' sp uint16 %listen_port ;
+	    fwmark_kv = 'FwMark'i sp '=' sp fwmark >mark %fwmark ;
+	    address = 'Address'i sp '=' sp item >mark %address ( sp ',' sp item >mark %address )* ;
+	    dns = 'DNS'i sp '=' sp item >mark %dns ( sp ',' sp item >mark %dns )* ;
+	    mtu = 'MTU'i sp '=' sp uint16 %mtu ;
+
+	    public_key = 'PublicKey'i sp '=' sp key >mark %public_key ;
+	    preshared_key = 'PresharedKey'i sp '=' sp key >mark %preshared_key ;
+	    allowed_ips = 'AllowedIPs'i sp '=' sp item >mark %allowed_ips ( sp ',' sp item >mark %allowed_ips )* ;
+	    endpoint = 'Endpoint'i sp '=' sp item >mark %endpoint ;
+	    keepalive_kv = 'PersistentKeepalive'i sp '=' sp keepalive %keepalive ;
+
+	    interface_kv = private_key | listen_port | fwmark_kv | address | dns | mtu ;
+	    peer_kv = public_key | preshared_key | allowed_ips | endpoint | keepalive_kv ;
+
+	    interface_line = ws* interface_kv tail | blank ;
+	    peer_line = ws* peer_kv tail | blank ;
+
+	    interface_section = ws* '[Interface]'i tail ( '\n' interface_line )* ;
+	    peer_section = ws* '[Peer]'i @peer tail ( '\n' peer_line )* ;
+	    section = interface_section | peer_section ;
+
+	    # lines before the first section may only be blank or comments
+	    preamble = blank ( '\n' blank )* ;
+
+	    main := preamble | ( preamble '\n' )? section ( '\n' section )* ;
+
+	    write init;
+	    write exec;
+	}%%
+
+	if cs < wireguard_first_final {
+		e := &WGSyntaxError{Line: bytes.Count(data[:p], []byte("\n")) + 1, Offset: p}
+		if p == pe {
+			e.Msg = "unexpected end of file"
+		} else {
+			e.Msg = "unexpected " + strconv.QuoteRune(rune(data[p]))
+		}
+		return WGConfig{}, e
+	}
+
+	return c, nil
+}
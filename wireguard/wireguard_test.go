@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// the example from wg(8)
+var data = []byte(`[Interface]
+PrivateKey = yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=
+ListenPort = 51820
+
+[Peer]
+PublicKey = xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=
+Endpoint = 192.95.5.67:1234
+AllowedIPs = 10.192.122.3/32, 10.192.124.1/24
+
+[Peer]
+PublicKey = TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0=
+Endpoint = [2607:5300:60:6b0::c05f:543]:2468
+AllowedIPs = 10.192.122.4/32, 192.168.0.0/16
+
+[Peer]
+PublicKey = gN65BkIKy1eCE9pP1wdc8ROUtkHLF2PfAqYdyYBz6EA=
+Endpoint = test.wireguard.com:18981
+AllowedIPs = 10.10.10.230/32
+`)
+
+var hits int
+
+func bs(s ...string) [][]byte {
+	var b [][]byte
+	for _, s := range s {
+		b = append(b, []byte(s))
+	}
+	return b
+}
+
+func TestParseWireGuardConfig(t *testing.T) {
+	want := WGConfig{
+		Interface: WGInterface{
+			PrivateKey: []byte("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			ListenPort: 51820,
+		},
+		Peers: []WGPeer{
+			{
+				PublicKey:  []byte("xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="),
+				Endpoint:   []byte("192.95.5.67:1234"),
+				AllowedIPs: bs("10.192.122.3/32", "10.192.124.1/24"),
+			},
+			{
+				PublicKey:  []byte("TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0="),
+				Endpoint:   []byte("[2607:5300:60:6b0::c05f:543]:2468"),
+				AllowedIPs: bs("10.192.122.4/32", "192.168.0.0/16"),
+			},
+			{
+				PublicKey:  []byte("gN65BkIKy1eCE9pP1wdc8ROUtkHLF2PfAqYdyYBz6EA="),
+				Endpoint:   []byte("test.wireguard.com:18981"),
+				AllowedIPs: bs("10.10.10.230/32"),
+			},
+		},
+	}
+
+	got, err := ParseWireGuardConfig(data)
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWireGuardConfig(wg(8) example)=%+v, %v, want %+v", got, err, want)
+	}
+
+	// wg-quick settings, comments, odd spacing and case, CRLF line
+	// endings, continuations, and no final newline
+	quick := "# laptop\r\n" +
+		"\r\n" +
+		"[interface]\r\n" +
+		"  privatekey=yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=  # secret\r\n" +
+		"Address = 10.200.100.8/24, fd00::8/64\r\n" +
+		"DNS = 10.200.100.1\r\n" +
+		"MTU = 1420\r\n" +
+		"FwMark = 0xca6c\r\n" +
+		"[Peer]\r\n" +
+		"PublicKey = GtL7fZc/bLnqZldpVofMCD6hDjrK28SsdLxevJ+qtKU=\r\n" +
+		"PresharedKey = /UwcSPg38hW/D9Y3tcS1FOV0K1wuURMbS0sesJEP5ak=\r\n" +
+		"AllowedIPs = 0.0.0.0/0, \\\r\n" +
+		"             ::/0\r\n" +
+		"AllowedIPs = 10.0.0.0/8\r\n" +
+		"Endpoint = demo.wireguard.com:51820\r\n" +
+		"PersistentKeepalive = 25"
+
+	want = WGConfig{
+		Interface: WGInterface{
+			PrivateKey: []byte("yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk="),
+			FwMark:     []byte("0xca6c"),
+			Address:    bs("10.200.100.8/24", "fd00::8/64"),
+			DNS:        bs("10.200.100.1"),
+			MTU:        1420,
+		},
+		Peers: []WGPeer{{
+			PublicKey:           []byte("GtL7fZc/bLnqZldpVofMCD6hDjrK28SsdLxevJ+qtKU="),
+			PresharedKey:        []byte("/UwcSPg38hW/D9Y3tcS1FOV0K1wuURMbS0sesJEP5ak="),
+			AllowedIPs:          bs("0.0.0.0/0", "::/0", "10.0.0.0/8"),
+			Endpoint:            []byte("demo.wireguard.com:51820"),
+			PersistentKeepalive: 25,
+		}},
+	}
+
+	got, err = ParseWireGuardConfig([]byte(quick))
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseWireGuardConfig(wg-quick example)=%+v, %v, want %+v", got, err, want)
+	}
+
+	for _, s := range []string{"", "\n", "# nothing here", "[Interface]", "[Peer]\nPersistentKeepalive = off\n"} {
+		if _, err := ParseWireGuardConfig([]byte(s)); err != nil {
+			t.Errorf("ParseWireGuardConfig(%q)=%v", s, err)
+		}
+	}
+}
+
+func TestParseWireGuardConfigErrors(t *testing.T) {
+	tests := []struct {
+		conf string
+		line int
+		msg  string
+	}{
+		{"PrivateKey = yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk=\n", 1, "unexpected 'P'"},
+		{"[Interface]\nListenPort = 65536\n", 2, "unexpected '6'"},
+		{"[Interface]\nListenPort = 51820x\n", 2, "unexpected 'x'"},
+		{"[Interface]\nPublicKey = xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg=\n", 2, "unexpected 'u'"},
+		{"[Interface]\nPostUp = iptables -A FORWARD -i wg0 -j ACCEPT\n", 2, "unexpected 'o'"},
+		{"[Peer]\nPublicKey = xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8D\n", 2, "unexpected '\\n'"},
+		{"[Peer]\nPublicKey = xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dh=\n", 2, "unexpected 'h'"},
+		{"[Peer]\nAllowedIPs = 10.0.0.0/8,\n", 2, "unexpected '\\n'"},
+		{"[Peer]\nAllowedIPs = 10.0.0.0/8 192.168.0.0/16\n", 2, "unexpected '1'"},
+		{"[Peer]\nEndpoint =", 2, "unexpected end of file"},
+		{"[Peers]\n", 1, "unexpected 's'"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseWireGuardConfig([]byte(tt.conf))
+		var se *WGSyntaxError
+		if !errors.As(err, &se) || se.Line != tt.line || se.Msg != tt.msg {
+			t.Errorf("ParseWireGuardConfig(%q)=%v, want %q on line %d", tt.conf, err, tt.msg, tt.line)
+		}
+	}
+}
+
+// scanWireGuardConfig is a typical hand written parser.  It doesn't check
+// the keys or which section they're in, and doesn't handle continuations.
+func scanWireGuardConfig(data []byte) (WGConfig, bool) {
+	var c WGConfig
+	var peer *WGPeer
+	inInterface := false
+	split := func(v string) [][]byte {
+		var out [][]byte
+		for _, f := range strings.Split(v, ",") {
+			out = append(out, []byte(strings.TrimSpace(f)))
+		}
+		return out
+	}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case strings.EqualFold(line, "[Interface]"):
+			inInterface = true
+			continue
+		case strings.EqualFold(line, "[Peer]"):
+			inInterface = false
+			c.Peers = append(c.Peers, WGPeer{})
+			peer = &c.Peers[len(c.Peers)-1]
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			return WGConfig{}, false
+		}
+		k, v = strings.ToLower(strings.TrimSpace(k)), strings.TrimSpace(v)
+		var err error
+		switch {
+		case inInterface && k == "privatekey":
+			c.Interface.PrivateKey = []byte(v)
+		case inInterface && k == "listenport":
+			c.Interface.ListenPort, err = strconv.Atoi(v)
+		case peer != nil && k == "publickey":
+			peer.PublicKey = []byte(v)
+		case peer != nil && k == "endpoint":
+			peer.Endpoint = []byte(v)
+		case peer != nil && k == "allowedips":
+			peer.AllowedIPs = append(peer.AllowedIPs, split(v)...)
+		default:
+			return WGConfig{}, false
+		}
+		if err != nil {
+			return WGConfig{}, false
+		}
+	}
+	return c, true
+}
+
+func TestWireGuardAlternatives(t *testing.T) {
+	want, _ := ParseWireGuardConfig(data)
+	if got, ok := scanWireGuardConfig(data); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("scanWireGuardConfig=%+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkScanner(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, ok := scanWireGuardConfig(data); ok {
+			hits++
+		}
+	}
+}
+
+func BenchmarkRagel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseWireGuardConfig(data); err == nil {
+			hits++
+		}
+	}
+}